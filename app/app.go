@@ -173,7 +173,16 @@ var (
 				axelarcorkclient.CommunityPoolEthereumSpendProposalHandler,
 				axelarcorkclient.UpgradeAxelarProxyContractHandler,
 				axelarcorkclient.CancelAxelarProxyContractUpgradeHandler,
+				axelarcorkclient.SetCellarSelectorAllowlistHandler,
+				axelarcorkclient.SetCellarPausedHandler,
+				axelarcorkclient.SetCellarABIHandler,
+				axelarcorkclient.SetCellarMetadataHandler,
 				corkclient.ScheduledCorkProposalHandler,
+				corkclient.SetCellarVoteThresholdProposalHandler,
+				corkclient.SetCellarSelectorAllowlistProposalHandler,
+				corkclient.SetCellarPausedProposalHandler,
+				corkclient.SetCellarABIProposalHandler,
+				corkclient.SetCellarMetadataProposalHandler,
 				auctionclient.SetProposalHandler,
 				pubsubclient.AddPublisherProposalHandler,
 				pubsubclient.RemovePublisherProposalHandler,
@@ -468,7 +477,7 @@ func NewSommelierApp(
 
 	app.CorkKeeper = corkkeeper.NewKeeper(
 		appCodec, keys[corktypes.StoreKey], app.GetSubspace(corktypes.ModuleName),
-		app.StakingKeeper, app.GravityKeeper, app.PubsubKeeper,
+		app.StakingKeeper, app.SlashingKeeper, app.GravityKeeper, app.PubsubKeeper,
 	)
 
 	app.AuctionKeeper = auctionkeeper.NewKeeper(
@@ -512,6 +521,11 @@ func NewSommelierApp(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter, app.MsgServiceRouter(), govtypes.DefaultConfig(),
 	)
+	app.GovKeeper = *app.GovKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+			app.CorkKeeper.GovHooks(),
+			app.AxelarCorkKeeper.GovHooks(),
+		))
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
@@ -560,14 +574,14 @@ func NewSommelierApp(
 		ica.NewAppModule(nil, &app.ICAHostKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
-		axelarcork.NewAppModule(app.AxelarCorkKeeper, appCodec),
+		axelarcork.NewAppModule(app.AxelarCorkKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper, app.IBCKeeper.ChannelKeeper),
 		gravity.NewAppModule(app.GravityKeeper, app.BankKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-		cork.NewAppModule(app.CorkKeeper, appCodec),
+		cork.NewAppModule(app.CorkKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper),
 		incentives.NewAppModule(app.IncentivesKeeper, app.DistrKeeper, app.BankKeeper, app.MintKeeper, appCodec),
 		cellarfees.NewAppModule(app.CellarFeesKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.MintKeeper, app.CorkKeeper, app.GravityKeeper, app.AuctionKeeper),
 		auction.NewAppModule(app.AuctionKeeper, app.BankKeeper, app.AccountKeeper, appCodec),
-		pubsub.NewAppModule(appCodec, app.PubsubKeeper, app.StakingKeeper, app.GravityKeeper),
+		pubsub.NewAppModule(appCodec, app.PubsubKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -652,7 +666,6 @@ func NewSommelierApp(
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -670,6 +683,7 @@ func NewSommelierApp(
 		cellarfeestypes.ModuleName,
 		auctiontypes.ModuleName,
 		pubsubtypes.ModuleName,
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -697,12 +711,13 @@ func NewSommelierApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-		cork.NewAppModule(app.CorkKeeper, appCodec),
-		axelarcork.NewAppModule(app.AxelarCorkKeeper, appCodec),
+		gravitySimulationModule{gravity.NewAppModule(app.GravityKeeper, app.BankKeeper)},
+		cork.NewAppModule(app.CorkKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper),
+		axelarcork.NewAppModule(app.AxelarCorkKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper, app.IBCKeeper.ChannelKeeper),
 		incentives.NewAppModule(app.IncentivesKeeper, app.DistrKeeper, app.BankKeeper, app.MintKeeper, appCodec),
 		cellarfees.NewAppModule(app.CellarFeesKeeper, appCodec, app.AccountKeeper, app.BankKeeper, app.MintKeeper, app.CorkKeeper, app.GravityKeeper, app.AuctionKeeper),
 		auction.NewAppModule(app.AuctionKeeper, app.BankKeeper, app.AccountKeeper, appCodec),
-		pubsub.NewAppModule(appCodec, app.PubsubKeeper, app.StakingKeeper, app.GravityKeeper),
+		pubsub.NewAppModule(appCodec, app.PubsubKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GravityKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -15,6 +15,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	appparams "github.com/peggyjv/sommelier/v7/app/params"
+	axelarcorktypes "github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+	corktypes "github.com/peggyjv/sommelier/v7/x/cork/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -98,6 +100,56 @@ func TestGetMaccPerms(t *testing.T) {
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
 
+// ensure that scheduled cork proposals carrying only a contract call JSON fail through the gov router when no
+// contract call encoder is registered for their cellar type, rather than being dropped
+func TestScheduledCorkProposalWithoutEncoder(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(100000000000000))),
+	}
+
+	app := NewSommelierApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	genesisState := genesisStateWithValSet(t, app, NewDefaultGenesisState(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := tmjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		},
+	)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	cellarID := "0x6ea5992aB4A78D5720bD12A089D13c073d04B55d"
+	protoJSON := "{\"cellar_id\":\"" + cellarID + "\",\"cellar_v1\":{\"trust_position\":{}},\"block_height\":10}"
+
+	app.CorkKeeper.SetCellar(ctx, corktypes.ManagedCellar{CellarId: cellarID})
+	corkHandler := app.GovKeeper.LegacyRouter().GetRoute(corktypes.RouterKey)
+	corkProposal := corktypes.NewScheduledCorkProposal("title", "description", 10, cellarID, protoJSON, nil)
+	require.ErrorIs(t, corkHandler(ctx, corkProposal), corktypes.ErrContractCallEncoderNotFound)
+	require.Empty(t, app.CorkKeeper.GetGovernanceScheduledCorks(ctx))
+
+	chainID := uint64(1)
+	app.AxelarCorkKeeper.SetChainConfiguration(ctx, chainID, axelarcorktypes.ChainConfiguration{
+		Name:         "Ethereum",
+		Id:           chainID,
+		ProxyAddress: "0x1111111111111111111111111111111111111111",
+	})
+	app.AxelarCorkKeeper.SetCellar(ctx, axelarcorktypes.AxelarManagedCellar{ChainId: chainID, CellarId: cellarID})
+	axelarCorkHandler := app.GovKeeper.LegacyRouter().GetRoute(axelarcorktypes.RouterKey)
+	axelarCorkProposal := axelarcorktypes.NewAxelarScheduledCorkProposal("title", "description", 10, chainID, cellarID, protoJSON, uint64(ctx.BlockTime().Unix())+3600, nil)
+	require.ErrorIs(t, axelarCorkHandler(ctx, axelarCorkProposal), axelarcorktypes.ErrContractCallEncoderNotFound)
+	require.Empty(t, app.AxelarCorkKeeper.GetGovernanceScheduledAxelarCorks(ctx, chainID))
+}
+
 func genesisStateWithValSet(t *testing.T,
 	app *SommelierApp, genesisState GenesisState,
 	valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount,
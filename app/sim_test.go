@@ -75,6 +75,15 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// regressionSeeds are simulation seeds that previously failed, with -NumBlocks=50 -BlockSize=50. Before axelar cork
+// deadlines were drawn within the deadline horizon and past the votes, they were drawn up to a day ahead.
+var regressionSeeds = []int64{
+	1, // axelar_cork_schedule: a validator's vote in the next block was rejected as the cork deadline had passed
+	3, // axelar_cork_schedule: a validator's vote in the next block was rejected as the cork deadline had passed
+	4, // axelar_cork_schedule: a validator's vote in the next block was rejected as the cork deadline had passed
+	5, // axelarcork EndBlocker divided by zero when tallying scheduled corks without any bonded power
+}
+
 func TestAppStateDeterminism(t *testing.T) {
 	if !simapp.FlagEnabledValue {
 		t.Skip("skipping application simulation")
@@ -87,12 +96,18 @@ func TestAppStateDeterminism(t *testing.T) {
 	config.AllInvariants = false
 	config.ChainID = "sommerlier-1"
 
-	numSeeds := 3
+	// seeds that previously failed are always run before the random ones
+	seeds := append([]int64{}, regressionSeeds...)
+	for i := 0; i < 3; i++ {
+		seeds = append(seeds, rand.Int63())
+	}
+
+	numSeeds := len(seeds)
 	numTimesToRunPerSeed := 5
 	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
 
 	for i := 0; i < numSeeds; i++ {
-		config.Seed = rand.Int63()
+		config.Seed = seeds[i]
 
 		for j := 0; j < numTimesToRunPerSeed; j++ {
 			var logger log.Logger
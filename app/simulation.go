@@ -0,0 +1,18 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/peggyjv/gravity-bridge/module/v4/x/gravity"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+)
+
+// gravitySimulationModule supplies the gravity module's default genesis to the simulator, since the gravity module
+// doesn't generate one itself and its begin blocker requires its params to be set
+type gravitySimulationModule struct {
+	gravity.AppModule
+}
+
+// GenerateGenesisState sets the default gravity genesis state
+func (gravitySimulationModule) GenerateGenesisState(simState *module.SimulationState) {
+	simState.GenState[gravitytypes.ModuleName] = simState.Cdc.MustMarshalJSON(gravitytypes.DefaultGenesisState())
+}
@@ -266,15 +266,19 @@ func (s *IntegrationTestSuite) TestAxelarCork() {
 		///////////////////////////////////////////////
 
 		protoJSON := "{\"cellar_id\":\"0x123801a7D398351b8bE11C439e05C5B3259aeC9B\",\"cellar_v1\":{\"some_fuction\":{\"function_args\":{}},\"block_height\":12345}}"
+		status, err = node.Status(context.Background())
+		s.Require().NoError(err)
+		governanceBlockHeight := uint64(status.SyncInfo.LatestBlockHeight + 1000)
 		s.T().Log("Creating AxelarScheduledCorkProposal for counter contract")
 		addAxelarScheduledCorkProp := types.AxelarScheduledCorkProposal{
 			Title:                 "schedule a cork to the counter contract",
 			Description:           "arbitrum counter contract scheduled cork",
-			BlockHeight:           targetBlockHeight,
+			BlockHeight:           governanceBlockHeight,
 			ChainId:               arbitrumChainID,
 			TargetContractAddress: counterContract.Hex(),
 			ContractCallProtoJson: protoJSON,
 			Deadline:              deadline,
+			EncodedContractCall:   ABIEncodedInc(),
 		}
 
 		addAxelarScheduledCorkPropMsg, err := govtypesv1beta1.NewMsgSubmitProposal(
@@ -304,8 +308,16 @@ func (s *IntegrationTestSuite) TestAxelarCork() {
 		s.Require().Equal(propContent.TargetContractAddress, addAxelarScheduledCorkProp.TargetContractAddress)
 		s.Require().Equal(propContent.ContractCallProtoJson, addAxelarScheduledCorkProp.ContractCallProtoJson)
 		s.Require().Equal(propContent.Deadline, addAxelarScheduledCorkProp.Deadline)
+		s.Require().Equal(propContent.EncodedContractCall, addAxelarScheduledCorkProp.EncodedContractCall)
 		propID++
 
+		s.T().Log("Verifying the governance axelar cork was scheduled")
+		governanceCorksResponse, err := axelarcorkQueryClient.QueryGovernanceScheduledCorks(context.Background(), &types.QueryGovernanceScheduledAxelarCorksRequest{ChainId: arbitrumChainID})
+		s.Require().NoError(err)
+		s.Require().Len(governanceCorksResponse.Corks, 1)
+		s.Require().Equal(governanceBlockHeight, governanceCorksResponse.Corks[0].BlockHeight)
+		s.Require().Equal(ABIEncodedInc(), governanceCorksResponse.Corks[0].Cork.EncodedContractCall)
+
 		//////////////////////////////////////
 		// Upgrade an Axelar proxy contract //
 		//////////////////////////////////////
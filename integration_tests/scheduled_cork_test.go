@@ -266,12 +266,16 @@ func (s *IntegrationTestSuite) TestScheduledCork() {
 		}, time.Minute, 10*time.Second, "count was never updated")
 
 		s.T().Log("Test governance-scheduled cork creation")
+		status, err = node.Status(context.Background())
+		s.Require().NoError(err)
+		governanceBlockHeight := uint64(status.SyncInfo.LatestBlockHeight + 1000)
 		corkProposal := types.ScheduledCorkProposal{
 			Title:                 "initial token price submission",
 			Description:           "our first token prices",
 			TargetContractAddress: unusedGenesisContract.Hex(),
 			ContractCallProtoJson: "{\"cellar_id\":\"0x123801a7D398351b8bE11C439e05C5B3259aeC9B\",\"cellar_v1\":{\"some_fuction\":{\"function_args\":{}},\"block_height\":12345}}",
-			BlockHeight:           uint64(targetBlockHeight),
+			BlockHeight:           governanceBlockHeight,
+			EncodedContractCall:   ABIEncodedInc(),
 		}
 		corkProposalMsg, err := govtypesv1beta1.NewMsgSubmitProposal(
 			&corkProposal,
@@ -326,6 +330,13 @@ func (s *IntegrationTestSuite) TestScheduledCork() {
 		}, time.Second*30, time.Second*5, "proposal was never accepted")
 		s.T().Log("Proposal approved!")
 
+		s.T().Log("Verify the governance cork was scheduled")
+		governanceCorksResponse, err := corkQueryClient.QueryGovernanceScheduledCorks(context.Background(), &types.QueryGovernanceScheduledCorksRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(governanceCorksResponse.Corks, 1)
+		s.Require().Equal(governanceBlockHeight, governanceCorksResponse.Corks[0].BlockHeight)
+		s.Require().Equal(ABIEncodedInc(), governanceCorksResponse.Corks[0].Cork.EncodedContractCall)
+
 		s.T().Logf("create governance proposal to remove counter contract")
 		removalProposal := types.RemoveManagedCellarIDsProposal{
 			Title:       "add counter contract in test",
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/peggyjv/sommelier/v7/app/params"
+	"github.com/peggyjv/sommelier/v7/x/auction/types"
+)
+
+// RegisterInvariants registers all auction invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bid-payments", BidPaymentsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the auction module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return BidPaymentsInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the auction module holds exactly the remaining tokens for sale of each active
+// auction, plus the usomm proceeds of active auctions that have yet to be sent to their proceeds module
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := map[string]sdk.Int{params.BaseCoinUnit: sdk.ZeroInt()}
+		for _, auction := range k.GetActiveAuctions(ctx) {
+			denom := auction.RemainingTokensForSale.Denom
+			if _, ok := expected[denom]; !ok {
+				expected[denom] = sdk.ZeroInt()
+			}
+			expected[denom] = expected[denom].Add(auction.RemainingTokensForSale.Amount)
+
+			for _, bid := range k.GetBidsByAuctionID(ctx, auction.Id) {
+				expected[params.BaseCoinUnit] = expected[params.BaseCoinUnit].Add(bid.TotalUsommPaid.Amount)
+			}
+		}
+
+		denoms := make([]string, 0, len(expected))
+		for denom := range expected {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		var msg string
+		broken := false
+		moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+		for _, denom := range denoms {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddress, denom)
+			if !balance.Amount.Equal(expected[denom]) {
+				broken = true
+				msg += fmt.Sprintf("\tmodule balance of %s is %s, expected %s\n", denom, balance.Amount, expected[denom])
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module balance",
+			fmt.Sprintf("module balances not matching active auctions:\n%s", msg)), broken
+	}
+}
+
+// BidPaymentsInvariant checks that the usomm paid for every bid is the cost of its fulfilled sale tokens at the
+// bid's unit price, rounded up as it is when the bid is placed
+func BidPaymentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IterateBids(ctx, func(auctionID uint32, bidID uint64, bid types.Bid) (stop bool) {
+			expected := sdk.NewInt(sdk.NewDecFromInt(bid.TotalFulfilledSaleTokens.Amount).Mul(bid.SaleTokenUnitPriceInUsomm).Ceil().TruncateInt64())
+			if bid.TotalUsommPaid.Denom != params.BaseCoinUnit || !bid.TotalUsommPaid.Amount.Equal(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d bid %d paid %s for %s at %s, expected %s%s\n",
+					auctionID, bidID, bid.TotalUsommPaid, bid.TotalFulfilledSaleTokens, bid.SaleTokenUnitPriceInUsomm, expected, params.BaseCoinUnit)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "bid payments",
+			fmt.Sprintf("bids with inconsistent payments:\n%s", msg)), broken
+	}
+}
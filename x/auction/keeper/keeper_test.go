@@ -455,3 +455,65 @@ func (suite *KeeperTestSuite) TestUnhappyPathsForBeginAuction() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	ctx, auctionKeeper := suite.ctx, suite.auctionKeeper
+	require := suite.Require()
+
+	moduleAddress := authtypes.NewModuleAddress(auctionTypes.ModuleName)
+	saleToken := "gravity0xdac17f958d2ee523a2206206994597c13d831ec7"
+	auction := auctionTypes.Auction{
+		Id:                         1,
+		StartingTokensForSale:      sdk.NewCoin(saleToken, sdk.NewInt(10000)),
+		StartBlock:                 1,
+		InitialPriceDecreaseRate:   sdk.MustNewDecFromStr("0.05"),
+		CurrentPriceDecreaseRate:   sdk.MustNewDecFromStr("0.05"),
+		PriceDecreaseBlockInterval: 10,
+		InitialUnitPriceInUsomm:    sdk.MustNewDecFromStr("0.5"),
+		CurrentUnitPriceInUsomm:    sdk.MustNewDecFromStr("0.5"),
+		RemainingTokensForSale:     sdk.NewCoin(saleToken, sdk.NewInt(7500)),
+		FundingModuleAccount:       permissionedFunder.GetName(),
+		ProceedsModuleAccount:      permissionedReciever.GetName(),
+	}
+	auctionKeeper.setActiveAuction(ctx, auction)
+
+	bid := auctionTypes.Bid{
+		Id:                        1,
+		AuctionId:                 1,
+		Bidder:                    cosmosAddress1,
+		MaxBidInUsomm:             sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1251)),
+		SaleTokenMinimumAmount:    sdk.NewCoin(saleToken, sdk.NewInt(1)),
+		TotalFulfilledSaleTokens:  sdk.NewCoin(saleToken, sdk.NewInt(2500)),
+		SaleTokenUnitPriceInUsomm: sdk.MustNewDecFromStr("0.5"),
+		TotalUsommPaid:            sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1250)),
+		BlockHeight:               2,
+	}
+	auctionKeeper.setBid(ctx, bid)
+
+	// Balances matching the remaining tokens for sale and unsent proceeds
+	suite.mockGetBalance(ctx, moduleAddress, saleToken, sdk.NewCoin(saleToken, sdk.NewInt(7500)))
+	suite.mockGetBalance(ctx, moduleAddress, params.BaseCoinUnit, sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1250)))
+	_, broken := ModuleBalanceInvariant(auctionKeeper)(ctx)
+	require.False(broken)
+
+	suite.mockGetBalance(ctx, moduleAddress, saleToken, sdk.NewCoin(saleToken, sdk.NewInt(7000)))
+	suite.mockGetBalance(ctx, moduleAddress, params.BaseCoinUnit, sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1250)))
+	msg, broken := ModuleBalanceInvariant(auctionKeeper)(ctx)
+	require.True(broken)
+	require.Contains(msg, fmt.Sprintf("module balance of %s is 7000, expected 7500", saleToken))
+
+	_, broken = BidPaymentsInvariant(auctionKeeper)(ctx)
+	require.False(broken)
+
+	// Fractional costs are rounded up
+	bid.TotalFulfilledSaleTokens = sdk.NewCoin(saleToken, sdk.NewInt(2501))
+	bid.TotalUsommPaid = sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1251))
+	auctionKeeper.setBid(ctx, bid)
+	_, broken = BidPaymentsInvariant(auctionKeeper)(ctx)
+	require.False(broken)
+
+	bid.TotalUsommPaid = sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(1250))
+	auctionKeeper.setBid(ctx, bid)
+	_, broken = BidPaymentsInvariant(auctionKeeper)(ctx)
+	require.True(broken)
+}
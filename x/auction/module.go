@@ -14,6 +14,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/peggyjv/sommelier/v7/x/auction/client/cli"
 	"github.com/peggyjv/sommelier/v7/x/auction/keeper"
+	"github.com/peggyjv/sommelier/v7/x/auction/simulation"
 	"github.com/peggyjv/sommelier/v7/x/auction/types"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -96,8 +97,10 @@ func NewAppModule(keeper keeper.Keeper, bankKeeper types.BankKeeper, accountKeep
 // Name returns the auction module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the auction module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auction module.
 func (am AppModule) Route() sdk.Route { return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper)) }
@@ -116,7 +119,7 @@ func (AppModule) ConsensusVersion() uint64 {
 }
 
 func (am AppModule) WeightedOperations(simState module.SimulationState) []sim.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper)
 }
 
 // RegisterServices registers module services.
@@ -154,14 +157,15 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the distribution module.
+// GenerateGenesisState creates a randomized GenState of the auction module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents returns all the distribution content functions used to
 // simulate governance proposals.
 func (am AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
-	return nil
+	return simulation.ProposalContents(am.keeper)
 }
 
 // RandomizedParams creates randomized distribution param changes for the simulator.
@@ -0,0 +1,161 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+	"github.com/peggyjv/sommelier/v7/app/params"
+	"github.com/peggyjv/sommelier/v7/x/auction/types"
+	cellarfeestypes "github.com/peggyjv/sommelier/v7/x/cellarfees/types"
+)
+
+// Simulation parameter constants
+const (
+	MinimumBidInUsomm                    = "minimum_bid_in_usomm"
+	AuctionMaxBlockAge                   = "auction_max_block_age"
+	AuctionPriceDecreaseAccelerationRate = "auction_price_decrease_acceleration_rate"
+	SaleDenoms                           = "sale_denoms"
+)
+
+// GenMinimumBidInUsomm randomized MinimumBidInUsomm
+func GenMinimumBidInUsomm(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000000))
+}
+
+// GenAuctionMaxBlockAge randomized AuctionMaxBlockAge
+func GenAuctionMaxBlockAge(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200))
+}
+
+// GenAuctionPriceDecreaseAccelerationRate randomized AuctionPriceDecreaseAccelerationRate
+func GenAuctionPriceDecreaseAccelerationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(100)), 3)
+}
+
+// GenSaleDenoms randomized set of gravity denoms with active auctions at genesis
+func GenSaleDenoms(r *rand.Rand) []string {
+	denoms := make([]string, simtypes.RandIntBetween(r, 1, 4))
+	for i := range denoms {
+		bz := make([]byte, common.AddressLength)
+		r.Read(bz)
+		denoms[i] = gravitytypes.GravityDenomPrefix + common.BytesToAddress(bz).Hex()
+	}
+
+	return denoms
+}
+
+// RandomTokenPrice returns a random token price for denom last updated at the given height
+func RandomTokenPrice(r *rand.Rand, denom string, height uint64) types.TokenPrice {
+	return types.TokenPrice{
+		Denom:            denom,
+		Exponent:         uint64(r.Intn(19)),
+		UsdPrice:         sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100000)), 2),
+		LastUpdatedBlock: height,
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for auction. Every sale denom gets a funded active auction,
+// and the simulation accounts are given usomm to bid with. The bank genesis state, which is generated before this
+// module's, is updated to hold the new balances.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minimumBidInUsomm uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinimumBidInUsomm, &minimumBidInUsomm, simState.Rand,
+		func(r *rand.Rand) { minimumBidInUsomm = GenMinimumBidInUsomm(r) },
+	)
+
+	var auctionMaxBlockAge uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuctionMaxBlockAge, &auctionMaxBlockAge, simState.Rand,
+		func(r *rand.Rand) { auctionMaxBlockAge = GenAuctionMaxBlockAge(r) },
+	)
+
+	var auctionPriceDecreaseAccelerationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuctionPriceDecreaseAccelerationRate, &auctionPriceDecreaseAccelerationRate, simState.Rand,
+		func(r *rand.Rand) { auctionPriceDecreaseAccelerationRate = GenAuctionPriceDecreaseAccelerationRate(r) },
+	)
+
+	var saleDenoms []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SaleDenoms, &saleDenoms, simState.Rand,
+		func(r *rand.Rand) { saleDenoms = GenSaleDenoms(r) },
+	)
+
+	r := simState.Rand
+	auctionGenesis := types.DefaultGenesisState()
+	auctionGenesis.Params.MinimumBidInUsomm = minimumBidInUsomm
+	auctionGenesis.Params.AuctionMaxBlockAge = auctionMaxBlockAge
+	auctionGenesis.Params.AuctionPriceDecreaseAccelerationRate = auctionPriceDecreaseAccelerationRate
+
+	usommPrice := RandomTokenPrice(r, params.BaseCoinUnit, 1)
+	auctionGenesis.TokenPrices = append(auctionGenesis.TokenPrices, &usommPrice)
+
+	auctionBalance := sdk.NewCoins()
+	for i, denom := range saleDenoms {
+		tokenPrice := RandomTokenPrice(r, denom, 1)
+		auctionGenesis.TokenPrices = append(auctionGenesis.TokenPrices, &tokenPrice)
+
+		tokensForSale := sdk.NewCoin(denom, sdk.NewInt(int64(simtypes.RandIntBetween(r, 1000000, 1000000000))))
+		priceDecreaseRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 1000)), 5)
+		unitPrice := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10000)), 3)
+		auctionGenesis.Auctions = append(auctionGenesis.Auctions, &types.Auction{
+			Id:                         uint32(i + 1),
+			StartingTokensForSale:      tokensForSale,
+			StartBlock:                 1,
+			InitialPriceDecreaseRate:   priceDecreaseRate,
+			CurrentPriceDecreaseRate:   priceDecreaseRate,
+			PriceDecreaseBlockInterval: uint64(simtypes.RandIntBetween(r, 1, 20)),
+			InitialUnitPriceInUsomm:    unitPrice,
+			CurrentUnitPriceInUsomm:    unitPrice,
+			RemainingTokensForSale:     tokensForSale,
+			FundingModuleAccount:       cellarfeestypes.ModuleName,
+			ProceedsModuleAccount:      cellarfeestypes.ModuleName,
+		})
+		auctionBalance = auctionBalance.Add(tokensForSale)
+	}
+	auctionGenesis.LastAuctionId = uint32(len(auctionGenesis.Auctions))
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Coins:   auctionBalance,
+	})
+	bankGenesis.Supply = bankGenesis.Supply.Add(auctionBalance...)
+
+	balanceIndexes := make(map[string]int, len(bankGenesis.Balances))
+	for i, balance := range bankGenesis.Balances {
+		balanceIndexes[balance.Address] = i
+	}
+	for _, account := range simState.Accounts {
+		usomm := sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(int64(simtypes.RandIntBetween(r, 1000000, 1000000000))))
+		if i, ok := balanceIndexes[account.Address.String()]; ok {
+			bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(usomm)
+		} else {
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+				Address: account.Address.String(),
+				Coins:   sdk.NewCoins(usomm),
+			})
+		}
+		bankGenesis.Supply = bankGenesis.Supply.Add(usomm)
+	}
+	bankGenesis.Balances = banktypes.SanitizeGenesisBalances(bankGenesis.Balances)
+
+	bz, err := json.MarshalIndent(&auctionGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated auction parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&auctionGenesis)
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
@@ -0,0 +1,115 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/peggyjv/sommelier/v7/app/params"
+	"github.com/peggyjv/sommelier/v7/x/auction/keeper"
+	"github.com/peggyjv/sommelier/v7/x/auction/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSubmitBid = "op_weight_msg_submit_bid" //nolint:gosec
+
+	DefaultWeightMsgSubmitBid = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgSubmitBid int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitBid, &weightMsgSubmitBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitBid = DefaultWeightMsgSubmitBid
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSubmitBid,
+			SimulateMsgSubmitBid(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSubmitBid generates a MsgSubmitBidRequest from a random account for a random active auction. The bid
+// and minimum purchase amount are chosen so that the bid can always be at least partially fulfilled.
+func SimulateMsgSubmitBid(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		auctions := k.GetActiveAuctions(ctx)
+		if len(auctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "no active auctions"), nil, nil
+		}
+
+		auction := auctions[r.Intn(len(auctions))]
+		unitPrice := auction.CurrentUnitPriceInUsomm
+		if !unitPrice.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "auction unit price is not positive"), nil, nil
+		}
+
+		saleTokenBalance := bk.GetBalance(ctx, k.GetAuctionAccount(ctx).GetAddress(), auction.StartingTokensForSale.Denom).Amount
+		if !saleTokenBalance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "auction has no tokens remaining"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(params.BaseCoinUnit)
+
+		// mirror the minimum bid check of the msg server, which is relaxed when little value is left in the auction
+		minBid := sdk.NewIntFromUint64(k.GetParamSet(ctx).MinimumBidInUsomm)
+		remainingValue := sdk.NewDecFromInt(saleTokenBalance).Mul(unitPrice)
+		if remainingValue.LT(sdk.NewDecFromInt(minBid)) {
+			minBid = sdk.NewInt(remainingValue.TruncateInt64())
+		}
+		// the bid must also be able to buy at least one sale token
+		if oneToken := unitPrice.Ceil().TruncateInt(); minBid.LT(oneToken) {
+			minBid = oneToken
+		}
+
+		if spendable.LT(minBid) || !spendable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "insufficient usomm to bid"), nil, nil
+		}
+
+		maxBid := minBid
+		if extra, err := simtypes.RandPositiveInt(r, spendable.Sub(minBid).AddRaw(1)); err == nil {
+			maxBid = minBid.Add(extra).SubRaw(1)
+		}
+
+		purchasable := sdk.MinInt(sdk.NewDecFromInt(maxBid).Quo(unitPrice).TruncateInt(), saleTokenBalance)
+		minimumAmount, err := simtypes.RandPositiveInt(r, purchasable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "bid cannot purchase any sale tokens"), nil, nil
+		}
+
+		maxBidInUsomm := sdk.NewCoin(params.BaseCoinUnit, maxBid)
+		msg, err := types.NewMsgSubmitBidRequest(auction.Id, maxBidInUsomm, sdk.NewCoin(auction.StartingTokensForSale.Denom, minimumAmount), simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitBidRequest, "unable to build msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(maxBidInUsomm),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/peggyjv/sommelier/v7/x/auction/keeper"
+	"github.com/peggyjv/sommelier/v7/x/auction/types"
+)
+
+// OpWeightSubmitSetTokenPricesProposal app params key for set token prices proposal
+const OpWeightSubmitSetTokenPricesProposal = "op_weight_submit_set_token_prices_proposal" //nolint:gosec
+
+// DefaultWeightSetTokenPricesProposal is the default weight of the set token prices proposal
+const DefaultWeightSetTokenPricesProposal = 5
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSetTokenPricesProposal,
+			DefaultWeightSetTokenPricesProposal,
+			SimulateSetTokenPricesProposalContent(k),
+		),
+	}
+}
+
+// SimulateSetTokenPricesProposalContent generates random set-token-prices proposal content updating a random subset
+// of the existing token prices
+func SimulateSetTokenPricesProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		var proposedTokenPrices []*types.ProposedTokenPrice
+		for _, tokenPrice := range k.GetTokenPrices(ctx) {
+			if r.Intn(2) == 0 {
+				continue
+			}
+
+			price := RandomTokenPrice(r, tokenPrice.Denom, uint64(ctx.BlockHeight()))
+			proposedTokenPrices = append(proposedTokenPrices, &types.ProposedTokenPrice{
+				Denom:    price.Denom,
+				Exponent: price.Exponent,
+				UsdPrice: price.UsdPrice,
+			})
+		}
+
+		if len(proposedTokenPrices) == 0 {
+			return nil
+		}
+
+		return types.NewSetTokenPricesProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			proposedTokenPrices,
+		)
+	}
+}
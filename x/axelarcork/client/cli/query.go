@@ -7,10 +7,25 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagTargetContract = "target-contract"
+	FlagValidator      = "validator"
+	FlagMinBlockHeight = "min-block-height"
+	FlagMaxBlockHeight = "max-block-height"
+	FlagApproval       = "approval"
+	FlagDecode         = "decode"
+	FlagCorkID         = "cork-id"
+)
+
+// decodeFlagUsage is the usage of the flag that asks for contract calls to be decoded with their cellar's ABI
+const decodeFlagUsage = "decode contract calls using their cellar's registered ABI"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	corkQueryCmd := &cobra.Command{
@@ -34,6 +49,19 @@ func GetQueryCmd() *cobra.Command {
 		queryChainConfigurations(),
 		queryAxelarContractCallNonces(),
 		queryAxelayProxyUpgradeData(),
+		queryCellarSelectorAllowlist(),
+		queryCellarSelectorAllowlists(),
+		queryCellarPauseState(),
+		queryPausedCellars(),
+		queryGovernanceScheduledCorks(),
+		queryCellarABI(),
+		queryCellarABIs(),
+		queryCorkTally(),
+		queryManagedCellar(),
+		queryManagedCellars(),
+		queryCorkRelay(),
+		queryCorkRelays(),
+		queryWinningCorks(),
 	}...)
 
 	return corkQueryCmd
@@ -115,8 +143,14 @@ func queryCellarIDsByChainID() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryCellarIDsByChainIDRequest{
-				ChainId: chainID.Uint64(),
+				ChainId:    chainID.Uint64(),
+				Pagination: *pageReq,
 			}
 
 			queryClient := types.NewQueryClient(ctx)
@@ -131,6 +165,7 @@ func queryCellarIDsByChainID() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cellar ids")
 
 	return cmd
 }
@@ -154,8 +189,20 @@ func queryScheduledCorks() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryScheduledCorksRequest{
-				ChainId: chainID.Uint64(),
+				ChainId:    chainID.Uint64(),
+				Pagination: *pageReq,
+			}
+			if err := readScheduledCorkFilters(cmd, &req.TargetContractAddress, &req.Validator, &req.MinBlockHeight, &req.MaxBlockHeight); err != nil {
+				return err
+			}
+			if req.DecodeCalls, err = cmd.Flags().GetBool(FlagDecode); err != nil {
+				return err
 			}
 
 			res, err := queryClient.QueryScheduledCorks(cmd.Context(), req)
@@ -168,6 +215,12 @@ func queryScheduledCorks() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().String(FlagTargetContract, "", "only return corks targeting this contract address")
+	cmd.Flags().String(FlagValidator, "", "only return corks scheduled by this validator address")
+	cmd.Flags().Uint64(FlagMinBlockHeight, 0, "only return corks scheduled at or after this block height")
+	cmd.Flags().Uint64(FlagMaxBlockHeight, 0, "only return corks scheduled at or before this block height")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -195,9 +248,21 @@ func queryScheduledCorksByBlockHeight() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryScheduledCorksByBlockHeightRequest{
 				BlockHeight: height.Uint64(),
 				ChainId:     chainID.Uint64(),
+				Pagination:  *pageReq,
+				DecodeCalls: decode,
 			}
 
 			res, err := queryClient.QueryScheduledCorksByBlockHeight(cmd.Context(), req)
@@ -210,6 +275,8 @@ func queryScheduledCorksByBlockHeight() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -275,9 +342,21 @@ func queryScheduledCorksByID() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryScheduledCorksByIDRequest{
-				Id:      id,
-				ChainId: chainID.Uint64(),
+				Id:          id,
+				ChainId:     chainID.Uint64(),
+				Pagination:  *pageReq,
+				DecodeCalls: decode,
 			}
 			res, err := queryClient.QueryScheduledCorksByID(cmd.Context(), req)
 			if err != nil {
@@ -289,6 +368,8 @@ func queryScheduledCorksByID() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -317,9 +398,15 @@ func queryCorkResult() *cobra.Command {
 				return err
 			}
 
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryCorkResultRequest{
-				Id:      corkID,
-				ChainId: chainID.Uint64(),
+				Id:         corkID,
+				ChainId:    chainID.Uint64(),
+				DecodeCall: decode,
 			}
 
 			res, err := queryClient.QueryCorkResult(cmd.Context(), req)
@@ -332,6 +419,7 @@ func queryCorkResult() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -354,8 +442,20 @@ func queryCorkResults() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryCorkResultsRequest{
-				ChainId: chainID.Uint64(),
+				ChainId:    chainID.Uint64(),
+				Pagination: *pageReq,
+			}
+			if err := readCorkResultFilters(cmd, &req.TargetContractAddress, &req.MinBlockHeight, &req.MaxBlockHeight, &req.Approval); err != nil {
+				return err
+			}
+			if req.DecodeCalls, err = cmd.Flags().GetBool(FlagDecode); err != nil {
+				return err
 			}
 
 			res, err := queryClient.QueryCorkResults(cmd.Context(), req)
@@ -368,6 +468,12 @@ func queryCorkResults() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cork results")
+	cmd.Flags().String(FlagTargetContract, "", "only return results for corks targeting this contract address")
+	cmd.Flags().Uint64(FlagMinBlockHeight, 0, "only return results tallied at or after this block height")
+	cmd.Flags().Uint64(FlagMaxBlockHeight, 0, "only return results tallied at or before this block height")
+	cmd.Flags().String(FlagApproval, "any", "only return results with this approval status (any|approved|rejected)")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -460,3 +566,608 @@ func queryAxelayProxyUpgradeData() *cobra.Command {
 
 	return cmd
 }
+
+func queryCellarSelectorAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-selector-allowlist [chain-id] [cellar-id]",
+		Aliases: []string{"csa"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the function selectors corks may call on a cellar, empty if any function is allowed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[1]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid EVM address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarSelectorAllowlistRequest{
+				ChainId:  chainID.Uint64(),
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarSelectorAllowlist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarSelectorAllowlists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-selector-allowlists [chain-id]",
+		Aliases: []string{"csas"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query the function selector allowlists of all cellars, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCellarSelectorAllowlistsRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCellarSelectorAllowlists(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-pause-state [chain-id] [cellar-id]",
+		Aliases: []string{"cps"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "query whether a cellar is paused",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[1]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid EVM address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarPauseStateRequest{
+				ChainId:  chainID.Uint64(),
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarPauseState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryPausedCellars() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-cellars [chain-id]",
+		Aliases: []string{"pcs"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query the IDs of all paused cellars, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPausedCellarsRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryPausedCellars(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryGovernanceScheduledCorks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "governance-scheduled-corks [chain-id]",
+		Aliases: []string{"gscs"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query corks scheduled by governance that have yet to be made relayable, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGovernanceScheduledAxelarCorksRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryGovernanceScheduledCorks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarABI() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-abi [chain-id] [cellar-id]",
+		Aliases: []string{"cabi"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the governance registered contract ABI of a cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[1]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid EVM address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarABIRequest{
+				ChainId:  chainID.Uint64(),
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarABI(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarABIs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-abis [chain-id]",
+		Aliases: []string{"cabis"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query the governance registered contract ABIs of all cellars, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCellarABIsRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCellarABIs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-tally [chain-id] [block-height-or-cork-id]",
+		Aliases: []string{"ct"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "preview the tally of scheduled corks using current validator power",
+		Long:    "preview the tally of a chain's corks scheduled for a block height, or of a single scheduled cork given its keccak256 cork ID, using current validator power",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCorkTallyRequest{ChainId: chainID.Uint64()}
+			// the length of a keccak256 hash string
+			if len(args[1]) == 64 {
+				req.Id = args[1]
+			} else {
+				height, err := math.ParseUint(args[1])
+				if err != nil {
+					return err
+				}
+
+				req.BlockHeight = height.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCorkTally(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readScheduledCorkFilters reads and validates the scheduled cork filter flags
+func readScheduledCorkFilters(cmd *cobra.Command, target *string, validator *string, minHeight *uint64, maxHeight *uint64) error {
+	var err error
+	if *target, err = cmd.Flags().GetString(FlagTargetContract); err != nil {
+		return err
+	}
+	if *target != "" && !common.IsHexAddress(*target) {
+		return fmt.Errorf("%s is not a valid ethereum address", *target)
+	}
+
+	if *validator, err = cmd.Flags().GetString(FlagValidator); err != nil {
+		return err
+	}
+	if *validator != "" {
+		if _, err := sdk.ValAddressFromBech32(*validator); err != nil {
+			return err
+		}
+	}
+
+	return readBlockHeightRange(cmd, minHeight, maxHeight)
+}
+
+// readCorkResultFilters reads and validates the cork result filter flags
+func readCorkResultFilters(cmd *cobra.Command, target *string, minHeight *uint64, maxHeight *uint64, approval *types.ApprovalFilter) error {
+	var err error
+	if *target, err = cmd.Flags().GetString(FlagTargetContract); err != nil {
+		return err
+	}
+	if *target != "" && !common.IsHexAddress(*target) {
+		return fmt.Errorf("%s is not a valid ethereum address", *target)
+	}
+
+	approvalStr, err := cmd.Flags().GetString(FlagApproval)
+	if err != nil {
+		return err
+	}
+
+	switch approvalStr {
+	case "", "any":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_ANY
+	case "approved":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_APPROVED
+	case "rejected":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_REJECTED
+	default:
+		return fmt.Errorf("invalid approval filter %s, must be one of any, approved or rejected", approvalStr)
+	}
+
+	return readBlockHeightRange(cmd, minHeight, maxHeight)
+}
+
+func readBlockHeightRange(cmd *cobra.Command, minHeight *uint64, maxHeight *uint64) error {
+	var err error
+	if *minHeight, err = cmd.Flags().GetUint64(FlagMinBlockHeight); err != nil {
+		return err
+	}
+	if *maxHeight, err = cmd.Flags().GetUint64(FlagMaxBlockHeight); err != nil {
+		return err
+	}
+	if *maxHeight != 0 && *minHeight > *maxHeight {
+		return fmt.Errorf("min block height %d is greater than max block height %d", *minHeight, *maxHeight)
+	}
+
+	return nil
+}
+
+func queryManagedCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "managed-cellar [chain-id] [cellar-id]",
+		Aliases: []string{"mc"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the record and metadata of a managed cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[1]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid EVM address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryManagedCellarRequest{
+				ChainId:  chainID.Uint64(),
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryManagedCellar(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryManagedCellars() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "managed-cellars [chain-id]",
+		Aliases: []string{"mcs"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query the records and metadata of all managed cellars, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryManagedCellarsRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryManagedCellars(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkRelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-relay [chain-id] [cork-id]",
+		Aliases: []string{"crl"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the relay status of a winning cork",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			corkID := args[1]
+			// the length of a keccak256 hash string
+			if len(corkID) != 64 {
+				return fmt.Errorf("invalid ID length, must be a keccak256 hash")
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			req := &types.QueryCorkRelayRequest{
+				ChainId: chainID.Uint64(),
+				Id:      corkID,
+			}
+
+			res, err := queryClient.QueryCorkRelay(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkRelays() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-relays [chain-id]",
+		Aliases: []string{"crls"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query the relay status of all relayed corks, optionally limited to a single chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCorkRelaysRequest{}
+			if len(args) == 1 {
+				chainID, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.ChainId = chainID.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCorkRelays(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryWinningCorks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "winning-corks [chain-id] [contract-address]",
+		Aliases: []string{"wcs"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "query the approved corks that can be relayed, optionally limited to a single target contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			if len(args) == 1 {
+				res, err := queryClient.QueryWinningAxelarCorks(cmd.Context(), &types.QueryWinningAxelarCorksRequest{
+					ChainId: chainID.Uint64(),
+				})
+				if err != nil {
+					return err
+				}
+
+				return ctx.PrintProto(res)
+			}
+
+			contractAddr := args[1]
+			if !common.IsHexAddress(contractAddr) {
+				return fmt.Errorf("%s is not a valid EVM address", contractAddr)
+			}
+
+			res, err := queryClient.QueryWinningAxelarCork(cmd.Context(), &types.QueryWinningAxelarCorkRequest{
+				ChainId:         chainID.Uint64(),
+				ContractAddress: contractAddr,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
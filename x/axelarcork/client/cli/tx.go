@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -32,7 +34,10 @@ func GetTxCmd() *cobra.Command {
 		CmdScheduleAxelarCork(),
 		CmdRelayAxelarCork(),
 		CmdBumpAxelarCorkGas(),
-		CmdRelayAxelarProxyUpgrade())
+		CmdRelayAxelarProxyUpgrade(),
+		CmdCancelAxelarCork(),
+		CmdPauseAxelarCellar(),
+		CmdResumeAxelarCellar())
 
 	return corkTxCmd
 }
@@ -93,6 +98,99 @@ func CmdScheduleAxelarCork() *cobra.Command {
 	return cmd
 }
 
+func CmdCancelAxelarCork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-axelar-cork [chain-id] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel your validator's scheduled Axelar corks for a contract",
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			chainID, err := math.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+
+			contractAddr := args[1]
+			if !common.IsHexAddress(contractAddr) {
+				return fmt.Errorf("contract address %s is invalid", contractAddr)
+			}
+
+			cancelCorkMsg := types.MsgCancelAxelarCorkRequest{
+				Signer:                from.String(),
+				ChainId:               chainID.Uint64(),
+				TargetContractAddress: contractAddr,
+			}
+			if err := cancelCorkMsg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &cancelCorkMsg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdPauseAxelarCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-axelar-cellar [chain-id] [cellar-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Pause a cellar as the pause guardian, blocking new and approved Axelar corks from being relayed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setAxelarCellarPaused(cmd, args[0], args[1], true)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResumeAxelarCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-axelar-cellar [chain-id] [cellar-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Resume a paused cellar as the pause guardian",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setAxelarCellarPaused(cmd, args[0], args[1], false)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func setAxelarCellarPaused(cmd *cobra.Command, chainIDArg string, cellarID string, paused bool) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := math.ParseUint(chainIDArg)
+	if err != nil {
+		return err
+	}
+
+	msg := types.MsgSetAxelarCellarPausedRequest{
+		Signer:   clientCtx.GetFromAddress().String(),
+		ChainId:  chainID.Uint64(),
+		CellarId: cellarID,
+		Paused:   paused,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+}
+
 func CmdRelayAxelarCork() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "relay-axelar-cork [chain-id] [contract-address] [token] [fee]",
@@ -127,12 +225,18 @@ func CmdRelayAxelarCork() *cobra.Command {
 				return err
 			}
 
+			corkID, err := cmd.Flags().GetString(FlagCorkID)
+			if err != nil {
+				return err
+			}
+
 			relayCorkMsg := types.MsgRelayAxelarCorkRequest{
 				Signer:                from.String(),
 				Token:                 token,
 				Fee:                   fee.Uint64(),
 				ChainId:               chainID.Uint64(),
 				TargetContractAddress: contractAddr,
+				CorkId:                corkID,
 			}
 			if err := relayCorkMsg.ValidateBasic(); err != nil {
 				return err
@@ -142,6 +246,8 @@ func CmdRelayAxelarCork() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagCorkID, "", "ID of a specific queued cork to relay, defaults to the head of the cellar's queue")
+
 	return cmd
 }
 
@@ -253,8 +359,21 @@ Where proposal.json contains:
   "chain_id": 42161,
   "cellar_ids": ["0x123801a7D398351b8bE11C439e05C5B3259aeC9B", "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"],
   "publisher_domain": "example.com",
-  "deposit": "10000usomm"
+  "deposit": "10000usomm",
+  "cellar_metadata": [
+    {
+      "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+      "metadata": {
+        "name": "Dollary-doos LP",
+        "version": "v2.5",
+        "asset_denom": "usdc",
+        "strategy_spec_url": "https://example.com/strategies/dollary-doos-lp"
+      }
+    }
+  ]
 }
+
+The cellar_metadata field is optional.
 `,
 				version.AppName,
 			),
@@ -300,7 +419,11 @@ Where proposal.json contains:
 				proposal.ChainId,
 				&types.CellarIDSet{ChainId: proposal.ChainId, Ids: proposal.CellarIds},
 				proposal.PublisherDomain,
+				proposal.CellarMetadata,
 			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
 
 			from := clientCtx.GetFromAddress()
 			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
@@ -414,10 +537,13 @@ Where proposal.json contains:
   "target_contract_address": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
   "contract_call_proto_json": "{\"cellar_id\":\"0x123801a7D398351b8bE11C439e05C5B3259aeC9B\",\"<cellar_type_name>\":{\"some_fuction\":{\"function_args\":{}},\"block_height\":12345}}",
   "deadline": 1706225320,
+  "encoded_contract_call": "0x1f2c3d4e",
   "deposit": "10000usomm"
 }
 
 The contract_call_proto_json field must be the JSON representation of a ScheduleRequest, which is defined in Steward's protos. For more information, see the Steward API docs at https://github.com/peggyjv/steward.
+
+If the proposal passes, the cork is made relayable at block_height without a validator vote. The optional encoded_contract_call field is the hex encoded contract call to relay. When it is omitted, the contract call is encoded from contract_call_proto_json by the encoder registered for its cellar type.
 `,
 				version.AppName,
 			),
@@ -443,6 +569,13 @@ The contract_call_proto_json field must be the JSON representation of a Schedule
 				return err
 			}
 
+			var encodedContractCall []byte
+			if proposal.EncodedContractCall != "" {
+				if encodedContractCall, err = hex.DecodeString(strings.TrimPrefix(proposal.EncodedContractCall, "0x")); err != nil {
+					return fmt.Errorf("invalid encoded contract call: %w", err)
+				}
+			}
+
 			content := types.NewAxelarScheduledCorkProposal(
 				proposal.Title,
 				proposal.Description,
@@ -451,6 +584,7 @@ The contract_call_proto_json field must be the JSON representation of a Schedule
 				proposal.TargetContractAddress,
 				proposal.ContractCallProtoJson,
 				proposal.Deadline,
+				encodedContractCall,
 			)
 			if err := content.ValidateBasic(); err != nil {
 				return err
@@ -849,3 +983,298 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func GetCmdSubmitSetAxelarCellarSelectorAllowlistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-axelar-cellar-selector-allowlist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an axelar cellar function selector allowlist proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the function selectors corks may call on an Axelar cellar along with an initial deposit.
+An empty selector list removes the allowlist so that corks may call any function.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-axelar-cellar-selector-allowlist <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar Selector Allowlist Proposal",
+  "description": "Only allow rebalancing on this cellar",
+  "chain_id": 1000,
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "selectors": ["0x12345678", "0x9abcdef0"],
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetAxelarCellarSelectorAllowlistProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAxelarCellarSelectorAllowlistProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ChainId,
+				proposal.CellarId,
+				proposal.Selectors,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetAxelarCellarPausedProposal implements the command to submit an axelar cellar pause proposal
+func GetCmdSubmitSetAxelarCellarPausedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-axelar-cellar-paused [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an axelar cellar pause proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume an Axelar cellar along with an initial deposit.
+Corks for a paused cellar can't be scheduled and approved corks for it are not made relayable.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-axelar-cellar-paused <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Pause Dollary-doos LP Cellar Proposal",
+  "description": "Pause the cellar while its strategist key is rotated",
+  "chain_id": 1000,
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "paused": true,
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetAxelarCellarPausedProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAxelarCellarPausedProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ChainId,
+				proposal.CellarId,
+				proposal.Paused,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetAxelarCellarABIProposal implements the command to submit an axelar cellar ABI proposal
+func GetCmdSubmitSetAxelarCellarABIProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-axelar-cellar-abi [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an axelar cellar ABI proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register the contract ABI of an Axelar cellar along with an initial deposit.
+The ABI is used to decode the contract calls of the cellar's corks in query responses. An empty ABI
+removes the cellar's registered ABI. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-axelar-cellar-abi <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar ABI Proposal",
+  "description": "Register the ABI of the Dollary-doos LP cellar",
+  "chain_id": 1000,
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "abi": "[{\"type\":\"function\",\"name\":\"setFeesDistributor\",\"inputs\":[{\"name\":\"newFeesDistributor\",\"type\":\"bytes32\"}],\"outputs\":[]}]",
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetAxelarCellarABIProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAxelarCellarABIProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ChainId,
+				proposal.CellarId,
+				proposal.Abi,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetAxelarCellarMetadataProposal implements the command to submit a cellar metadata proposal
+func GetCmdSubmitSetAxelarCellarMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-axelar-cellar-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an axelar cellar metadata proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the metadata of an Axelar cellar along with an initial deposit.
+Every metadata field is optional, fields left out are cleared. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-axelar-cellar-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar Metadata Proposal",
+  "description": "Describe the Dollary-doos LP cellar",
+  "chain_id": 1000,
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "metadata": {
+    "name": "Dollary-doos LP",
+    "version": "v2.5",
+    "asset_denom": "usdc",
+    "strategy_spec_url": "https://example.com/strategies/dollary-doos-lp"
+  },
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetAxelarCellarMetadataProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAxelarCellarMetadataProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ChainId,
+				proposal.CellarId,
+				proposal.Metadata,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -14,4 +14,8 @@ var (
 	RemoveChainConfigurationHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveChainConfigurationProposal)
 	UpgradeAxelarProxyContractHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeAxelarProxyContractProposal)
 	CancelAxelarProxyContractUpgradeHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitCancelAxelarProxyContractUpgradeProposal)
+	SetCellarSelectorAllowlistHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitSetAxelarCellarSelectorAllowlistProposal)
+	SetCellarPausedHandler                    = govclient.NewProposalHandler(cli.GetCmdSubmitSetAxelarCellarPausedProposal)
+	SetCellarABIHandler                       = govclient.NewProposalHandler(cli.GetCmdSubmitSetAxelarCellarABIProposal)
+	SetCellarMetadataHandler                  = govclient.NewProposalHandler(cli.GetCmdSubmitSetAxelarCellarMetadataProposal)
 )
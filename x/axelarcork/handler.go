@@ -27,6 +27,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgBumpAxelarCorkGasRequest:
 			res, err := k.BumpCorkGas(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelAxelarCorkRequest:
+			res, err := k.CancelScheduledCork(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAxelarCellarPausedRequest:
+			res, err := k.SetCellarPaused(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized axelar cork message type: %T", msg)
 		}
@@ -52,6 +58,14 @@ func NewProposalHandler(k keeper.Keeper) govtypesv1beta1.Handler {
 			return keeper.HandleUpgradeAxelarProxyContractProposal(ctx, k, *c)
 		case *types.CancelAxelarProxyContractUpgradeProposal:
 			return keeper.HandleCancelAxelarProxyContractUpgradeProposal(ctx, k, *c)
+		case *types.SetAxelarCellarSelectorAllowlistProposal:
+			return keeper.HandleSetAxelarCellarSelectorAllowlistProposal(ctx, k, *c)
+		case *types.SetAxelarCellarPausedProposal:
+			return keeper.HandleSetAxelarCellarPausedProposal(ctx, k, *c)
+		case *types.SetAxelarCellarABIProposal:
+			return keeper.HandleSetAxelarCellarABIProposal(ctx, k, *c)
+		case *types.SetAxelarCellarMetadataProposal:
+			return keeper.HandleSetAxelarCellarMetadataProposal(ctx, k, *c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized axelar cork proposal content type: %T", c)
@@ -55,11 +55,19 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet types.Packet, relay
 }
 
 func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet types.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnAxelarCorkAcknowledgement(ctx, packet.GetSourceChannel(), packet.GetSequence(), acknowledgement)
 }
 
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet types.Packet, relayer sdk.AccAddress) error {
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnAxelarCorkTimeout(ctx, packet.GetSourceChannel(), packet.GetSequence())
 }
 
 func (im IBCMiddleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,25 +14,92 @@ import (
 // BeginBlocker is called at the beginning of every block
 func (k Keeper) BeginBlocker(ctx sdk.Context) {}
 
+// pruneAxelarCorkResults deletes the chain's axelar cork results that are older than the retention window,
+// optionally emitting an event with the contents of each pruned result so that indexers can keep the history
+func (k Keeper) pruneAxelarCorkResults(ctx sdk.Context, chainID uint64, params types.Params) {
+	if params.CorkResultRetentionBlocks == 0 || uint64(ctx.BlockHeight()) <= params.CorkResultRetentionBlocks {
+		return
+	}
+
+	pruneHeight := uint64(ctx.BlockHeight()) - params.CorkResultRetentionBlocks
+
+	var prunedIDs [][]byte
+	k.IterateAxelarCorkResultIDsUpToHeight(ctx, chainID, pruneHeight, func(id []byte, _ uint64) (stop bool) {
+		prunedIDs = append(prunedIDs, id)
+		return false
+	})
+
+	for _, id := range prunedIDs {
+		corkResult, found := k.GetAxelarCorkResult(ctx, chainID, id)
+		if found && params.ArchivePrunedCorkResults {
+			if err := ctx.EventManager().EmitTypedEvent(&types.AxelarCorkResultArchivedEvent{
+				ChainId: chainID,
+				CorkId:  hex.EncodeToString(id),
+				Result:  &corkResult,
+			}); err != nil {
+				k.Logger(ctx).Error("failed to emit axelar cork result archived event", "error", err)
+			}
+		}
+
+		k.DeleteAxelarCorkResult(ctx, chainID, id)
+
+		if relay, found := k.GetAxelarCorkRelay(ctx, chainID, id); found && relay.Status != types.AxelarCorkRelayStatus_RELAY_STATUS_PENDING {
+			k.DeleteAxelarCorkRelay(ctx, chainID, id)
+		}
+	}
+
+	if len(prunedIDs) > 0 {
+		k.Logger(ctx).Info("pruned axelar cork results",
+			"count", len(prunedIDs),
+			"prune height", pruneHeight,
+			"chain id", chainID)
+	}
+}
+
 // EndBlocker defines the oracle logic that executes at the end of every block:
 //
-// 1) Collects all winning votes
+// 1) Collects all winning votes along with the corks governance scheduled for this block height
+//
+// 2) Stores all winning votes and governance scheduled corks as corks that strategists are allowed to relay via
+// Axelar, skipping those for paused cellars
+//
+// 3) Removes relayable corks that were not relayed within CorkTimeoutBlocks of their approval or whose deadline has
+// passed
 //
-// 2) Stores all winning votes as corks that strategists are allowed to relay via Axelar
+// 4) Prunes axelar cork results older than the retention window
 
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParamSet(ctx)
+
 	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
 		k.Logger(ctx).Info("tallying scheduled cork votes",
 			"height", fmt.Sprintf("%d", ctx.BlockHeight()),
 			"chain id", config.Id)
 
 		winningScheduledVotes := k.GetApprovedScheduledAxelarCorks(ctx, config.Id)
+		winningScheduledVotes = append(winningScheduledVotes, k.takeGovernanceScheduledAxelarCorks(ctx, config.Id)...)
 		if len(winningScheduledVotes) > 0 {
 			k.Logger(ctx).Info("marking all winning scheduled cork votes as relayable",
 				"winning votes", winningScheduledVotes,
 				"chain id", config.Id)
 			for _, c := range winningScheduledVotes {
-				k.SetWinningAxelarCork(ctx, config.Id, uint64(ctx.BlockHeight()), c)
+				if k.IsCellarPaused(ctx, config.Id, common.HexToAddress(c.TargetContractAddress)) {
+					corkID := hex.EncodeToString(c.IDHash(uint64(ctx.BlockHeight())))
+					k.Logger(ctx).Info("skipping approved axelar cork for paused cellar",
+						"target contract address", c.TargetContractAddress,
+						"cork id", corkID,
+						"chain id", config.Id)
+					if err := ctx.EventManager().EmitTypedEvent(&types.PausedCellarCorkSkippedEvent{
+						ChainId:               config.Id,
+						CorkId:                corkID,
+						TargetContractAddress: c.TargetContractAddress,
+					}); err != nil {
+						k.Logger(ctx).Error("failed to emit paused cellar cork skipped event", "error", err)
+					}
+					continue
+				}
+
+				k.EnqueueWinningAxelarCork(ctx, config.Id, uint64(ctx.BlockHeight()), c)
 			}
 		}
 
@@ -39,18 +107,36 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 			"height", fmt.Sprintf("%d", ctx.BlockHeight()),
 			"chain id", config.Id)
 
-		timeoutHeight := uint64(ctx.BlockHeight()) - k.GetParamSet(ctx).CorkTimeoutBlocks
-		k.IterateWinningAxelarCorks(ctx, config.Id, func(_ common.Address, blockHeight uint64, cork types.AxelarCork) (stop bool) {
-			if blockHeight >= timeoutHeight {
+		type queuePosition struct {
+			contract    common.Address
+			blockHeight uint64
+			queueNonce  uint64
+		}
+		var expired []queuePosition
+		k.IterateWinningAxelarCorks(ctx, config.Id, func(contract common.Address, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool) {
+			if uint64(ctx.BlockHeight()) >= blockHeight+params.CorkTimeoutBlocks {
 				k.Logger(ctx).Info("deleting expired approved scheduled axelar cork",
 					"scheduled height", fmt.Sprintf("%d", blockHeight),
 					"target contract address", cork.TargetContractAddress)
 
-				k.DeleteWinningAxelarCorkByBlockheight(ctx, config.Id, blockHeight, cork)
+				expired = append(expired, queuePosition{contract, blockHeight, queueNonce})
+			} else if cork.DeadlinePassed(ctx.BlockTime()) {
+				k.Logger(ctx).Info("deleting approved scheduled axelar cork past its deadline",
+					"scheduled height", fmt.Sprintf("%d", blockHeight),
+					"deadline", fmt.Sprintf("%d", cork.Deadline),
+					"target contract address", cork.TargetContractAddress)
+
+				expired = append(expired, queuePosition{contract, blockHeight, queueNonce})
 			}
 			return false
 		})
 
+		for _, position := range expired {
+			k.DeleteWinningAxelarCork(ctx, config.Id, position.contract, position.blockHeight, position.queueNonce)
+		}
+
+		k.pruneAxelarCorkResults(ctx, config.Id, params)
+
 		return false
 	})
 }
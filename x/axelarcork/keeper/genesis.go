@@ -24,7 +24,13 @@ func InitGenesis(ctx sdk.Context, k Keeper, gs types.GenesisState) {
 	}
 
 	for _, cellarIDSet := range gs.CellarIds {
-		k.SetCellarIDs(ctx, cellarIDSet.ChainId, *cellarIDSet)
+		for _, id := range cellarIDSet.Ids {
+			k.SetCellar(ctx, types.AxelarManagedCellar{ChainId: cellarIDSet.ChainId, CellarId: id})
+		}
+	}
+
+	for _, cellar := range gs.ManagedCellars {
+		k.SetCellar(ctx, cellar)
 	}
 
 	for _, corkResult := range gs.CorkResults.CorkResults {
@@ -65,6 +71,28 @@ func InitGenesis(ctx sdk.Context, k Keeper, gs types.GenesisState) {
 
 		k.SetAxelarProxyUpgradeData(ctx, ud.ChainId, *ud)
 	}
+
+	for _, allowlist := range gs.CellarSelectorAllowlists {
+		k.SetCellarSelectorAllowlist(ctx, allowlist)
+	}
+
+	for _, paused := range gs.PausedCellarIds {
+		for _, id := range paused.Ids {
+			ctx.KVStore(k.storeKey).Set(types.GetPausedCellarKey(paused.ChainId, common.HexToAddress(id)), []byte{})
+		}
+	}
+
+	for _, cork := range gs.GovernanceScheduledCorks {
+		k.SetGovernanceScheduledAxelarCork(ctx, cork.Cork.ChainId, cork.BlockHeight, *cork.Cork)
+	}
+
+	for _, cellarABI := range gs.CellarAbis {
+		k.SetCellarABI(ctx, cellarABI)
+	}
+
+	for _, relay := range gs.CorkRelays {
+		k.SetAxelarCorkRelay(ctx, relay)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -86,10 +114,19 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			cellarIDSet.Ids = append(cellarIDSet.Ids, id.String())
 		}
 		gs.CellarIds = append(gs.CellarIds, &cellarIDSet)
+		gs.ManagedCellars = append(gs.ManagedCellars, k.GetCellars(ctx, config.Id)...)
 
 		gs.ScheduledCorks.ScheduledCorks = append(gs.ScheduledCorks.ScheduledCorks, k.GetScheduledAxelarCorks(ctx, config.Id)...)
 		gs.CorkResults.CorkResults = append(gs.CorkResults.CorkResults, k.GetAxelarCorkResults(ctx, config.Id)...)
 
+		if paused := k.GetPausedCellarIDs(ctx, config.Id); len(paused.Ids) > 0 {
+			gs.PausedCellarIds = append(gs.PausedCellarIds, &paused)
+		}
+
+		gs.GovernanceScheduledCorks = append(gs.GovernanceScheduledCorks, k.GetGovernanceScheduledAxelarCorks(ctx, config.Id)...)
+		gs.CellarAbis = append(gs.CellarAbis, k.GetCellarABIs(ctx, config.Id)...)
+		gs.CorkRelays = append(gs.CorkRelays, k.GetAxelarCorkRelays(ctx, config.Id)...)
+
 		return false
 	})
 
@@ -111,5 +148,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	k.IterateCellarSelectorAllowlists(ctx, func(allowlist types.AxelarCellarSelectorAllowlist) (stop bool) {
+		gs.CellarSelectorAllowlists = append(gs.CellarSelectorAllowlists, allowlist)
+
+		return false
+	})
+
 	return gs
 }
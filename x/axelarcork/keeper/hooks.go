@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type GovHooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks returns the wrapper struct implementing the gov hooks
+func (k Keeper) GovHooks() GovHooks {
+	return GovHooks{k}
+}
+
+func (h GovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+
+func (h GovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+}
+
+func (h GovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {}
+
+func (h GovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {}
+
+// AfterProposalVotingPeriodEnded records the proposal's ID on the cellars it added, which the add managed cellars
+// proposal handler marked as pending
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.setCellarProposalID(ctx, proposalID)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+)
+
+// RegisterInvariants registers all axelarcork invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "scheduled-cork-chains", ScheduledCorkChainsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the axelarcork module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ScheduledCorkChainsInvariant(k)(ctx)
+	}
+}
+
+// ScheduledCorkChainsInvariant checks that every scheduled cork, whether scheduled by validators or by governance,
+// is stored under the chain it targets. Corks left behind for a removed chain configuration are tolerated.
+func ScheduledCorkChainsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		checkChain := func(kind string, key []byte, corkChainID uint64) {
+			chainID := binary.BigEndian.Uint64(key[1:9])
+			if corkChainID != chainID {
+				broken = true
+				msg += fmt.Sprintf("\t%s cork %X targets chain %d but is stored under chain %d\n", kind, key, corkChainID, chainID)
+			}
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		iter := sdk.KVStorePrefixIterator(store, []byte{types.ScheduledCorkKeyPrefix})
+		for ; iter.Valid(); iter.Next() {
+			var cork types.AxelarCork
+			k.cdc.MustUnmarshal(iter.Value(), &cork)
+			checkChain("scheduled", iter.Key(), cork.ChainId)
+		}
+		iter.Close()
+
+		iter = sdk.KVStorePrefixIterator(store, []byte{types.GovernanceScheduledCorkPrefix})
+		for ; iter.Valid(); iter.Next() {
+			var scheduled types.GovernanceScheduledAxelarCork
+			k.cdc.MustUnmarshal(iter.Value(), &scheduled)
+			checkChain("governance scheduled", iter.Key(), scheduled.GetCork().GetChainId())
+		}
+		iter.Close()
+
+		return sdk.FormatInvariant(types.ModuleName, "scheduled cork chains",
+			fmt.Sprintf("scheduled corks stored under a chain other than their target chain:\n%s", msg)), broken
+	}
+}
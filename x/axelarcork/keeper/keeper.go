@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"reflect"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -30,6 +33,10 @@ type Keeper struct {
 	pubsubKeeper       types.PubsubKeeper
 
 	Ics4Wrapper types.ICS4Wrapper
+
+	// contractCallEncoders is shared by copies of the keeper so that encoders registered after the keeper
+	// has been passed to other modules are still used
+	contractCallEncoders map[string]types.ContractCallEncoder
 }
 
 // NewKeeper creates a new x/axelarcork Keeper instance
@@ -57,6 +64,8 @@ func NewKeeper(
 		pubsubKeeper:       pubsubKeeper,
 
 		Ics4Wrapper: wrapper,
+
+		contractCallEncoders: make(map[string]types.ContractCallEncoder),
 	}
 }
 
@@ -96,8 +105,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 func (k Keeper) SetScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, val sdk.ValAddress, cork types.AxelarCork) []byte {
 	id := cork.IDHash(blockHeight)
+	contract := common.HexToAddress(cork.TargetContractAddress)
 	bz := k.cdc.MustMarshal(&cork)
-	ctx.KVStore(k.storeKey).Set(types.GetScheduledAxelarCorkKey(chainID, blockHeight, id, val, common.HexToAddress(cork.TargetContractAddress)), bz)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledAxelarCorkKey(chainID, blockHeight, id, val, contract), bz)
+	store.Set(types.GetScheduledAxelarCorkIDIndexKey(chainID, blockHeight, id, val, contract), []byte{})
 	return id
 }
 
@@ -119,7 +131,9 @@ func (k Keeper) GetScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHei
 // DeleteScheduledAxelarCork deletes the scheduled cork for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) DeleteScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) {
-	ctx.KVStore(k.storeKey).Delete(types.GetScheduledAxelarCorkKey(chainID, blockHeight, id, val, contract))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledAxelarCorkKey(chainID, blockHeight, id, val, contract))
+	store.Delete(types.GetScheduledAxelarCorkIDIndexKey(chainID, blockHeight, id, val, contract))
 }
 
 // IterateScheduledAxelarCorks iterates over all scheduled corks by chain ID
@@ -138,13 +152,8 @@ func (k Keeper) IterateScheduledAxelarCorksByPrefix(ctx sdk.Context, prefix []by
 
 	for ; iter.Valid(); iter.Next() {
 		var cork types.AxelarCork
-		keyPair := bytes.NewBuffer(iter.Key())
-		keyPair.Next(1) // trim prefix byte
-		keyPair.Next(8) // trim chain id, it was filtered in the prefix
-		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
-		id := keyPair.Next(32)
-		val := sdk.ValAddress(keyPair.Next(20))
-		contract := common.BytesToAddress(keyPair.Next(20))
+		// trim prefix byte and chain id, it was filtered in the prefix
+		blockHeight, id, val, contract := parseScheduledAxelarCorkKey(iter.Key()[9:])
 
 		k.cdc.MustUnmarshal(iter.Value(), &cork)
 		if cb(val, blockHeight, id, contract, cork) {
@@ -184,17 +193,36 @@ func (k Keeper) GetScheduledAxelarCorksByBlockHeight(ctx sdk.Context, chainID ui
 	return scheduledCorks
 }
 
+// IterateScheduledAxelarCorksByID iterates over all scheduled corks with the given ID using the ID index
+func (k Keeper) IterateScheduledAxelarCorksByID(ctx sdk.Context, chainID uint64, id []byte, cb func(val sdk.ValAddress, blockHeight uint64, id []byte, cel common.Address, cork types.AxelarCork) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetScheduledAxelarCorkIDIndexPrefix(chainID, id)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		blockHeight, val, contract := parseScheduledAxelarCorkIDIndexKey(iter.Key()[len(prefix):])
+
+		cork, found := k.GetScheduledAxelarCork(ctx, chainID, blockHeight, id, val, contract)
+		if !found {
+			continue
+		}
+
+		if cb(val, blockHeight, id, contract, cork) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetScheduledAxelarCorksByID(ctx sdk.Context, chainID uint64, queriedID []byte) []*types.ScheduledAxelarCork {
 	var scheduledCorks []*types.ScheduledAxelarCork
-	k.IterateScheduledAxelarCorks(ctx, chainID, func(val sdk.ValAddress, blockHeight uint64, id []byte, _ common.Address, cork types.AxelarCork) (stop bool) {
-		if bytes.Equal(id, queriedID) {
-			scheduledCorks = append(scheduledCorks, &types.ScheduledAxelarCork{
-				Validator:   val.String(),
-				Cork:        &cork,
-				BlockHeight: blockHeight,
-				Id:          hex.EncodeToString(id),
-			})
-		}
+	k.IterateScheduledAxelarCorksByID(ctx, chainID, queriedID, func(val sdk.ValAddress, blockHeight uint64, id []byte, _ common.Address, cork types.AxelarCork) (stop bool) {
+		scheduledCorks = append(scheduledCorks, &types.ScheduledAxelarCork{
+			Validator:   val.String(),
+			Cork:        &cork,
+			BlockHeight: blockHeight,
+			Id:          hex.EncodeToString(id),
+		})
 
 		return false
 	})
@@ -202,42 +230,121 @@ func (k Keeper) GetScheduledAxelarCorksByID(ctx sdk.Context, chainID uint64, que
 	return scheduledCorks
 }
 
+// parseScheduledAxelarCorkKey parses a scheduled cork key with its prefix byte and chain ID removed
+func parseScheduledAxelarCorkKey(key []byte) (blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) {
+	keyPair := bytes.NewBuffer(key)
+	blockHeight = sdk.BigEndianToUint64(keyPair.Next(8))
+	id = keyPair.Next(32)
+	val, contract = parseValidatorAndContract(keyPair.Bytes())
+	return
+}
+
+// parseScheduledAxelarCorkIDIndexKey parses a scheduled cork ID index key with its prefix byte, chain ID and ID removed
+func parseScheduledAxelarCorkIDIndexKey(key []byte) (blockHeight uint64, val sdk.ValAddress, contract common.Address) {
+	keyPair := bytes.NewBuffer(key)
+	blockHeight = sdk.BigEndianToUint64(keyPair.Next(8))
+	val, contract = parseValidatorAndContract(keyPair.Bytes())
+	return
+}
+
+// parseValidatorAndContract splits the trailing <val_address><address> portion of a scheduled cork key,
+// the contract address is always the last 20 bytes
+func parseValidatorAndContract(bz []byte) (sdk.ValAddress, common.Address) {
+	if len(bz) < common.AddressLength {
+		return sdk.ValAddress(bz), common.Address{}
+	}
+
+	split := len(bz) - common.AddressLength
+	return sdk.ValAddress(bz[:split]), common.BytesToAddress(bz[split:])
+}
+
 /////////////////
 // WinningCork //
 /////////////////
 
-func (k Keeper) SetWinningAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, cork types.AxelarCork) {
+// SetWinningAxelarCork stores a winning cork at its position in the target contract's queue
+func (k Keeper) SetWinningAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) {
 	bz := k.cdc.MustMarshal(&cork)
-	ctx.KVStore(k.storeKey).Set(types.GetWinningAxelarCorkKey(chainID, blockHeight, common.HexToAddress(cork.TargetContractAddress)), bz)
+	ctx.KVStore(k.storeKey).Set(types.GetWinningAxelarCorkKey(chainID, common.HexToAddress(cork.TargetContractAddress), blockHeight, queueNonce), bz)
+}
+
+// EnqueueWinningAxelarCork appends a winning cork to the end of the target contract's queue, returning its queue nonce
+func (k Keeper) EnqueueWinningAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, cork types.AxelarCork) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nonceKey := types.GetWinningAxelarCorkQueueNonceKey(chainID, common.HexToAddress(cork.TargetContractAddress))
+
+	var queueNonce uint64
+	if bz := store.Get(nonceKey); len(bz) > 0 {
+		queueNonce = sdk.BigEndianToUint64(bz)
+	}
+	queueNonce++
+	store.Set(nonceKey, sdk.Uint64ToBigEndian(queueNonce))
+
+	k.SetWinningAxelarCork(ctx, chainID, blockHeight, queueNonce, cork)
+
+	return queueNonce
+}
+
+// IterateWinningAxelarCorks iterates over the winning corks of a chain, visiting each target contract's queue in order
+func (k Keeper) IterateWinningAxelarCorks(ctx sdk.Context, chainID uint64, cb func(contract common.Address, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool)) {
+	k.iterateWinningAxelarCorks(ctx, types.GetWinningAxelarCorkKeyPrefix(chainID), cb)
 }
 
-func (k Keeper) IterateWinningAxelarCorks(ctx sdk.Context, chainID uint64, cb func(contract common.Address, blockHeight uint64, cork types.AxelarCork) (stop bool)) {
+// IterateWinningAxelarCorkQueue iterates over a target contract's winning corks in order of approval height and then
+// queue nonce
+func (k Keeper) IterateWinningAxelarCorkQueue(ctx sdk.Context, chainID uint64, contractAddr common.Address, cb func(blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool)) {
+	k.iterateWinningAxelarCorks(ctx, types.GetWinningAxelarCorkQueuePrefix(chainID, contractAddr), func(_ common.Address, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool) {
+		return cb(blockHeight, queueNonce, cork)
+	})
+}
+
+func (k Keeper) iterateWinningAxelarCorks(ctx sdk.Context, prefix []byte, cb func(contract common.Address, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetWinningAxelarCorkKeyPrefix(chainID))
+	iter := sdk.KVStorePrefixIterator(store, prefix)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		var cork types.AxelarCork
 		keyPair := bytes.NewBuffer(iter.Key())
-		keyPair.Next(1) // trim prefix byte
-		keyPair.Next(8) // trim chain ID
-		blockHeight := binary.BigEndian.Uint64(keyPair.Next(8))
+		keyPair.Next(1)                                            // trim prefix byte
+		keyPair.Next(8)                                            // trim chain ID
 		contractAddress := common.BytesToAddress(keyPair.Next(20)) // contract
+		blockHeight := binary.BigEndian.Uint64(keyPair.Next(8))
+		queueNonce := binary.BigEndian.Uint64(keyPair.Next(8))
 
 		k.cdc.MustUnmarshal(iter.Value(), &cork)
-		if cb(contractAddress, blockHeight, cork) {
+		if cb(contractAddress, blockHeight, queueNonce, cork) {
 			break
 		}
 	}
 }
 
-func (k Keeper) GetWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address) (uint64, types.AxelarCork, bool) {
-	var bh uint64
+// GetWinningAxelarCork returns the winning cork at the head of the target contract's queue
+func (k Keeper) GetWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address) (uint64, uint64, types.AxelarCork, bool) {
+	var bh, qn uint64
 	var c types.AxelarCork
 	found := false
-	k.IterateWinningAxelarCorks(ctx, chainID, func(contract common.Address, blockHeight uint64, cork types.AxelarCork) (stop bool) {
-		if contractAddr == contract {
+	k.IterateWinningAxelarCorkQueue(ctx, chainID, contractAddr, func(blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool) {
+		bh = blockHeight
+		qn = queueNonce
+		c = cork
+		found = true
+
+		return true
+	})
+
+	return bh, qn, c, found
+}
+
+// GetWinningAxelarCorkByID returns the first winning cork in the target contract's queue with the given ID
+func (k Keeper) GetWinningAxelarCorkByID(ctx sdk.Context, chainID uint64, contractAddr common.Address, id []byte) (uint64, uint64, types.AxelarCork, bool) {
+	var bh, qn uint64
+	var c types.AxelarCork
+	found := false
+	k.IterateWinningAxelarCorkQueue(ctx, chainID, contractAddr, func(blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool) {
+		if bytes.Equal(cork.IDHash(blockHeight), id) {
 			bh = blockHeight
+			qn = queueNonce
 			c = cork
 			found = true
 			return true
@@ -246,25 +353,134 @@ func (k Keeper) GetWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAd
 		return false
 	})
 
-	return bh, c, found
+	return bh, qn, c, found
+}
+
+// GetWinningAxelarCorkAt returns the winning cork at a position in the target contract's queue
+func (k Keeper) GetWinningAxelarCorkAt(ctx sdk.Context, chainID uint64, contractAddr common.Address, blockHeight uint64, queueNonce uint64) (types.AxelarCork, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetWinningAxelarCorkKey(chainID, contractAddr, blockHeight, queueNonce))
+	if len(bz) == 0 {
+		return types.AxelarCork{}, false
+	}
+
+	var cork types.AxelarCork
+	k.cdc.MustUnmarshal(bz, &cork)
+
+	return cork, true
 }
 
-func (k Keeper) DeleteWinningAxelarCorkByBlockheight(ctx sdk.Context, chainID uint64, blockHeight uint64, cork types.AxelarCork) {
-	ctx.KVStore(k.storeKey).Delete(types.GetWinningAxelarCorkKey(chainID, blockHeight, common.HexToAddress(cork.TargetContractAddress)))
+// DeleteWinningAxelarCork removes a winning cork from the target contract's queue
+func (k Keeper) DeleteWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address, blockHeight uint64, queueNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetWinningAxelarCorkKey(chainID, contractAddr, blockHeight, queueNonce))
 }
 
-// TODO (Collin): Need pruning logic. This method is unused.
-func (k Keeper) DeleteWinningAxelarCork(ctx sdk.Context, chainID uint64, c types.AxelarCork) {
+// SetRelayingWinningAxelarCork marks the queued winning cork being relayed with a contract call nonce so that the
+// outgoing packet can be matched against that exact entry
+func (k Keeper) SetRelayingWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address, contractCallNonce uint64, blockHeight uint64, queueNonce uint64) {
+	bz := append(sdk.Uint64ToBigEndian(blockHeight), sdk.Uint64ToBigEndian(queueNonce)...)
+	ctx.KVStore(k.storeKey).Set(types.GetRelayingWinningAxelarCorkKey(chainID, contractAddr, contractCallNonce), bz)
+}
 
-	k.IterateWinningAxelarCorks(ctx, chainID, func(contract common.Address, blockHeight uint64, cork types.AxelarCork) (stop bool) {
-		if c.Equals(cork) {
-			k.DeleteWinningAxelarCorkByBlockheight(ctx, chainID, blockHeight, cork)
+// GetRelayingWinningAxelarCork returns the queue position of the winning cork being relayed with a contract call nonce
+func (k Keeper) GetRelayingWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address, contractCallNonce uint64) (uint64, uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRelayingWinningAxelarCorkKey(chainID, contractAddr, contractCallNonce))
+	if len(bz) == 0 {
+		return 0, 0, false
+	}
 
-			return true
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:]), true
+}
+
+func (k Keeper) DeleteRelayingWinningAxelarCork(ctx sdk.Context, chainID uint64, contractAddr common.Address, contractCallNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetRelayingWinningAxelarCorkKey(chainID, contractAddr, contractCallNonce))
+}
+
+/////////////////
+// Cork Relays //
+/////////////////
+
+// SetAxelarCorkRelay records the relay of a cork, indexing it by packet sequence while it awaits an acknowledgement
+// or timeout
+func (k Keeper) SetAxelarCorkRelay(ctx sdk.Context, relay types.AxelarCorkRelay) {
+	id, err := hex.DecodeString(relay.CorkId)
+	if err != nil {
+		panic(fmt.Sprintf("invalid cork ID %s: %s", relay.CorkId, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAxelarCorkRelayKey(relay.ChainId, id), k.cdc.MustMarshal(&relay))
+
+	pendingKey := types.GetPendingAxelarCorkRelayKey(relay.SourceChannel, relay.Sequence)
+	if relay.Status == types.AxelarCorkRelayStatus_RELAY_STATUS_PENDING {
+		store.Set(pendingKey, types.GetAxelarCorkRelayKey(relay.ChainId, id))
+	} else {
+		store.Delete(pendingKey)
+	}
+}
+
+// GetAxelarCorkRelay returns the latest relay of a cork
+func (k Keeper) GetAxelarCorkRelay(ctx sdk.Context, chainID uint64, id []byte) (types.AxelarCorkRelay, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAxelarCorkRelayKey(chainID, id))
+	if len(bz) == 0 {
+		return types.AxelarCorkRelay{}, false
+	}
+
+	var relay types.AxelarCorkRelay
+	k.cdc.MustUnmarshal(bz, &relay)
+
+	return relay, true
+}
+
+// GetPendingAxelarCorkRelay returns the relay awaiting an acknowledgement or timeout for the packet sent on a channel
+// with the given sequence
+func (k Keeper) GetPendingAxelarCorkRelay(ctx sdk.Context, sourceChannel string, sequence uint64) (types.AxelarCorkRelay, bool) {
+	store := ctx.KVStore(k.storeKey)
+	relayKey := store.Get(types.GetPendingAxelarCorkRelayKey(sourceChannel, sequence))
+	if len(relayKey) == 0 {
+		return types.AxelarCorkRelay{}, false
+	}
+
+	var relay types.AxelarCorkRelay
+	k.cdc.MustUnmarshal(store.Get(relayKey), &relay)
+
+	return relay, true
+}
+
+// DeleteAxelarCorkRelay removes the relay of a cork along with its pending index entry
+func (k Keeper) DeleteAxelarCorkRelay(ctx sdk.Context, chainID uint64, id []byte) {
+	relay, found := k.GetAxelarCorkRelay(ctx, chainID, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAxelarCorkRelayKey(relay.SourceChannel, relay.Sequence))
+	store.Delete(types.GetAxelarCorkRelayKey(chainID, id))
+}
+
+// IterateAxelarCorkRelays iterates over the cork relays of a chain
+func (k Keeper) IterateAxelarCorkRelays(ctx sdk.Context, chainID uint64, cb func(relay types.AxelarCorkRelay) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetAxelarCorkRelayKeyPrefix(chainID))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var relay types.AxelarCorkRelay
+		k.cdc.MustUnmarshal(iter.Value(), &relay)
+		if cb(relay) {
+			break
 		}
+	}
+}
 
+// GetAxelarCorkRelays returns the cork relays of a chain
+func (k Keeper) GetAxelarCorkRelays(ctx sdk.Context, chainID uint64) []types.AxelarCorkRelay {
+	var relays []types.AxelarCorkRelay
+	k.IterateAxelarCorkRelays(ctx, chainID, func(relay types.AxelarCorkRelay) (stop bool) {
+		relays = append(relays, relay)
 		return false
 	})
+
+	return relays
 }
 
 ///////////////////////////
@@ -291,8 +507,10 @@ func (k Keeper) GetScheduledBlockHeights(ctx sdk.Context, chainID uint64) []uint
 ////////////////////////
 
 func (k Keeper) SetAxelarCorkResult(ctx sdk.Context, chainID uint64, id []byte, corkResult types.AxelarCorkResult) {
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&corkResult)
-	ctx.KVStore(k.storeKey).Set(types.GetAxelarCorkResultKey(chainID, id), bz)
+	store.Set(types.GetAxelarCorkResultKey(chainID, id), bz)
+	store.Set(types.GetAxelarCorkResultHeightIndexKey(chainID, corkResult.BlockHeight, id), []byte{})
 }
 
 func (k Keeper) GetAxelarCorkResult(ctx sdk.Context, chainID uint64, id []byte) (types.AxelarCorkResult, bool) {
@@ -308,7 +526,32 @@ func (k Keeper) GetAxelarCorkResult(ctx sdk.Context, chainID uint64, id []byte)
 }
 
 func (k Keeper) DeleteAxelarCorkResult(ctx sdk.Context, chainID uint64, id []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.GetAxelarCorkResultKey(chainID, id))
+	corkResult, found := k.GetAxelarCorkResult(ctx, chainID, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAxelarCorkResultKey(chainID, id))
+	store.Delete(types.GetAxelarCorkResultHeightIndexKey(chainID, corkResult.BlockHeight, id))
+}
+
+// IterateAxelarCorkResultIDsUpToHeight iterates over the IDs of the chain's axelar cork results from block heights at
+// or below maxHeight, in ascending block height order
+func (k Keeper) IterateAxelarCorkResultIDsUpToHeight(ctx sdk.Context, chainID uint64, maxHeight uint64, cb func(id []byte, blockHeight uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAxelarCorkResultHeightIndexPrefix(chainID))
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(maxHeight+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		keyPair := bytes.NewBuffer(iter.Key())
+		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
+		id := keyPair.Next(32)
+
+		if cb(id, blockHeight) {
+			break
+		}
+	}
 }
 
 // IterateCorksResult iterates over all cork results by chain ID
@@ -321,6 +564,7 @@ func (k Keeper) IterateAxelarCorkResults(ctx sdk.Context, chainID uint64, cb fun
 		var corkResult types.AxelarCorkResult
 		keyPair := bytes.NewBuffer(iter.Key())
 		keyPair.Next(1) // trim prefix byte
+		keyPair.Next(8) // trim chain ID
 		id := keyPair.Next(32)
 
 		k.cdc.MustUnmarshal(iter.Value(), &corkResult)
@@ -345,39 +589,65 @@ func (k Keeper) GetAxelarCorkResults(ctx sdk.Context, chainID uint64) []*types.A
 // Votes //
 ///////////
 
-func (k Keeper) GetApprovedScheduledAxelarCorks(ctx sdk.Context, chainID uint64) (approvedCorks []types.AxelarCork) {
-	currentBlockHeight := uint64(ctx.BlockHeight())
-	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
-	corks := []types.AxelarCork{}
-	powers := []uint64{}
-	k.IterateScheduledAxelarCorksByBlockHeight(ctx, chainID, currentBlockHeight, func(val sdk.ValAddress, _ uint64, id []byte, addr common.Address, cork types.AxelarCork) (stop bool) {
+// axelarCorkTally is the consensus power of the validators that scheduled a cork for a block height
+type axelarCorkTally struct {
+	cork   types.AxelarCork
+	power  uint64
+	voters []sdk.ValAddress
+}
+
+// tallyScheduledAxelarCorks sums the current consensus power of the validators behind each distinct cork scheduled
+// on a chain for a block height
+func (k Keeper) tallyScheduledAxelarCorks(ctx sdk.Context, chainID uint64, blockHeight uint64) []axelarCorkTally {
+	tallies := []axelarCorkTally{}
+	k.IterateScheduledAxelarCorksByBlockHeight(ctx, chainID, blockHeight, func(val sdk.ValAddress, _ uint64, _ []byte, _ common.Address, cork types.AxelarCork) (stop bool) {
 		validator := k.stakingKeeper.Validator(ctx, val)
 		validatorPower := uint64(validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx)))
-		found := false
-		for i, c := range corks {
-			if c.Equals(cork) {
-				powers[i] += validatorPower
-
-				found = true
-				break
+		for i := range tallies {
+			if tallies[i].cork.Equals(cork) {
+				tallies[i].power += validatorPower
+				tallies[i].voters = append(tallies[i].voters, val)
+				return false
 			}
 		}
 
-		if !found {
-			corks = append(corks, cork)
-			powers = append(powers, validatorPower)
-		}
+		tallies = append(tallies, axelarCorkTally{
+			cork:   cork,
+			power:  validatorPower,
+			voters: []sdk.ValAddress{val},
+		})
+		return false
+	})
 
-		k.DeleteScheduledAxelarCork(ctx, chainID, currentBlockHeight, id, val, addr)
+	return tallies
+}
+
+// tallyResult returns the share of the total power behind a tallied cork, the vote threshold, and whether the
+// share exceeds the threshold. Nothing is approved without any bonded power.
+func tallyResult(tally axelarCorkTally, totalPower sdk.Int) (approvalPercentage sdk.Dec, threshold sdk.Dec, approved bool) {
+	threshold = sdk.MustNewDecFromStr(CorkVoteThresholdStr)
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec(), threshold, false
+	}
+
+	approvalPercentage = sdk.NewDecFromInt(sdk.NewIntFromUint64(tally.power)).Quo(sdk.NewDecFromInt(totalPower))
+
+	return approvalPercentage, threshold, approvalPercentage.GT(threshold)
+}
+
+func (k Keeper) GetApprovedScheduledAxelarCorks(ctx sdk.Context, chainID uint64) (approvedCorks []types.AxelarCork) {
+	currentBlockHeight := uint64(ctx.BlockHeight())
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	tallies := k.tallyScheduledAxelarCorks(ctx, chainID, currentBlockHeight)
 
+	k.IterateScheduledAxelarCorksByBlockHeight(ctx, chainID, currentBlockHeight, func(val sdk.ValAddress, _ uint64, id []byte, addr common.Address, _ types.AxelarCork) (stop bool) {
+		k.DeleteScheduledAxelarCork(ctx, chainID, currentBlockHeight, id, val, addr)
 		return false
 	})
 
-	threshold := sdk.MustNewDecFromStr(CorkVoteThresholdStr)
-	for i, power := range powers {
-		cork := corks[i]
-		approvalPercentage := sdk.NewDecFromInt(sdk.NewIntFromUint64(power)).Quo(sdk.NewDecFromInt(totalPower))
-		quorumReached := approvalPercentage.GT(threshold)
+	for _, tally := range tallies {
+		cork := tally.cork
+		approvalPercentage, _, quorumReached := tallyResult(tally, totalPower)
 		corkResult := types.AxelarCorkResult{
 			Cork:               &cork,
 			BlockHeight:        currentBlockHeight,
@@ -396,39 +666,129 @@ func (k Keeper) GetApprovedScheduledAxelarCorks(ctx sdk.Context, chainID uint64)
 	return approvedCorks
 }
 
+// GetAxelarCorkTallies previews the tally of a chain's corks scheduled for a block height against the given total
+// power, without consuming the scheduled corks
+func (k Keeper) GetAxelarCorkTallies(ctx sdk.Context, chainID uint64, blockHeight uint64, totalPower sdk.Int) []types.AxelarCorkTally {
+	tallies := []types.AxelarCorkTally{}
+	for _, tally := range k.tallyScheduledAxelarCorks(ctx, chainID, blockHeight) {
+		approvalPercentage, threshold, approved := tallyResult(tally, totalPower)
+
+		voters := make([]string, len(tally.voters))
+		for i, val := range tally.voters {
+			voters[i] = val.String()
+		}
+
+		tallies = append(tallies, types.AxelarCorkTally{
+			Cork:               tally.cork,
+			Id:                 hex.EncodeToString(tally.cork.IDHash(blockHeight)),
+			BlockHeight:        blockHeight,
+			Power:              tally.power,
+			ApprovalPercentage: approvalPercentage.Mul(sdk.NewDec(100)).String(),
+			VoteThreshold:      threshold.String(),
+			Approved:           approved,
+			Voters:             voters,
+		})
+	}
+
+	return tallies
+}
+
 /////////////
 // Cellars //
 /////////////
 
-func (k Keeper) SetCellarIDs(ctx sdk.Context, chainID uint64, c types.CellarIDSet) {
-	bz := k.cdc.MustMarshal(&c)
-	ctx.KVStore(k.storeKey).Set(types.MakeCellarIDsKey(chainID), bz)
+// SetCellar adds a managed cellar, or replaces the record of an existing one
+func (k Keeper) SetCellar(ctx sdk.Context, cellar types.AxelarManagedCellar) {
+	address := common.HexToAddress(cellar.CellarId)
+	cellar.CellarId = address.String()
+	bz := k.cdc.MustMarshal(&cellar)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarKey(cellar.ChainId, address), bz)
 }
 
-func (k Keeper) GetCellarIDs(ctx sdk.Context, chainID uint64) (cellars []common.Address) {
+// GetCellar returns the record of a managed cellar
+func (k Keeper) GetCellar(ctx sdk.Context, chainID uint64, address common.Address) (types.AxelarManagedCellar, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarKey(chainID, address))
+	if len(bz) == 0 {
+		return types.AxelarManagedCellar{}, false
+	}
+
+	var cellar types.AxelarManagedCellar
+	k.cdc.MustUnmarshal(bz, &cellar)
+
+	return cellar, true
+}
+
+// DeleteCellar removes a managed cellar
+func (k Keeper) DeleteCellar(ctx sdk.Context, chainID uint64, address common.Address) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.MakeCellarIDsKey(chainID))
+	store.Delete(types.GetCellarKey(chainID, address))
+	store.Delete(types.GetPendingCellarProposalKey(chainID, address))
+}
 
-	var cids types.CellarIDSet
-	k.cdc.MustUnmarshal(bz, &cids)
+// IterateCellars iterates over the managed cellars of a chain in address order
+func (k Keeper) IterateCellars(ctx sdk.Context, chainID uint64, cb func(cellar types.AxelarManagedCellar) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetCellarKeyPrefix(chainID))
+	defer iter.Close()
 
-	for _, cid := range cids.Ids {
-		cellars = append(cellars, common.HexToAddress(cid))
+	for ; iter.Valid(); iter.Next() {
+		var cellar types.AxelarManagedCellar
+		k.cdc.MustUnmarshal(iter.Value(), &cellar)
+		if cb(cellar) {
+			break
+		}
 	}
+}
+
+// GetCellars returns the records of all managed cellars of a chain
+func (k Keeper) GetCellars(ctx sdk.Context, chainID uint64) (cellars []types.AxelarManagedCellar) {
+	k.IterateCellars(ctx, chainID, func(cellar types.AxelarManagedCellar) (stop bool) {
+		cellars = append(cellars, cellar)
+		return false
+	})
+
+	return cellars
+}
+
+func (k Keeper) GetCellarIDs(ctx sdk.Context, chainID uint64) (cellars []common.Address) {
+	k.IterateCellars(ctx, chainID, func(cellar types.AxelarManagedCellar) (stop bool) {
+		cellars = append(cellars, common.HexToAddress(cellar.CellarId))
+		return false
+	})
 
 	return cellars
 }
 
 func (k Keeper) HasCellarID(ctx sdk.Context, chainID uint64, address common.Address) (found bool) {
-	found = false
-	for _, id := range k.GetCellarIDs(ctx, chainID) {
-		if id == address {
-			found = true
-			break
-		}
+	return ctx.KVStore(k.storeKey).Has(types.GetCellarKey(chainID, address))
+}
+
+// setPendingCellarProposal marks a cellar as added by the governance proposal currently being executed, the
+// proposal's ID is recorded once gov calls AfterProposalVotingPeriodEnded
+func (k Keeper) setPendingCellarProposal(ctx sdk.Context, chainID uint64, address common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingCellarProposalKey(chainID, address), []byte{})
+}
+
+// setCellarProposalID records the proposal ID on every cellar pending one
+func (k Keeper) setCellarProposalID(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPendingCellarProposalKeyPrefix())
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
 	}
 
-	return found
+	for _, key := range keys {
+		store.Delete(key)
+
+		chainID := sdk.BigEndianToUint64(key[1:9])
+		address := common.BytesToAddress(key[9:])
+		if cellar, found := k.GetCellar(ctx, chainID, address); found {
+			cellar.ProposalId = proposalID
+			k.SetCellar(ctx, cellar)
+		}
+	}
 }
 
 /////////////////////////////////
@@ -524,6 +884,328 @@ func (k Keeper) IterateAxelarProxyUpgradeData(ctx sdk.Context, cb func(chainID u
 	}
 }
 
+///////////////////////////////
+// Cellar Selector Allowlists //
+///////////////////////////////
+
+func (k Keeper) SetCellarSelectorAllowlist(ctx sdk.Context, allowlist types.AxelarCellarSelectorAllowlist) {
+	bz := k.cdc.MustMarshal(&allowlist)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarSelectorAllowlistKey(allowlist.ChainId, common.HexToAddress(allowlist.CellarId)), bz)
+}
+
+// GetCellarSelectorAllowlist returns the governance set function selector allowlist for a cellar, if one exists
+func (k Keeper) GetCellarSelectorAllowlist(ctx sdk.Context, chainID uint64, cellar common.Address) (types.AxelarCellarSelectorAllowlist, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarSelectorAllowlistKey(chainID, cellar))
+	if len(bz) == 0 {
+		return types.AxelarCellarSelectorAllowlist{}, false
+	}
+
+	allowlist := types.AxelarCellarSelectorAllowlist{}
+	k.cdc.MustUnmarshal(bz, &allowlist)
+
+	return allowlist, true
+}
+
+func (k Keeper) DeleteCellarSelectorAllowlist(ctx sdk.Context, chainID uint64, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCellarSelectorAllowlistKey(chainID, cellar))
+}
+
+// IterateCellarSelectorAllowlists iterates over the function selector allowlists of cellars on every chain
+func (k Keeper) IterateCellarSelectorAllowlists(ctx sdk.Context, cb func(allowlist types.AxelarCellarSelectorAllowlist) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.CellarSelectorAllowlistPrefix})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		allowlist := types.AxelarCellarSelectorAllowlist{}
+		k.cdc.MustUnmarshal(iter.Value(), &allowlist)
+		if cb(allowlist) {
+			break
+		}
+	}
+}
+
+// ValidateCorkSelector returns an error if the cork's target cellar has a function selector allowlist that
+// does not include the function the cork calls. Cellars without an allowlist accept any function.
+func (k Keeper) ValidateCorkSelector(ctx sdk.Context, chainID uint64, cork types.AxelarCork) error {
+	allowlist, found := k.GetCellarSelectorAllowlist(ctx, chainID, common.HexToAddress(cork.TargetContractAddress))
+	if !found {
+		return nil
+	}
+
+	selector, err := cork.Selector()
+	if err != nil {
+		return err
+	}
+
+	if !allowlist.Allows(selector) {
+		return errorsmod.Wrapf(types.ErrSelectorNotAllowed, "selector %s on cellar %s on chain %d", selector, cork.TargetContractAddress, chainID)
+	}
+
+	return nil
+}
+
+////////////////////
+// Paused Cellars //
+////////////////////
+
+// SetCellarPauseState pauses or resumes a cellar and emits an event recording the authority that changed its state
+func (k Keeper) SetCellarPauseState(ctx sdk.Context, chainID uint64, cellar common.Address, paused bool, authority string) error {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.GetPausedCellarKey(chainID, cellar), []byte{})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.GetPausedCellarKey(chainID, cellar))
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.CellarPauseEvent{
+		ChainId:   chainID,
+		CellarId:  cellar.String(),
+		Paused:    paused,
+		Authority: authority,
+	})
+}
+
+func (k Keeper) IsCellarPaused(ctx sdk.Context, chainID uint64, cellar common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetPausedCellarKey(chainID, cellar))
+}
+
+// IteratePausedCellars iterates over the paused cellars on a chain
+func (k Keeper) IteratePausedCellars(ctx sdk.Context, chainID uint64, cb func(cellar common.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetPausedCellarKeyPrefix(chainID)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToAddress(iter.Key()[len(prefix):])) {
+			break
+		}
+	}
+}
+
+// GetPausedCellarIDs returns the paused cellars on a chain
+func (k Keeper) GetPausedCellarIDs(ctx sdk.Context, chainID uint64) types.CellarIDSet {
+	paused := types.CellarIDSet{ChainId: chainID, Ids: []string{}}
+	k.IteratePausedCellars(ctx, chainID, func(cellar common.Address) (stop bool) {
+		paused.Ids = append(paused.Ids, cellar.String())
+		return false
+	})
+
+	return paused
+}
+
+////////////////////////////////
+// Governance Scheduled Corks //
+////////////////////////////////
+
+// RegisterContractCallEncoder registers the encoder used to derive the contract call of a scheduled cork proposal
+// whose ScheduleRequest JSON contains a call for the given cellar type, such as "cellar_v1"
+func (k Keeper) RegisterContractCallEncoder(cellarType string, encoder types.ContractCallEncoder) {
+	if _, found := k.contractCallEncoders[cellarType]; found {
+		panic(fmt.Sprintf("contract call encoder for cellar type %s already registered", cellarType))
+	}
+
+	k.contractCallEncoders[cellarType] = encoder
+}
+
+// EncodeContractCall ABI encodes the cellar call in the JSON form of a Steward ScheduleRequest using the
+// encoder registered for its cellar type
+func (k Keeper) EncodeContractCall(protoJSON string) ([]byte, error) {
+	cellarType, callJSON, err := types.ParseContractCallProtoJSON(protoJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, found := k.contractCallEncoders[cellarType]
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrContractCallEncoderNotFound, "cellar type: %s", cellarType)
+	}
+
+	encoded, err := encoder(callJSON)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidJSON, "failed to encode %s call: %s", cellarType, err)
+	}
+
+	return encoded, nil
+}
+
+// SetGovernanceScheduledAxelarCork stores a cork scheduled by governance to be made relayable at a block height
+func (k Keeper) SetGovernanceScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, cork types.AxelarCork) []byte {
+	id := cork.IDHash(blockHeight)
+	bz := k.cdc.MustMarshal(&types.GovernanceScheduledAxelarCork{
+		Cork:        &cork,
+		BlockHeight: blockHeight,
+		Id:          hex.EncodeToString(id),
+	})
+	ctx.KVStore(k.storeKey).Set(types.GetGovernanceScheduledAxelarCorkKey(chainID, blockHeight, id), bz)
+	return id
+}
+
+func (k Keeper) GetGovernanceScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, id []byte) (types.GovernanceScheduledAxelarCork, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGovernanceScheduledAxelarCorkKey(chainID, blockHeight, id))
+	if len(bz) == 0 {
+		return types.GovernanceScheduledAxelarCork{}, false
+	}
+
+	var cork types.GovernanceScheduledAxelarCork
+	k.cdc.MustUnmarshal(bz, &cork)
+	return cork, true
+}
+
+func (k Keeper) DeleteGovernanceScheduledAxelarCork(ctx sdk.Context, chainID uint64, blockHeight uint64, id []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.GetGovernanceScheduledAxelarCorkKey(chainID, blockHeight, id))
+}
+
+// IterateGovernanceScheduledAxelarCorks iterates over the chain's governance scheduled corks that have yet to be
+// made relayable
+func (k Keeper) IterateGovernanceScheduledAxelarCorks(ctx sdk.Context, chainID uint64, cb func(cork types.GovernanceScheduledAxelarCork) (stop bool)) {
+	k.IterateGovernanceScheduledAxelarCorksByPrefix(ctx, types.GetGovernanceScheduledAxelarCorkKeyPrefix(chainID), cb)
+}
+
+// IterateGovernanceScheduledAxelarCorksByBlockHeight iterates over the chain's governance scheduled corks for a
+// block height
+func (k Keeper) IterateGovernanceScheduledAxelarCorksByBlockHeight(ctx sdk.Context, chainID uint64, blockHeight uint64, cb func(cork types.GovernanceScheduledAxelarCork) (stop bool)) {
+	k.IterateGovernanceScheduledAxelarCorksByPrefix(ctx, types.GetGovernanceScheduledAxelarCorkKeyByBlockHeightPrefix(chainID, blockHeight), cb)
+}
+
+func (k Keeper) IterateGovernanceScheduledAxelarCorksByPrefix(ctx sdk.Context, prefix []byte, cb func(cork types.GovernanceScheduledAxelarCork) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cork types.GovernanceScheduledAxelarCork
+		k.cdc.MustUnmarshal(iter.Value(), &cork)
+		if cb(cork) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetGovernanceScheduledAxelarCorks(ctx sdk.Context, chainID uint64) []types.GovernanceScheduledAxelarCork {
+	corks := []types.GovernanceScheduledAxelarCork{}
+	k.IterateGovernanceScheduledAxelarCorks(ctx, chainID, func(cork types.GovernanceScheduledAxelarCork) (stop bool) {
+		corks = append(corks, cork)
+		return false
+	})
+
+	return corks
+}
+
+// deleteGovernanceScheduledAxelarCorksForCellar removes the chain's governance scheduled corks targeting a cellar that
+// have yet to be made relayable
+func (k Keeper) deleteGovernanceScheduledAxelarCorksForCellar(ctx sdk.Context, chainID uint64, cellar common.Address) {
+	var scheduled []types.GovernanceScheduledAxelarCork
+	k.IterateGovernanceScheduledAxelarCorks(ctx, chainID, func(cork types.GovernanceScheduledAxelarCork) (stop bool) {
+		if common.HexToAddress(cork.Cork.TargetContractAddress) == cellar {
+			scheduled = append(scheduled, cork)
+		}
+		return false
+	})
+
+	for _, s := range scheduled {
+		k.DeleteGovernanceScheduledAxelarCork(ctx, chainID, s.BlockHeight, s.Cork.IDHash(s.BlockHeight))
+	}
+}
+
+// takeGovernanceScheduledAxelarCorks removes the chain's governance scheduled corks for the current block height and
+// records an approved result for each. Corks that validators already approved at this height are not returned again.
+func (k Keeper) takeGovernanceScheduledAxelarCorks(ctx sdk.Context, chainID uint64) (corks []types.AxelarCork) {
+	currentBlockHeight := uint64(ctx.BlockHeight())
+
+	var scheduled []types.GovernanceScheduledAxelarCork
+	k.IterateGovernanceScheduledAxelarCorksByBlockHeight(ctx, chainID, currentBlockHeight, func(cork types.GovernanceScheduledAxelarCork) (stop bool) {
+		scheduled = append(scheduled, cork)
+		return false
+	})
+
+	for _, s := range scheduled {
+		id := s.Cork.IDHash(currentBlockHeight)
+		k.DeleteGovernanceScheduledAxelarCork(ctx, chainID, currentBlockHeight, id)
+
+		if corkResult, found := k.GetAxelarCorkResult(ctx, chainID, id); found && corkResult.Approved {
+			continue
+		}
+
+		k.SetAxelarCorkResult(ctx, chainID, id, types.AxelarCorkResult{
+			Cork:        s.Cork,
+			BlockHeight: currentBlockHeight,
+			Approved:    true,
+			Governance:  true,
+		})
+		corks = append(corks, *s.Cork)
+	}
+
+	return corks
+}
+
+/////////////////
+// Cellar ABIs //
+/////////////////
+
+func (k Keeper) SetCellarABI(ctx sdk.Context, cellarABI types.AxelarCellarABI) {
+	bz := k.cdc.MustMarshal(&cellarABI)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarABIKey(cellarABI.ChainId, common.HexToAddress(cellarABI.CellarId)), bz)
+}
+
+// GetCellarABI returns the governance registered ABI for a cellar, if one exists
+func (k Keeper) GetCellarABI(ctx sdk.Context, chainID uint64, cellar common.Address) (types.AxelarCellarABI, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarABIKey(chainID, cellar))
+	if len(bz) == 0 {
+		return types.AxelarCellarABI{}, false
+	}
+
+	var cellarABI types.AxelarCellarABI
+	k.cdc.MustUnmarshal(bz, &cellarABI)
+	return cellarABI, true
+}
+
+func (k Keeper) DeleteCellarABI(ctx sdk.Context, chainID uint64, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCellarABIKey(chainID, cellar))
+}
+
+// IterateCellarABIs iterates over the ABIs of cellars on a chain
+func (k Keeper) IterateCellarABIs(ctx sdk.Context, chainID uint64, cb func(cellarABI types.AxelarCellarABI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCellarABIKeyPrefix(chainID))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cellarABI types.AxelarCellarABI
+		k.cdc.MustUnmarshal(iter.Value(), &cellarABI)
+		if cb(cellarABI) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetCellarABIs(ctx sdk.Context, chainID uint64) []types.AxelarCellarABI {
+	abis := []types.AxelarCellarABI{}
+	k.IterateCellarABIs(ctx, chainID, func(cellarABI types.AxelarCellarABI) (stop bool) {
+		abis = append(abis, cellarABI)
+		return false
+	})
+
+	return abis
+}
+
+// DecodeCork decodes the contract call of a cork using its target cellar's registered ABI. Corks whose cellar has
+// no registered ABI, or whose call the ABI doesn't describe, are not decoded.
+func (k Keeper) DecodeCork(ctx sdk.Context, chainID uint64, id string, cork types.AxelarCork) (types.DecodedContractCall, bool) {
+	cellarABI, found := k.GetCellarABI(ctx, chainID, common.HexToAddress(cork.TargetContractAddress))
+	if !found {
+		return types.DecodedContractCall{}, false
+	}
+
+	decoded, err := cellarABI.DecodeContractCall(id, cork.EncodedContractCall)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to decode cork", "id", id, "chain id", chainID, "cellar", cork.TargetContractAddress, "error", err)
+		return types.DecodedContractCall{}, false
+	}
+
+	return decoded, true
+}
+
 /////////////////////
 // Module Accounts //
 /////////////////////
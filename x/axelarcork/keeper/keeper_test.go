@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"encoding/hex"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/tests/mocks"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	moduletestutil "github.com/peggyjv/sommelier/v7/testutil"
@@ -118,19 +121,46 @@ func (suite *KeeperTestSuite) TestSetGetCellarIDsHappyPath() {
 	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
 	require := suite.Require()
 
-	cellarIDSet := types.CellarIDSet{
-		ChainId: TestEVMChainID,
-		Ids:     []string{sampleCellarHex},
+	cellar := types.AxelarManagedCellar{
+		ChainId:         TestEVMChainID,
+		CellarId:        sampleCellarHex,
+		AddedHeight:     10,
+		PublisherDomain: "example.com",
 	}
-	expected := []common.Address{}
-	for _, id := range cellarIDSet.Ids {
-		expected = append(expected, common.HexToAddress(id))
-	}
-	axelarcorkKeeper.SetCellarIDs(ctx, TestEVMChainID, cellarIDSet)
-	actual := axelarcorkKeeper.GetCellarIDs(ctx, TestEVMChainID)
+	axelarcorkKeeper.SetCellar(ctx, cellar)
 
-	require.Equal(expected, actual)
+	require.Equal([]common.Address{sampleCellarAddr}, axelarcorkKeeper.GetCellarIDs(ctx, TestEVMChainID))
 	require.True(axelarcorkKeeper.HasCellarID(ctx, TestEVMChainID, sampleCellarAddr))
+	require.False(axelarcorkKeeper.HasCellarID(ctx, TestEVMChainID+1, sampleCellarAddr))
+
+	actual, found := axelarcorkKeeper.GetCellar(ctx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(sampleCellarAddr.String(), actual.CellarId)
+	require.Equal(uint64(10), actual.AddedHeight)
+	require.Equal("example.com", actual.PublisherDomain)
+
+	axelarcorkKeeper.DeleteCellar(ctx, TestEVMChainID, sampleCellarAddr)
+	require.False(axelarcorkKeeper.HasCellarID(ctx, TestEVMChainID, sampleCellarAddr))
+	require.Empty(axelarcorkKeeper.GetCellarIDs(ctx, TestEVMChainID))
+}
+
+func (suite *KeeperTestSuite) TestCellarProposalIDSetByGovHook() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex, AddedHeight: 5})
+	axelarcorkKeeper.setPendingCellarProposal(ctx, TestEVMChainID, sampleCellarAddr)
+
+	axelarcorkKeeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, 42)
+
+	cellar, found := axelarcorkKeeper.GetCellar(ctx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(uint64(42), cellar.ProposalId)
+
+	// a later proposal must not overwrite the recorded ID
+	axelarcorkKeeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, 43)
+	cellar, _ = axelarcorkKeeper.GetCellar(ctx, TestEVMChainID, sampleCellarAddr)
+	require.Equal(uint64(42), cellar.ProposalId)
 }
 
 func (suite *KeeperTestSuite) TestSetGetDeleteScheduledCork() {
@@ -217,6 +247,39 @@ func (suite *KeeperTestSuite) TestGetWinningVotes() {
 	require.Empty(axelarcorkKeeper.GetScheduledAxelarCorksByBlockHeight(ctx, TestEVMChainID, testHeight))
 }
 
+func (suite *KeeperTestSuite) TestGetWinningVotesWithoutBondedPower() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetParams(ctx, types.DefaultParams())
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	testHeight := uint64(ctx.BlockHeight())
+	cork := types.AxelarCork{
+		EncodedContractCall:   []byte("testcall"),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              uint64(ctx.BlockTime().Unix()) + 3600,
+	}
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, testHeight, sdk.ValAddress("12345678901234567890"), cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.ZeroInt())
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(0))
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+
+	// nothing is approved, rather than dividing by zero
+	require.Empty(axelarcorkKeeper.GetApprovedScheduledAxelarCorks(ctx, TestEVMChainID))
+	results := axelarcorkKeeper.GetAxelarCorkResults(ctx, TestEVMChainID)
+	require.Len(results, 1)
+	require.False(results[0].Approved)
+	require.Equal("0.000000000000000000", results[0].ApprovalPercentage)
+}
+
 func (suite *KeeperTestSuite) TestCorkResults() {
 	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
 	require := suite.Require()
@@ -249,6 +312,175 @@ func (suite *KeeperTestSuite) TestCorkResults() {
 	require.Empty(axelarcorkKeeper.GetAxelarCorkResults(ctx, TestEVMChainID))
 }
 
+func (suite *KeeperTestSuite) TestPruneAxelarCorkResults() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.CorkResultRetentionBlocks = 2
+	axelarcorkKeeper.SetParams(ctx, params)
+
+	newResult := func(call string, height uint64) ([]byte, types.AxelarCorkResult) {
+		cork := types.AxelarCork{
+			EncodedContractCall:   []byte(call),
+			TargetContractAddress: sampleCellarHex,
+			ChainId:               TestEVMChainID,
+		}
+		return cork.IDHash(height), types.AxelarCorkResult{
+			Cork:               &cork,
+			BlockHeight:        height,
+			Approved:           true,
+			ApprovalPercentage: "100.00",
+		}
+	}
+
+	// ctx height is 5, so results from height 3 and below are outside the retention window
+	oldID, oldResult := newResult("oldcall", 3)
+	recentID, recentResult := newResult("recentcall", 4)
+	axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID, oldID, oldResult)
+	axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID, recentID, recentResult)
+
+	// results on other chains are untouched
+	axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID+1, oldID, oldResult)
+
+	axelarcorkKeeper.pruneAxelarCorkResults(ctx, TestEVMChainID, params)
+
+	_, found := axelarcorkKeeper.GetAxelarCorkResult(ctx, TestEVMChainID, oldID)
+	require.False(found)
+	_, found = axelarcorkKeeper.GetAxelarCorkResult(ctx, TestEVMChainID, recentID)
+	require.True(found)
+	_, found = axelarcorkKeeper.GetAxelarCorkResult(ctx, TestEVMChainID+1, oldID)
+	require.True(found)
+
+	// the height index only holds the remaining result
+	var indexedIDs [][]byte
+	axelarcorkKeeper.IterateAxelarCorkResultIDsUpToHeight(ctx, TestEVMChainID, uint64(ctx.BlockHeight()), func(id []byte, _ uint64) (stop bool) {
+		indexedIDs = append(indexedIDs, id)
+		return false
+	})
+	require.Equal([][]byte{recentID}, indexedIDs)
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal("axelarcork.v1.AxelarCorkResultArchivedEvent", events[0].Type)
+
+	// pruning without archiving emits no events
+	params.ArchivePrunedCorkResults = false
+	params.CorkResultRetentionBlocks = 1
+	axelarcorkKeeper.pruneAxelarCorkResults(ctx, TestEVMChainID, params)
+	require.Empty(axelarcorkKeeper.GetAxelarCorkResults(ctx, TestEVMChainID))
+	require.Len(ctx.EventManager().Events(), 1)
+}
+
+func (suite *KeeperTestSuite) TestGovernanceScheduledCork() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetParams(ctx, types.DefaultParams())
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+
+	executionHeight := uint64(ctx.BlockHeight() + 5)
+	deadline := uint64(10000000000)
+	protoJSON := "{\"cellar_id\":\"" + sampleCellarHex + "\",\"cellar_v1\":{\"trust_position\":{}},\"block_height\":10}"
+	proposal := types.NewAxelarScheduledCorkProposal("title", "description", uint64(ctx.BlockHeight()), TestEVMChainID, sampleCellarHex, protoJSON, deadline, nil)
+	// proposals for a past height are only a signal for Steward
+	require.NoError(HandleScheduledCorkProposal(ctx, axelarcorkKeeper, *proposal))
+	require.Empty(axelarcorkKeeper.GetGovernanceScheduledAxelarCorks(ctx, TestEVMChainID))
+
+	// proposals without an encoder for their cellar type fail
+	proposal.BlockHeight = executionHeight
+	require.ErrorIs(HandleScheduledCorkProposal(ctx, axelarcorkKeeper, *proposal), types.ErrContractCallEncoderNotFound)
+	require.Empty(axelarcorkKeeper.GetGovernanceScheduledAxelarCorks(ctx, TestEVMChainID))
+
+	// raw calldata doesn't need an encoder
+	proposal.EncodedContractCall = []byte("rawcall")
+	require.NoError(HandleScheduledCorkProposal(ctx, axelarcorkKeeper, *proposal))
+
+	cork := types.AxelarCork{
+		EncodedContractCall:   proposal.EncodedContractCall,
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              deadline,
+	}
+	scheduled := axelarcorkKeeper.GetGovernanceScheduledAxelarCorks(ctx, TestEVMChainID)
+	require.Len(scheduled, 1)
+	require.Equal(executionHeight, scheduled[0].BlockHeight)
+	require.Equal(hex.EncodeToString(cork.IDHash(executionHeight)), scheduled[0].Id)
+	require.NoError(scheduled[0].ValidateBasic())
+
+	// the cork becomes relayable at the execution height without any votes
+	executionCtx := ctx.WithBlockHeight(int64(executionHeight))
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100))
+
+	axelarcorkKeeper.EndBlocker(executionCtx)
+
+	require.Empty(axelarcorkKeeper.GetGovernanceScheduledAxelarCorks(executionCtx, TestEVMChainID))
+	blockHeight, _, winningCork, found := axelarcorkKeeper.GetWinningAxelarCork(executionCtx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(executionHeight, blockHeight)
+	require.Equal(cork, winningCork)
+
+	result, found := axelarcorkKeeper.GetAxelarCorkResult(executionCtx, TestEVMChainID, cork.IDHash(executionHeight))
+	require.True(found)
+	require.True(result.Approved)
+	require.True(result.Governance)
+	require.NoError(result.ValidateBasic())
+
+	// winning corks are pruned once their deadline passes
+	deadlineCtx := executionCtx.WithBlockHeight(int64(executionHeight + 1)).WithBlockTime(time.Unix(int64(deadline), 0))
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100))
+
+	axelarcorkKeeper.EndBlocker(deadlineCtx)
+
+	_, _, _, found = axelarcorkKeeper.GetWinningAxelarCork(deadlineCtx, TestEVMChainID, sampleCellarAddr)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestRemoveManagedCellarsProposal() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	otherCellarHex := "0x0000000000000000000000000000000000000001"
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: otherCellarHex})
+	axelarcorkKeeper.SetCellarABI(ctx, types.AxelarCellarABI{ChainId: TestEVMChainID, CellarId: sampleCellarHex, Abi: "[]"})
+	axelarcorkKeeper.SetCellarABI(ctx, types.AxelarCellarABI{ChainId: TestEVMChainID, CellarId: otherCellarHex, Abi: "[]"})
+
+	executionHeight := uint64(ctx.BlockHeight() + 5)
+	deadline := uint64(ctx.BlockTime().Unix()) + 3600
+	cellarCork := types.AxelarCork{EncodedContractCall: []byte("call1"), ChainId: TestEVMChainID, TargetContractAddress: sampleCellarHex, Deadline: deadline}
+	otherCork := types.AxelarCork{EncodedContractCall: []byte("call2"), ChainId: TestEVMChainID, TargetContractAddress: otherCellarHex, Deadline: deadline}
+	axelarcorkKeeper.SetGovernanceScheduledAxelarCork(ctx, TestEVMChainID, executionHeight, cellarCork)
+	axelarcorkKeeper.SetGovernanceScheduledAxelarCork(ctx, TestEVMChainID, executionHeight, otherCork)
+
+	suite.pubsubKeeper.EXPECT().DeleteDefaultSubscription(ctx, NewAxelarSubscriptionID(TestEVMChainID, sampleCellarAddr))
+	proposal := types.NewRemoveAxelarManagedCellarIDsProposal("title", "description", TestEVMChainID, &types.CellarIDSet{ChainId: TestEVMChainID, Ids: []string{sampleCellarHex}})
+	require.NoError(HandleRemoveManagedCellarsProposal(ctx, axelarcorkKeeper, *proposal))
+
+	require.False(axelarcorkKeeper.HasCellarID(ctx, TestEVMChainID, sampleCellarAddr))
+	_, found := axelarcorkKeeper.GetCellarABI(ctx, TestEVMChainID, sampleCellarAddr)
+	require.False(found)
+	_, found = axelarcorkKeeper.GetGovernanceScheduledAxelarCork(ctx, TestEVMChainID, executionHeight, cellarCork.IDHash(executionHeight))
+	require.False(found)
+
+	// other cellars are unaffected
+	require.True(axelarcorkKeeper.HasCellarID(ctx, TestEVMChainID, common.HexToAddress(otherCellarHex)))
+	_, found = axelarcorkKeeper.GetCellarABI(ctx, TestEVMChainID, common.HexToAddress(otherCellarHex))
+	require.True(found)
+	_, found = axelarcorkKeeper.GetGovernanceScheduledAxelarCork(ctx, TestEVMChainID, executionHeight, otherCork.IDHash(executionHeight))
+	require.True(found)
+}
+
 func (suite *KeeperTestSuite) TestParamSet() {
 	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
 	require := suite.Require()
@@ -373,3 +605,167 @@ func (suite *KeeperTestSuite) TestAxelarProxyUpgradeData() {
 	require.False(found)
 
 }
+
+func (suite *KeeperTestSuite) TestAxelarCorkRelayAcknowledgements() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	channel := "channel-0"
+	relayer := sdk.AccAddress([]byte("relayer_address_____"))
+	token := sdk.NewInt64Coin("usomm", 1000)
+	newRelay := func(sequence uint64, blockHeight uint64) types.AxelarCorkRelay {
+		cork := types.AxelarCork{
+			EncodedContractCall:   []byte{0x1, 0x2, byte(sequence)},
+			ChainId:               TestEVMChainID,
+			TargetContractAddress: sampleCellarHex,
+			Deadline:              100,
+		}
+
+		return types.AxelarCorkRelay{
+			ChainId:       TestEVMChainID,
+			CorkId:        hex.EncodeToString(cork.IDHash(blockHeight)),
+			Cork:          cork,
+			BlockHeight:   blockHeight,
+			Relayer:       relayer.String(),
+			Token:         token,
+			SourceChannel: channel,
+			Sequence:      sequence,
+			Status:        types.AxelarCorkRelayStatus_RELAY_STATUS_PENDING,
+			QueueNonce:    sequence,
+		}
+	}
+
+	// successful acknowledgements only settle the relay
+	acknowledged := newRelay(1, 3)
+	axelarcorkKeeper.SetAxelarCorkRelay(ctx, acknowledged)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(axelarcorkKeeper.OnAxelarCorkAcknowledgement(ctx, channel, 1, ack.Acknowledgement()))
+
+	id, _ := hex.DecodeString(acknowledged.CorkId)
+	relay, found := axelarcorkKeeper.GetAxelarCorkRelay(ctx, TestEVMChainID, id)
+	require.True(found)
+	require.Equal(types.AxelarCorkRelayStatus_RELAY_STATUS_ACKNOWLEDGED, relay.Status)
+	_, found = axelarcorkKeeper.GetPendingAxelarCorkRelay(ctx, channel, 1)
+	require.False(found)
+	_, _, _, found = axelarcorkKeeper.GetWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr)
+	require.False(found)
+
+	// error acknowledgements restore the winning cork and refund the relayer
+	failed := newRelay(2, 4)
+	axelarcorkKeeper.SetAxelarCorkRelay(ctx, failed)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, sdk.NewCoins(token)).Return(nil)
+	ack = channeltypes.NewErrorAcknowledgement(fmt.Errorf("execution failed"))
+	require.NoError(axelarcorkKeeper.OnAxelarCorkAcknowledgement(ctx, channel, 2, ack.Acknowledgement()))
+
+	id, _ = hex.DecodeString(failed.CorkId)
+	relay, found = axelarcorkKeeper.GetAxelarCorkRelay(ctx, TestEVMChainID, id)
+	require.True(found)
+	require.Equal(types.AxelarCorkRelayStatus_RELAY_STATUS_FAILED, relay.Status)
+	require.NotEmpty(relay.Error)
+	blockHeight, queueNonce, winningCork, found := axelarcorkKeeper.GetWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(failed.BlockHeight, blockHeight)
+	require.Equal(failed.QueueNonce, queueNonce)
+	require.Equal(failed.Cork, winningCork)
+	axelarcorkKeeper.DeleteWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr, blockHeight, queueNonce)
+
+	// timeouts restore the winning cork and refund the relayer
+	timedOut := newRelay(3, 5)
+	axelarcorkKeeper.SetAxelarCorkRelay(ctx, timedOut)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, sdk.NewCoins(token)).Return(nil)
+	require.NoError(axelarcorkKeeper.OnAxelarCorkTimeout(ctx, channel, 3))
+
+	id, _ = hex.DecodeString(timedOut.CorkId)
+	relay, found = axelarcorkKeeper.GetAxelarCorkRelay(ctx, TestEVMChainID, id)
+	require.True(found)
+	require.Equal(types.AxelarCorkRelayStatus_RELAY_STATUS_TIMED_OUT, relay.Status)
+	_, _, winningCork, found = axelarcorkKeeper.GetWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(timedOut.Cork, winningCork)
+	axelarcorkKeeper.DeleteWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr, timedOut.BlockHeight, timedOut.QueueNonce)
+
+	// a failed refund still settles the packet, marking the relay failed
+	refundFailed := newRelay(5, 6)
+	axelarcorkKeeper.SetAxelarCorkRelay(ctx, refundFailed)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, sdk.NewCoins(token)).Return(fmt.Errorf("insufficient funds"))
+	require.NoError(axelarcorkKeeper.OnAxelarCorkTimeout(ctx, channel, 5))
+
+	id, _ = hex.DecodeString(refundFailed.CorkId)
+	relay, found = axelarcorkKeeper.GetAxelarCorkRelay(ctx, TestEVMChainID, id)
+	require.True(found)
+	require.Equal(types.AxelarCorkRelayStatus_RELAY_STATUS_FAILED, relay.Status)
+	require.Equal("insufficient funds", relay.RefundError)
+	_, found = axelarcorkKeeper.GetPendingAxelarCorkRelay(ctx, channel, 5)
+	require.False(found)
+	_, _, winningCork, found = axelarcorkKeeper.GetWinningAxelarCork(ctx, TestEVMChainID, sampleCellarAddr)
+	require.True(found)
+	require.Equal(refundFailed.Cork, winningCork)
+	refundFailedEmitted := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "axelarcork.v1.AxelarCorkRelayRefundFailedEvent" {
+			refundFailedEmitted = true
+		}
+	}
+	require.True(refundFailedEmitted)
+
+	// packets that were not cork relays, or were already settled, are ignored
+	require.NoError(axelarcorkKeeper.OnAxelarCorkTimeout(ctx, channel, 3))
+	require.NoError(axelarcorkKeeper.OnAxelarCorkTimeout(ctx, channel, 4))
+
+	relaysRes, err := suite.queryClient.QueryCorkRelays(sdk.WrapSDKContext(ctx), &types.QueryCorkRelaysRequest{})
+	require.NoError(err)
+	require.Len(relaysRes.Relays, 0) // no chain configuration is set
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{Name: "test", Id: TestEVMChainID, ProxyAddress: zeroAddressHex})
+	relaysRes, err = suite.queryClient.QueryCorkRelays(sdk.WrapSDKContext(ctx), &types.QueryCorkRelaysRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Len(relaysRes.Relays, 4)
+
+	relayRes, err := suite.queryClient.QueryCorkRelay(sdk.WrapSDKContext(ctx), &types.QueryCorkRelayRequest{ChainId: TestEVMChainID, Id: timedOut.CorkId})
+	require.NoError(err)
+	require.Equal(types.AxelarCorkRelayStatus_RELAY_STATUS_TIMED_OUT, relayRes.Relay.Status)
+}
+
+func (suite *KeeperTestSuite) TestScheduledCorkChainsInvariant() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	invariant := ScheduledCorkChainsInvariant(axelarcorkKeeper)
+	_, broken := invariant(ctx)
+	require.False(broken)
+
+	testHeight := uint64(123)
+	val := []byte("testaddress")
+	cork := types.AxelarCork{
+		EncodedContractCall:   []byte("testcall"),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              uint64(10000000000),
+	}
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, testHeight, val, cork)
+	axelarcorkKeeper.SetGovernanceScheduledAxelarCork(ctx, TestEVMChainID, testHeight, cork)
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	// corks left behind when the chain configuration is removed don't break the invariant
+	require.NoError(HandleRemoveChainConfigurationProposal(ctx, axelarcorkKeeper, types.RemoveChainConfigurationProposal{
+		Title:       "remove",
+		Description: "remove",
+		ChainId:     TestEVMChainID,
+	}))
+	require.Len(axelarcorkKeeper.GetScheduledAxelarCorks(ctx, TestEVMChainID), 1)
+	require.Len(axelarcorkKeeper.GetGovernanceScheduledAxelarCorks(ctx, TestEVMChainID), 1)
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	// corks stored under a chain other than their target chain break it
+	axelarcorkKeeper.SetGovernanceScheduledAxelarCork(ctx, TestEVMChainID+1, testHeight, cork)
+	msg, broken := invariant(ctx)
+	require.True(broken)
+	require.Contains(msg, "governance scheduled cork")
+}
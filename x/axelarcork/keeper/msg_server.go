@@ -24,7 +24,8 @@ var _ types.MsgServer = Keeper{}
 // ScheduleCork implements types.MsgServer
 func (k Keeper) ScheduleCork(c context.Context, msg *types.MsgScheduleAxelarCorkRequest) (*types.MsgScheduleAxelarCorkResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	if !k.GetParamSet(ctx).Enabled {
+	params := k.GetParamSet(ctx)
+	if !params.Enabled {
 		return nil, types.ErrDisabled
 	}
 
@@ -43,10 +44,22 @@ func (k Keeper) ScheduleCork(c context.Context, msg *types.MsgScheduleAxelarCork
 		return nil, types.ErrUnmanagedCellarAddress
 	}
 
+	if k.IsCellarPaused(ctx, config.Id, common.HexToAddress(msg.Cork.TargetContractAddress)) {
+		return nil, errorsmod.Wrapf(types.ErrCellarPaused, "%s", msg.Cork.TargetContractAddress)
+	}
+
+	if err := k.ValidateCorkSelector(ctx, config.Id, *msg.Cork); err != nil {
+		return nil, err
+	}
+
 	if msg.BlockHeight <= uint64(ctx.BlockHeight()) {
 		return nil, types.ErrSchedulingInThePast
 	}
 
+	if err := msg.Cork.ValidateDeadline(ctx.BlockTime(), params.MaxCorkDeadlineHorizon); err != nil {
+		return nil, err
+	}
+
 	corkID := k.SetScheduledAxelarCork(ctx, config.Id, msg.BlockHeight, validatorAddr, *msg.Cork)
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.ScheduleCorkEvent{
@@ -75,10 +88,35 @@ func (k Keeper) RelayCork(c context.Context, msg *types.MsgRelayAxelarCorkReques
 		return nil, fmt.Errorf("chain by id %d not found", msg.ChainId)
 	}
 
+	if k.IsCellarPaused(ctx, config.Id, common.HexToAddress(msg.TargetContractAddress)) {
+		return nil, errorsmod.Wrapf(types.ErrCellarPaused, "%s", msg.TargetContractAddress)
+	}
+
 	// winning cork will be deleted during the middleware pass
-	_, cork, ok := k.GetWinningAxelarCork(ctx, config.Id, common.HexToAddress(msg.TargetContractAddress))
-	if !ok {
-		return nil, fmt.Errorf("no cork on chain %d found for address %s", config.Id, msg.TargetContractAddress)
+	contractAddr := common.HexToAddress(msg.TargetContractAddress)
+	var blockHeight, queueNonce uint64
+	var cork types.AxelarCork
+	if msg.CorkId == "" {
+		blockHeight, queueNonce, cork, ok = k.GetWinningAxelarCork(ctx, config.Id, contractAddr)
+		if !ok {
+			return nil, fmt.Errorf("no cork on chain %d found for address %s", config.Id, msg.TargetContractAddress)
+		}
+	} else {
+		id, err := hex.DecodeString(msg.CorkId)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cork ID %s: %s", msg.CorkId, err)
+		}
+
+		blockHeight, queueNonce, cork, ok = k.GetWinningAxelarCorkByID(ctx, config.Id, contractAddr, id)
+		if !ok {
+			return nil, fmt.Errorf("no cork with ID %s on chain %d found for address %s", msg.CorkId, config.Id, msg.TargetContractAddress)
+		}
+	}
+
+	// the proxy contract would reject the call anyway, so don't spend the relayer's token on it
+	if cork.DeadlinePassed(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrCorkDeadlinePassed, "cork %x on chain %d for address %s had deadline %d",
+			cork.IDHash(blockHeight), config.Id, msg.TargetContractAddress, cork.Deadline)
 	}
 
 	// transfer tokens to the module account
@@ -93,6 +131,7 @@ func (k Keeper) RelayCork(c context.Context, msg *types.MsgRelayAxelarCorkReques
 	if err != nil {
 		return nil, err
 	}
+	k.SetRelayingWinningAxelarCork(ctx, config.Id, contractAddr, nonce, blockHeight, queueNonce)
 
 	axelarMemo := types.AxelarBody{
 		DestinationChain:   config.Name,
@@ -119,11 +158,25 @@ func (k Keeper) RelayCork(c context.Context, msg *types.MsgRelayAxelarCorkReques
 		uint64(ctx.BlockTime().Add(time.Duration(params.TimeoutDuration)).UnixNano()),
 		string(memoBz),
 	)
-	_, err = k.transferKeeper.Transfer(c, transferMsg)
+	res, err := k.transferKeeper.Transfer(c, transferMsg)
 	if err != nil {
 		return nil, err
 	}
 
+	// track the relay so the cork can be restored and the relayer refunded if the packet fails
+	k.SetAxelarCorkRelay(ctx, types.AxelarCorkRelay{
+		ChainId:       config.Id,
+		CorkId:        hex.EncodeToString(cork.IDHash(blockHeight)),
+		Cork:          cork,
+		BlockHeight:   blockHeight,
+		Relayer:       signer.String(),
+		Token:         msg.Token,
+		SourceChannel: params.IbcChannel,
+		Sequence:      res.Sequence,
+		Status:        types.AxelarCorkRelayStatus_RELAY_STATUS_PENDING,
+		QueueNonce:    queueNonce,
+	})
+
 	return &types.MsgRelayAxelarCorkResponse{}, nil
 }
 
@@ -220,9 +273,82 @@ func (k Keeper) BumpCorkGas(c context.Context, msg *types.MsgBumpAxelarCorkGasRe
 	return &types.MsgBumpAxelarCorkGasResponse{}, nil
 }
 
+// CancelScheduledCork deletes all of the signer's validator's scheduled corks for the target contract on the given chain
 func (k Keeper) CancelScheduledCork(c context.Context, msg *types.MsgCancelAxelarCorkRequest) (*types.MsgCancelAxelarCorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.GetParamSet(ctx).Enabled {
+		return nil, types.ErrDisabled
+	}
 
-	// todo: implement
+	signer := msg.MustGetSigner()
+	validatorAddr := k.gravityKeeper.GetOrchestratorValidatorAddress(ctx, signer)
+	if validatorAddr == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not a delegate", signer.String())
+	}
+
+	config, ok := k.GetChainConfigurationByID(ctx, msg.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", msg.ChainId)
+	}
+
+	type scheduledCorkKey struct {
+		blockHeight uint64
+		id          []byte
+	}
+
+	targetAddress := common.HexToAddress(msg.TargetContractAddress)
+	var toDelete []scheduledCorkKey
+	k.IterateScheduledAxelarCorks(ctx, config.Id, func(val sdk.ValAddress, blockHeight uint64, id []byte, contract common.Address, _ types.AxelarCork) (stop bool) {
+		if val.Equals(validatorAddr) && contract == targetAddress {
+			toDelete = append(toDelete, scheduledCorkKey{blockHeight: blockHeight, id: append([]byte{}, id...)})
+		}
+
+		return false
+	})
+
+	if len(toDelete) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrScheduledCorkNotFound, "chain ID: %d, contract: %s, validator: %s", config.Id, msg.TargetContractAddress, validatorAddr)
+	}
+
+	for _, key := range toDelete {
+		k.DeleteScheduledAxelarCork(ctx, config.Id, key.blockHeight, key.id, validatorAddr, targetAddress)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.CancelScheduledCorkEvent{
+		Signer:                signer.String(),
+		Validator:             validatorAddr.String(),
+		ChainId:               config.Id,
+		TargetContractAddress: msg.TargetContractAddress,
+		Cancelled:             uint64(len(toDelete)),
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCancelAxelarCorkResponse{}, nil
 }
+
+func (k Keeper) SetCellarPaused(c context.Context, msg *types.MsgSetAxelarCellarPausedRequest) (*types.MsgSetAxelarCellarPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer := msg.MustGetSigner()
+	guardian := k.GetParamSet(ctx).PauseGuardian
+	if guardian == "" || guardian != signer.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the pause guardian", signer.String())
+	}
+
+	config, ok := k.GetChainConfigurationByID(ctx, msg.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", msg.ChainId)
+	}
+
+	cellarAddress := common.HexToAddress(msg.CellarId)
+	if !k.HasCellarID(ctx, config.Id, cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", msg.CellarId)
+	}
+
+	if err := k.SetCellarPauseState(ctx, config.Id, cellarAddress, msg.Paused, signer.String()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAxelarCellarPausedResponse{}, nil
+}
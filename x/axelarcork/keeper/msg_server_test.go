@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+)
+
+var (
+	sampleOrchAddr = sdk.AccAddress("orchestrator________")
+	sampleValAddr  = sdk.ValAddress("validator___________")
+)
+
+func (suite *KeeperTestSuite) TestCancelScheduledCork() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	axelarcorkKeeper.SetParams(ctx, params)
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	otherVal := sdk.ValAddress("othervalidator______")
+	cork := types.AxelarCork{
+		EncodedContractCall:   []byte("testcall"),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              10000000000,
+	}
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 10, sampleValAddr, cork)
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 11, sampleValAddr, cork)
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 10, otherVal, cork)
+
+	cancelMsg := &types.MsgCancelAxelarCorkRequest{
+		Signer:                sampleOrchAddr.String(),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+	}
+	_, err := axelarcorkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), cancelMsg)
+	require.NoError(err)
+
+	// only the other validator's cork remains
+	remaining := axelarcorkKeeper.GetScheduledAxelarCorks(ctx, TestEVMChainID)
+	require.Len(remaining, 1)
+	require.Equal(otherVal.String(), remaining[0].Validator)
+
+	events := ctx.EventManager().Events()
+	require.Equal("axelarcork.v1.CancelScheduledCorkEvent", events[len(events)-1].Type)
+
+	_, err = axelarcorkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), cancelMsg)
+	require.ErrorIs(err, types.ErrScheduledCorkNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledCorkUnhappyPath() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	cancelMsg := &types.MsgCancelAxelarCorkRequest{
+		Signer:                sampleOrchAddr.String(),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+	}
+
+	params := types.DefaultParams()
+	params.Enabled = false
+	axelarcorkKeeper.SetParams(ctx, params)
+	_, err := axelarcorkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), cancelMsg)
+	require.ErrorIs(err, types.ErrDisabled)
+
+	params.Enabled = true
+	axelarcorkKeeper.SetParams(ctx, params)
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(nil)
+	_, err = axelarcorkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), cancelMsg)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr)
+	_, err = axelarcorkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), cancelMsg)
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestScheduleCorkSelectorAllowlist() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	axelarcorkKeeper.SetParams(ctx, params)
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	newMsg := func(call string) *types.MsgScheduleAxelarCorkRequest {
+		return &types.MsgScheduleAxelarCorkRequest{
+			Cork: &types.AxelarCork{
+				EncodedContractCall:   []byte(call),
+				ChainId:               TestEVMChainID,
+				TargetContractAddress: sampleCellarHex,
+				Deadline:              uint64(ctx.BlockTime().Unix()) + 3600,
+			},
+			ChainId:     TestEVMChainID,
+			BlockHeight: 10,
+			Signer:      sampleOrchAddr.String(),
+		}
+	}
+
+	// any function may be called until an allowlist is set
+	_, err := axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap1"))
+	require.NoError(err)
+
+	// "call" is 0x63616c6c
+	proposal := types.NewSetAxelarCellarSelectorAllowlistProposal("title", "desc", TestEVMChainID, sampleCellarHex, []string{"0x63616C6C"})
+	require.NoError(HandleSetAxelarCellarSelectorAllowlistProposal(ctx, axelarcorkKeeper, *proposal))
+
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call1"))
+	require.NoError(err)
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap2"))
+	require.ErrorIs(err, types.ErrSelectorNotAllowed)
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("ca"))
+	require.ErrorIs(err, types.ErrInvalidSelector)
+
+	result, err := axelarcorkKeeper.QueryCellarSelectorAllowlist(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistRequest{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal([]string{"0x63616c6c"}, result.Selectors)
+
+	// the allowlist is scoped to its chain
+	result, err = axelarcorkKeeper.QueryCellarSelectorAllowlist(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistRequest{ChainId: TestEVMChainID + 1, CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Empty(result.Selectors)
+
+	allResult, err := axelarcorkKeeper.QueryCellarSelectorAllowlists(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistsRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Len(allResult.Allowlists, 1)
+	allResult, err = axelarcorkKeeper.QueryCellarSelectorAllowlists(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistsRequest{ChainId: TestEVMChainID + 1})
+	require.NoError(err)
+	require.Empty(allResult.Allowlists)
+
+	// an empty selector list removes the allowlist
+	proposal = types.NewSetAxelarCellarSelectorAllowlistProposal("title", "desc", TestEVMChainID, sampleCellarHex, nil)
+	require.NoError(HandleSetAxelarCellarSelectorAllowlistProposal(ctx, axelarcorkKeeper, *proposal))
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap2"))
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestScheduleCorkDeadline() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MaxCorkDeadlineHorizon = 3600
+	axelarcorkKeeper.SetParams(ctx, params)
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	now := uint64(ctx.BlockTime().Unix())
+	newMsg := func(deadline uint64) *types.MsgScheduleAxelarCorkRequest {
+		return &types.MsgScheduleAxelarCorkRequest{
+			Cork: &types.AxelarCork{
+				EncodedContractCall:   []byte("call"),
+				ChainId:               TestEVMChainID,
+				TargetContractAddress: sampleCellarHex,
+				Deadline:              deadline,
+			},
+			ChainId:     TestEVMChainID,
+			BlockHeight: 10,
+			Signer:      sampleOrchAddr.String(),
+		}
+	}
+
+	_, err := axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg(now))
+	require.ErrorIs(err, types.ErrCorkDeadlinePassed)
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg(now+3601))
+	require.ErrorIs(err, types.ErrCorkDeadlineTooFar)
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg(now+3600))
+	require.NoError(err)
+
+	// a zero horizon disables the upper bound
+	params.MaxCorkDeadlineHorizon = 0
+	axelarcorkKeeper.SetParams(ctx, params)
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg(10000000000))
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSetCellarPaused() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	guardian := sdk.AccAddress("guardian____________")
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.PauseGuardian = guardian.String()
+	axelarcorkKeeper.SetParams(ctx, params)
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	scheduleMsg := &types.MsgScheduleAxelarCorkRequest{
+		Cork: &types.AxelarCork{
+			EncodedContractCall:   []byte("call1"),
+			ChainId:               TestEVMChainID,
+			TargetContractAddress: sampleCellarHex,
+			Deadline:              uint64(ctx.BlockTime().Unix()) + 3600,
+		},
+		ChainId:     TestEVMChainID,
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	}
+	pauseMsg := func(signer sdk.AccAddress, chainID uint64, paused bool) *types.MsgSetAxelarCellarPausedRequest {
+		return &types.MsgSetAxelarCellarPausedRequest{
+			Signer:   signer.String(),
+			ChainId:  chainID,
+			CellarId: sampleCellarHex,
+			Paused:   paused,
+		}
+	}
+
+	// only the pause guardian may pause a cellar
+	_, err := axelarcorkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), pauseMsg(sampleOrchAddr, TestEVMChainID, true))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = axelarcorkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), pauseMsg(guardian, TestEVMChainID+1, true))
+	require.Error(err)
+
+	_, err = axelarcorkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), pauseMsg(guardian, TestEVMChainID, true))
+	require.NoError(err)
+	require.True(axelarcorkKeeper.IsCellarPaused(ctx, TestEVMChainID, sampleCellarAddr))
+
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.ErrorIs(err, types.ErrCellarPaused)
+
+	_, err = axelarcorkKeeper.RelayCork(sdk.WrapSDKContext(ctx), &types.MsgRelayAxelarCorkRequest{
+		Signer:                sampleOrchAddr.String(),
+		Token:                 sdk.NewInt64Coin("usomm", 100),
+		Fee:                   50,
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+	})
+	require.ErrorIs(err, types.ErrCellarPaused)
+
+	stateResult, err := axelarcorkKeeper.QueryCellarPauseState(sdk.WrapSDKContext(ctx), &types.QueryCellarPauseStateRequest{
+		ChainId:  TestEVMChainID,
+		CellarId: sampleCellarHex,
+	})
+	require.NoError(err)
+	require.True(stateResult.Paused)
+
+	pausedResult, err := axelarcorkKeeper.QueryPausedCellars(sdk.WrapSDKContext(ctx), &types.QueryPausedCellarsRequest{})
+	require.NoError(err)
+	require.Len(pausedResult.PausedCellarIds, 1)
+	require.Equal(uint64(TestEVMChainID), pausedResult.PausedCellarIds[0].ChainId)
+	require.Equal([]string{sampleCellarAddr.String()}, pausedResult.PausedCellarIds[0].Ids)
+
+	// governance can resume a cellar without the guardian
+	proposal := types.NewSetAxelarCellarPausedProposal("title", "desc", TestEVMChainID, sampleCellarHex, false)
+	require.NoError(HandleSetAxelarCellarPausedProposal(ctx, axelarcorkKeeper, *proposal))
+	require.False(axelarcorkKeeper.IsCellarPaused(ctx, TestEVMChainID, sampleCellarAddr))
+
+	_, err = axelarcorkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.NoError(err)
+}
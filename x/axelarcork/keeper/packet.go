@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
 )
@@ -86,24 +88,38 @@ func (k Keeper) ValidateAxelarPacket(ctx sdk.Context, sourceChannel string, data
 	}
 
 	// Validate logic call
-	if targetContract, nonce, _, callData, err := types.DecodeLogicCallArgs(axelarBody.Payload); err == nil {
+	if targetContract, nonce, deadline, callData, err := types.DecodeLogicCallArgs(axelarBody.Payload); err == nil {
 		if nonce == 0 {
 			return fmt.Errorf("nonce cannot be zero")
 		}
 
-		// TODO(bolten): is there any validation on the deadline worth doing?
+		// match the exact queued cork that was marked for relay with this contract call nonce
+		targetContractAddr := common.HexToAddress(targetContract)
+		blockHeight, queueNonce, ok := k.GetRelayingWinningAxelarCork(ctx, chainConfig.Id, targetContractAddr, nonce)
+		if !ok {
+			return fmt.Errorf("no cork expected for chain %s:%d at address %s with nonce %d", chainConfig.Name, chainConfig.Id, targetContract, nonce)
+		}
 
-		blockHeight, winningCork, ok := k.GetWinningAxelarCork(ctx, chainConfig.Id, common.HexToAddress(targetContract))
+		winningCork, ok := k.GetWinningAxelarCorkAt(ctx, chainConfig.Id, targetContractAddr, blockHeight, queueNonce)
 		if !ok {
-			return fmt.Errorf("no cork expected for chain %s:%d at address %s", chainConfig.Name, chainConfig.Id, axelarBody.DestinationAddress)
+			return fmt.Errorf("no cork queued for chain %s:%d at address %s, height %d and queue nonce %d", chainConfig.Name, chainConfig.Id, targetContract, blockHeight, queueNonce)
 		}
 
 		if !bytes.Equal(winningCork.EncodedContractCall, callData) {
 			return fmt.Errorf("cork body did not match expected body. received: %x, expected: %x", callData, winningCork.EncodedContractCall)
 		}
 
+		if deadline != winningCork.Deadline {
+			return fmt.Errorf("cork deadline did not match expected deadline. received: %d, expected: %d", deadline, winningCork.Deadline)
+		}
+
+		if winningCork.DeadlinePassed(ctx.BlockTime()) {
+			return errorsmod.Wrapf(types.ErrCorkDeadlinePassed, "deadline %d is not after block time %d", deadline, ctx.BlockTime().Unix())
+		}
+
 		// all checks have passed, delete the cork from state
-		k.DeleteWinningAxelarCorkByBlockheight(ctx, chainConfig.Id, blockHeight, winningCork)
+		k.DeleteWinningAxelarCork(ctx, chainConfig.Id, targetContractAddr, blockHeight, queueNonce)
+		k.DeleteRelayingWinningAxelarCork(ctx, chainConfig.Id, targetContractAddr, nonce)
 
 		return nil
 	}
@@ -141,3 +157,94 @@ func (k Keeper) ValidateAxelarPacket(ctx sdk.Context, sourceChannel string, data
 
 	return fmt.Errorf("invalid payload: %s", axelarBody.Payload)
 }
+
+// OnAxelarCorkAcknowledgement settles the relay of a cork once its packet is acknowledged. An error acknowledgement
+// restores the winning cork so it can be relayed again and refunds the relayer's token from the module account. A
+// failed refund is logged and recorded on the relay rather than returned, so the packet is still settled.
+func (k Keeper) OnAxelarCorkAcknowledgement(ctx sdk.Context, sourceChannel string, sequence uint64, acknowledgement []byte) error {
+	relay, found := k.GetPendingAxelarCorkRelay(ctx, sourceChannel, sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("cannot unmarshal ICS-20 transfer packet acknowledgement: %s", err)
+	}
+
+	if ack.Success() {
+		relay.Status = types.AxelarCorkRelayStatus_RELAY_STATUS_ACKNOWLEDGED
+		k.SetAxelarCorkRelay(ctx, relay)
+
+		return k.emitAxelarCorkRelayResult(ctx, relay)
+	}
+
+	relay.Error = ack.GetError()
+	return k.failAxelarCorkRelay(ctx, relay, types.AxelarCorkRelayStatus_RELAY_STATUS_FAILED)
+}
+
+// OnAxelarCorkTimeout restores the winning cork of a timed out relay and refunds the relayer's token from the module
+// account
+func (k Keeper) OnAxelarCorkTimeout(ctx sdk.Context, sourceChannel string, sequence uint64) error {
+	relay, found := k.GetPendingAxelarCorkRelay(ctx, sourceChannel, sequence)
+	if !found {
+		return nil
+	}
+
+	return k.failAxelarCorkRelay(ctx, relay, types.AxelarCorkRelayStatus_RELAY_STATUS_TIMED_OUT)
+}
+
+func (k Keeper) failAxelarCorkRelay(ctx sdk.Context, relay types.AxelarCorkRelay, status types.AxelarCorkRelayStatus) error {
+	// a failed refund must not be returned to core IBC, which would leave the packet in flight, so the relay is
+	// settled as failed and the refund can be made manually
+	if err := k.refundAxelarCorkRelayer(ctx, relay); err != nil {
+		k.Logger(ctx).Error("failed to refund axelar cork relayer",
+			"chain id", relay.ChainId,
+			"cork id", relay.CorkId,
+			"sequence", relay.Sequence,
+			"relayer", relay.Relayer,
+			"token", relay.Token.String(),
+			"error", err)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.AxelarCorkRelayRefundFailedEvent{
+			ChainId:  relay.ChainId,
+			CorkId:   relay.CorkId,
+			Sequence: relay.Sequence,
+			Relayer:  relay.Relayer,
+			Token:    relay.Token.String(),
+			Error:    err.Error(),
+		}); err != nil {
+			return err
+		}
+
+		relay.RefundError = err.Error()
+		status = types.AxelarCorkRelayStatus_RELAY_STATUS_FAILED
+	}
+
+	// put the cork back at its original position in the queue
+	k.SetWinningAxelarCork(ctx, relay.ChainId, relay.BlockHeight, relay.QueueNonce, relay.Cork)
+
+	relay.Status = status
+	k.SetAxelarCorkRelay(ctx, relay)
+
+	return k.emitAxelarCorkRelayResult(ctx, relay)
+}
+
+func (k Keeper) refundAxelarCorkRelayer(ctx sdk.Context, relay types.AxelarCorkRelay) error {
+	relayer, err := sdk.AccAddressFromBech32(relay.Relayer)
+	if err != nil {
+		return err
+	}
+
+	// the transfer module has already refunded the escrowed token to the module account by this point
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, sdk.NewCoins(relay.Token))
+}
+
+func (k Keeper) emitAxelarCorkRelayResult(ctx sdk.Context, relay types.AxelarCorkRelay) error {
+	return ctx.EventManager().EmitTypedEvent(&types.AxelarCorkRelayResultEvent{
+		ChainId:  relay.ChainId,
+		CorkId:   relay.CorkId,
+		Sequence: relay.Sequence,
+		Status:   relay.Status,
+	})
+}
@@ -1,13 +1,14 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"sort"
 	"time"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 
@@ -38,35 +39,35 @@ func HandleAddManagedCellarsProposal(ctx sdk.Context, k Keeper, p types.AddAxela
 		return err
 	}
 
-	cellarAddresses := k.GetCellarIDs(ctx, config.Id)
+	metadata := make(map[common.Address]types.CellarMetadata, len(p.CellarMetadata))
+	for _, entry := range p.CellarMetadata {
+		metadata[common.HexToAddress(entry.CellarId)] = entry.Metadata
+	}
 
+	// cellars that are already managed keep their record, their metadata is updated with a SetAxelarCellarMetadataProposal
 	for _, proposedCellarID := range p.CellarIds.Ids {
 		proposedCellarAddress := common.HexToAddress(proposedCellarID)
-		found := false
-		for _, id := range cellarAddresses {
-			if id == proposedCellarAddress {
-				found = true
-			}
-		}
-		if !found {
-			cellarAddresses = append(cellarAddresses, proposedCellarAddress)
-			subscriptionID := NewAxelarSubscriptionID(p.ChainId, proposedCellarAddress)
-			defaultSubscription := pubsubtypes.DefaultSubscription{
-				SubscriptionId:  subscriptionID,
-				PublisherDomain: p.PublisherDomain,
-			}
-			k.pubsubKeeper.SetDefaultSubscription(ctx, defaultSubscription)
+		if k.HasCellarID(ctx, config.Id, proposedCellarAddress) {
+			continue
 		}
-	}
 
-	idStrings := make([]string, len(cellarAddresses))
-	for i, cid := range cellarAddresses {
-		idStrings[i] = cid.String()
+		k.SetCellar(ctx, types.AxelarManagedCellar{
+			ChainId:         config.Id,
+			CellarId:        proposedCellarAddress.String(),
+			AddedHeight:     uint64(ctx.BlockHeight()),
+			PublisherDomain: p.PublisherDomain,
+			Metadata:        metadata[proposedCellarAddress],
+		})
+		k.setPendingCellarProposal(ctx, config.Id, proposedCellarAddress)
+
+		subscriptionID := NewAxelarSubscriptionID(p.ChainId, proposedCellarAddress)
+		defaultSubscription := pubsubtypes.DefaultSubscription{
+			SubscriptionId:  subscriptionID,
+			PublisherDomain: p.PublisherDomain,
+		}
+		k.pubsubKeeper.SetDefaultSubscription(ctx, defaultSubscription)
 	}
 
-	sort.Strings(idStrings)
-	k.SetCellarIDs(ctx, config.Id, types.CellarIDSet{ChainId: config.Id, Ids: idStrings})
-
 	return nil
 }
 
@@ -81,34 +82,27 @@ func HandleRemoveManagedCellarsProposal(ctx sdk.Context, k Keeper, p types.Remov
 		return err
 	}
 
-	var outputCellarIDs types.CellarIDSet
-
-	for _, existingID := range k.GetCellarIDs(ctx, config.Id) {
-		found := false
-		for _, inputID := range p.CellarIds.Ids {
-			if existingID == common.HexToAddress(inputID) {
-				found = true
-			}
-		}
-
-		if !found {
-			outputCellarIDs.Ids = append(outputCellarIDs.Ids, existingID.String())
-		}
-	}
-	outputCellarIDs.ChainId = config.Id
-
-	// unlike for adding an ID, we don't need to re-sort because we're removing elements from an already sorted list
-	k.SetCellarIDs(ctx, config.Id, outputCellarIDs)
-
 	for _, cellarToDelete := range p.CellarIds.Ids {
-		subscriptionID := NewAxelarSubscriptionID(p.ChainId, common.HexToAddress(cellarToDelete))
+		cellarAddress := common.HexToAddress(cellarToDelete)
+		k.DeleteCellar(ctx, config.Id, cellarAddress)
+		subscriptionID := NewAxelarSubscriptionID(p.ChainId, cellarAddress)
 		k.pubsubKeeper.DeleteDefaultSubscription(ctx, subscriptionID)
+		k.DeleteCellarSelectorAllowlist(ctx, config.Id, cellarAddress)
+		k.DeleteCellarABI(ctx, config.Id, cellarAddress)
+		k.deleteGovernanceScheduledAxelarCorksForCellar(ctx, config.Id, cellarAddress)
+		if k.IsCellarPaused(ctx, config.Id, cellarAddress) {
+			if err := k.SetCellarPauseState(ctx, config.Id, cellarAddress, false, authtypes.NewModuleAddress(govtypes.ModuleName).String()); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
 // HandleScheduledCorkProposal is a handler for executing a passed scheduled cork proposal
+// by scheduling a governance cork that is made relayable at the proposal's block height without a validator vote.
+// The contract call is encoded from the proposal's ScheduleRequest JSON unless raw calldata is supplied.
 func HandleScheduledCorkProposal(ctx sdk.Context, k Keeper, p types.AxelarScheduledCorkProposal) error {
 	config, ok := k.GetChainConfigurationByID(ctx, p.ChainId)
 	if !ok {
@@ -119,7 +113,42 @@ func HandleScheduledCorkProposal(ctx sdk.Context, k Keeper, p types.AxelarSchedu
 		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.TargetContractAddress)
 	}
 
-	return nil
+	// proposals that can't be executed on chain remain a signal for Steward to act on off chain
+	if p.BlockHeight <= uint64(ctx.BlockHeight()) {
+		k.Logger(ctx).Info("scheduled cork proposal block height has passed, treating it as a signal",
+			"block height", p.BlockHeight,
+			"target contract address", p.TargetContractAddress,
+			"chain id", config.Id)
+		return nil
+	}
+
+	encodedContractCall := p.EncodedContractCall
+	if len(encodedContractCall) == 0 {
+		var err error
+		encodedContractCall, err = k.EncodeContractCall(p.ContractCallProtoJson)
+		if err != nil {
+			return err
+		}
+	}
+
+	cork := types.AxelarCork{
+		EncodedContractCall:   encodedContractCall,
+		ChainId:               config.Id,
+		TargetContractAddress: p.TargetContractAddress,
+		Deadline:              p.Deadline,
+	}
+	if err := cork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	corkID := k.SetGovernanceScheduledAxelarCork(ctx, config.Id, p.BlockHeight, cork)
+
+	return ctx.EventManager().EmitTypedEvent(&types.GovernanceCorkScheduledEvent{
+		ChainId:               config.Id,
+		CorkId:                hex.EncodeToString(corkID),
+		BlockHeight:           p.BlockHeight,
+		TargetContractAddress: p.TargetContractAddress,
+	})
 }
 
 func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.AxelarCommunityPoolSpendProposal) error {
@@ -243,3 +272,101 @@ func HandleCancelAxelarProxyContractUpgradeProposal(ctx sdk.Context, k Keeper, p
 
 	return nil
 }
+
+// HandleSetAxelarCellarSelectorAllowlistProposal is a handler for executing a passed cellar selector allowlist proposal
+func HandleSetAxelarCellarSelectorAllowlistProposal(ctx sdk.Context, k Keeper, p types.SetAxelarCellarSelectorAllowlistProposal) error {
+	config, ok := k.GetChainConfigurationByID(ctx, p.ChainId)
+	if !ok {
+		return fmt.Errorf("chain by id %d not found", p.ChainId)
+	}
+
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, config.Id, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if len(p.Selectors) == 0 {
+		k.DeleteCellarSelectorAllowlist(ctx, config.Id, cellarAddress)
+		return nil
+	}
+
+	selectors, err := types.NormalizeSelectors(p.Selectors)
+	if err != nil {
+		return err
+	}
+
+	k.SetCellarSelectorAllowlist(ctx, types.AxelarCellarSelectorAllowlist{
+		ChainId:   config.Id,
+		CellarId:  cellarAddress.String(),
+		Selectors: selectors,
+	})
+
+	return nil
+}
+
+// HandleSetAxelarCellarPausedProposal is a handler for executing a passed cellar pause proposal
+func HandleSetAxelarCellarPausedProposal(ctx sdk.Context, k Keeper, p types.SetAxelarCellarPausedProposal) error {
+	config, ok := k.GetChainConfigurationByID(ctx, p.ChainId)
+	if !ok {
+		return fmt.Errorf("chain by id %d not found", p.ChainId)
+	}
+
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, config.Id, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	return k.SetCellarPauseState(ctx, config.Id, cellarAddress, p.Paused, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+}
+
+// HandleSetAxelarCellarABIProposal is a handler for executing a passed cellar ABI proposal
+func HandleSetAxelarCellarABIProposal(ctx sdk.Context, k Keeper, p types.SetAxelarCellarABIProposal) error {
+	config, ok := k.GetChainConfigurationByID(ctx, p.ChainId)
+	if !ok {
+		return fmt.Errorf("chain by id %d not found", p.ChainId)
+	}
+
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, config.Id, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if p.Abi == "" {
+		k.DeleteCellarABI(ctx, config.Id, cellarAddress)
+		return nil
+	}
+
+	if _, err := types.ParseABI(p.Abi); err != nil {
+		return err
+	}
+
+	k.SetCellarABI(ctx, types.AxelarCellarABI{
+		ChainId:  config.Id,
+		CellarId: cellarAddress.String(),
+		Abi:      p.Abi,
+	})
+
+	return nil
+}
+
+// HandleSetAxelarCellarMetadataProposal is a handler for executing a passed cellar metadata proposal
+func HandleSetAxelarCellarMetadataProposal(ctx sdk.Context, k Keeper, p types.SetAxelarCellarMetadataProposal) error {
+	config, ok := k.GetChainConfigurationByID(ctx, p.ChainId)
+	if !ok {
+		return fmt.Errorf("chain by id %d not found", p.ChainId)
+	}
+
+	cellar, found := k.GetCellar(ctx, config.Id, common.HexToAddress(p.CellarId))
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if err := p.Metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cellar.Metadata = p.Metadata
+	k.SetCellar(ctx, cellar)
+
+	return nil
+}
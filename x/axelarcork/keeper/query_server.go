@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -61,10 +64,31 @@ func (k Keeper) QueryCellarIDsByChainID(c context.Context, req *types.QueryCella
 	}
 
 	response := &types.QueryCellarIDsByChainIDResponse{}
-	for _, id := range k.GetCellarIDs(ctx, config.Id) {
-		response.CellarIds = append(response.CellarIds, id.String())
+	// callers that don't paginate receive every managed cellar, as before pagination was added
+	if isEmptyPageRequest(req.Pagination) {
+		for _, id := range k.GetCellarIDs(ctx, config.Id) {
+			response.CellarIds = append(response.CellarIds, id.String())
+		}
+		response.Pagination.Total = uint64(len(response.CellarIds))
+
+		return response, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCellarKeyPrefix(config.Id))
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, value []byte) error {
+		var cellar types.AxelarManagedCellar
+		if err := k.cdc.Unmarshal(value, &cellar); err != nil {
+			return err
+		}
+
+		response.CellarIds = append(response.CellarIds, cellar.CellarId)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	response.Pagination = *pageRes
 	return response, nil
 }
 
@@ -79,17 +103,66 @@ func (k Keeper) QueryScheduledCorks(c context.Context, req *types.QueryScheduled
 		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
 	}
 
+	var cellar common.Address
+	if req.TargetContractAddress != "" {
+		if !common.IsHexAddress(req.TargetContractAddress) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target contract address: %s", req.TargetContractAddress)
+		}
+		cellar = common.HexToAddress(req.TargetContractAddress)
+	}
+
+	var validator sdk.ValAddress
+	if req.Validator != "" {
+		var err error
+		if validator, err = sdk.ValAddressFromBech32(req.Validator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s: %s", req.Validator, err)
+		}
+	}
+
 	response := types.QueryScheduledCorksResponse{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledAxelarCorkKeyPrefix(config.Id))
+	pageRes, err := query.FilteredPaginate(
+		store,
+		&req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			blockHeight, id, val, contract := parseScheduledAxelarCorkKey(key)
+			if req.MinBlockHeight != 0 && blockHeight < req.MinBlockHeight {
+				return false, nil
+			}
+			if req.MaxBlockHeight != 0 && blockHeight > req.MaxBlockHeight {
+				return false, nil
+			}
+			if req.TargetContractAddress != "" && contract != cellar {
+				return false, nil
+			}
+			if req.Validator != "" && !val.Equals(validator) {
+				return false, nil
+			}
+
+			if accumulate {
+				var cork types.AxelarCork
+				if err := k.cdc.Unmarshal(value, &cork); err != nil {
+					return false, err
+				}
+
+				response.Corks = append(response.Corks, &types.ScheduledAxelarCork{
+					Cork:        &cork,
+					BlockHeight: blockHeight,
+					Validator:   val.String(),
+					Id:          hex.EncodeToString(id),
+				})
+				if decoded, found := k.decodeCork(ctx, req.DecodeCalls, config.Id, id, cork); found {
+					response.DecodedCalls = append(response.DecodedCalls, decoded)
+				}
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	k.IterateScheduledAxelarCorks(ctx, config.Id, func(val sdk.ValAddress, blockHeight uint64, id []byte, cel common.Address, cork types.AxelarCork) (stop bool) {
-		response.Corks = append(response.Corks, &types.ScheduledAxelarCork{
-			Cork:        &cork,
-			BlockHeight: blockHeight,
-			Validator:   val.String(),
-			Id:          hex.EncodeToString(id),
-		})
-		return false
-	})
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -121,7 +194,32 @@ func (k Keeper) QueryScheduledCorksByBlockHeight(c context.Context, req *types.Q
 	}
 
 	response := types.QueryScheduledCorksByBlockHeightResponse{}
-	response.Corks = k.GetScheduledAxelarCorksByBlockHeight(ctx, config.Id, req.BlockHeight)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledAxelarCorkKeyByBlockHeightPrefix(config.Id, req.BlockHeight))
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, value []byte) error {
+		var cork types.AxelarCork
+		if err := k.cdc.Unmarshal(value, &cork); err != nil {
+			return err
+		}
+
+		keyPair := bytes.NewBuffer(key)
+		id := keyPair.Next(32)
+		val, _ := parseValidatorAndContract(keyPair.Bytes())
+		response.Corks = append(response.Corks, &types.ScheduledAxelarCork{
+			Cork:        &cork,
+			BlockHeight: req.BlockHeight,
+			Validator:   val.String(),
+			Id:          hex.EncodeToString(id),
+		})
+		if decoded, found := k.decodeCork(ctx, req.DecodeCalls, config.Id, id, cork); found {
+			response.DecodedCalls = append(response.DecodedCalls, decoded)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -142,7 +240,30 @@ func (k Keeper) QueryScheduledCorksByID(c context.Context, req *types.QuerySched
 	}
 
 	response := types.QueryScheduledCorksByIDResponse{}
-	response.Corks = k.GetScheduledAxelarCorksByID(ctx, config.Id, id)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledAxelarCorkIDIndexPrefix(config.Id, id))
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, _ []byte) error {
+		blockHeight, val, contract := parseScheduledAxelarCorkIDIndexKey(key)
+		cork, found := k.GetScheduledAxelarCork(ctx, config.Id, blockHeight, id, val, contract)
+		if !found {
+			return nil
+		}
+
+		response.Corks = append(response.Corks, &types.ScheduledAxelarCork{
+			Cork:        &cork,
+			BlockHeight: blockHeight,
+			Validator:   val.String(),
+			Id:          hex.EncodeToString(id),
+		})
+		if decoded, found := k.decodeCork(ctx, req.DecodeCalls, config.Id, id, cork); found {
+			response.DecodedCalls = append(response.DecodedCalls, decoded)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -169,6 +290,9 @@ func (k Keeper) QueryCorkResult(c context.Context, req *types.QueryCorkResultReq
 		return &types.QueryCorkResultResponse{}, status.Errorf(codes.NotFound, "No cork result found for id: %s", req.GetId())
 	}
 	response.CorkResult = &result
+	if decoded, found := k.decodeCork(ctx, req.DecodeCall, config.Id, id, *result.Cork); found {
+		response.DecodedCall = &decoded
+	}
 
 	return &response, nil
 }
@@ -184,8 +308,55 @@ func (k Keeper) QueryCorkResults(c context.Context, req *types.QueryCorkResultsR
 		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
 	}
 
+	var cellar common.Address
+	if req.TargetContractAddress != "" {
+		if !common.IsHexAddress(req.TargetContractAddress) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target contract address: %s", req.TargetContractAddress)
+		}
+		cellar = common.HexToAddress(req.TargetContractAddress)
+	}
+
 	response := types.QueryCorkResultsResponse{}
-	response.CorkResults = k.GetAxelarCorkResults(ctx, config.Id)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAxelarCorkResultPrefix(config.Id))
+	pageRes, err := query.FilteredPaginate(
+		store,
+		&req.Pagination,
+		func(id []byte, value []byte, accumulate bool) (bool, error) {
+			var corkResult types.AxelarCorkResult
+			if err := k.cdc.Unmarshal(value, &corkResult); err != nil {
+				return false, err
+			}
+
+			if req.MinBlockHeight != 0 && corkResult.BlockHeight < req.MinBlockHeight {
+				return false, nil
+			}
+			if req.MaxBlockHeight != 0 && corkResult.BlockHeight > req.MaxBlockHeight {
+				return false, nil
+			}
+			if req.TargetContractAddress != "" && common.HexToAddress(corkResult.Cork.TargetContractAddress) != cellar {
+				return false, nil
+			}
+			if req.Approval == types.ApprovalFilter_APPROVAL_FILTER_APPROVED && !corkResult.Approved {
+				return false, nil
+			}
+			if req.Approval == types.ApprovalFilter_APPROVAL_FILTER_REJECTED && corkResult.Approved {
+				return false, nil
+			}
+
+			if accumulate {
+				response.CorkResults = append(response.CorkResults, &corkResult)
+				if decoded, found := k.decodeCork(ctx, req.DecodeCalls, config.Id, id, *corkResult.Cork); found {
+					response.DecodedCalls = append(response.DecodedCalls, decoded)
+				}
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -248,3 +419,337 @@ func (k Keeper) QueryAxelarProxyUpgradeData(c context.Context, req *types.QueryA
 
 	return &response, nil
 }
+
+func (k Keeper) QueryCellarSelectorAllowlist(c context.Context, req *types.QueryCellarSelectorAllowlistRequest) (*types.QueryCellarSelectorAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowlist, _ := k.GetCellarSelectorAllowlist(ctx, req.ChainId, common.HexToAddress(req.CellarId))
+
+	return &types.QueryCellarSelectorAllowlistResponse{Selectors: allowlist.Selectors}, nil
+}
+
+func (k Keeper) QueryCellarSelectorAllowlists(c context.Context, req *types.QueryCellarSelectorAllowlistsRequest) (*types.QueryCellarSelectorAllowlistsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryCellarSelectorAllowlistsResponse{Allowlists: []types.AxelarCellarSelectorAllowlist{}}
+	k.IterateCellarSelectorAllowlists(ctx, func(allowlist types.AxelarCellarSelectorAllowlist) (stop bool) {
+		if req.ChainId == 0 || allowlist.ChainId == req.ChainId {
+			response.Allowlists = append(response.Allowlists, allowlist)
+		}
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCellarPauseState(c context.Context, req *types.QueryCellarPauseStateRequest) (*types.QueryCellarPauseStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCellarPauseStateResponse{
+		Paused: k.IsCellarPaused(ctx, req.ChainId, common.HexToAddress(req.CellarId)),
+	}, nil
+}
+
+func (k Keeper) QueryPausedCellars(c context.Context, req *types.QueryPausedCellarsRequest) (*types.QueryPausedCellarsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryPausedCellarsResponse{PausedCellarIds: []*types.CellarIDSet{}}
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		if req.ChainId != 0 && config.Id != req.ChainId {
+			return false
+		}
+
+		if paused := k.GetPausedCellarIDs(ctx, config.Id); len(paused.Ids) > 0 {
+			response.PausedCellarIds = append(response.PausedCellarIds, &paused)
+		}
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryGovernanceScheduledCorks(c context.Context, req *types.QueryGovernanceScheduledAxelarCorksRequest) (*types.QueryGovernanceScheduledAxelarCorksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryGovernanceScheduledAxelarCorksResponse{Corks: []types.GovernanceScheduledAxelarCork{}}
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		if req.ChainId != 0 && config.Id != req.ChainId {
+			return false
+		}
+
+		response.Corks = append(response.Corks, k.GetGovernanceScheduledAxelarCorks(ctx, config.Id)...)
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCellarABI(c context.Context, req *types.QueryCellarABIRequest) (*types.QueryCellarABIResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	cellarABI, _ := k.GetCellarABI(sdk.UnwrapSDKContext(c), req.ChainId, common.HexToAddress(req.CellarId))
+
+	return &types.QueryCellarABIResponse{Abi: cellarABI.Abi}, nil
+}
+
+func (k Keeper) QueryCellarABIs(c context.Context, req *types.QueryCellarABIsRequest) (*types.QueryCellarABIsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryCellarABIsResponse{Abis: []types.AxelarCellarABI{}}
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		if req.ChainId != 0 && config.Id != req.ChainId {
+			return false
+		}
+
+		response.Abis = append(response.Abis, k.GetCellarABIs(ctx, config.Id)...)
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryManagedCellar(c context.Context, req *types.QueryManagedCellarRequest) (*types.QueryManagedCellarResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	cellar, found := k.GetCellar(sdk.UnwrapSDKContext(c), req.ChainId, common.HexToAddress(req.CellarId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cellar %s is not managed on chain %d", req.CellarId, req.ChainId)
+	}
+
+	return &types.QueryManagedCellarResponse{Cellar: cellar}, nil
+}
+
+func (k Keeper) QueryManagedCellars(c context.Context, req *types.QueryManagedCellarsRequest) (*types.QueryManagedCellarsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryManagedCellarsResponse{Cellars: []types.AxelarManagedCellar{}}
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		if req.ChainId != 0 && config.Id != req.ChainId {
+			return false
+		}
+
+		response.Cellars = append(response.Cellars, k.GetCellars(ctx, config.Id)...)
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCorkTally(c context.Context, req *types.QueryCorkTallyRequest) (*types.QueryCorkTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if (req.BlockHeight == 0) == (req.Id == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of block height and cork ID must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, ok := k.GetChainConfigurationByID(ctx, req.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
+	}
+
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return nil, status.Error(codes.FailedPrecondition, "no bonded validator power")
+	}
+
+	response := types.QueryCorkTallyResponse{
+		Tallies:    []types.AxelarCorkTally{},
+		TotalPower: totalPower.Uint64(),
+	}
+
+	if req.BlockHeight != 0 {
+		response.Tallies = k.GetAxelarCorkTallies(ctx, config.Id, req.BlockHeight, totalPower)
+		return &response, nil
+	}
+
+	id, err := hex.DecodeString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to decode %s from hexadecimal to bytes", req.Id)
+	}
+
+	// a cork ID commits to its block height, so every scheduled copy of the cork shares one height
+	scheduled := k.GetScheduledAxelarCorksByID(ctx, config.Id, id)
+	if len(scheduled) == 0 {
+		return nil, status.Errorf(codes.NotFound, "No scheduled cork found for id: %s", req.Id)
+	}
+
+	for _, tally := range k.GetAxelarCorkTallies(ctx, config.Id, scheduled[0].BlockHeight, totalPower) {
+		if tally.Id == hex.EncodeToString(id) {
+			response.Tallies = append(response.Tallies, tally)
+		}
+	}
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCorkRelay(c context.Context, req *types.QueryCorkRelayRequest) (*types.QueryCorkRelayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, ok := k.GetChainConfigurationByID(ctx, req.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
+	}
+
+	id, err := hex.DecodeString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to decode %s from hexadecimal to bytes", req.Id)
+	}
+
+	relay, found := k.GetAxelarCorkRelay(ctx, config.Id, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No cork relay found for id: %s", req.Id)
+	}
+
+	return &types.QueryCorkRelayResponse{Relay: relay}, nil
+}
+
+func (k Keeper) QueryCorkRelays(c context.Context, req *types.QueryCorkRelaysRequest) (*types.QueryCorkRelaysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	response := types.QueryCorkRelaysResponse{Relays: []types.AxelarCorkRelay{}}
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		if req.ChainId != 0 && config.Id != req.ChainId {
+			return false
+		}
+
+		response.Relays = append(response.Relays, k.GetAxelarCorkRelays(ctx, config.Id)...)
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryWinningAxelarCork(c context.Context, req *types.QueryWinningAxelarCorkRequest) (*types.QueryWinningAxelarCorkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.ContractAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", req.ContractAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, ok := k.GetChainConfigurationByID(ctx, req.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
+	}
+
+	blockHeight, queueNonce, cork, found := k.GetWinningAxelarCork(ctx, config.Id, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No winning cork found for contract %s on chain %d", req.ContractAddress, config.Id)
+	}
+
+	return &types.QueryWinningAxelarCorkResponse{
+		Cork: k.winningAxelarCork(ctx, k.GetParamSet(ctx), config.Id, blockHeight, queueNonce, cork),
+	}, nil
+}
+
+func (k Keeper) QueryWinningAxelarCorks(c context.Context, req *types.QueryWinningAxelarCorksRequest) (*types.QueryWinningAxelarCorksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, ok := k.GetChainConfigurationByID(ctx, req.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("chain by id %d not found", req.ChainId)
+	}
+
+	params := k.GetParamSet(ctx)
+	response := types.QueryWinningAxelarCorksResponse{Corks: []types.WinningAxelarCork{}}
+	k.IterateWinningAxelarCorks(ctx, config.Id, func(_ common.Address, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) (stop bool) {
+		response.Corks = append(response.Corks, k.winningAxelarCork(ctx, params, config.Id, blockHeight, queueNonce, cork))
+
+		return false
+	})
+
+	return &response, nil
+}
+
+// winningAxelarCork describes a winning cork along with what a relayer needs to know to relay it
+func (k Keeper) winningAxelarCork(ctx sdk.Context, params types.Params, chainID uint64, blockHeight uint64, queueNonce uint64, cork types.AxelarCork) types.WinningAxelarCork {
+	return types.WinningAxelarCork{
+		Cork:           cork,
+		Id:             hex.EncodeToString(cork.IDHash(blockHeight)),
+		ApprovalHeight: blockHeight,
+		ExpiryHeight:   blockHeight + params.CorkTimeoutBlocks,
+		NextNonce:      k.GetAxelarContractCallNonce(ctx, chainID, cork.TargetContractAddress) + 1,
+		QueueNonce:     queueNonce,
+	}
+}
+
+// decodeCork decodes a queried cork's contract call when the request asks for it
+func (k Keeper) decodeCork(ctx sdk.Context, decode bool, chainID uint64, id []byte, cork types.AxelarCork) (types.DecodedContractCall, bool) {
+	if !decode {
+		return types.DecodedContractCall{}, false
+	}
+
+	return k.DecodeCork(ctx, chainID, hex.EncodeToString(id), cork)
+}
+
+// isEmptyPageRequest returns true if the request carries no pagination options
+func isEmptyPageRequest(pageReq query.PageRequest) bool {
+	return pageReq.Key == nil && pageReq.Offset == 0 && pageReq.Limit == 0 && !pageReq.CountTotal && !pageReq.Reverse
+}
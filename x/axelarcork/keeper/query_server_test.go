@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"encoding/hex"
+	"math/big"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+	pubsubtypes "github.com/peggyjv/sommelier/v7/x/pubsub/types"
 )
 
 const TestEVMChainID = 2
@@ -59,7 +64,9 @@ func (suite *KeeperTestSuite) TestQueriesHappyPath() {
 	axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID, id, corkResult)
 
 	ids := []string{"0x0000000000000000000000000000000000000000", "0x1111111111111111111111111111111111111111"}
-	axelarcorkKeeper.SetCellarIDs(ctx, TestEVMChainID, types.CellarIDSet{ChainId: TestEVMChainID, Ids: ids})
+	for _, id := range ids {
+		axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: id})
+	}
 
 	nonce := types.AxelarContractCallNonce{
 		Nonce:           1,
@@ -165,3 +172,343 @@ func (suite *KeeperTestSuite) TestQueriesUnhappyPath() {
 	require.Nil(noncesResult)
 	require.NotNil(err)
 }
+
+func (suite *KeeperTestSuite) TestQueriesPaginationAndFilters() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	otherCellarHex := "0x1111111111111111111111111111111111111111"
+	otherValAddr := sdk.ValAddress("othervalidator______")
+	newCork := func(call string, target string) types.AxelarCork {
+		return types.AxelarCork{EncodedContractCall: []byte(call), TargetContractAddress: target, ChainId: TestEVMChainID}
+	}
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 10, sampleValAddr, newCork("call1", sampleCellarHex))
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 10, otherValAddr, newCork("call1", sampleCellarHex))
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, 11, sampleValAddr, newCork("call2", otherCellarHex))
+	// corks on other chains are not returned
+	axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID+1, 10, sampleValAddr, newCork("call1", sampleCellarHex))
+
+	scheduledCorksResult, err := axelarcorkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		ChainId:    TestEVMChainID,
+		Pagination: query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 2)
+	require.Equal(uint64(3), scheduledCorksResult.Pagination.Total)
+
+	scheduledCorksResult, err = axelarcorkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Validator:             otherValAddr.String(),
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 1)
+
+	scheduledCorksResult, err = axelarcorkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		ChainId:        TestEVMChainID,
+		MinBlockHeight: 11,
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 1)
+
+	call1 := newCork("call1", sampleCellarHex)
+	id := call1.IDHash(10)
+	scheduledCorksByIDResult, err := axelarcorkKeeper.QueryScheduledCorksByID(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksByIDRequest{
+		ChainId: TestEVMChainID,
+		Id:      hex.EncodeToString(id),
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksByIDResult.Corks, 2)
+
+	axelarcorkKeeper.DeleteScheduledAxelarCork(ctx, TestEVMChainID, 10, id, sampleValAddr, sampleCellarAddr)
+	require.Len(axelarcorkKeeper.GetScheduledAxelarCorksByID(ctx, TestEVMChainID, id), 1)
+
+	for i, approved := range []bool{true, false} {
+		cork := newCork("resultcall", sampleCellarHex)
+		height := uint64(20 + i)
+		axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID, cork.IDHash(height), types.AxelarCorkResult{
+			Cork:               &cork,
+			BlockHeight:        height,
+			Approved:           approved,
+			ApprovalPercentage: "50.00",
+		})
+	}
+
+	corkResultsResult, err := axelarcorkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{
+		ChainId:  TestEVMChainID,
+		Approval: types.ApprovalFilter_APPROVAL_FILTER_REJECTED,
+	})
+	require.NoError(err)
+	require.Len(corkResultsResult.CorkResults, 1)
+	require.False(corkResultsResult.CorkResults[0].Approved)
+}
+
+func (suite *KeeperTestSuite) TestQueriesDecodeCalls() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	cellarABI := `[{"type":"function","name":"setRebalanceDeviation","inputs":[{"name":"newDeviation","type":"uint256"}],"outputs":[]}]`
+	contractABI, err := types.ParseABI(cellarABI)
+	require.NoError(err)
+	call, err := contractABI.Pack("setRebalanceDeviation", big.NewInt(5))
+	require.NoError(err)
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+
+	proposal := types.NewSetAxelarCellarABIProposal("title", "desc", TestEVMChainID, sampleCellarHex, cellarABI)
+	require.NoError(HandleSetAxelarCellarABIProposal(ctx, axelarcorkKeeper, *proposal))
+
+	abiResult, err := axelarcorkKeeper.QueryCellarABI(sdk.WrapSDKContext(ctx), &types.QueryCellarABIRequest{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(cellarABI, abiResult.Abi)
+
+	abisResult, err := axelarcorkKeeper.QueryCellarABIs(sdk.WrapSDKContext(ctx), &types.QueryCellarABIsRequest{})
+	require.NoError(err)
+	require.Len(abisResult.Abis, 1)
+
+	testHeight := uint64(ctx.BlockHeight())
+	cork := types.AxelarCork{
+		EncodedContractCall:   call,
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              10000000000,
+	}
+	id := axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, testHeight, sdk.ValAddress("12345678901234567890"), cork)
+	axelarcorkKeeper.SetAxelarCorkResult(ctx, TestEVMChainID, id, types.AxelarCorkResult{Cork: &cork, BlockHeight: testHeight, Approved: true, ApprovalPercentage: "100.00"})
+
+	expected := types.DecodedContractCall{
+		CorkId:    hex.EncodeToString(id),
+		Method:    "setRebalanceDeviation",
+		Signature: "setRebalanceDeviation(uint256)",
+		Arguments: `{"newDeviation":"5"}`,
+	}
+
+	scheduledCorksResult, err := axelarcorkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Empty(scheduledCorksResult.DecodedCalls)
+
+	scheduledCorksResult, err = axelarcorkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{ChainId: TestEVMChainID, DecodeCalls: true})
+	require.NoError(err)
+	require.Equal([]types.DecodedContractCall{expected}, scheduledCorksResult.DecodedCalls)
+
+	corkResultResult, err := axelarcorkKeeper.QueryCorkResult(sdk.WrapSDKContext(ctx), &types.QueryCorkResultRequest{ChainId: TestEVMChainID, Id: hex.EncodeToString(id), DecodeCall: true})
+	require.NoError(err)
+	require.Equal(&expected, corkResultResult.DecodedCall)
+
+	corkResultsResult, err := axelarcorkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{ChainId: TestEVMChainID, DecodeCalls: true})
+	require.NoError(err)
+	require.Equal([]types.DecodedContractCall{expected}, corkResultsResult.DecodedCalls)
+
+	// an empty ABI removes the cellar's ABI
+	proposal = types.NewSetAxelarCellarABIProposal("title", "desc", TestEVMChainID, sampleCellarHex, "")
+	require.NoError(HandleSetAxelarCellarABIProposal(ctx, axelarcorkKeeper, *proposal))
+
+	_, found := axelarcorkKeeper.GetCellarABI(ctx, TestEVMChainID, sampleCellarAddr)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestQueryCorkTally() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	testHeight := uint64(ctx.BlockHeight() + 10)
+	cork := types.AxelarCork{
+		EncodedContractCall:   []byte("testcall"),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              10000000000,
+	}
+	val := sdk.ValAddress("12345678901234567890")
+	id := axelarcorkKeeper.SetScheduledAxelarCork(ctx, TestEVMChainID, testHeight, val, cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100)).Times(2)
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator).Times(2)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(60)).Times(2)
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt()).Times(2)
+
+	expected := types.AxelarCorkTally{
+		Cork:               cork,
+		Id:                 hex.EncodeToString(id),
+		BlockHeight:        testHeight,
+		Power:              60,
+		ApprovalPercentage: "60.000000000000000000",
+		VoteThreshold:      "0.670000000000000000",
+		Approved:           false,
+		Voters:             []string{val.String()},
+	}
+
+	tallyResult, err := axelarcorkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{ChainId: TestEVMChainID, BlockHeight: testHeight})
+	require.NoError(err)
+	require.Equal(uint64(100), tallyResult.TotalPower)
+	require.Equal([]types.AxelarCorkTally{expected}, tallyResult.Tallies)
+
+	tallyResult, err = axelarcorkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{ChainId: TestEVMChainID, Id: hex.EncodeToString(id)})
+	require.NoError(err)
+	require.Equal([]types.AxelarCorkTally{expected}, tallyResult.Tallies)
+
+	// previewing the tally doesn't consume the scheduled corks
+	require.Len(axelarcorkKeeper.GetScheduledAxelarCorksByBlockHeight(ctx, TestEVMChainID, testHeight), 1)
+
+	_, err = axelarcorkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{ChainId: TestEVMChainID})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryManagedCellarMetadata() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	metadata := types.CellarMetadata{
+		Name:            "Dollary-doos LP",
+		Version:         "v2.5",
+		AssetDenom:      "usdc",
+		StrategySpecUrl: "https://example.com/strategies/dollary-doos-lp",
+	}
+
+	suite.pubsubKeeper.EXPECT().GetPublisher(ctx, "example.com").Return(pubsubtypes.Publisher{}, true)
+	suite.pubsubKeeper.EXPECT().SetDefaultSubscription(ctx, gomock.Any()).Times(2)
+	addProposal := types.NewAddAxelarManagedCellarIDsProposal(
+		"title",
+		"desc",
+		TestEVMChainID,
+		&types.CellarIDSet{ChainId: TestEVMChainID, Ids: []string{sampleCellarHex, "0x0000000000000000000000000000000000000001"}},
+		"example.com",
+		[]types.CellarMetadataEntry{{CellarId: sampleCellarHex, Metadata: metadata}},
+	)
+	require.NoError(HandleAddManagedCellarsProposal(ctx, axelarcorkKeeper, *addProposal))
+
+	cellarResult, err := axelarcorkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(sampleCellarAddr.String(), cellarResult.Cellar.CellarId)
+	require.Equal(uint64(ctx.BlockHeight()), cellarResult.Cellar.AddedHeight)
+	require.Equal("example.com", cellarResult.Cellar.PublisherDomain)
+	require.Equal(metadata, cellarResult.Cellar.Metadata)
+
+	cellarsResult, err := axelarcorkKeeper.QueryManagedCellars(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarsRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Len(cellarsResult.Cellars, 2)
+	require.Equal(types.CellarMetadata{}, cellarsResult.Cellars[0].Metadata)
+
+	cellarsResult, err = axelarcorkKeeper.QueryManagedCellars(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarsRequest{ChainId: TestEVMChainID + 1})
+	require.NoError(err)
+	require.Empty(cellarsResult.Cellars)
+
+	updated := types.CellarMetadata{Name: "Dollary-doos LP v3", Version: "v3"}
+	setProposal := types.NewSetAxelarCellarMetadataProposal("title", "desc", TestEVMChainID, sampleCellarHex, updated)
+	require.NoError(HandleSetAxelarCellarMetadataProposal(ctx, axelarcorkKeeper, *setProposal))
+
+	cellarResult, err = axelarcorkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{ChainId: TestEVMChainID, CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(updated, cellarResult.Cellar.Metadata)
+	require.Equal("example.com", cellarResult.Cellar.PublisherDomain)
+
+	setProposal = types.NewSetAxelarCellarMetadataProposal("title", "desc", TestEVMChainID, "0x0000000000000000000000000000000000000002", updated)
+	require.ErrorIs(HandleSetAxelarCellarMetadataProposal(ctx, axelarcorkKeeper, *setProposal), types.ErrUnmanagedCellarAddress)
+
+	_, err = axelarcorkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{ChainId: TestEVMChainID, CellarId: "0x0000000000000000000000000000000000000002"})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryWinningAxelarCorks() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	axelarcorkKeeper.SetParams(ctx, params)
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+
+	cork := types.AxelarCork{
+		EncodedContractCall:   []byte("testcall"),
+		ChainId:               TestEVMChainID,
+		TargetContractAddress: sampleCellarHex,
+		Deadline:              100,
+	}
+	approvalHeight := uint64(4)
+	queueNonce := axelarcorkKeeper.EnqueueWinningAxelarCork(ctx, TestEVMChainID, approvalHeight, cork)
+	axelarcorkKeeper.SetAxelarContractCallNonce(ctx, TestEVMChainID, sampleCellarHex, 7)
+
+	expected := types.WinningAxelarCork{
+		Cork:           cork,
+		Id:             hex.EncodeToString(cork.IDHash(approvalHeight)),
+		ApprovalHeight: approvalHeight,
+		ExpiryHeight:   approvalHeight + params.CorkTimeoutBlocks,
+		NextNonce:      8,
+		QueueNonce:     queueNonce,
+	}
+
+	corkResult, err := suite.queryClient.QueryWinningAxelarCork(sdk.WrapSDKContext(ctx), &types.QueryWinningAxelarCorkRequest{ChainId: TestEVMChainID, ContractAddress: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(expected, corkResult.Cork)
+
+	corksResult, err := suite.queryClient.QueryWinningAxelarCorks(sdk.WrapSDKContext(ctx), &types.QueryWinningAxelarCorksRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Equal([]types.WinningAxelarCork{expected}, corksResult.Corks)
+
+	_, err = suite.queryClient.QueryWinningAxelarCork(sdk.WrapSDKContext(ctx), &types.QueryWinningAxelarCorkRequest{ChainId: TestEVMChainID, ContractAddress: oneAddressHex})
+	require.Error(err)
+
+	_, err = suite.queryClient.QueryWinningAxelarCork(sdk.WrapSDKContext(ctx), &types.QueryWinningAxelarCorkRequest{ChainId: TestEVMChainID, ContractAddress: "not an address"})
+	require.Error(err)
+
+	_, err = suite.queryClient.QueryWinningAxelarCorks(sdk.WrapSDKContext(ctx), &types.QueryWinningAxelarCorksRequest{ChainId: TestEVMChainID + 1})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryCellarIDsWithoutPagination() {
+	ctx, axelarcorkKeeper := suite.ctx, suite.axelarcorkKeeper
+	require := suite.Require()
+
+	axelarcorkKeeper.SetChainConfiguration(ctx, TestEVMChainID, types.ChainConfiguration{
+		Name:         "testevm",
+		Id:           TestEVMChainID,
+		ProxyAddress: "0x123",
+	})
+	cellarCount := int(query.DefaultLimit) + 50
+	for i := 1; i <= cellarCount; i++ {
+		axelarcorkKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: TestEVMChainID, CellarId: common.BigToAddress(big.NewInt(int64(i))).Hex()})
+	}
+
+	result, err := axelarcorkKeeper.QueryCellarIDs(sdk.WrapSDKContext(ctx), &types.QueryCellarIDsRequest{})
+	require.NoError(err)
+	require.Len(result.CellarIds[0].Ids, cellarCount)
+
+	// legacy callers that don't paginate receive every cellar
+	byChainResult, err := axelarcorkKeeper.QueryCellarIDsByChainID(sdk.WrapSDKContext(ctx), &types.QueryCellarIDsByChainIDRequest{ChainId: TestEVMChainID})
+	require.NoError(err)
+	require.Len(byChainResult.CellarIds, cellarCount)
+	require.Equal(uint64(cellarCount), byChainResult.Pagination.Total)
+
+	byChainResult, err = axelarcorkKeeper.QueryCellarIDsByChainID(sdk.WrapSDKContext(ctx), &types.QueryCellarIDsByChainIDRequest{
+		ChainId:    TestEVMChainID,
+		Pagination: query.PageRequest{Limit: 10},
+	})
+	require.NoError(err)
+	require.Len(byChainResult.CellarIds, 10)
+	require.NotNil(byChainResult.Pagination.NextKey)
+}
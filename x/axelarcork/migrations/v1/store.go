@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+)
+
+// MigrateStore builds the scheduled cork ID index and the cork result block height index for corks scheduled and
+// results recorded before consensus version 2
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Axelarcork v1 to v2: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.ScheduledCorkKeyPrefix})
+	defer iter.Close()
+
+	var indexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keyPair := bytes.NewBuffer(iter.Key())
+		keyPair.Next(1) // trim prefix byte
+		chainID := sdk.BigEndianToUint64(keyPair.Next(8))
+		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
+		id := keyPair.Next(32)
+		rest := keyPair.Bytes()
+		val := sdk.ValAddress(rest[:len(rest)-common.AddressLength])
+		contract := common.BytesToAddress(rest[len(rest)-common.AddressLength:])
+
+		indexKeys = append(indexKeys, types.GetScheduledAxelarCorkIDIndexKey(chainID, blockHeight, id, val, contract))
+	}
+
+	resultIter := sdk.KVStorePrefixIterator(store, []byte{types.CorkResultPrefix})
+	defer resultIter.Close()
+
+	for ; resultIter.Valid(); resultIter.Next() {
+		var corkResult types.AxelarCorkResult
+		if err := cdc.Unmarshal(resultIter.Value(), &corkResult); err != nil {
+			return err
+		}
+
+		keyPair := bytes.NewBuffer(resultIter.Key())
+		keyPair.Next(1) // trim prefix byte
+		chainID := sdk.BigEndianToUint64(keyPair.Next(8))
+		id := keyPair.Next(32)
+
+		indexKeys = append(indexKeys, types.GetAxelarCorkResultHeightIndexKey(chainID, corkResult.BlockHeight, id))
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+
+	ctx.Logger().Info("Axelarcork v1 to v2: Store migration complete")
+
+	return nil
+}
+
+// MigrateParamStore initializes params introduced in axelarcork consensus version 2 to their defaults
+func MigrateParamStore(ctx sdk.Context, subspace paramstypes.Subspace) error {
+	ctx.Logger().Info("Axelarcork v1 to v2: Beginning params migration")
+
+	defaults := types.DefaultParams()
+
+	// Don't want to overwrite values if they were set in an upgrade handler
+	if !subspace.Has(ctx, types.KeyCorkResultRetentionBlocks) {
+		subspace.Set(ctx, types.KeyCorkResultRetentionBlocks, defaults.CorkResultRetentionBlocks)
+	}
+
+	if !subspace.Has(ctx, types.KeyArchivePrunedCorkResults) {
+		subspace.Set(ctx, types.KeyArchivePrunedCorkResults, defaults.ArchivePrunedCorkResults)
+	}
+
+	if !subspace.Has(ctx, types.KeyPauseGuardian) {
+		subspace.Set(ctx, types.KeyPauseGuardian, defaults.PauseGuardian)
+	}
+
+	ctx.Logger().Info("Axelarcork v1 to v2: Params migration complete")
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+)
+
+// MigrateStore moves each chain's managed cellar ID set into per-cellar records and each chain's winning corks into
+// per-cellar queues, both introduced in consensus version 3
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Axelarcork v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.CellarIDsKeyPrefix})
+	defer iter.Close()
+
+	var legacyKeys [][]byte
+	var cellars []types.AxelarManagedCellar
+	for ; iter.Valid(); iter.Next() {
+		legacyKeys = append(legacyKeys, iter.Key())
+
+		var cellarIDs types.CellarIDSet
+		if err := cdc.Unmarshal(iter.Value(), &cellarIDs); err != nil {
+			return err
+		}
+
+		chainID := sdk.BigEndianToUint64(iter.Key()[1:])
+		for _, id := range cellarIDs.Ids {
+			cellars = append(cellars, types.AxelarManagedCellar{
+				ChainId:  chainID,
+				CellarId: common.HexToAddress(id).String(),
+			})
+		}
+	}
+
+	for _, cellar := range cellars {
+		store.Set(types.GetCellarKey(cellar.ChainId, common.HexToAddress(cellar.CellarId)), cdc.MustMarshal(&cellar))
+	}
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+
+	migrateWinningCorks(store)
+
+	ctx.Logger().Info("Axelarcork v2 to v3: Store migration complete")
+
+	return nil
+}
+
+// migrateWinningCorks re-keys winning corks from <chain_id><block_height><contract_address> into per-contract queues
+// keyed by <chain_id><contract_address><block_height><queue_nonce>, assigning queue nonces in approval height order
+func migrateWinningCorks(store sdk.KVStore) {
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.WinningCorkPrefix})
+	defer iter.Close()
+
+	type legacyWinningCork struct {
+		key         []byte
+		chainID     uint64
+		blockHeight uint64
+		contract    common.Address
+		value       []byte
+	}
+
+	var corks []legacyWinningCork
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		corks = append(corks, legacyWinningCork{
+			key:         key,
+			chainID:     sdk.BigEndianToUint64(key[1:9]),
+			blockHeight: sdk.BigEndianToUint64(key[9:17]),
+			contract:    common.BytesToAddress(key[17:]),
+			value:       iter.Value(),
+		})
+	}
+
+	for _, cork := range corks {
+		store.Delete(cork.key)
+	}
+
+	for _, cork := range corks {
+		nonceKey := types.GetWinningAxelarCorkQueueNonceKey(cork.chainID, cork.contract)
+
+		var queueNonce uint64
+		if bz := store.Get(nonceKey); len(bz) > 0 {
+			queueNonce = sdk.BigEndianToUint64(bz)
+		}
+		queueNonce++
+
+		store.Set(nonceKey, sdk.Uint64ToBigEndian(queueNonce))
+		store.Set(types.GetWinningAxelarCorkKey(cork.chainID, cork.contract, cork.blockHeight, queueNonce), cork.value)
+	}
+}
+
+// MigrateParamStore initializes params introduced in axelarcork consensus version 3 to their defaults
+func MigrateParamStore(ctx sdk.Context, subspace paramstypes.Subspace) error {
+	ctx.Logger().Info("Axelarcork v2 to v3: Beginning params migration")
+
+	defaults := types.DefaultParams()
+
+	// Don't want to overwrite values if they were set in an upgrade handler
+	if !subspace.Has(ctx, types.KeyMaxCorkDeadlineHorizon) {
+		subspace.Set(ctx, types.KeyMaxCorkDeadlineHorizon, defaults.MaxCorkDeadlineHorizon)
+	}
+
+	ctx.Logger().Info("Axelarcork v2 to v3: Params migration complete")
+
+	return nil
+}
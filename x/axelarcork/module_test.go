@@ -1,13 +1,23 @@
 package axelarcork_test
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/tests"
 	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
 	"github.com/stretchr/testify/require"
@@ -162,3 +172,216 @@ func TestSendPacket_EmptyPayload(t *testing.T) {
 	_, err := acMiddleware.SendPacket(ctx, nil, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
 	require.Error(t, err)
 }
+
+func TestRelayProxyUpgrade_EndToEnd(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	setup := tests.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	acKeeper := setup.Keepers.AxelarCorkKeeper
+
+	params := tests.TestParams()
+	params.Enabled = true
+	params.IbcChannel = testSourceChannel
+	params.IbcPort = testSourcePort
+	acKeeper.SetParams(ctx, params)
+
+	chainID := uint64(1)
+	acKeeper.SetChainConfiguration(ctx, chainID, types.ChainConfiguration{
+		Name:         "Ethereum",
+		Id:           chainID,
+		ProxyAddress: "0x1111111111111111111111111111111111111111",
+	})
+	acKeeper.SetCellar(ctx, types.AxelarManagedCellar{ChainId: chainID, CellarId: "0x2222222222222222222222222222222222222222"})
+
+	// governance approves the upgrade
+	proposal := types.NewUpgradeAxelarProxyContractProposal("title", "desc", chainID, "0x3333333333333333333333333333333333333333")
+	require.NoError(t, axelarcork.NewProposalHandler(*acKeeper)(ctx, proposal))
+	upgradeData, found := acKeeper.GetAxelarProxyUpgradeData(ctx, chainID)
+	require.True(t, found)
+
+	// the upgrade is routed through the Msg service once executable
+	ctx = ctx.WithBlockHeight(upgradeData.ExecutableHeightThreshold)
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+	types.RegisterMsgServer(router, *acKeeper)
+
+	relayer := tests.AccAddress()
+	token := sdk.NewInt64Coin(testDenom, 100)
+	msg, err := types.NewMsgRelayAxelarProxyUpgradeRequest(relayer, token, 10, chainID)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	setup.Mocks.AccountKeeperMock.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), relayer, types.ModuleName, sdk.NewCoins(token)).Return(nil)
+
+	// the transfer keeper sends the packet through the middleware, which must accept the upgrade payload
+	expectTransferThroughMiddleware(setup, 1)
+
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	_, found = acKeeper.GetAxelarProxyUpgradeData(ctx, chainID)
+	require.False(t, found)
+}
+
+// expectTransferThroughMiddleware mocks an ICS20 transfer that sends its packet through the axelarcork middleware
+func expectTransferThroughMiddleware(setup *tests.Setup, sequence uint64) {
+	setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(c context.Context, transfer *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				transfer.Token.Denom, transfer.Token.Amount.String(), transfer.Sender, transfer.Receiver, transfer.Memo)
+			sequence, err := setup.AxelarCorkMiddleware.SendPacket(sdk.UnwrapSDKContext(c), nil, transfer.SourcePort, transfer.SourceChannel, transfer.TimeoutHeight, transfer.TimeoutTimestamp, packetData.GetBytes())
+			if err != nil {
+				return nil, err
+			}
+
+			return &transfertypes.MsgTransferResponse{Sequence: sequence}, nil
+		})
+	setup.Mocks.ICS4WrapperMock.EXPECT().SendPacket(gomock.Any(), nil, testSourcePort, testSourceChannel, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(sequence, nil)
+}
+
+func TestRelayCork_Queue(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	setup := tests.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	acKeeper := setup.Keepers.AxelarCorkKeeper
+
+	params := tests.TestParams()
+	params.Enabled = true
+	params.IbcChannel = testSourceChannel
+	params.IbcPort = testSourcePort
+	acKeeper.SetParams(ctx, params)
+
+	chainID := uint64(1)
+	cellar := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	acKeeper.SetChainConfiguration(ctx, chainID, types.ChainConfiguration{
+		Name:         "Ethereum",
+		Id:           chainID,
+		ProxyAddress: "0x1111111111111111111111111111111111111111",
+	})
+
+	newCork := func(call string) types.AxelarCork {
+		return types.AxelarCork{
+			EncodedContractCall:   []byte(call),
+			ChainId:               chainID,
+			TargetContractAddress: cellar.Hex(),
+			Deadline:              100,
+		}
+	}
+
+	// corks approved at the same height are queued in approval order, after those approved earlier
+	later := newCork("later")
+	first := newCork("first")
+	second := newCork("second")
+	acKeeper.EnqueueWinningAxelarCork(ctx, chainID, 12, later)
+	acKeeper.EnqueueWinningAxelarCork(ctx, chainID, 10, first)
+	acKeeper.EnqueueWinningAxelarCork(ctx, chainID, 10, second)
+
+	var queue []types.AxelarCork
+	acKeeper.IterateWinningAxelarCorkQueue(ctx, chainID, cellar, func(_ uint64, _ uint64, cork types.AxelarCork) (stop bool) {
+		queue = append(queue, cork)
+		return false
+	})
+	require.Equal(t, []types.AxelarCork{first, second, later}, queue)
+
+	relayer := tests.AccAddress()
+	token := sdk.NewInt64Coin(testDenom, 100)
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	setup.Mocks.AccountKeeperMock.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), relayer, types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(2)
+
+	// relaying a specific cork removes exactly that entry
+	msg, err := types.NewMsgRelayAxelarCorkRequest(relayer, token, 10, chainID, cellar, hex.EncodeToString(second.IDHash(10)))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	expectTransferThroughMiddleware(setup, 1)
+	_, err = acKeeper.RelayCork(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, _, _, found := acKeeper.GetWinningAxelarCorkByID(ctx, chainID, cellar, second.IDHash(10))
+	require.False(t, found)
+	blockHeight, queueNonce, head, found := acKeeper.GetWinningAxelarCork(ctx, chainID, cellar)
+	require.True(t, found)
+	require.Equal(t, first, head)
+
+	relay, found := acKeeper.GetAxelarCorkRelay(ctx, chainID, second.IDHash(10))
+	require.True(t, found)
+	require.Equal(t, uint64(10), relay.BlockHeight)
+	require.Equal(t, uint64(3), relay.QueueNonce)
+
+	// relaying without an ID takes the head of the queue
+	msg, err = types.NewMsgRelayAxelarCorkRequest(relayer, token, 10, chainID, cellar, "")
+	require.NoError(t, err)
+	expectTransferThroughMiddleware(setup, 2)
+	_, err = acKeeper.RelayCork(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, found = acKeeper.GetWinningAxelarCorkAt(ctx, chainID, cellar, blockHeight, queueNonce)
+	require.False(t, found)
+	_, _, head, found = acKeeper.GetWinningAxelarCork(ctx, chainID, cellar)
+	require.True(t, found)
+	require.Equal(t, later, head)
+
+	// unknown cork IDs are rejected before any tokens move
+	msg, err = types.NewMsgRelayAxelarCorkRequest(relayer, token, 10, chainID, cellar, hex.EncodeToString(first.IDHash(10)))
+	require.NoError(t, err)
+	_, err = acKeeper.RelayCork(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// corks past their deadline fail before any tokens move
+	msg, err = types.NewMsgRelayAxelarCorkRequest(relayer, token, 10, chainID, cellar, "")
+	require.NoError(t, err)
+	_, err = acKeeper.RelayCork(sdk.WrapSDKContext(ctx.WithBlockTime(time.Unix(int64(later.Deadline), 0))), msg)
+	require.ErrorIs(t, err, types.ErrCorkDeadlinePassed)
+	_, _, head, found = acKeeper.GetWinningAxelarCork(ctx, chainID, cellar)
+	require.True(t, found)
+	require.Equal(t, later, head)
+}
+
+func TestSendPacket_UnmarkedCork(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	setup := tests.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	acKeeper := setup.Keepers.AxelarCorkKeeper
+
+	params := tests.TestParams()
+	params.Enabled = true
+	params.IbcChannel = testSourceChannel
+	acKeeper.SetParams(ctx, params)
+
+	chainID := uint64(1)
+	proxy := "0x1111111111111111111111111111111111111111"
+	cellar := "0x2222222222222222222222222222222222222222"
+	acKeeper.SetChainConfiguration(ctx, chainID, types.ChainConfiguration{Name: "Ethereum", Id: chainID, ProxyAddress: proxy})
+
+	cork := types.AxelarCork{EncodedContractCall: []byte("call"), ChainId: chainID, TargetContractAddress: cellar, Deadline: 100}
+	acKeeper.EnqueueWinningAxelarCork(ctx, chainID, 10, cork)
+
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	setup.Mocks.AccountKeeperMock.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+
+	// a queued cork that was not marked for relay with the packet's nonce is rejected
+	payload, err := types.EncodeLogicCallArgs(cellar, 1, cork.Deadline, cork.EncodedContractCall)
+	require.NoError(t, err)
+	memo, err := json.Marshal(types.AxelarBody{DestinationChain: "Ethereum", DestinationAddress: proxy, Payload: payload, Type: types.PureMessage})
+	require.NoError(t, err)
+	packetData := transfertypes.NewFungibleTokenPacketData(testDenom, testAmount, moduleAccount.GetAddress().String(), tests.TestGMPAccount.String(), string(memo))
+
+	_, err = setup.AxelarCorkMiddleware.SendPacket(ctx, nil, testSourcePort, testSourceChannel, clienttypes.ZeroHeight(), 0, packetData.GetBytes())
+	require.Error(t, err)
+
+	_, _, _, found := acKeeper.GetWinningAxelarCork(ctx, chainID, common.HexToAddress(cellar))
+	require.True(t, found)
+}
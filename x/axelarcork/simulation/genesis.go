@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+	corksim "github.com/peggyjv/sommelier/v7/x/cork/simulation"
+)
+
+// Simulation parameter constants
+const (
+	Enabled             = "enabled"
+	ChainConfigurations = "chain_configurations"
+)
+
+// GenEnabled randomized Enabled, which is true most of the time so that corks can be scheduled
+func GenEnabled(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// GenChainConfigurations randomized set of chain configurations
+func GenChainConfigurations(r *rand.Rand) types.ChainConfigurations {
+	configurations := make([]*types.ChainConfiguration, simtypes.RandIntBetween(r, 1, 4))
+	for i := range configurations {
+		configurations[i] = &types.ChainConfiguration{
+			Name:         strings.ToLower(simtypes.RandStringOfLength(r, 8)),
+			Id:           uint64(i + 1),
+			ProxyAddress: corksim.RandomEVMAddress(r).Hex(),
+		}
+	}
+
+	return types.ChainConfigurations{Configurations: configurations}
+}
+
+// RandomizedGenState generates a random GenesisState for axelarcork, managing a few random cellars on each of the
+// configured chains
+func RandomizedGenState(simState *module.SimulationState) {
+	var enabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Enabled, &enabled, simState.Rand,
+		func(r *rand.Rand) { enabled = GenEnabled(r) },
+	)
+
+	var chainConfigurations types.ChainConfigurations
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ChainConfigurations, &chainConfigurations, simState.Rand,
+		func(r *rand.Rand) { chainConfigurations = GenChainConfigurations(r) },
+	)
+
+	r := simState.Rand
+	axelarcorkGenesis := types.DefaultGenesisState()
+	axelarcorkGenesis.Params.Enabled = enabled
+	axelarcorkGenesis.Params.IbcPort = "transfer"
+	axelarcorkGenesis.Params.IbcChannel = "channel-0"
+	axelarcorkGenesis.Params.GmpAccount = simState.Accounts[r.Intn(len(simState.Accounts))].Address.String()
+	axelarcorkGenesis.Params.ExecutorAccount = corksim.RandomEVMAddress(r).Hex()
+	axelarcorkGenesis.Params.TimeoutDuration = uint64(simtypes.RandIntBetween(r, 1, 1000000000))
+	axelarcorkGenesis.Params.CorkResultRetentionBlocks = uint64(r.Intn(200))
+	axelarcorkGenesis.ChainConfigurations = chainConfigurations
+
+	for _, config := range chainConfigurations.Configurations {
+		axelarcorkGenesis.CellarIds = append(axelarcorkGenesis.CellarIds, &types.CellarIDSet{
+			ChainId: config.Id,
+			Ids:     corksim.GenCellarIDs(r),
+		})
+	}
+
+	bz, err := json.MarshalIndent(axelarcorkGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated axelarcork parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&axelarcorkGenesis)
+}
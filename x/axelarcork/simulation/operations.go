@@ -0,0 +1,301 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/keeper"
+	"github.com/peggyjv/sommelier/v7/x/axelarcork/types"
+	corksim "github.com/peggyjv/sommelier/v7/x/cork/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgScheduleAxelarCork = "op_weight_msg_schedule_axelar_cork" //nolint:gosec
+	OpWeightMsgRelayAxelarCork    = "op_weight_msg_relay_axelar_cork"    //nolint:gosec
+
+	DefaultWeightMsgScheduleAxelarCork = 50
+	DefaultWeightMsgRelayAxelarCork    = 20
+
+	// maxBlockTimeSpan is an upper bound on the seconds the simulator advances the block time per block
+	maxBlockTimeSpan = 10000
+	// maxVoteBlocks is the number of blocks a scheduled cork's deadline must outlast, covering the other
+	// validators' votes in the next block and the execution height
+	maxVoteBlocks = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, gk types.GravityKeeper, sk types.StakingKeeper, ck types.ChannelKeeper) simulation.WeightedOperations {
+	var weightMsgScheduleAxelarCork int
+	appParams.GetOrGenerate(cdc, OpWeightMsgScheduleAxelarCork, &weightMsgScheduleAxelarCork, nil,
+		func(_ *rand.Rand) {
+			weightMsgScheduleAxelarCork = DefaultWeightMsgScheduleAxelarCork
+		},
+	)
+
+	var weightMsgRelayAxelarCork int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRelayAxelarCork, &weightMsgRelayAxelarCork, nil,
+		func(_ *rand.Rand) {
+			weightMsgRelayAxelarCork = DefaultWeightMsgRelayAxelarCork
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgScheduleAxelarCork,
+			SimulateMsgScheduleAxelarCork(ak, bk, k, gk, sk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRelayAxelarCork,
+			SimulateMsgRelayAxelarCork(ak, bk, k, ck),
+		),
+	}
+}
+
+// SimulateMsgScheduleAxelarCork generates a MsgScheduleAxelarCorkRequest from a random validator for a random
+// managed cellar on a random chain, and schedules the same cork vote from the other bonded validators in the next
+// block so that the cork can be approved. Validators without delegate keys register them first.
+func SimulateMsgScheduleAxelarCork(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, gk types.GravityKeeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParamSet(ctx).Enabled {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "axelarcork is disabled"), nil, nil
+		}
+
+		validators := sk.GetBondedValidatorsByPower(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "no bonded validators"), nil, nil
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "validator account not found"), nil, nil
+		}
+
+		if gk.GetOrchestratorValidatorAddress(ctx, simAccount.Address) == nil {
+			return corksim.DeliverMsgDelegateKeys(r, app, ctx, ak, bk, simAccount)
+		}
+
+		config, found := randomChainConfiguration(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "no chain configurations"), nil, nil
+		}
+
+		cellars := k.GetCellarIDs(ctx, config.Id)
+		if len(cellars) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "no managed cellars"), nil, nil
+		}
+
+		deadline, ok := randomDeadline(r, ctx, k.GetParamSet(ctx).MaxCorkDeadlineHorizon)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "deadline horizon too short"), nil, nil
+		}
+
+		cork := types.AxelarCork{
+			EncodedContractCall:   corksim.RandomContractCall(r),
+			ChainId:               config.Id,
+			TargetContractAddress: cellars[r.Intn(len(cellars))].Hex(),
+			Deadline:              deadline,
+		}
+		// leave room for the other validators' votes in the next block
+		blockHeight := uint64(ctx.BlockHeight()) + uint64(simtypes.RandIntBetween(r, 2, 10))
+
+		opMsg, _, err := simulateMsgScheduleAxelarCorkVote(ak, bk, k, gk, validator.GetOperator(), cork, blockHeight)(r, app, ctx, accs, chainID)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		var futureOps []simtypes.FutureOperation
+		for _, otherValidator := range validators {
+			if otherValidator.GetOperator().Equals(validator.GetOperator()) || r.Intn(4) == 0 {
+				continue
+			}
+
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          simulateMsgScheduleAxelarCorkVote(ak, bk, k, gk, otherValidator.GetOperator(), cork, blockHeight),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+func simulateMsgScheduleAxelarCorkVote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, gk types.GravityKeeper,
+	validator sdk.ValAddress, cork types.AxelarCork, blockHeight uint64) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParamSet(ctx).Enabled {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "axelarcork is disabled"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "validator account not found"), nil, nil
+		}
+
+		if !validator.Equals(gk.GetOrchestratorValidatorAddress(ctx, simAccount.Address)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "validator has no delegate keys"), nil, nil
+		}
+
+		if blockHeight <= uint64(ctx.BlockHeight()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "block height has passed"), nil, nil
+		}
+
+		if cork.DeadlinePassed(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "cork deadline has passed"), nil, nil
+		}
+
+		if _, found := k.GetChainConfigurationByID(ctx, cork.ChainId); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "chain configuration not found"), nil, nil
+		}
+
+		cellar := common.HexToAddress(cork.TargetContractAddress)
+		if !k.HasCellarID(ctx, cork.ChainId, cellar) || k.IsCellarPaused(ctx, cork.ChainId, cellar) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "cellar does not accept scheduled corks"), nil, nil
+		}
+
+		if err := k.ValidateCorkSelector(ctx, cork.ChainId, cork); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "selector not allowed"), nil, nil
+		}
+
+		msg, err := types.NewMsgScheduleAxelarCorkRequest(cork.ChainId, cork.EncodedContractCall, cellar, cork.Deadline, blockHeight, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleAxelarCorkRequest, "unable to build msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRelayAxelarCork generates a MsgRelayAxelarCorkRequest for a random cellar with a winning cork. Relaying
+// transfers the cork over IBC, so the operation is skipped when the configured channel does not exist.
+func SimulateMsgRelayAxelarCork(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, ck types.ChannelKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParamSet(ctx)
+		if !params.Enabled {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "axelarcork is disabled"), nil, nil
+		}
+
+		if _, found := ck.GetChannel(ctx, params.IbcPort, params.IbcChannel); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "ibc channel not found"), nil, nil
+		}
+
+		config, found := randomChainConfiguration(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "no chain configurations"), nil, nil
+		}
+
+		cellars := k.GetCellarIDs(ctx, config.Id)
+		if len(cellars) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "no managed cellars"), nil, nil
+		}
+
+		cellar := cellars[r.Intn(len(cellars))]
+		if k.IsCellarPaused(ctx, config.Id, cellar) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "cellar is paused"), nil, nil
+		}
+
+		_, _, winningCork, found := k.GetWinningAxelarCork(ctx, config.Id, cellar)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "no winning cork"), nil, nil
+		}
+
+		if winningCork.DeadlinePassed(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "winning cork deadline has passed"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "no spendable coins"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "unable to generate token amount"), nil, nil
+		}
+		token := sdk.NewCoin(coin.Denom, amount)
+		fee := uint64(simtypes.RandIntBetween(r, 1, 1000))
+
+		msg, err := types.NewMsgRelayAxelarCorkRequest(simAccount.Address, token, fee, config.Id, cellar, "")
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayAxelarCorkRequest, "unable to build msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(token),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomDeadline returns a deadline that outlasts the cork's votes and execution height while staying within the
+// maximum deadline horizon at the current block time, or false if the horizon is too short to allow one
+func randomDeadline(r *rand.Rand, ctx sdk.Context, maxHorizon uint64) (uint64, bool) {
+	minOffset := maxBlockTimeSpan * maxVoteBlocks
+	maxOffset := 30 * 24 * 60 * 60
+	if maxHorizon != 0 && maxHorizon < uint64(maxOffset) {
+		maxOffset = int(maxHorizon)
+	}
+
+	if maxOffset <= minOffset {
+		return 0, false
+	}
+
+	return uint64(ctx.BlockTime().Unix()) + uint64(simtypes.RandIntBetween(r, minOffset+1, maxOffset+1)), true
+}
+
+func randomChainConfiguration(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.ChainConfiguration, bool) {
+	var configs []types.ChainConfiguration
+	k.IterateChainConfigurations(ctx, func(config types.ChainConfiguration) (stop bool) {
+		configs = append(configs, config)
+		return false
+	})
+
+	if len(configs) == 0 {
+		return types.ChainConfiguration{}, false
+	}
+
+	return configs[r.Intn(len(configs))], true
+}
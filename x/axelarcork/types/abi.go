@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ParseABI parses a JSON encoded contract ABI, which must define at least one function
+func ParseABI(abiJSON string) (abi.ABI, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return abi.ABI{}, errorsmod.Wrap(ErrInvalidABI, err.Error())
+	}
+
+	if len(contractABI.Methods) == 0 {
+		return abi.ABI{}, errorsmod.Wrap(ErrInvalidABI, "ABI defines no functions")
+	}
+
+	return contractABI, nil
+}
+
+func (c *AxelarCellarABI) ValidateBasic() error {
+	if c.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(c.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", c.CellarId)
+	}
+
+	_, err := ParseABI(c.Abi)
+	return err
+}
+
+// DecodeContractCall decodes the contract call of the cork with the given ID using the cellar's ABI
+func (c *AxelarCellarABI) DecodeContractCall(corkID string, contractCall []byte) (DecodedContractCall, error) {
+	contractABI, err := ParseABI(c.Abi)
+	if err != nil {
+		return DecodedContractCall{}, err
+	}
+
+	if len(contractCall) < FunctionSelectorLength {
+		return DecodedContractCall{}, errorsmod.Wrap(ErrInvalidSelector, "contract call is shorter than a function selector")
+	}
+
+	method, err := contractABI.MethodById(contractCall[:FunctionSelectorLength])
+	if err != nil {
+		return DecodedContractCall{}, errorsmod.Wrap(ErrInvalidSelector, err.Error())
+	}
+
+	values, err := method.Inputs.Unpack(contractCall[FunctionSelectorLength:])
+	if err != nil {
+		return DecodedContractCall{}, errorsmod.Wrapf(ErrInvalidABI, "failed to unpack %s arguments: %s", method.Sig, err)
+	}
+
+	arguments := make(map[string]interface{}, len(values))
+	for i, input := range method.Inputs {
+		name := input.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+
+		arguments[name] = jsonABIValue(values[i])
+	}
+
+	bz, err := json.Marshal(arguments)
+	if err != nil {
+		return DecodedContractCall{}, err
+	}
+
+	return DecodedContractCall{
+		CorkId:    corkID,
+		Method:    method.RawName,
+		Signature: method.Sig,
+		Arguments: string(bz),
+	}, nil
+}
+
+// jsonABIValue converts a value unpacked from calldata into the form it is rendered as in JSON: addresses as
+// checksummed hex, bytes as 0x prefixed hex and integers as decimal strings so that no precision is lost
+func jsonABIValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return value
+	}
+
+	if rv.Type().Elem().Kind() == reflect.Uint8 {
+		bz := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(bz), rv)
+		return hexutil.Encode(bz)
+	}
+
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = jsonABIValue(rv.Index(i).Interface())
+	}
+
+	return elems
+}
@@ -2,7 +2,11 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +15,12 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// FunctionSelectorLength is the number of leading calldata bytes that identify the contract function being called
+const FunctionSelectorLength = 4
+
+// MaxCellarMetadataFieldLength is the maximum length of each cellar metadata field
+const MaxCellarMetadataFieldLength = 256
+
 func (c *AxelarCork) IDHash(blockHeight uint64) []byte {
 	blockHeightBytes := sdk.Uint64ToBigEndian(blockHeight)
 	chainIDBytes := sdk.Uint64ToBigEndian(c.ChainId)
@@ -62,6 +72,26 @@ func (c *AxelarCork) ValidateBasic() error {
 	return nil
 }
 
+// DeadlinePassed returns true if the cork can no longer be executed at the given block time
+func (c *AxelarCork) DeadlinePassed(blockTime time.Time) bool {
+	now := blockTime.Unix()
+	return now >= 0 && c.Deadline <= uint64(now)
+}
+
+// ValidateDeadline checks that the cork's deadline is after the given block time and, when maxHorizon is non-zero, no
+// more than maxHorizon seconds after it
+func (c *AxelarCork) ValidateDeadline(blockTime time.Time, maxHorizon uint64) error {
+	if c.DeadlinePassed(blockTime) {
+		return errorsmod.Wrapf(ErrCorkDeadlinePassed, "deadline %d is not after block time %d", c.Deadline, blockTime.Unix())
+	}
+
+	if now := blockTime.Unix(); maxHorizon != 0 && now >= 0 && c.Deadline > uint64(now)+maxHorizon {
+		return errorsmod.Wrapf(ErrCorkDeadlineTooFar, "deadline %d is more than %d seconds after block time %d", c.Deadline, maxHorizon, now)
+	}
+
+	return nil
+}
+
 func (s *ScheduledAxelarCork) ValidateBasic() error {
 	if err := s.Cork.ValidateBasic(); err != nil {
 		return err
@@ -91,6 +121,11 @@ func (c *AxelarCorkResult) ValidateBasic() error {
 		return fmt.Errorf("block height must be non-zero")
 	}
 
+	// governance scheduled corks are never voted on
+	if c.Governance {
+		return nil
+	}
+
 	if _, err := sdk.NewDecFromStr(c.ApprovalPercentage); err != nil {
 		return fmt.Errorf("approval percentage must be a valid Dec")
 	}
@@ -98,6 +133,54 @@ func (c *AxelarCorkResult) ValidateBasic() error {
 	return nil
 }
 
+func (g *GovernanceScheduledAxelarCork) ValidateBasic() error {
+	if g.Cork == nil {
+		return ErrEmptyContractCall
+	}
+
+	if err := g.Cork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if g.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if g.Id != hex.EncodeToString(g.Cork.IDHash(g.BlockHeight)) {
+		return fmt.Errorf("ID does not match the cork and block height")
+	}
+
+	return nil
+}
+
+func (r *AxelarCorkRelay) ValidateBasic() error {
+	if r.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if err := r.Cork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if r.CorkId != hex.EncodeToString(r.Cork.IDHash(r.BlockHeight)) {
+		return fmt.Errorf("ID does not match the cork and block height")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Relayer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid relayer address %s: %s", r.Relayer, err)
+	}
+
+	if err := r.Token.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := AxelarCorkRelayStatus_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("invalid relay status %d", r.Status)
+	}
+
+	return nil
+}
+
 func (c *CellarIDSet) ValidateBasic() error {
 	if c.ChainId == 0 {
 		return fmt.Errorf("chain ID must be non-zero")
@@ -111,3 +194,124 @@ func (c *CellarIDSet) ValidateBasic() error {
 
 	return nil
 }
+
+func (c *AxelarManagedCellar) ValidateBasic() error {
+	if c.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(c.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", c.CellarId)
+	}
+
+	return c.Metadata.ValidateBasic()
+}
+
+// ValidateBasic checks the metadata fields that are set, all of them are optional
+func (m *CellarMetadata) ValidateBasic() error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"name", m.Name},
+		{"version", m.Version},
+		{"asset denom", m.AssetDenom},
+		{"strategy spec url", m.StrategySpecUrl},
+	}
+	for _, field := range fields {
+		if len(field.value) > MaxCellarMetadataFieldLength {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "%s longer than %d characters", field.name, MaxCellarMetadataFieldLength)
+		}
+	}
+
+	if m.AssetDenom != "" {
+		if err := sdk.ValidateDenom(m.AssetDenom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "asset denom: %s", err)
+		}
+	}
+
+	if m.StrategySpecUrl != "" {
+		u, err := url.ParseRequestURI(m.StrategySpecUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "strategy spec url must be an http(s) URL: %s", m.StrategySpecUrl)
+		}
+	}
+
+	return nil
+}
+
+func (e *CellarMetadataEntry) ValidateBasic() error {
+	if !common.IsHexAddress(e.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", e.CellarId)
+	}
+
+	return e.Metadata.ValidateBasic()
+}
+
+// Selector returns the 0x prefixed, hex encoded function selector of the cork's contract call
+func (c *AxelarCork) Selector() (string, error) {
+	if len(c.EncodedContractCall) < FunctionSelectorLength {
+		return "", errorsmod.Wrap(ErrInvalidSelector, "contract call is shorter than a function selector")
+	}
+
+	return "0x" + hex.EncodeToString(c.EncodedContractCall[:FunctionSelectorLength]), nil
+}
+
+// NormalizeSelector validates a hex encoded function selector and returns it 0x prefixed and lower case
+func NormalizeSelector(selector string) (string, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(selector), "0x"))
+	if err != nil || len(bz) != FunctionSelectorLength {
+		return "", errorsmod.Wrapf(ErrInvalidSelector, "%s", selector)
+	}
+
+	return "0x" + hex.EncodeToString(bz), nil
+}
+
+// NormalizeSelectors normalizes each selector in the list, rejecting duplicates
+func NormalizeSelectors(selectors []string) ([]string, error) {
+	normalized := make([]string, 0, len(selectors))
+	seen := make(map[string]bool, len(selectors))
+	for _, selector := range selectors {
+		s, err := NormalizeSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[s] {
+			return nil, errorsmod.Wrapf(ErrInvalidSelector, "duplicate selector %s", s)
+		}
+
+		seen[s] = true
+		normalized = append(normalized, s)
+	}
+
+	return normalized, nil
+}
+
+func (a *AxelarCellarSelectorAllowlist) ValidateBasic() error {
+	if a.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(a.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", a.CellarId)
+	}
+
+	if len(a.Selectors) == 0 {
+		return errorsmod.Wrap(ErrInvalidSelector, "allowlist must contain at least one selector")
+	}
+
+	_, err := NormalizeSelectors(a.Selectors)
+	return err
+}
+
+// Allows returns true if the selector is in the allowlist
+func (a *AxelarCellarSelectorAllowlist) Allows(selector string) bool {
+	for _, s := range a.Selectors {
+		if strings.EqualFold(s, selector) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -25,6 +25,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AxelarCorkRelayStatus is the state of the IBC transfer that relays an approved cork to Axelar
+type AxelarCorkRelayStatus int32
+
+const (
+	// the transfer was sent and is awaiting an acknowledgement or timeout
+	AxelarCorkRelayStatus_RELAY_STATUS_PENDING AxelarCorkRelayStatus = 0
+	// the transfer was acknowledged successfully
+	AxelarCorkRelayStatus_RELAY_STATUS_ACKNOWLEDGED AxelarCorkRelayStatus = 1
+	// the transfer was rejected with an error acknowledgement or the relayer could not be refunded, see refund_error
+	AxelarCorkRelayStatus_RELAY_STATUS_FAILED AxelarCorkRelayStatus = 2
+	// the transfer timed out, the cork was restored and the relayer refunded
+	AxelarCorkRelayStatus_RELAY_STATUS_TIMED_OUT AxelarCorkRelayStatus = 3
+)
+
+var AxelarCorkRelayStatus_name = map[int32]string{
+	0: "RELAY_STATUS_PENDING",
+	1: "RELAY_STATUS_ACKNOWLEDGED",
+	2: "RELAY_STATUS_FAILED",
+	3: "RELAY_STATUS_TIMED_OUT",
+}
+
+var AxelarCorkRelayStatus_value = map[string]int32{
+	"RELAY_STATUS_PENDING":      0,
+	"RELAY_STATUS_ACKNOWLEDGED": 1,
+	"RELAY_STATUS_FAILED":       2,
+	"RELAY_STATUS_TIMED_OUT":    3,
+}
+
+func (x AxelarCorkRelayStatus) String() string {
+	return proto.EnumName(AxelarCorkRelayStatus_name, int32(x))
+}
+
+func (AxelarCorkRelayStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{0}
+}
+
 type AxelarCork struct {
 	// call body containing the ABI encoded bytes to send to the contract
 	EncodedContractCall []byte `protobuf:"bytes,1,opt,name=encoded_contract_call,json=encodedContractCall,proto3" json:"encoded_contract_call,omitempty"`
@@ -210,18 +246,82 @@ func (m *ScheduledAxelarCorks) GetScheduledCorks() []*ScheduledAxelarCork {
 	return nil
 }
 
+// GovernanceScheduledAxelarCork is a cork scheduled by a passed governance proposal, made relayable at its block
+// height without a validator vote
+type GovernanceScheduledAxelarCork struct {
+	Cork        *AxelarCork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork,omitempty"`
+	BlockHeight uint64      `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Id          string      `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GovernanceScheduledAxelarCork) Reset()         { *m = GovernanceScheduledAxelarCork{} }
+func (m *GovernanceScheduledAxelarCork) String() string { return proto.CompactTextString(m) }
+func (*GovernanceScheduledAxelarCork) ProtoMessage()    {}
+func (*GovernanceScheduledAxelarCork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{3}
+}
+func (m *GovernanceScheduledAxelarCork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceScheduledAxelarCork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceScheduledAxelarCork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceScheduledAxelarCork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceScheduledAxelarCork.Merge(m, src)
+}
+func (m *GovernanceScheduledAxelarCork) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceScheduledAxelarCork) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceScheduledAxelarCork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceScheduledAxelarCork proto.InternalMessageInfo
+
+func (m *GovernanceScheduledAxelarCork) GetCork() *AxelarCork {
+	if m != nil {
+		return m.Cork
+	}
+	return nil
+}
+
+func (m *GovernanceScheduledAxelarCork) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GovernanceScheduledAxelarCork) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type AxelarCorkResult struct {
 	Cork               *AxelarCork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork,omitempty"`
 	BlockHeight        uint64      `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Approved           bool        `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
 	ApprovalPercentage string      `protobuf:"bytes,4,opt,name=approval_percentage,json=approvalPercentage,proto3" json:"approval_percentage,omitempty"`
+	// true if the cork was scheduled by a governance proposal rather than approved by a validator vote
+	Governance bool `protobuf:"varint,5,opt,name=governance,proto3" json:"governance,omitempty"`
 }
 
 func (m *AxelarCorkResult) Reset()         { *m = AxelarCorkResult{} }
 func (m *AxelarCorkResult) String() string { return proto.CompactTextString(m) }
 func (*AxelarCorkResult) ProtoMessage()    {}
 func (*AxelarCorkResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{3}
+	return fileDescriptor_f8790c2a041a4f43, []int{4}
 }
 func (m *AxelarCorkResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +378,13 @@ func (m *AxelarCorkResult) GetApprovalPercentage() string {
 	return ""
 }
 
+func (m *AxelarCorkResult) GetGovernance() bool {
+	if m != nil {
+		return m.Governance
+	}
+	return false
+}
+
 type AxelarCorkResults struct {
 	CorkResults []*AxelarCorkResult `protobuf:"bytes,1,rep,name=cork_results,json=corkResults,proto3" json:"cork_results,omitempty"`
 }
@@ -286,7 +393,7 @@ func (m *AxelarCorkResults) Reset()         { *m = AxelarCorkResults{} }
 func (m *AxelarCorkResults) String() string { return proto.CompactTextString(m) }
 func (*AxelarCorkResults) ProtoMessage()    {}
 func (*AxelarCorkResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{4}
+	return fileDescriptor_f8790c2a041a4f43, []int{5}
 }
 func (m *AxelarCorkResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +438,7 @@ func (m *CellarIDSet) Reset()         { *m = CellarIDSet{} }
 func (m *CellarIDSet) String() string { return proto.CompactTextString(m) }
 func (*CellarIDSet) ProtoMessage()    {}
 func (*CellarIDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{5}
+	return fileDescriptor_f8790c2a041a4f43, []int{6}
 }
 func (m *CellarIDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +495,7 @@ func (m *ChainConfiguration) Reset()         { *m = ChainConfiguration{} }
 func (m *ChainConfiguration) String() string { return proto.CompactTextString(m) }
 func (*ChainConfiguration) ProtoMessage()    {}
 func (*ChainConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{6}
+	return fileDescriptor_f8790c2a041a4f43, []int{7}
 }
 func (m *ChainConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +560,7 @@ func (m *ChainConfigurations) Reset()         { *m = ChainConfigurations{} }
 func (m *ChainConfigurations) String() string { return proto.CompactTextString(m) }
 func (*ChainConfigurations) ProtoMessage()    {}
 func (*ChainConfigurations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{7}
+	return fileDescriptor_f8790c2a041a4f43, []int{8}
 }
 func (m *ChainConfigurations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +607,7 @@ func (m *AxelarContractCallNonce) Reset()         { *m = AxelarContractCallNonce
 func (m *AxelarContractCallNonce) String() string { return proto.CompactTextString(m) }
 func (*AxelarContractCallNonce) ProtoMessage()    {}
 func (*AxelarContractCallNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{8}
+	return fileDescriptor_f8790c2a041a4f43, []int{9}
 }
 func (m *AxelarContractCallNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +669,7 @@ func (m *AxelarUpgradeData) Reset()         { *m = AxelarUpgradeData{} }
 func (m *AxelarUpgradeData) String() string { return proto.CompactTextString(m) }
 func (*AxelarUpgradeData) ProtoMessage()    {}
 func (*AxelarUpgradeData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8790c2a041a4f43, []int{9}
+	return fileDescriptor_f8790c2a041a4f43, []int{10}
 }
 func (m *AxelarUpgradeData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,475 +719,672 @@ func (m *AxelarUpgradeData) GetExecutableHeightThreshold() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*AxelarCork)(nil), "axelarcork.v1.AxelarCork")
-	proto.RegisterType((*ScheduledAxelarCork)(nil), "axelarcork.v1.ScheduledAxelarCork")
-	proto.RegisterType((*ScheduledAxelarCorks)(nil), "axelarcork.v1.ScheduledAxelarCorks")
-	proto.RegisterType((*AxelarCorkResult)(nil), "axelarcork.v1.AxelarCorkResult")
-	proto.RegisterType((*AxelarCorkResults)(nil), "axelarcork.v1.AxelarCorkResults")
-	proto.RegisterType((*CellarIDSet)(nil), "axelarcork.v1.CellarIDSet")
-	proto.RegisterType((*ChainConfiguration)(nil), "axelarcork.v1.ChainConfiguration")
-	proto.RegisterType((*ChainConfigurations)(nil), "axelarcork.v1.ChainConfigurations")
-	proto.RegisterType((*AxelarContractCallNonce)(nil), "axelarcork.v1.AxelarContractCallNonce")
-	proto.RegisterType((*AxelarUpgradeData)(nil), "axelarcork.v1.AxelarUpgradeData")
+// Restricts the function selectors validators may call on a cellar
+type AxelarCellarSelectorAllowlist struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// 0x prefixed, hex encoded 4 byte function selectors
+	Selectors []string `protobuf:"bytes,3,rep,name=selectors,proto3" json:"selectors,omitempty"`
 }
 
-func init() { proto.RegisterFile("axelarcork/v1/axelarcork.proto", fileDescriptor_f8790c2a041a4f43) }
-
-var fileDescriptor_f8790c2a041a4f43 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe4, 0x44,
-	0x10, 0x8e, 0x67, 0x06, 0x36, 0x53, 0x33, 0x9b, 0x0d, 0x9d, 0xac, 0xd6, 0x09, 0xc8, 0x99, 0x35,
-	0x97, 0xe1, 0xb0, 0x36, 0x09, 0x12, 0x48, 0x1c, 0x90, 0x36, 0xb3, 0x42, 0x44, 0x20, 0x84, 0xbc,
-	0x20, 0x24, 0x2e, 0xa6, 0xa7, 0xbb, 0xd6, 0xd3, 0xa4, 0xc7, 0x6d, 0x75, 0xf7, 0x8c, 0x32, 0x6f,
-	0xc0, 0x91, 0x0b, 0x2f, 0xc1, 0x05, 0xf1, 0x16, 0x2b, 0x71, 0xd9, 0x23, 0x27, 0x40, 0xc9, 0x8b,
-	0x20, 0xb7, 0xed, 0xf9, 0xcb, 0x92, 0xdb, 0x9e, 0xdc, 0x55, 0x5f, 0x55, 0xf9, 0xeb, 0xaf, 0xaa,
-	0x1a, 0x02, 0x7a, 0x85, 0x92, 0x6a, 0xa6, 0xf4, 0x65, 0x3c, 0x3f, 0x8d, 0x57, 0x56, 0x54, 0x68,
-	0x65, 0x15, 0xb9, 0xbf, 0xe6, 0x99, 0x9f, 0x1e, 0x1f, 0x66, 0x2a, 0x53, 0x0e, 0x89, 0xcb, 0x53,
-	0x15, 0x74, 0x1c, 0x30, 0x65, 0xa6, 0xca, 0xc4, 0x63, 0x6a, 0x30, 0x9e, 0x9f, 0x8e, 0xd1, 0xd2,
-	0xd3, 0x98, 0x29, 0x91, 0x57, 0x78, 0xf8, 0xbb, 0x07, 0xf0, 0xd4, 0xd5, 0x19, 0x29, 0x7d, 0x49,
-	0xce, 0xe0, 0x21, 0xe6, 0x4c, 0x71, 0xe4, 0x29, 0x53, 0xb9, 0xd5, 0x94, 0xd9, 0x94, 0x51, 0x29,
-	0x7d, 0x6f, 0xe0, 0x0d, 0xfb, 0xc9, 0x41, 0x0d, 0x8e, 0x6a, 0x6c, 0x44, 0xa5, 0x24, 0x47, 0xb0,
-	0xcb, 0x26, 0x54, 0xe4, 0xa9, 0xe0, 0x7e, 0x6b, 0xe0, 0x0d, 0x3b, 0xc9, 0x3d, 0x67, 0x5f, 0x70,
-	0xf2, 0x31, 0x3c, 0xb2, 0x54, 0x67, 0x68, 0x57, 0xd5, 0x28, 0xe7, 0x1a, 0x8d, 0xf1, 0xdb, 0x03,
-	0x6f, 0xd8, 0x4d, 0x1e, 0x56, 0x70, 0x53, 0xef, 0x69, 0x05, 0x92, 0x63, 0xd8, 0xe5, 0x48, 0xb9,
-	0x14, 0x39, 0xfa, 0x1d, 0x57, 0x72, 0x69, 0x87, 0xbf, 0x7a, 0x70, 0xf0, 0x9c, 0x4d, 0x90, 0xcf,
-	0x24, 0xf2, 0x35, 0xea, 0x4f, 0xa0, 0x53, 0x4a, 0xe1, 0x98, 0xf6, 0xce, 0x8e, 0xa2, 0x0d, 0x75,
-	0xa2, 0x55, 0x60, 0xe2, 0xc2, 0xc8, 0x63, 0xe8, 0x8f, 0xa5, 0x62, 0x97, 0xe9, 0x04, 0x45, 0x36,
-	0xb1, 0x35, 0xf3, 0x9e, 0xf3, 0x7d, 0xe1, 0x5c, 0xe4, 0x3d, 0xe8, 0xce, 0xa9, 0x14, 0x9c, 0x5a,
-	0xa5, 0x6b, 0xbe, 0x2b, 0x07, 0xd9, 0x83, 0x96, 0xe0, 0x8e, 0x5d, 0x37, 0x69, 0x09, 0x1e, 0x32,
-	0x38, 0x7c, 0x0d, 0x2d, 0x43, 0xbe, 0x84, 0x07, 0xa6, 0xf1, 0xa7, 0xe5, 0xaf, 0x8d, 0xef, 0x0d,
-	0xda, 0xc3, 0xde, 0x59, 0xb8, 0x45, 0xf1, 0x35, 0xd9, 0xc9, 0xde, 0x32, 0xd5, 0x15, 0x0b, 0xff,
-	0xf0, 0x60, 0x7f, 0x0d, 0x46, 0x33, 0x93, 0xf6, 0x0d, 0xdc, 0xfc, 0x18, 0x76, 0x69, 0x51, 0x68,
-	0x35, 0x47, 0xee, 0x2e, 0xbe, 0x9b, 0x2c, 0x6d, 0x12, 0xc3, 0x41, 0x75, 0xa6, 0x32, 0x2d, 0x50,
-	0x33, 0xcc, 0x2d, 0xcd, 0xb0, 0x16, 0x82, 0x34, 0xd0, 0x37, 0x4b, 0x24, 0xfc, 0x1e, 0xde, 0xd9,
-	0xa6, 0x6c, 0xc8, 0x39, 0xf4, 0x4b, 0x32, 0xa9, 0xae, 0xec, 0x5a, 0x92, 0x93, 0xff, 0xe7, 0xee,
-	0xe2, 0x92, 0x1e, 0x5b, 0xd5, 0x08, 0x3f, 0x85, 0xde, 0x08, 0xa5, 0xa4, 0xfa, 0xe2, 0xd9, 0x73,
-	0xb4, 0x1b, 0x73, 0xe8, 0x6d, 0xce, 0xe1, 0x3e, 0xb4, 0x05, 0x37, 0x7e, 0x6b, 0xd0, 0x1e, 0x76,
-	0x93, 0xf2, 0x18, 0xfe, 0xe9, 0x01, 0x19, 0x95, 0xe8, 0x48, 0xe5, 0x2f, 0x44, 0x36, 0xd3, 0xd4,
-	0x0a, 0x95, 0x13, 0x02, 0x9d, 0x9c, 0x4e, 0xd1, 0xe5, 0x77, 0x13, 0x77, 0xae, 0x1b, 0x5d, 0xa9,
-	0xd4, 0x12, 0x9c, 0xbc, 0x0f, 0xf7, 0x0b, 0xad, 0xae, 0x16, 0x5b, 0xa3, 0xdc, 0x77, 0xce, 0x66,
-	0x82, 0x25, 0xf4, 0xc6, 0x5a, 0xf0, 0x0c, 0xd3, 0x17, 0x88, 0xc6, 0xef, 0xb8, 0xeb, 0x1d, 0x45,
-	0xd5, 0x36, 0x46, 0xe5, 0x36, 0x46, 0xf5, 0x36, 0x46, 0x23, 0x25, 0xf2, 0xf3, 0x0f, 0x5f, 0xfe,
-	0x7d, 0xb2, 0xf3, 0xdb, 0x3f, 0x27, 0xc3, 0x4c, 0xd8, 0xc9, 0x6c, 0x1c, 0x31, 0x35, 0x8d, 0xeb,
-	0xd5, 0xad, 0x3e, 0x4f, 0x0c, 0xbf, 0x8c, 0xed, 0xa2, 0x40, 0xe3, 0x12, 0x4c, 0x02, 0x55, 0xfd,
-	0xcf, 0x11, 0x4d, 0xf8, 0x23, 0x1c, 0xdc, 0xbe, 0x8c, 0x21, 0x17, 0xb0, 0xc7, 0x36, 0x3c, 0xb5,
-	0xcc, 0x8f, 0xb7, 0x64, 0xbe, 0x9d, 0x9b, 0x6c, 0x25, 0x86, 0x33, 0x78, 0xd4, 0x34, 0x63, 0xb5,
-	0xfa, 0x5f, 0xab, 0x9c, 0xe1, 0x5d, 0xba, 0x7f, 0x00, 0xfb, 0xb7, 0x16, 0xbf, 0xe5, 0xd4, 0x7a,
-	0xc0, 0xb6, 0x56, 0xfe, 0x10, 0xde, 0xca, 0xcb, 0x72, 0x4e, 0xcd, 0x4e, 0x52, 0x19, 0xe1, 0xcf,
-	0x5e, 0x33, 0x3c, 0xdf, 0x15, 0x99, 0xa6, 0x1c, 0x9f, 0x51, 0x4b, 0xef, 0xfa, 0xa3, 0x0f, 0xf7,
-	0x0a, 0xba, 0x90, 0x8a, 0x56, 0x1d, 0xeb, 0x27, 0x8d, 0x49, 0x3e, 0x83, 0x77, 0xf1, 0x0a, 0xd9,
-	0xcc, 0xd2, 0xb1, 0xc4, 0x7a, 0xf6, 0x53, 0x3b, 0xd1, 0x68, 0x26, 0x4a, 0x56, 0x63, 0xde, 0x4e,
-	0x8e, 0x56, 0x21, 0xd5, 0x2a, 0x7c, 0xdb, 0x04, 0x9c, 0x7f, 0xf5, 0xf2, 0x3a, 0xf0, 0x5e, 0x5d,
-	0x07, 0xde, 0xbf, 0xd7, 0x81, 0xf7, 0xcb, 0x4d, 0xb0, 0xf3, 0xea, 0x26, 0xd8, 0xf9, 0xeb, 0x26,
-	0xd8, 0xf9, 0xe1, 0x6c, 0xad, 0x67, 0x05, 0x66, 0xd9, 0xe2, 0xa7, 0x79, 0x6c, 0xd4, 0x74, 0x8a,
-	0x52, 0xa0, 0x8e, 0xe7, 0x9f, 0xc4, 0x57, 0x6b, 0x8f, 0x77, 0xd5, 0xc3, 0xf1, 0xdb, 0xee, 0xf9,
-	0xfd, 0xe8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x87, 0x4c, 0x2b, 0xe5, 0x05, 0x00, 0x00,
+func (m *AxelarCellarSelectorAllowlist) Reset()         { *m = AxelarCellarSelectorAllowlist{} }
+func (m *AxelarCellarSelectorAllowlist) String() string { return proto.CompactTextString(m) }
+func (*AxelarCellarSelectorAllowlist) ProtoMessage()    {}
+func (*AxelarCellarSelectorAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{11}
 }
-
-func (m *AxelarCork) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarCellarSelectorAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCellarSelectorAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCellarSelectorAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AxelarCork) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarCellarSelectorAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCellarSelectorAllowlist.Merge(m, src)
+}
+func (m *AxelarCellarSelectorAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCellarSelectorAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCellarSelectorAllowlist.DiscardUnknown(m)
 }
 
-func (m *AxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Deadline != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Deadline))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.TargetContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_AxelarCellarSelectorAllowlist proto.InternalMessageInfo
+
+func (m *AxelarCellarSelectorAllowlist) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.EncodedContractCall) > 0 {
-		i -= len(m.EncodedContractCall)
-		copy(dAtA[i:], m.EncodedContractCall)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.EncodedContractCall)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AxelarCellarSelectorAllowlist) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ScheduledAxelarCork) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarCellarSelectorAllowlist) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScheduledAxelarCork) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AxelarManagedCellar is a cellar on an EVM chain managed by the axelarcork module along with a record of how it was
+// added
+type AxelarManagedCellar struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// block height at which the cellar was added
+	AddedHeight uint64 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+	// domain of the publisher whose default subscription was set when the cellar was added
+	PublisherDomain string `protobuf:"bytes,4,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	// ID of the governance proposal that added the cellar, zero if it was not added by a proposal
+	ProposalId uint64         `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Metadata   CellarMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *ScheduledAxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Cork != nil {
-		{
-			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+func (m *AxelarManagedCellar) Reset()         { *m = AxelarManagedCellar{} }
+func (m *AxelarManagedCellar) String() string { return proto.CompactTextString(m) }
+func (*AxelarManagedCellar) ProtoMessage()    {}
+func (*AxelarManagedCellar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{12}
+}
+func (m *AxelarManagedCellar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarManagedCellar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarManagedCellar.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AxelarManagedCellar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarManagedCellar.Merge(m, src)
+}
+func (m *AxelarManagedCellar) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarManagedCellar) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarManagedCellar.DiscardUnknown(m)
 }
 
-func (m *ScheduledAxelarCorks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AxelarManagedCellar proto.InternalMessageInfo
+
+func (m *AxelarManagedCellar) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ScheduledAxelarCorks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarManagedCellar) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func (m *ScheduledAxelarCorks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScheduledCorks) > 0 {
-		for iNdEx := len(m.ScheduledCorks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScheduledCorks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *AxelarManagedCellar) GetAddedHeight() uint64 {
+	if m != nil {
+		return m.AddedHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AxelarCorkResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarManagedCellar) GetPublisherDomain() string {
+	if m != nil {
+		return m.PublisherDomain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AxelarCorkResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AxelarCorkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ApprovalPercentage) > 0 {
-		i -= len(m.ApprovalPercentage)
-		copy(dAtA[i:], m.ApprovalPercentage)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ApprovalPercentage)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Approved {
-		i--
-		if m.Approved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Cork != nil {
-		{
-			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAxelarcork(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *AxelarManagedCellar) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AxelarCorkResults) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarManagedCellar) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return CellarMetadata{}
 }
 
-func (m *AxelarCorkResults) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CellarMetadata is the optional, governance provided description of a managed cellar
+type CellarMetadata struct {
+	// human readable name of the cellar
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cellar contract version or type
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// denomination of the asset the cellar accepts
+	AssetDenom string `protobuf:"bytes,3,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// link to the specification of the cellar's strategy
+	StrategySpecUrl string `protobuf:"bytes,4,opt,name=strategy_spec_url,json=strategySpecUrl,proto3" json:"strategy_spec_url,omitempty"`
 }
 
-func (m *AxelarCorkResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CorkResults) > 0 {
-		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CellarMetadata) Reset()         { *m = CellarMetadata{} }
+func (m *CellarMetadata) String() string { return proto.CompactTextString(m) }
+func (*CellarMetadata) ProtoMessage()    {}
+func (*CellarMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{13}
+}
+func (m *CellarMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CellarMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarMetadata.Merge(m, src)
+}
+func (m *CellarMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarMetadata.DiscardUnknown(m)
 }
 
-func (m *CellarIDSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CellarMetadata proto.InternalMessageInfo
+
+func (m *CellarMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CellarIDSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CellarMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *CellarIDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *CellarMetadata) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChainConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CellarMetadata) GetStrategySpecUrl() string {
+	if m != nil {
+		return m.StrategySpecUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ChainConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CellarMetadataEntry is the metadata proposed for a single cellar
+type CellarMetadataEntry struct {
+	CellarId string         `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata CellarMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *ChainConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BridgeFees) > 0 {
-		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *CellarMetadataEntry) Reset()         { *m = CellarMetadataEntry{} }
+func (m *CellarMetadataEntry) String() string { return proto.CompactTextString(m) }
+func (*CellarMetadataEntry) ProtoMessage()    {}
+func (*CellarMetadataEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{14}
+}
+func (m *CellarMetadataEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarMetadataEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarMetadataEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ProxyAddress) > 0 {
-		i -= len(m.ProxyAddress)
-		copy(dAtA[i:], m.ProxyAddress)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ProxyAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CellarMetadataEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarMetadataEntry.Merge(m, src)
+}
+func (m *CellarMetadataEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarMetadataEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarMetadataEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarMetadataEntry proto.InternalMessageInfo
+
+func (m *CellarMetadataEntry) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChainConfigurations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CellarMetadataEntry) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return CellarMetadata{}
 }
 
-func (m *ChainConfigurations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AxelarCellarABI is the governance registered contract ABI used to decode the contract calls of corks targeting a
+// cellar on an EVM chain
+type AxelarCellarABI struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// JSON encoded contract ABI
+	Abi string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
 }
 
-func (m *ChainConfigurations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Configurations) > 0 {
-		for iNdEx := len(m.Configurations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Configurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AxelarCellarABI) Reset()         { *m = AxelarCellarABI{} }
+func (m *AxelarCellarABI) String() string { return proto.CompactTextString(m) }
+func (*AxelarCellarABI) ProtoMessage()    {}
+func (*AxelarCellarABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{15}
+}
+func (m *AxelarCellarABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCellarABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCellarABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AxelarCellarABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCellarABI.Merge(m, src)
+}
+func (m *AxelarCellarABI) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCellarABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCellarABI.DiscardUnknown(m)
 }
 
-func (m *AxelarContractCallNonce) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AxelarCellarABI proto.InternalMessageInfo
+
+func (m *AxelarCellarABI) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AxelarContractCallNonce) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarCellarABI) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func (m *AxelarContractCallNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
+func (m *AxelarCellarABI) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+// DecodedContractCall is a cork's contract call decoded with its cellar's registered ABI
+type DecodedContractCall struct {
+	// hex encoded ID of the cork whose contract call was decoded
+	CorkId string `protobuf:"bytes,1,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	// name of the called function
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// canonical signature of the called function, such as transfer(address,uint256)
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// JSON object of the call's arguments keyed by argument name
+	Arguments string `protobuf:"bytes,4,opt,name=arguments,proto3" json:"arguments,omitempty"`
+}
+
+func (m *DecodedContractCall) Reset()         { *m = DecodedContractCall{} }
+func (m *DecodedContractCall) String() string { return proto.CompactTextString(m) }
+func (*DecodedContractCall) ProtoMessage()    {}
+func (*DecodedContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{16}
+}
+func (m *DecodedContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DecodedContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedContractCall.Merge(m, src)
+}
+func (m *DecodedContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedContractCall proto.InternalMessageInfo
+
+func (m *DecodedContractCall) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AxelarUpgradeData) Marshal() (dAtA []byte, err error) {
+func (m *DecodedContractCall) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DecodedContractCall) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *DecodedContractCall) GetArguments() string {
+	if m != nil {
+		return m.Arguments
+	}
+	return ""
+}
+
+// AxelarCorkRelay records the IBC transfer that relayed an approved cork, keyed by the cork ID
+type AxelarCorkRelay struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// hex encoded ID of the relayed cork
+	CorkId string     `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	Cork   AxelarCork `protobuf:"bytes,3,opt,name=cork,proto3" json:"cork"`
+	// block height at which the cork was approved
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// account that paid the relay token, refunded if the transfer fails
+	Relayer       string     `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Token         types.Coin `protobuf:"bytes,6,opt,name=token,proto3" json:"token"`
+	SourceChannel string     `protobuf:"bytes,7,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// IBC packet sequence of the transfer
+	Sequence uint64                `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   AxelarCorkRelayStatus `protobuf:"varint,9,opt,name=status,proto3,enum=axelarcork.v1.AxelarCorkRelayStatus" json:"status,omitempty"`
+	// error returned in the acknowledgement of a failed transfer
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// position of the cork in its cellar's queue of winning corks, used to restore it if the transfer fails
+	QueueNonce uint64 `protobuf:"varint,11,opt,name=queue_nonce,json=queueNonce,proto3" json:"queue_nonce,omitempty"`
+	// error returned when refunding the relayer's token after a failed transfer, the relay is marked failed
+	RefundError string `protobuf:"bytes,12,opt,name=refund_error,json=refundError,proto3" json:"refund_error,omitempty"`
+}
+
+func (m *AxelarCorkRelay) Reset()         { *m = AxelarCorkRelay{} }
+func (m *AxelarCorkRelay) String() string { return proto.CompactTextString(m) }
+func (*AxelarCorkRelay) ProtoMessage()    {}
+func (*AxelarCorkRelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8790c2a041a4f43, []int{17}
+}
+func (m *AxelarCorkRelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCorkRelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCorkRelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AxelarCorkRelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCorkRelay.Merge(m, src)
+}
+func (m *AxelarCorkRelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCorkRelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCorkRelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AxelarCorkRelay proto.InternalMessageInfo
+
+func (m *AxelarCorkRelay) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelay) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelay) GetCork() AxelarCork {
+	if m != nil {
+		return m.Cork
+	}
+	return AxelarCork{}
+}
+
+func (m *AxelarCorkRelay) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelay) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelay) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *AxelarCorkRelay) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelay) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelay) GetStatus() AxelarCorkRelayStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AxelarCorkRelayStatus_RELAY_STATUS_PENDING
+}
+
+func (m *AxelarCorkRelay) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelay) GetQueueNonce() uint64 {
+	if m != nil {
+		return m.QueueNonce
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelay) GetRefundError() string {
+	if m != nil {
+		return m.RefundError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("axelarcork.v1.AxelarCorkRelayStatus", AxelarCorkRelayStatus_name, AxelarCorkRelayStatus_value)
+	proto.RegisterType((*AxelarCork)(nil), "axelarcork.v1.AxelarCork")
+	proto.RegisterType((*ScheduledAxelarCork)(nil), "axelarcork.v1.ScheduledAxelarCork")
+	proto.RegisterType((*ScheduledAxelarCorks)(nil), "axelarcork.v1.ScheduledAxelarCorks")
+	proto.RegisterType((*GovernanceScheduledAxelarCork)(nil), "axelarcork.v1.GovernanceScheduledAxelarCork")
+	proto.RegisterType((*AxelarCorkResult)(nil), "axelarcork.v1.AxelarCorkResult")
+	proto.RegisterType((*AxelarCorkResults)(nil), "axelarcork.v1.AxelarCorkResults")
+	proto.RegisterType((*CellarIDSet)(nil), "axelarcork.v1.CellarIDSet")
+	proto.RegisterType((*ChainConfiguration)(nil), "axelarcork.v1.ChainConfiguration")
+	proto.RegisterType((*ChainConfigurations)(nil), "axelarcork.v1.ChainConfigurations")
+	proto.RegisterType((*AxelarContractCallNonce)(nil), "axelarcork.v1.AxelarContractCallNonce")
+	proto.RegisterType((*AxelarUpgradeData)(nil), "axelarcork.v1.AxelarUpgradeData")
+	proto.RegisterType((*AxelarCellarSelectorAllowlist)(nil), "axelarcork.v1.AxelarCellarSelectorAllowlist")
+	proto.RegisterType((*AxelarManagedCellar)(nil), "axelarcork.v1.AxelarManagedCellar")
+	proto.RegisterType((*CellarMetadata)(nil), "axelarcork.v1.CellarMetadata")
+	proto.RegisterType((*CellarMetadataEntry)(nil), "axelarcork.v1.CellarMetadataEntry")
+	proto.RegisterType((*AxelarCellarABI)(nil), "axelarcork.v1.AxelarCellarABI")
+	proto.RegisterType((*DecodedContractCall)(nil), "axelarcork.v1.DecodedContractCall")
+	proto.RegisterType((*AxelarCorkRelay)(nil), "axelarcork.v1.AxelarCorkRelay")
+}
+
+func init() { proto.RegisterFile("axelarcork/v1/axelarcork.proto", fileDescriptor_f8790c2a041a4f43) }
+
+var fileDescriptor_f8790c2a041a4f43 = []byte{
+	// 1334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xd8, 0xce, 0x87, 0x8f, 0xdd, 0xc4, 0x1d, 0xa7, 0xcd, 0x24, 0x7d, 0x71, 0x92, 0x79,
+	0xef, 0x49, 0x69, 0xa5, 0xda, 0x2f, 0xa9, 0x1e, 0x48, 0x08, 0x81, 0x1c, 0xdb, 0x2d, 0x56, 0xd3,
+	0xb4, 0x9a, 0x24, 0xaa, 0x80, 0xc5, 0x70, 0x3d, 0x73, 0x3a, 0x1e, 0x32, 0x9e, 0xeb, 0xde, 0x7b,
+	0xc7, 0xc4, 0x4b, 0x90, 0x90, 0x90, 0xd8, 0xb0, 0xe1, 0x9f, 0x60, 0xc3, 0xbf, 0x51, 0xc4, 0xa6,
+	0xec, 0x58, 0x01, 0x6a, 0xff, 0x0e, 0x24, 0x34, 0xf7, 0xce, 0xf8, 0x2b, 0x21, 0x48, 0x95, 0x60,
+	0x95, 0x39, 0xbf, 0xf3, 0x7d, 0xee, 0xf9, 0x70, 0xa0, 0x42, 0xce, 0x31, 0x20, 0xcc, 0xa1, 0xec,
+	0xac, 0x36, 0xd8, 0xab, 0x8d, 0xa9, 0x6a, 0x9f, 0x51, 0x41, 0xf5, 0x6b, 0x13, 0xc8, 0x60, 0x6f,
+	0x63, 0xd5, 0xa3, 0x1e, 0x95, 0x9c, 0x5a, 0xfc, 0xa5, 0x84, 0x36, 0x2a, 0x0e, 0xe5, 0x3d, 0xca,
+	0x6b, 0x1d, 0xc2, 0xb1, 0x36, 0xd8, 0xeb, 0xa0, 0x20, 0x7b, 0x35, 0x87, 0xfa, 0xa1, 0xe2, 0x9b,
+	0xdf, 0x6b, 0x00, 0x75, 0x69, 0xa7, 0x41, 0xd9, 0x99, 0xbe, 0x0f, 0x37, 0x30, 0x74, 0xa8, 0x8b,
+	0xae, 0xed, 0xd0, 0x50, 0x30, 0xe2, 0x08, 0xdb, 0x21, 0x41, 0x60, 0x68, 0xdb, 0xda, 0x6e, 0xd1,
+	0x2a, 0x27, 0xcc, 0x46, 0xc2, 0x6b, 0x90, 0x20, 0xd0, 0xd7, 0x61, 0xc9, 0xe9, 0x12, 0x3f, 0xb4,
+	0x7d, 0xd7, 0xc8, 0x6c, 0x6b, 0xbb, 0x39, 0x6b, 0x51, 0xd2, 0x6d, 0x57, 0x7f, 0x0b, 0xd6, 0x04,
+	0x61, 0x1e, 0x8a, 0xb1, 0x35, 0xe2, 0xba, 0x0c, 0x39, 0x37, 0xb2, 0xdb, 0xda, 0x6e, 0xde, 0xba,
+	0xa1, 0xd8, 0xa9, 0xbd, 0xba, 0x62, 0xea, 0x1b, 0xb0, 0xe4, 0x22, 0x71, 0x03, 0x3f, 0x44, 0x23,
+	0x27, 0x4d, 0x8e, 0x68, 0xf3, 0x5b, 0x0d, 0xca, 0xc7, 0x4e, 0x17, 0xdd, 0x28, 0x40, 0x77, 0x22,
+	0xf4, 0xbb, 0x90, 0x8b, 0x4b, 0x21, 0x23, 0x2d, 0xec, 0xaf, 0x57, 0xa7, 0xaa, 0x53, 0x1d, 0x0b,
+	0x5a, 0x52, 0x4c, 0xdf, 0x81, 0x62, 0x27, 0xa0, 0xce, 0x99, 0xdd, 0x45, 0xdf, 0xeb, 0x8a, 0x24,
+	0xf2, 0x82, 0xc4, 0x3e, 0x90, 0x90, 0xfe, 0x2f, 0xc8, 0x0f, 0x48, 0xe0, 0xbb, 0x44, 0x50, 0x96,
+	0xc4, 0x3b, 0x06, 0xf4, 0x65, 0xc8, 0xf8, 0xae, 0x8c, 0x2e, 0x6f, 0x65, 0x7c, 0xd7, 0x74, 0x60,
+	0xf5, 0x92, 0xb0, 0xb8, 0xfe, 0x10, 0x56, 0x78, 0x8a, 0xdb, 0xb1, 0x6b, 0x6e, 0x68, 0xdb, 0xd9,
+	0xdd, 0xc2, 0xbe, 0x39, 0x13, 0xe2, 0x25, 0xda, 0xd6, 0xf2, 0x48, 0x55, 0x1a, 0x33, 0x3f, 0xd7,
+	0x60, 0xf3, 0x01, 0x1d, 0x20, 0x0b, 0x49, 0xe8, 0xe0, 0x3f, 0x53, 0x06, 0x95, 0x68, 0x76, 0x94,
+	0xe8, 0x4f, 0x1a, 0x94, 0x26, 0xec, 0x20, 0x8f, 0x02, 0xf1, 0x37, 0xb8, 0xdd, 0x80, 0x25, 0xd2,
+	0xef, 0x33, 0x3a, 0x40, 0xe5, 0x7c, 0xc9, 0x1a, 0xd1, 0x7a, 0x0d, 0xca, 0xea, 0x9b, 0x04, 0x76,
+	0x1f, 0x99, 0x83, 0xa1, 0x20, 0x1e, 0x26, 0x8f, 0xa1, 0xa7, 0xac, 0x27, 0x23, 0x8e, 0x5e, 0x01,
+	0xf0, 0x46, 0x65, 0x33, 0xe6, 0xa5, 0xb9, 0x09, 0xc4, 0x7c, 0x0a, 0xd7, 0x67, 0x53, 0xe2, 0xfa,
+	0x01, 0x14, 0xe3, 0x60, 0x6d, 0xa6, 0xe8, 0xe4, 0xd9, 0xb6, 0xfe, 0x3c, 0x37, 0x29, 0x67, 0x15,
+	0x9c, 0xb1, 0x0d, 0xf3, 0x1d, 0x28, 0x34, 0x30, 0x08, 0x08, 0x6b, 0x37, 0x8f, 0x51, 0x4c, 0xcd,
+	0x8a, 0x36, 0x3d, 0x2b, 0x25, 0xc8, 0xfa, 0x2e, 0x37, 0x32, 0xdb, 0xd9, 0xdd, 0xbc, 0x15, 0x7f,
+	0x9a, 0x3f, 0x6a, 0xa0, 0x37, 0x62, 0x6e, 0x83, 0x86, 0xcf, 0x7c, 0x2f, 0x62, 0x44, 0xf8, 0x34,
+	0xd4, 0x75, 0xc8, 0x85, 0xa4, 0x87, 0x52, 0x3f, 0x6f, 0xc9, 0xef, 0xe4, 0x8d, 0x54, 0x15, 0x33,
+	0xbe, 0xab, 0xff, 0x1b, 0xae, 0xf5, 0x19, 0x3d, 0x1f, 0xce, 0x8c, 0x5b, 0x51, 0x82, 0xe9, 0x94,
+	0x05, 0x50, 0xe8, 0x30, 0xdf, 0xf5, 0xd0, 0x7e, 0x86, 0xc8, 0x8d, 0x9c, 0x4c, 0x6f, 0xbd, 0xaa,
+	0x36, 0x46, 0x35, 0xde, 0x18, 0xd5, 0x64, 0x63, 0x54, 0x1b, 0xd4, 0x0f, 0x0f, 0xfe, 0xf7, 0xe2,
+	0x97, 0xad, 0xb9, 0xef, 0x7e, 0xdd, 0xda, 0xf5, 0x7c, 0xd1, 0x8d, 0x3a, 0x55, 0x87, 0xf6, 0x6a,
+	0xc9, 0x7a, 0x51, 0x7f, 0xee, 0x72, 0xf7, 0xac, 0x26, 0x86, 0x7d, 0xe4, 0x52, 0x81, 0x5b, 0xa0,
+	0xec, 0xdf, 0x47, 0xe4, 0xe6, 0x27, 0x50, 0xbe, 0x98, 0x0c, 0xd7, 0xdb, 0xb0, 0xec, 0x4c, 0x21,
+	0x49, 0x99, 0x77, 0x66, 0xca, 0x7c, 0x51, 0xd7, 0x9a, 0x51, 0x34, 0x23, 0x58, 0x4b, 0x1f, 0x63,
+	0xbc, 0x9e, 0x8e, 0x68, 0xe8, 0xe0, 0x55, 0x75, 0xbf, 0x0d, 0xa5, 0x0b, 0xcb, 0x29, 0x23, 0xab,
+	0xb5, 0xe2, 0xcc, 0xac, 0xa5, 0x55, 0x98, 0x0f, 0x63, 0x73, 0xb2, 0x9a, 0x39, 0x4b, 0x11, 0xe6,
+	0x57, 0x5a, 0xda, 0x3c, 0xa7, 0x7d, 0x8f, 0x11, 0x17, 0x9b, 0x44, 0x90, 0xab, 0x3c, 0x1a, 0xb0,
+	0xd8, 0x27, 0xc3, 0x80, 0x12, 0xf5, 0x62, 0x45, 0x2b, 0x25, 0xf5, 0xf7, 0xe0, 0x16, 0x9e, 0xa3,
+	0x13, 0x09, 0xd2, 0x09, 0x30, 0x99, 0x0d, 0x5b, 0x74, 0x19, 0xf2, 0x2e, 0x0d, 0xd4, 0x18, 0x64,
+	0xad, 0xf5, 0xb1, 0x88, 0x1a, 0x95, 0x93, 0x54, 0xc0, 0x8c, 0x60, 0x33, 0xa9, 0x80, 0xec, 0xb9,
+	0x63, 0x0c, 0xd0, 0x11, 0x94, 0xd5, 0x83, 0x80, 0x7e, 0x16, 0xf8, 0xfc, 0xca, 0xfe, 0xbb, 0x05,
+	0x79, 0x47, 0x6a, 0xa5, 0x7b, 0x3c, 0x6f, 0x2d, 0x29, 0xa0, 0xed, 0xc6, 0xab, 0x90, 0x27, 0xc6,
+	0xe2, 0x5e, 0x8a, 0x5b, 0x74, 0x0c, 0x98, 0xbf, 0x6b, 0x50, 0x56, 0x7e, 0x1f, 0x91, 0x90, 0x78,
+	0xe8, 0x2a, 0xf7, 0x6f, 0xec, 0x6d, 0x07, 0x8a, 0xc4, 0x8d, 0x6f, 0x50, 0xb2, 0x1d, 0x54, 0xb9,
+	0x0b, 0x12, 0x4b, 0xb6, 0xc3, 0x6d, 0x28, 0xf5, 0xa3, 0x4e, 0xe0, 0xf3, 0x2e, 0x32, 0xdb, 0xa5,
+	0x3d, 0xe2, 0x87, 0xc9, 0xf8, 0xaf, 0x8c, 0xf0, 0xa6, 0x84, 0xf5, 0x2d, 0x28, 0xf4, 0x19, 0xed,
+	0x53, 0x4e, 0x82, 0xd8, 0xd9, 0xbc, 0x34, 0x06, 0x29, 0xd4, 0x76, 0xf5, 0xf7, 0x61, 0xa9, 0x87,
+	0x82, 0xb8, 0x44, 0x10, 0x63, 0x41, 0xee, 0xaf, 0xcd, 0xd9, 0xe6, 0x93, 0x91, 0x3d, 0x4a, 0x84,
+	0x0e, 0x72, 0xf1, 0x20, 0x58, 0x23, 0x25, 0xf3, 0x6b, 0x0d, 0x96, 0xa7, 0x45, 0x2e, 0x1d, 0x52,
+	0x03, 0x16, 0x07, 0xc8, 0xb8, 0x4f, 0xc3, 0x24, 0xe3, 0x94, 0x8c, 0x43, 0x24, 0x9c, 0xa3, 0xb0,
+	0x5d, 0x0c, 0x69, 0x2f, 0x19, 0x56, 0x90, 0x50, 0x33, 0x46, 0xf4, 0x3b, 0x70, 0x9d, 0x0b, 0x46,
+	0x04, 0x7a, 0x43, 0x9b, 0xf7, 0xd1, 0xb1, 0x23, 0x16, 0xa4, 0xf9, 0xa6, 0x8c, 0xe3, 0x3e, 0x3a,
+	0xa7, 0x2c, 0x30, 0x39, 0x94, 0xa7, 0x83, 0x69, 0x85, 0x82, 0x0d, 0xa7, 0x2b, 0xae, 0xcd, 0x54,
+	0x7c, 0xb2, 0x04, 0x99, 0x37, 0x29, 0xc1, 0xc7, 0xb0, 0x32, 0xd9, 0x79, 0xf5, 0x83, 0xf6, 0x1b,
+	0xbf, 0x7e, 0x09, 0xb2, 0xa4, 0xe3, 0x27, 0x45, 0x88, 0x3f, 0xcd, 0x2f, 0x34, 0x28, 0x37, 0xf1,
+	0xe2, 0x2f, 0x8f, 0x35, 0x58, 0x94, 0x0b, 0x7a, 0x94, 0xd0, 0x42, 0x4c, 0xb6, 0x5d, 0xfd, 0x26,
+	0x2c, 0xf4, 0x50, 0x74, 0x69, 0x6a, 0x3c, 0xa1, 0x64, 0x1b, 0xfb, 0x5e, 0x48, 0x44, 0xc4, 0x30,
+	0xbd, 0xe8, 0x23, 0x20, 0xe6, 0x12, 0xe6, 0x45, 0x3d, 0x0c, 0x05, 0x4f, 0x8a, 0x3b, 0x06, 0xcc,
+	0x1f, 0xb2, 0xa3, 0x14, 0xe5, 0x7e, 0x0f, 0xc8, 0xf0, 0xaa, 0x14, 0x27, 0x62, 0xcb, 0x4c, 0xc5,
+	0x76, 0x2f, 0xb9, 0x94, 0xd9, 0xbf, 0xb8, 0x94, 0x49, 0x89, 0x2f, 0xbf, 0x97, 0xb9, 0x8b, 0xf7,
+	0xd2, 0x80, 0x45, 0x16, 0x07, 0x85, 0x4c, 0xb6, 0x78, 0xde, 0x4a, 0x49, 0xfd, 0xff, 0x30, 0x2f,
+	0xe8, 0x19, 0x86, 0x49, 0x73, 0x5f, 0xb1, 0xe1, 0x95, 0x4b, 0x25, 0xad, 0xff, 0x17, 0x96, 0x39,
+	0x8d, 0x98, 0x83, 0xb6, 0xd3, 0x25, 0x61, 0x88, 0x81, 0xb1, 0x28, 0xed, 0x5e, 0x53, 0x68, 0x43,
+	0x81, 0xf1, 0x9d, 0xe6, 0xf8, 0x3c, 0xc2, 0x78, 0x2f, 0x2e, 0xa9, 0xdf, 0x6a, 0x29, 0xad, 0xbf,
+	0x0b, 0x0b, 0x5c, 0x10, 0x11, 0x71, 0x23, 0xbf, 0xad, 0xed, 0x2e, 0xef, 0xff, 0xe7, 0x8a, 0xdb,
+	0x19, 0x90, 0xe1, 0xb1, 0x94, 0xb5, 0x12, 0x9d, 0x78, 0xdd, 0x22, 0x63, 0x94, 0x19, 0x20, 0xfd,
+	0x2a, 0x22, 0x9e, 0x95, 0xe7, 0x11, 0x46, 0x68, 0xab, 0x55, 0x5c, 0x50, 0xe3, 0x2c, 0x21, 0xb5,
+	0xeb, 0x77, 0xa0, 0xc8, 0xf0, 0x59, 0x14, 0xba, 0xb6, 0xd2, 0x2e, 0x4a, 0xed, 0x82, 0xc2, 0x5a,
+	0x31, 0x74, 0xe7, 0x4b, 0x0d, 0x6e, 0x5c, 0xea, 0x5b, 0x37, 0x60, 0xd5, 0x6a, 0x1d, 0xd6, 0x3f,
+	0xb4, 0x8f, 0x4f, 0xea, 0x27, 0xa7, 0xc7, 0xf6, 0x93, 0xd6, 0x51, 0xb3, 0x7d, 0xf4, 0xa0, 0x34,
+	0xa7, 0x6f, 0xc2, 0xfa, 0x14, 0xa7, 0xde, 0x78, 0x78, 0xf4, 0xf8, 0xe9, 0x61, 0xab, 0xf9, 0xa0,
+	0xd5, 0x2c, 0x69, 0xfa, 0x1a, 0x94, 0xa7, 0xd8, 0xf7, 0xeb, 0xed, 0xc3, 0x56, 0xb3, 0x94, 0xd1,
+	0x37, 0xe0, 0xe6, 0x14, 0xe3, 0xa4, 0xfd, 0xa8, 0xd5, 0xb4, 0x1f, 0x9f, 0x9e, 0x94, 0xb2, 0x07,
+	0x87, 0x2f, 0x5e, 0x55, 0xb4, 0x97, 0xaf, 0x2a, 0xda, 0x6f, 0xaf, 0x2a, 0xda, 0x37, 0xaf, 0x2b,
+	0x73, 0x2f, 0x5f, 0x57, 0xe6, 0x7e, 0x7e, 0x5d, 0x99, 0xfb, 0x68, 0x7f, 0xe2, 0xc6, 0xf6, 0xd1,
+	0xf3, 0x86, 0x9f, 0x0e, 0x6a, 0x9c, 0xf6, 0x7a, 0x18, 0xf8, 0xc8, 0x6a, 0x83, 0xb7, 0x6b, 0xe7,
+	0x13, 0xff, 0x10, 0xa8, 0x9b, 0xdb, 0x59, 0x90, 0x3f, 0xe9, 0xef, 0xfd, 0x31, 0x00, 0x45, 0xc3,
+	0x15, 0xfe, 0x39, 0x0c, 0x00, 0x00,
+}
+
+func (m *AxelarCork) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1090,251 +1394,2382 @@ func (m *AxelarUpgradeData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AxelarUpgradeData) MarshalTo(dAtA []byte) (int, error) {
+func (m *AxelarCork) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AxelarUpgradeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExecutableHeightThreshold != 0 {
-		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ExecutableHeightThreshold))
+	if m.Deadline != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Deadline))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Payload)))
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.TargetContractAddress)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAxelarcork(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAxelarcork(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledAxelarCork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AxelarCork) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScheduledAxelarCork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledAxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EncodedContractCall)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Deadline != 0 {
-		n += 1 + sovAxelarcork(uint64(m.Deadline))
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduledAxelarCork) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledAxelarCorks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledAxelarCorks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledAxelarCorks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cork != nil {
-		l = m.Cork.Size()
-		n += 1 + l + sovAxelarcork(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if len(m.ScheduledCorks) > 0 {
+		for iNdEx := len(m.ScheduledCorks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCorks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduledAxelarCorks) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GovernanceScheduledAxelarCork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceScheduledAxelarCork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceScheduledAxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScheduledCorks) > 0 {
-		for _, e := range m.ScheduledCorks {
-			l = e.Size()
-			n += 1 + l + sovAxelarcork(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAxelarcork(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarCorkResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AxelarCorkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cork != nil {
-		l = m.Cork.Size()
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if m.Governance {
+		i--
+		if m.Governance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
+	if len(m.ApprovalPercentage) > 0 {
+		i -= len(m.ApprovalPercentage)
+		copy(dAtA[i:], m.ApprovalPercentage)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ApprovalPercentage)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Approved {
-		n += 2
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ApprovalPercentage)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarCorkResults) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AxelarCorkResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.CorkResults) > 0 {
-		for _, e := range m.CorkResults {
-			l = e.Size()
-			n += 1 + l + sovAxelarcork(uint64(l))
+		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CellarIDSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CellarIDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarIDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarIDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovAxelarcork(uint64(m.ChainId))
-	}
 	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovAxelarcork(uint64(l))
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.ChainId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Id != 0 {
-		n += 1 + sovAxelarcork(uint64(m.Id))
+	if len(m.ProxyAddress) > 0 {
+		i -= len(m.ProxyAddress)
+		copy(dAtA[i:], m.ProxyAddress)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ProxyAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProxyAddress)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.BridgeFees) > 0 {
-		for _, e := range m.BridgeFees {
-			l = e.Size()
-			n += 1 + l + sovAxelarcork(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfigurations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainConfigurations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfigurations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfigurations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Configurations) > 0 {
-		for _, e := range m.Configurations {
-			l = e.Size()
-			n += 1 + l + sovAxelarcork(uint64(l))
+		for iNdEx := len(m.Configurations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarContractCallNonce) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AxelarContractCallNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarContractCallNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarContractCallNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ChainId != 0 {
-		n += 1 + sovAxelarcork(uint64(m.ChainId))
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarUpgradeData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovAxelarcork(uint64(m.Nonce))
+	return dAtA[:n], nil
+}
+
+func (m *AxelarUpgradeData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarUpgradeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutableHeightThreshold != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ExecutableHeightThreshold))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarUpgradeData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AxelarCellarSelectorAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCellarSelectorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCellarSelectorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ChainId != 0 {
-		n += 1 + sovAxelarcork(uint64(m.ChainId))
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovAxelarcork(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarManagedCellar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarManagedCellar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarManagedCellar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ProposalId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.PublisherDomain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AddedHeight != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StrategySpecUrl) > 0 {
+		i -= len(m.StrategySpecUrl)
+		copy(dAtA[i:], m.StrategySpecUrl)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.StrategySpecUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarMetadataEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarMetadataEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarMetadataEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCellarABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCellarABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCellarABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		i -= len(m.Arguments)
+		copy(dAtA[i:], m.Arguments)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Arguments)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCorkRelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkRelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkRelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundError) > 0 {
+		i -= len(m.RefundError)
+		copy(dAtA[i:], m.RefundError)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.RefundError)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.QueueNonce != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.QueueNonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAxelarcork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintAxelarcork(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAxelarcork(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAxelarcork(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAxelarcork(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AxelarCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovAxelarcork(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *ScheduledAxelarCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledAxelarCorks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledCorks) > 0 {
+		for _, e := range m.ScheduledCorks {
+			l = e.Size()
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GovernanceScheduledAxelarCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func (m *AxelarCorkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
+	}
+	if m.Approved {
+		n += 2
+	}
+	l = len(m.ApprovalPercentage)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.Governance {
+		n += 2
+	}
+	return n
+}
+
+func (m *AxelarCorkResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CorkResults) > 0 {
+		for _, e := range m.CorkResults {
+			l = e.Size()
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CellarIDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovAxelarcork(uint64(m.Id))
+	}
+	l = len(m.ProxyAddress)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainConfigurations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configurations) > 0 {
+		for _, e := range m.Configurations {
+			l = e.Size()
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AxelarContractCallNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAxelarcork(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *AxelarUpgradeData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.ExecutableHeightThreshold != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ExecutableHeightThreshold))
+	}
+	return n
+}
+
+func (m *AxelarCellarSelectorAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovAxelarcork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AxelarManagedCellar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovAxelarcork(uint64(m.AddedHeight))
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ProposalId))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovAxelarcork(uint64(l))
+	return n
+}
+
+func (m *CellarMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.StrategySpecUrl)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func (m *CellarMetadataEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovAxelarcork(uint64(l))
+	return n
+}
+
+func (m *AxelarCellarABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodedContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = len(m.Arguments)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func (m *AxelarCorkRelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAxelarcork(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = m.Cork.Size()
+	n += 1 + l + sovAxelarcork(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovAxelarcork(uint64(m.BlockHeight))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovAxelarcork(uint64(l))
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAxelarcork(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAxelarcork(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	if m.QueueNonce != 0 {
+		n += 1 + sovAxelarcork(uint64(m.QueueNonce))
+	}
+	l = len(m.RefundError)
+	if l > 0 {
+		n += 1 + l + sovAxelarcork(uint64(l))
+	}
+	return n
+}
+
+func sovAxelarcork(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAxelarcork(x uint64) (n int) {
+	return sovAxelarcork(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AxelarCork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedContractCall == nil {
+				m.EncodedContractCall = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledAxelarCork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledAxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &AxelarCork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledAxelarCorks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledAxelarCorks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledAxelarCorks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCorks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCorks = append(m.ScheduledCorks, &ScheduledAxelarCork{})
+			if err := m.ScheduledCorks[len(m.ScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceScheduledAxelarCork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceScheduledAxelarCork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceScheduledAxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &AxelarCork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCorkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCorkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &AxelarCork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Governance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCorkResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCorkResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCorkResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkResults = append(m.CorkResults, &AxelarCorkResult{})
+			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarIDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarIDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.ExecutableHeightThreshold != 0 {
-		n += 1 + sovAxelarcork(uint64(m.ExecutableHeightThreshold))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFees = append(m.BridgeFees, types.Coin{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAxelarcork(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAxelarcork(x uint64) (n int) {
-	return sovAxelarcork(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AxelarCork) Unmarshal(dAtA []byte) error {
+func (m *ChainConfigurations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,17 +3792,17 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarCork: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainConfigurations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainConfigurations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configurations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1377,27 +3812,77 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncodedContractCall == nil {
-				m.EncodedContractCall = []byte{}
+			m.Configurations = append(m.Configurations, &ChainConfiguration{})
+			if err := m.Configurations[len(m.Configurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarContractCallNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarContractCallNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -1416,9 +3901,9 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1446,13 +3931,13 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Deadline = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1462,7 +3947,7 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1488,7 +3973,7 @@ func (m *AxelarCork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
+func (m *AxelarUpgradeData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,17 +3996,36 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledAxelarCork: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarUpgradeData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledAxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarUpgradeData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1531,33 +4035,100 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cork == nil {
-				m.Cork = &AxelarCork{}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
-			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableHeightThreshold", wireType)
+			}
+			m.ExecutableHeightThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableHeightThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAxelarcork(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCellarSelectorAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAxelarcork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCellarSelectorAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCellarSelectorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.BlockHeight = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1567,14 +4138,14 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1602,11 +4173,11 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1634,7 +4205,7 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1657,7 +4228,7 @@ func (m *ScheduledAxelarCork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduledAxelarCorks) Unmarshal(dAtA []byte) error {
+func (m *AxelarManagedCellar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1676,19 +4247,140 @@ func (m *ScheduledAxelarCorks) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledAxelarCorks: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledAxelarCorks: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarManagedCellar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarManagedCellar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCorks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,8 +4407,7 @@ func (m *ScheduledAxelarCorks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledCorks = append(m.ScheduledCorks, &ScheduledAxelarCork{})
-			if err := m.ScheduledCorks[len(m.ScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1741,7 +4432,7 @@ func (m *ScheduledAxelarCorks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
+func (m *CellarMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1764,17 +4455,17 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarCorkResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellarMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarCorkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellarMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1784,33 +4475,29 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cork == nil {
-				m.Cork = &AxelarCork{}
-			}
-			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1820,16 +4507,29 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -1839,15 +4539,27 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Approved = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategySpecUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1875,7 +4587,7 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			m.StrategySpecUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1898,7 +4610,7 @@ func (m *AxelarCorkResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarCorkResults) Unmarshal(dAtA []byte) error {
+func (m *CellarMetadataEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1921,15 +4633,47 @@ func (m *AxelarCorkResults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarCorkResults: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellarMetadataEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarCorkResults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellarMetadataEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1956,8 +4700,7 @@ func (m *AxelarCorkResults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorkResults = append(m.CorkResults, &AxelarCorkResult{})
-			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1982,7 +4725,7 @@ func (m *AxelarCorkResults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
+func (m *AxelarCellarABI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2005,10 +4748,10 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CellarIDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarCellarABI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CellarIDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarCellarABI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2032,7 +4775,7 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2060,7 +4803,39 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2083,7 +4858,7 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
+func (m *DecodedContractCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,15 +4881,15 @@ func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedContractCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2142,30 +4917,11 @@ func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CorkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAxelarcork
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2193,13 +4949,13 @@ func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyAddress = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2209,81 +4965,29 @@ func (m *ChainConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAxelarcork
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAxelarcork
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BridgeFees = append(m.BridgeFees, types.Coin{})
-			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAxelarcork(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAxelarcork
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainConfigurations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAxelarcork
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfigurations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfigurations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configurations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2293,25 +4997,23 @@ func (m *ChainConfigurations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configurations = append(m.Configurations, &ChainConfiguration{})
-			if err := m.Configurations[len(m.Configurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Arguments = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2334,7 +5036,7 @@ func (m *ChainConfigurations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
+func (m *AxelarCorkRelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2357,10 +5059,10 @@ func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarContractCallNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarCorkRelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarContractCallNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarCorkRelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2384,7 +5086,7 @@ func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,13 +5114,13 @@ func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.CorkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2428,66 +5130,146 @@ func (m *AxelarContractCallNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAxelarcork(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AxelarUpgradeData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAxelarcork
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarUpgradeData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarUpgradeData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.ChainId = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2497,16 +5279,35 @@ func (m *AxelarUpgradeData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AxelarCorkRelayStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2516,31 +5317,29 @@ func (m *AxelarUpgradeData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAxelarcork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableHeightThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueNonce", wireType)
 			}
-			m.ExecutableHeightThreshold = 0
+			m.QueueNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAxelarcork
@@ -2550,11 +5349,43 @@ func (m *AxelarUpgradeData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutableHeightThreshold |= int64(b&0x7F) << shift
+				m.QueueNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAxelarcork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAxelarcork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAxelarcork(dAtA[iNdEx:])
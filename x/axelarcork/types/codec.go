@@ -16,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayAxelarCorkRequest{}, "axelarcork/MsgRelayAxelarCorkRequest", nil)
 	cdc.RegisterConcrete(&MsgBumpAxelarCorkGasRequest{}, "axelarcork/MsgBumpAxelarCorkGasRequest", nil)
 	cdc.RegisterConcrete(&MsgCancelAxelarCorkRequest{}, "axelarcork/MsgCancelAxelarCorkRequest", nil)
+	cdc.RegisterConcrete(&MsgSetAxelarCellarPausedRequest{}, "axelarcork/MsgSetAxelarCellarPausedRequest", nil)
 	cdc.RegisterConcrete(&MsgRelayAxelarProxyUpgradeRequest{}, "axelarcork/MsgRelayAxelarProxyUpgradeRequest", nil)
 }
 
@@ -43,6 +44,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRelayAxelarCorkRequest{},
 		&MsgBumpAxelarCorkGasRequest{},
 		&MsgCancelAxelarCorkRequest{},
+		&MsgSetAxelarCellarPausedRequest{},
 		&MsgRelayAxelarProxyUpgradeRequest{},
 	)
 
@@ -55,6 +57,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&RemoveAxelarManagedCellarIDsProposal{},
 		&UpgradeAxelarProxyContractProposal{},
 		&CancelAxelarProxyContractUpgradeProposal{},
+		&SetAxelarCellarSelectorAllowlistProposal{},
+		&SetAxelarCellarPausedProposal{},
+		&SetAxelarCellarABIProposal{},
+		&SetAxelarCellarMetadataProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
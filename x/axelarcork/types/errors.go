@@ -6,11 +6,20 @@ import (
 
 // x/cork module sentinel errors
 var (
-	ErrInvalidEVMAddress      = errorsmod.Register(ModuleName, 2, "invalid evm address")
-	ErrUnmanagedCellarAddress = errorsmod.Register(ModuleName, 3, "cork sent to address that has not passed governance")
-	ErrEmptyContractCall      = errorsmod.Register(ModuleName, 4, "cork has an empty contract call body")
-	ErrSchedulingInThePast    = errorsmod.Register(ModuleName, 5, "cork is trying to be scheduled for a block that has already passed")
-	ErrInvalidJSON            = errorsmod.Register(ModuleName, 6, "invalid json")
-	ErrValuelessSend          = errorsmod.Register(ModuleName, 7, "transferring an empty token amount")
-	ErrDisabled               = errorsmod.Register(ModuleName, 8, "axelar disabled")
+	ErrInvalidEVMAddress           = errorsmod.Register(ModuleName, 2, "invalid evm address")
+	ErrUnmanagedCellarAddress      = errorsmod.Register(ModuleName, 3, "cork sent to address that has not passed governance")
+	ErrEmptyContractCall           = errorsmod.Register(ModuleName, 4, "cork has an empty contract call body")
+	ErrSchedulingInThePast         = errorsmod.Register(ModuleName, 5, "cork is trying to be scheduled for a block that has already passed")
+	ErrInvalidJSON                 = errorsmod.Register(ModuleName, 6, "invalid json")
+	ErrValuelessSend               = errorsmod.Register(ModuleName, 7, "transferring an empty token amount")
+	ErrDisabled                    = errorsmod.Register(ModuleName, 8, "axelar disabled")
+	ErrScheduledCorkNotFound       = errorsmod.Register(ModuleName, 9, "scheduled cork not found")
+	ErrInvalidSelector             = errorsmod.Register(ModuleName, 10, "invalid function selector")
+	ErrSelectorNotAllowed          = errorsmod.Register(ModuleName, 11, "function selector is not in the cellar's allowlist")
+	ErrCellarPaused                = errorsmod.Register(ModuleName, 12, "cellar is paused")
+	ErrContractCallEncoderNotFound = errorsmod.Register(ModuleName, 13, "no contract call encoder registered for cellar type")
+	ErrInvalidABI                  = errorsmod.Register(ModuleName, 14, "invalid contract ABI")
+	ErrInvalidCellarMetadata       = errorsmod.Register(ModuleName, 15, "invalid cellar metadata")
+	ErrCorkDeadlinePassed          = errorsmod.Register(ModuleName, 16, "cork deadline has passed")
+	ErrCorkDeadlineTooFar          = errorsmod.Register(ModuleName, 17, "cork deadline is beyond the maximum horizon")
 )
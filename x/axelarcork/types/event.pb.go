@@ -99,132 +99,2107 @@ func (m *ScheduleCorkEvent) GetChainId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*ScheduleCorkEvent)(nil), "axelarcork.v1.ScheduleCorkEvent")
+type CancelScheduledCorkEvent struct {
+	Signer                string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Validator             string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	ChainId               uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TargetContractAddress string `protobuf:"bytes,4,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// number of scheduled corks removed
+	Cancelled uint64 `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
-func init() { proto.RegisterFile("axelarcork/v1/event.proto", fileDescriptor_568389573c9d22fd) }
+func (m *CancelScheduledCorkEvent) Reset()         { *m = CancelScheduledCorkEvent{} }
+func (m *CancelScheduledCorkEvent) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledCorkEvent) ProtoMessage()    {}
+func (*CancelScheduledCorkEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{1}
+}
+func (m *CancelScheduledCorkEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelScheduledCorkEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelScheduledCorkEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelScheduledCorkEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledCorkEvent.Merge(m, src)
+}
+func (m *CancelScheduledCorkEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelScheduledCorkEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledCorkEvent.DiscardUnknown(m)
+}
 
-var fileDescriptor_568389573c9d22fd = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x6b, 0x08, 0x85, 0x1a, 0x18, 0xb0, 0x10, 0x4a, 0x11, 0xb2, 0x0a, 0x53, 0xa7, 0x58,
-	0x85, 0x81, 0x1d, 0x84, 0x04, 0x12, 0x53, 0xd9, 0x58, 0xaa, 0xfc, 0x39, 0x39, 0x26, 0x4e, 0x2e,
-	0x72, 0x5c, 0xab, 0x7d, 0x0b, 0x76, 0x5e, 0x88, 0xb1, 0x23, 0x23, 0x4a, 0x5e, 0x04, 0xc5, 0x20,
-	0x95, 0xed, 0xfb, 0x7e, 0x3f, 0xcb, 0xa7, 0x3b, 0x3a, 0x8e, 0x57, 0xa0, 0x63, 0x93, 0xa2, 0x29,
-	0x84, 0x9b, 0x09, 0x70, 0x50, 0xd9, 0xa8, 0x36, 0x68, 0x91, 0x1d, 0x6f, 0x55, 0xe4, 0x66, 0xe7,
-	0xa7, 0x12, 0x25, 0x7a, 0x23, 0xfa, 0xf4, 0xfb, 0xe8, 0xea, 0x83, 0xd0, 0x93, 0x97, 0x34, 0x87,
-	0x6c, 0xa9, 0xe1, 0x1e, 0x4d, 0xf1, 0xd0, 0x7f, 0xc0, 0xce, 0xe8, 0xb0, 0x51, 0xb2, 0x02, 0x13,
-	0x92, 0x09, 0x99, 0x8e, 0xe6, 0x7f, 0x8d, 0x5d, 0xd0, 0x91, 0x8b, 0xb5, 0xca, 0x62, 0x8b, 0x26,
-	0xdc, 0xf1, 0x6a, 0x0b, 0x18, 0xa3, 0x41, 0x3f, 0x2c, 0xdc, 0xf5, 0xc2, 0x67, 0x76, 0x49, 0x8f,
-	0x12, 0x8d, 0x69, 0xb1, 0xc8, 0x41, 0xc9, 0xdc, 0x86, 0xc1, 0x84, 0x4c, 0x83, 0xf9, 0xa1, 0x67,
-	0x8f, 0x1e, 0xb1, 0x31, 0x3d, 0x48, 0xf3, 0x58, 0x55, 0x0b, 0x95, 0x85, 0x7b, 0x5e, 0xef, 0xfb,
-	0xfe, 0x94, 0xdd, 0x3d, 0x7f, 0xb6, 0x9c, 0x6c, 0x5a, 0x4e, 0xbe, 0x5b, 0x4e, 0xde, 0x3b, 0x3e,
-	0xd8, 0x74, 0x7c, 0xf0, 0xd5, 0xf1, 0xc1, 0xeb, 0xb5, 0x54, 0x36, 0x5f, 0x26, 0x51, 0x8a, 0xa5,
-	0xa8, 0x41, 0xca, 0xf5, 0x9b, 0x13, 0x0d, 0x96, 0x25, 0x68, 0x05, 0x46, 0xb8, 0x5b, 0xb1, 0x12,
-	0xff, 0x2e, 0x63, 0xd7, 0x35, 0x34, 0xc9, 0xd0, 0xaf, 0x7c, 0xf3, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x1a, 0x10, 0x35, 0x2f, 0x34, 0x01, 0x00, 0x00,
+var xxx_messageInfo_CancelScheduledCorkEvent proto.InternalMessageInfo
+
+func (m *CancelScheduledCorkEvent) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *ScheduleCorkEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CancelScheduledCorkEvent) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ScheduleCorkEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CancelScheduledCorkEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *ScheduleCorkEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x28
+func (m *CancelScheduledCorkEvent) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *CancelScheduledCorkEvent) GetCancelled() uint64 {
+	if m != nil {
+		return m.Cancelled
 	}
-	if len(m.Cork) > 0 {
-		i -= len(m.Cork)
-		copy(dAtA[i:], m.Cork)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Cork)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+type AxelarCorkResultArchivedEvent struct {
+	ChainId uint64            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CorkId  string            `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	Result  *AxelarCorkResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *AxelarCorkResultArchivedEvent) Reset()         { *m = AxelarCorkResultArchivedEvent{} }
+func (m *AxelarCorkResultArchivedEvent) String() string { return proto.CompactTextString(m) }
+func (*AxelarCorkResultArchivedEvent) ProtoMessage()    {}
+func (*AxelarCorkResultArchivedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{2}
+}
+func (m *AxelarCorkResultArchivedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCorkResultArchivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCorkResultArchivedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AxelarCorkResultArchivedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCorkResultArchivedEvent.Merge(m, src)
+}
+func (m *AxelarCorkResultArchivedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCorkResultArchivedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCorkResultArchivedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AxelarCorkResultArchivedEvent proto.InternalMessageInfo
+
+func (m *AxelarCorkResultArchivedEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AxelarCorkResultArchivedEvent) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *AxelarCorkResultArchivedEvent) GetResult() *AxelarCorkResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CellarPauseEvent struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused   bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// the guardian address, or the gov module account when set by proposal
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *CellarPauseEvent) Reset()         { *m = CellarPauseEvent{} }
+func (m *CellarPauseEvent) String() string { return proto.CompactTextString(m) }
+func (*CellarPauseEvent) ProtoMessage()    {}
+func (*CellarPauseEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{3}
+}
+func (m *CellarPauseEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarPauseEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarPauseEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellarPauseEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarPauseEvent.Merge(m, src)
+}
+func (m *CellarPauseEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarPauseEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarPauseEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarPauseEvent proto.InternalMessageInfo
+
+func (m *CellarPauseEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *CellarPauseEvent) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *CellarPauseEvent) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *CellarPauseEvent) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// emitted for an approved cork that is not made relayable because its cellar is paused
+type PausedCellarCorkSkippedEvent struct {
+	ChainId               uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CorkId                string `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	TargetContractAddress string `protobuf:"bytes,3,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+}
+
+func (m *PausedCellarCorkSkippedEvent) Reset()         { *m = PausedCellarCorkSkippedEvent{} }
+func (m *PausedCellarCorkSkippedEvent) String() string { return proto.CompactTextString(m) }
+func (*PausedCellarCorkSkippedEvent) ProtoMessage()    {}
+func (*PausedCellarCorkSkippedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{4}
+}
+func (m *PausedCellarCorkSkippedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedCellarCorkSkippedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedCellarCorkSkippedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedCellarCorkSkippedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedCellarCorkSkippedEvent.Merge(m, src)
+}
+func (m *PausedCellarCorkSkippedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedCellarCorkSkippedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedCellarCorkSkippedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedCellarCorkSkippedEvent proto.InternalMessageInfo
+
+func (m *PausedCellarCorkSkippedEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *PausedCellarCorkSkippedEvent) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *PausedCellarCorkSkippedEvent) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+// emitted when a passed scheduled cork proposal schedules a cork that is made relayable without a validator vote
+type GovernanceCorkScheduledEvent struct {
+	ChainId               uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CorkId                string `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	BlockHeight           uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TargetContractAddress string `protobuf:"bytes,4,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+}
+
+func (m *GovernanceCorkScheduledEvent) Reset()         { *m = GovernanceCorkScheduledEvent{} }
+func (m *GovernanceCorkScheduledEvent) String() string { return proto.CompactTextString(m) }
+func (*GovernanceCorkScheduledEvent) ProtoMessage()    {}
+func (*GovernanceCorkScheduledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{5}
+}
+func (m *GovernanceCorkScheduledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceCorkScheduledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceCorkScheduledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceCorkScheduledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceCorkScheduledEvent.Merge(m, src)
+}
+func (m *GovernanceCorkScheduledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceCorkScheduledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceCorkScheduledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceCorkScheduledEvent proto.InternalMessageInfo
+
+func (m *GovernanceCorkScheduledEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GovernanceCorkScheduledEvent) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *GovernanceCorkScheduledEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GovernanceCorkScheduledEvent) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+// emitted when the IBC transfer relaying a cork is acknowledged or times out
+type AxelarCorkRelayResultEvent struct {
+	ChainId  uint64                `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CorkId   string                `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	Sequence uint64                `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   AxelarCorkRelayStatus `protobuf:"varint,4,opt,name=status,proto3,enum=axelarcork.v1.AxelarCorkRelayStatus" json:"status,omitempty"`
+}
+
+func (m *AxelarCorkRelayResultEvent) Reset()         { *m = AxelarCorkRelayResultEvent{} }
+func (m *AxelarCorkRelayResultEvent) String() string { return proto.CompactTextString(m) }
+func (*AxelarCorkRelayResultEvent) ProtoMessage()    {}
+func (*AxelarCorkRelayResultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{6}
+}
+func (m *AxelarCorkRelayResultEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCorkRelayResultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCorkRelayResultEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AxelarCorkRelayResultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCorkRelayResultEvent.Merge(m, src)
+}
+func (m *AxelarCorkRelayResultEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCorkRelayResultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCorkRelayResultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AxelarCorkRelayResultEvent proto.InternalMessageInfo
+
+func (m *AxelarCorkRelayResultEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelayResultEvent) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelayResultEvent) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelayResultEvent) GetStatus() AxelarCorkRelayStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AxelarCorkRelayStatus_RELAY_STATUS_PENDING
+}
+
+// emitted when the relayer's token can't be refunded after the IBC transfer relaying a cork fails
+type AxelarCorkRelayRefundFailedEvent struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CorkId   string `protobuf:"bytes,2,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Relayer  string `protobuf:"bytes,4,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Token    string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Error    string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) Reset()         { *m = AxelarCorkRelayRefundFailedEvent{} }
+func (m *AxelarCorkRelayRefundFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*AxelarCorkRelayRefundFailedEvent) ProtoMessage()    {}
+func (*AxelarCorkRelayRefundFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568389573c9d22fd, []int{7}
+}
+func (m *AxelarCorkRelayRefundFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCorkRelayRefundFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCorkRelayRefundFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AxelarCorkRelayRefundFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCorkRelayRefundFailedEvent.Merge(m, src)
+}
+func (m *AxelarCorkRelayRefundFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCorkRelayRefundFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCorkRelayRefundFailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AxelarCorkRelayRefundFailedEvent proto.InternalMessageInfo
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ScheduleCorkEvent)(nil), "axelarcork.v1.ScheduleCorkEvent")
+	proto.RegisterType((*CancelScheduledCorkEvent)(nil), "axelarcork.v1.CancelScheduledCorkEvent")
+	proto.RegisterType((*AxelarCorkResultArchivedEvent)(nil), "axelarcork.v1.AxelarCorkResultArchivedEvent")
+	proto.RegisterType((*CellarPauseEvent)(nil), "axelarcork.v1.CellarPauseEvent")
+	proto.RegisterType((*PausedCellarCorkSkippedEvent)(nil), "axelarcork.v1.PausedCellarCorkSkippedEvent")
+	proto.RegisterType((*GovernanceCorkScheduledEvent)(nil), "axelarcork.v1.GovernanceCorkScheduledEvent")
+	proto.RegisterType((*AxelarCorkRelayResultEvent)(nil), "axelarcork.v1.AxelarCorkRelayResultEvent")
+	proto.RegisterType((*AxelarCorkRelayRefundFailedEvent)(nil), "axelarcork.v1.AxelarCorkRelayRefundFailedEvent")
+}
+
+func init() { proto.RegisterFile("axelarcork/v1/event.proto", fileDescriptor_568389573c9d22fd) }
+
+var fileDescriptor_568389573c9d22fd = []byte{
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7b, 0xa4, 0x75, 0x93, 0x2b, 0x20, 0xb0, 0x0a, 0x75, 0x43, 0x31, 0xc5, 0x62, 0xe8,
+	0x14, 0xab, 0x45, 0xa2, 0x0b, 0x4b, 0x89, 0xf8, 0x51, 0x89, 0x01, 0xb9, 0x1b, 0x4b, 0x74, 0xbd,
+	0x7b, 0xd8, 0x26, 0x57, 0x9f, 0x39, 0x9f, 0xad, 0x66, 0x63, 0x46, 0x0c, 0xec, 0xfc, 0x07, 0x48,
+	0xec, 0xac, 0x6c, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x8f, 0xa0, 0xbb, 0x73, 0x48, 0xd2, 0x4a, 0x41,
+	0x4a, 0x36, 0xbf, 0xf7, 0xbd, 0xbb, 0xf7, 0xb9, 0xef, 0x7b, 0x3e, 0xbc, 0x4d, 0xce, 0x81, 0x13,
+	0x49, 0x85, 0xec, 0x87, 0xd5, 0x7e, 0x08, 0x15, 0x64, 0xaa, 0x93, 0x4b, 0xa1, 0x84, 0x7b, 0x63,
+	0x22, 0x75, 0xaa, 0xfd, 0xf6, 0x66, 0x2c, 0x62, 0x61, 0x94, 0x50, 0x7f, 0xd9, 0x45, 0x6d, 0x7f,
+	0x76, 0xff, 0xd4, 0x16, 0xa3, 0x07, 0x5f, 0x11, 0xbe, 0x7d, 0x42, 0x13, 0x60, 0x25, 0x87, 0xae,
+	0x90, 0xfd, 0xe7, 0xba, 0x80, 0x7b, 0x17, 0x3b, 0x45, 0x1a, 0x67, 0x20, 0x3d, 0xb4, 0x8b, 0xf6,
+	0x5a, 0x51, 0x1d, 0xb9, 0x3b, 0xb8, 0x55, 0x11, 0x9e, 0x32, 0xa2, 0x84, 0xf4, 0xae, 0x19, 0x69,
+	0x92, 0x70, 0x5d, 0xbc, 0xaa, 0x4f, 0xf6, 0x1a, 0x46, 0x30, 0xdf, 0xee, 0x43, 0x7c, 0xfd, 0x94,
+	0x0b, 0xda, 0xef, 0x25, 0x90, 0xc6, 0x89, 0xf2, 0x56, 0x77, 0xd1, 0xde, 0x6a, 0xb4, 0x61, 0x72,
+	0xaf, 0x4c, 0xca, 0xdd, 0xc6, 0x4d, 0x9a, 0x90, 0x34, 0xeb, 0xa5, 0xcc, 0x5b, 0x33, 0xf2, 0xba,
+	0x89, 0x8f, 0x59, 0xf0, 0x13, 0x61, 0xaf, 0x4b, 0x32, 0x0a, 0x7c, 0xcc, 0xc8, 0x96, 0x85, 0x9c,
+	0xae, 0xd6, 0x98, 0xa9, 0xe6, 0x3e, 0xc1, 0x5b, 0x8a, 0xc8, 0x18, 0x54, 0x8f, 0x8a, 0x4c, 0x49,
+	0x42, 0x55, 0x8f, 0x30, 0x26, 0xa1, 0x28, 0x0c, 0x76, 0x2b, 0xba, 0x63, 0xe5, 0x6e, 0xad, 0x1e,
+	0x59, 0x51, 0x17, 0xa4, 0x06, 0x92, 0xc3, 0xf8, 0x06, 0x93, 0x44, 0xf0, 0x19, 0xe1, 0xfb, 0x47,
+	0xc6, 0x76, 0x8d, 0x1e, 0x41, 0x51, 0x72, 0x75, 0x24, 0x69, 0x92, 0x56, 0xc0, 0xec, 0x45, 0xa6,
+	0x91, 0xd0, 0x2c, 0xd2, 0x16, 0x5e, 0xd7, 0x36, 0x6a, 0xc5, 0xde, 0xc4, 0xd1, 0xe1, 0x31, 0x73,
+	0x0f, 0xb1, 0x23, 0xcd, 0x51, 0xe6, 0x12, 0x1b, 0x07, 0x0f, 0x3a, 0x33, 0xd3, 0xd0, 0xb9, 0x5c,
+	0x31, 0xaa, 0x97, 0x07, 0x1f, 0x11, 0xbe, 0xd5, 0x05, 0xce, 0x89, 0x7c, 0x43, 0xca, 0x02, 0xfe,
+	0x4b, 0x70, 0x0f, 0xb7, 0xa8, 0x59, 0x3e, 0x61, 0x68, 0xda, 0xc4, 0x31, 0xd3, 0x2d, 0xc8, 0xf5,
+	0x29, 0xd6, 0xca, 0x66, 0x54, 0x47, 0xda, 0x11, 0x52, 0xaa, 0x44, 0xc8, 0x54, 0x0d, 0x6a, 0xef,
+	0x26, 0x89, 0xe0, 0x13, 0xc2, 0x3b, 0xa6, 0x38, 0xb3, 0x20, 0x9a, 0xf2, 0xa4, 0x9f, 0xe6, 0xf9,
+	0x32, 0x86, 0xcc, 0x69, 0x5e, 0x63, 0x4e, 0xf3, 0x82, 0xef, 0x08, 0xef, 0xbc, 0x14, 0x15, 0xc8,
+	0x4c, 0x77, 0xcc, 0xa0, 0x8c, 0x47, 0x6d, 0x71, 0x98, 0xcb, 0x53, 0xdf, 0xb8, 0x3a, 0xf5, 0x0b,
+	0x0e, 0x5b, 0xf0, 0x0d, 0xe1, 0xf6, 0x74, 0x73, 0x39, 0x19, 0xd8, 0x0e, 0x2f, 0x4e, 0xdb, 0xc6,
+	0xcd, 0x02, 0x3e, 0x94, 0x90, 0x51, 0xa8, 0x49, 0xff, 0xc5, 0xee, 0x53, 0xec, 0x14, 0x8a, 0xa8,
+	0xd2, 0x52, 0xdd, 0x3c, 0x78, 0x34, 0x67, 0xce, 0x38, 0x19, 0x9c, 0x98, 0xb5, 0x51, 0xbd, 0x27,
+	0xf8, 0x81, 0xf0, 0xee, 0x15, 0xd8, 0x77, 0x65, 0xc6, 0x5e, 0x90, 0x74, 0x29, 0x83, 0xe7, 0x21,
+	0x7b, 0x78, 0x5d, 0xea, 0x4a, 0x20, 0x6b, 0x27, 0xc7, 0xa1, 0xbb, 0x89, 0xd7, 0x94, 0xe8, 0x43,
+	0x66, 0x7e, 0xd2, 0x56, 0x64, 0x03, 0x9d, 0x05, 0x29, 0x85, 0xf4, 0x1c, 0x9b, 0x35, 0xc1, 0xb3,
+	0xd7, 0xbf, 0x86, 0x3e, 0xba, 0x18, 0xfa, 0xe8, 0xcf, 0xd0, 0x47, 0x5f, 0x46, 0xfe, 0xca, 0xc5,
+	0xc8, 0x5f, 0xf9, 0x3d, 0xf2, 0x57, 0xde, 0x1e, 0xc4, 0xa9, 0x4a, 0xca, 0xd3, 0x0e, 0x15, 0x67,
+	0x61, 0x0e, 0x71, 0x3c, 0x78, 0x5f, 0x85, 0x85, 0x38, 0x3b, 0x03, 0x9e, 0x82, 0x0c, 0xab, 0xc3,
+	0xf0, 0x7c, 0xea, 0x99, 0x0d, 0xd5, 0x20, 0x87, 0xe2, 0xd4, 0x31, 0xaf, 0xed, 0xe3, 0xbf, 0x03,
+	0x00, 0x37, 0x01, 0x25, 0x94, 0xcf, 0x05, 0x00, 0x00,
+}
+
+func (m *ScheduleCorkEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleCorkEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleCorkEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cork) > 0 {
+		i -= len(m.Cork)
+		copy(dAtA[i:], m.Cork)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Cork)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelScheduledCorkEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelScheduledCorkEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelScheduledCorkEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cancelled))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCorkResultArchivedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkResultArchivedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkResultArchivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarPauseEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarPauseEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarPauseEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedCellarCorkSkippedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedCellarCorkSkippedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedCellarCorkSkippedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceCorkScheduledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceCorkScheduledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceCorkScheduledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCorkRelayResultEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkRelayResultEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkRelayResultEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduleCorkEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Cork)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *CancelScheduledCorkEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Cancelled != 0 {
+		n += 1 + sovEvent(uint64(m.Cancelled))
+	}
+	return n
+}
+
+func (m *AxelarCorkResultArchivedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *CellarPauseEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *PausedCellarCorkSkippedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceCorkScheduledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *AxelarCorkRelayResultEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvent(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *AxelarCorkRelayRefundFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvent(uint64(m.ChainId))
+	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleCorkEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleCorkEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelScheduledCorkEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelScheduledCorkEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelScheduledCorkEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			m.Cancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cancelled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCorkResultArchivedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCorkResultArchivedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCorkResultArchivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &AxelarCorkResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarPauseEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarPauseEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarPauseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedCellarCorkSkippedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedCellarCorkSkippedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedCellarCorkSkippedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceCorkScheduledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceCorkScheduledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceCorkScheduledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ScheduleCorkEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Cork)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovEvent(uint64(m.ChainId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
+func (m *AxelarCorkRelayResultEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -247,15 +2222,34 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleCorkEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarCorkRelayResultEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleCorkEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarCorkRelayResultEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -283,11 +2277,118 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.CorkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AxelarCorkRelayStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCorkRelayRefundFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCorkRelayRefundFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCorkRelayRefundFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -315,11 +2416,30 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.CorkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -347,13 +2467,13 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cork = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -363,16 +2483,29 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.ChainId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -382,11 +2515,24 @@ func (m *ScheduleCorkEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const DefaultParamspace = ModuleName
 
 // DefaultGenesisState get raw genesis raw message for testing
@@ -13,6 +18,12 @@ func DefaultGenesisState() GenesisState {
 		CorkResults:              &AxelarCorkResults{},
 		AxelarContractCallNonces: []*AxelarContractCallNonce{},
 		AxelarUpgradeData:        []*AxelarUpgradeData{},
+		CellarSelectorAllowlists: []AxelarCellarSelectorAllowlist{},
+		PausedCellarIds:          []*CellarIDSet{},
+		GovernanceScheduledCorks: []GovernanceScheduledAxelarCork{},
+		CellarAbis:               []AxelarCellarABI{},
+		ManagedCellars:           []AxelarManagedCellar{},
+		CorkRelays:               []AxelarCorkRelay{},
 	}
 }
 
@@ -28,10 +39,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	cellarIDs := make(map[uint64]map[common.Address]bool, len(gs.CellarIds))
 	for _, cids := range gs.CellarIds {
 		if err := cids.ValidateBasic(); err != nil {
 			return err
 		}
+
+		if cellarIDs[cids.ChainId] == nil {
+			cellarIDs[cids.ChainId] = make(map[common.Address]bool, len(cids.Ids))
+		}
+		for _, id := range cids.Ids {
+			cellarIDs[cids.ChainId][common.HexToAddress(id)] = true
+		}
+	}
+
+	for _, cellar := range gs.ManagedCellars {
+		if err := cellar.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !cellarIDs[cellar.ChainId][common.HexToAddress(cellar.CellarId)] {
+			return errorsmod.Wrapf(ErrUnmanagedCellarAddress, "record for cellar %s on chain %d not in cellar IDs", cellar.CellarId, cellar.ChainId)
+		}
 	}
 
 	for _, sc := range gs.ScheduledCorks.ScheduledCorks {
@@ -58,5 +87,35 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, allowlist := range gs.CellarSelectorAllowlists {
+		if err := allowlist.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, paused := range gs.PausedCellarIds {
+		if err := paused.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, cork := range gs.GovernanceScheduledCorks {
+		if err := cork.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, cellarABI := range gs.CellarAbis {
+		if err := cellarABI.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, relay := range gs.CorkRelays {
+		if err := relay.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -25,13 +25,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - all cork state that must be provided at genesis
 type GenesisState struct {
-	Params                   *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	ChainConfigurations      ChainConfigurations        `protobuf:"bytes,2,opt,name=chain_configurations,json=chainConfigurations,proto3" json:"chain_configurations"`
-	CellarIds                []*CellarIDSet             `protobuf:"bytes,3,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
-	ScheduledCorks           *ScheduledAxelarCorks      `protobuf:"bytes,4,opt,name=scheduled_corks,json=scheduledCorks,proto3" json:"scheduled_corks,omitempty"`
-	CorkResults              *AxelarCorkResults         `protobuf:"bytes,5,opt,name=cork_results,json=corkResults,proto3" json:"cork_results,omitempty"`
-	AxelarContractCallNonces []*AxelarContractCallNonce `protobuf:"bytes,6,rep,name=axelar_contract_call_nonces,json=axelarContractCallNonces,proto3" json:"axelar_contract_call_nonces,omitempty"`
-	AxelarUpgradeData        []*AxelarUpgradeData       `protobuf:"bytes,7,rep,name=axelar_upgrade_data,json=axelarUpgradeData,proto3" json:"axelar_upgrade_data,omitempty"`
+	Params                   *Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ChainConfigurations      ChainConfigurations             `protobuf:"bytes,2,opt,name=chain_configurations,json=chainConfigurations,proto3" json:"chain_configurations"`
+	CellarIds                []*CellarIDSet                  `protobuf:"bytes,3,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	ScheduledCorks           *ScheduledAxelarCorks           `protobuf:"bytes,4,opt,name=scheduled_corks,json=scheduledCorks,proto3" json:"scheduled_corks,omitempty"`
+	CorkResults              *AxelarCorkResults              `protobuf:"bytes,5,opt,name=cork_results,json=corkResults,proto3" json:"cork_results,omitempty"`
+	AxelarContractCallNonces []*AxelarContractCallNonce      `protobuf:"bytes,6,rep,name=axelar_contract_call_nonces,json=axelarContractCallNonces,proto3" json:"axelar_contract_call_nonces,omitempty"`
+	AxelarUpgradeData        []*AxelarUpgradeData            `protobuf:"bytes,7,rep,name=axelar_upgrade_data,json=axelarUpgradeData,proto3" json:"axelar_upgrade_data,omitempty"`
+	CellarSelectorAllowlists []AxelarCellarSelectorAllowlist `protobuf:"bytes,8,rep,name=cellar_selector_allowlists,json=cellarSelectorAllowlists,proto3" json:"cellar_selector_allowlists"`
+	PausedCellarIds          []*CellarIDSet                  `protobuf:"bytes,9,rep,name=paused_cellar_ids,json=pausedCellarIds,proto3" json:"paused_cellar_ids,omitempty"`
+	GovernanceScheduledCorks []GovernanceScheduledAxelarCork `protobuf:"bytes,10,rep,name=governance_scheduled_corks,json=governanceScheduledCorks,proto3" json:"governance_scheduled_corks"`
+	CellarAbis               []AxelarCellarABI               `protobuf:"bytes,11,rep,name=cellar_abis,json=cellarAbis,proto3" json:"cellar_abis"`
+	// records of the managed cellars listed in cellar_ids, cellars without a record are added with an empty one
+	ManagedCellars []AxelarManagedCellar `protobuf:"bytes,12,rep,name=managed_cellars,json=managedCellars,proto3" json:"managed_cellars"`
+	CorkRelays     []AxelarCorkRelay     `protobuf:"bytes,13,rep,name=cork_relays,json=corkRelays,proto3" json:"cork_relays"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +123,48 @@ func (m *GenesisState) GetAxelarUpgradeData() []*AxelarUpgradeData {
 	return nil
 }
 
+func (m *GenesisState) GetCellarSelectorAllowlists() []AxelarCellarSelectorAllowlist {
+	if m != nil {
+		return m.CellarSelectorAllowlists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedCellarIds() []*CellarIDSet {
+	if m != nil {
+		return m.PausedCellarIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceScheduledCorks() []GovernanceScheduledAxelarCork {
+	if m != nil {
+		return m.GovernanceScheduledCorks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCellarAbis() []AxelarCellarABI {
+	if m != nil {
+		return m.CellarAbis
+	}
+	return nil
+}
+
+func (m *GenesisState) GetManagedCellars() []AxelarManagedCellar {
+	if m != nil {
+		return m.ManagedCellars
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorkRelays() []AxelarCorkRelay {
+	if m != nil {
+		return m.CorkRelays
+	}
+	return nil
+}
+
 type Params struct {
 	Enabled           bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	IbcChannel        string `protobuf:"bytes,2,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty" yaml:"ibc_channel"`
@@ -124,6 +173,14 @@ type Params struct {
 	ExecutorAccount   string `protobuf:"bytes,5,opt,name=executor_account,json=executorAccount,proto3" json:"executor_account,omitempty" yaml:"executor_account"`
 	TimeoutDuration   uint64 `protobuf:"varint,6,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty" yaml:"timeout_duration"`
 	CorkTimeoutBlocks uint64 `protobuf:"varint,7,opt,name=cork_timeout_blocks,json=corkTimeoutBlocks,proto3" json:"cork_timeout_blocks,omitempty" yaml:"cork_timeout_blocks"`
+	// number of blocks an axelar cork result is kept in state before it is pruned, pruning is disabled when zero
+	CorkResultRetentionBlocks uint64 `protobuf:"varint,8,opt,name=cork_result_retention_blocks,json=corkResultRetentionBlocks,proto3" json:"cork_result_retention_blocks,omitempty" yaml:"cork_result_retention_blocks"`
+	// emit an event containing each axelar cork result before it is pruned
+	ArchivePrunedCorkResults bool `protobuf:"varint,9,opt,name=archive_pruned_cork_results,json=archivePrunedCorkResults,proto3" json:"archive_pruned_cork_results,omitempty" yaml:"archive_pruned_cork_results"`
+	// account appointed by governance that may pause and resume cellars without a proposal, there is no guardian when empty
+	PauseGuardian string `protobuf:"bytes,10,opt,name=pause_guardian,json=pauseGuardian,proto3" json:"pause_guardian,omitempty" yaml:"pause_guardian"`
+	// maximum number of seconds a cork's deadline may be past the block time it is scheduled at, unlimited when zero
+	MaxCorkDeadlineHorizon uint64 `protobuf:"varint,11,opt,name=max_cork_deadline_horizon,json=maxCorkDeadlineHorizon,proto3" json:"max_cork_deadline_horizon,omitempty" yaml:"max_cork_deadline_horizon"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -208,6 +265,34 @@ func (m *Params) GetCorkTimeoutBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetCorkResultRetentionBlocks() uint64 {
+	if m != nil {
+		return m.CorkResultRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetArchivePrunedCorkResults() bool {
+	if m != nil {
+		return m.ArchivePrunedCorkResults
+	}
+	return false
+}
+
+func (m *Params) GetPauseGuardian() string {
+	if m != nil {
+		return m.PauseGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetMaxCorkDeadlineHorizon() uint64 {
+	if m != nil {
+		return m.MaxCorkDeadlineHorizon
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "axelarcork.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "axelarcork.v1.Params")
@@ -216,46 +301,65 @@ func init() {
 func init() { proto.RegisterFile("axelarcork/v1/genesis.proto", fileDescriptor_8d754a1cfeef5947) }
 
 var fileDescriptor_8d754a1cfeef5947 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xd1, 0x4e, 0xdb, 0x3c,
-	0x1c, 0xc5, 0xdb, 0xaf, 0xa5, 0x05, 0x97, 0x8f, 0x0e, 0x97, 0x6d, 0x51, 0x91, 0xd2, 0x2a, 0x93,
-	0x26, 0x2e, 0xb6, 0x46, 0xb0, 0x0b, 0xb4, 0xdd, 0xd1, 0xa2, 0x4d, 0x48, 0x08, 0x21, 0xb3, 0xdd,
-	0x6c, 0x17, 0x91, 0xe3, 0x78, 0x69, 0x86, 0x13, 0x47, 0xb6, 0x53, 0xc1, 0x5b, 0xec, 0x15, 0xf6,
-	0x16, 0x7b, 0x04, 0x2e, 0xb9, 0xdc, 0x55, 0x35, 0xc1, 0x1b, 0xf4, 0x09, 0xa6, 0xd8, 0x29, 0x6d,
-	0x33, 0x76, 0x17, 0x9f, 0xf3, 0x3b, 0xc7, 0x49, 0xfc, 0x97, 0xc1, 0x2e, 0xbe, 0xa2, 0x0c, 0x0b,
-	0xc2, 0xc5, 0xa5, 0x3b, 0xd9, 0x77, 0x43, 0x9a, 0x50, 0x19, 0xc9, 0x41, 0x2a, 0xb8, 0xe2, 0xf0,
-	0xff, 0x85, 0x39, 0x98, 0xec, 0x77, 0xed, 0x55, 0x76, 0xc9, 0xd4, 0x78, 0x77, 0x27, 0xe4, 0x21,
-	0xd7, 0x8f, 0x6e, 0xfe, 0x64, 0x54, 0xe7, 0x47, 0x1d, 0x6c, 0x7e, 0x30, 0xb5, 0x17, 0x0a, 0x2b,
-	0x0a, 0x5f, 0x83, 0x46, 0x8a, 0x05, 0x8e, 0xa5, 0x55, 0xed, 0x57, 0xf7, 0x5a, 0x07, 0x4f, 0x07,
-	0x2b, 0xdb, 0x0c, 0xce, 0xb5, 0x89, 0x0a, 0x08, 0x7e, 0x01, 0x3b, 0x64, 0x8c, 0xa3, 0xc4, 0x23,
-	0x3c, 0xf9, 0x1a, 0x85, 0x99, 0xc0, 0x2a, 0xe2, 0x89, 0xb4, 0xfe, 0xd3, 0x61, 0xa7, 0x14, 0x1e,
-	0xe5, 0xe8, 0x68, 0x85, 0x1c, 0xd6, 0x6f, 0xa6, 0xbd, 0x0a, 0xea, 0x90, 0xbf, 0x2d, 0xf8, 0x16,
-	0x00, 0x42, 0x19, 0xc3, 0xc2, 0x8b, 0x02, 0x69, 0xd5, 0xfa, 0xb5, 0xbd, 0xd6, 0x41, 0xb7, 0x5c,
-	0xa9, 0x81, 0x93, 0xe3, 0x0b, 0xaa, 0xd0, 0x86, 0xa1, 0x4f, 0x02, 0x09, 0x4f, 0x41, 0x5b, 0x92,
-	0x31, 0x0d, 0x32, 0x46, 0x03, 0x2f, 0x67, 0xa5, 0x55, 0xd7, 0xaf, 0xf4, 0xa2, 0x94, 0xbf, 0x98,
-	0x53, 0x47, 0x5a, 0x1e, 0xe5, 0x28, 0xda, 0x7a, 0xc8, 0xea, 0x35, 0x1c, 0x81, 0xcd, 0x9c, 0xf7,
-	0x04, 0x95, 0x19, 0x53, 0xd2, 0x5a, 0xd3, 0x55, 0xfd, 0x52, 0xd5, 0xa2, 0x01, 0x19, 0x0e, 0xb5,
-	0xc8, 0x62, 0x01, 0xe9, 0xfc, 0x38, 0xf3, 0x7f, 0xa5, 0x04, 0x26, 0xca, 0x23, 0x98, 0x31, 0x2f,
-	0xe1, 0x09, 0xa1, 0xd2, 0x6a, 0xe8, 0xcf, 0x7b, 0xf9, 0x8f, 0x4e, 0x13, 0x18, 0x61, 0xc6, 0xce,
-	0x72, 0x1c, 0x59, 0xf8, 0x71, 0x43, 0xc2, 0x73, 0xd0, 0x29, 0xb6, 0xc9, 0xd2, 0x50, 0xe0, 0x80,
-	0x7a, 0x01, 0x56, 0xd8, 0x6a, 0xea, 0xfa, 0xc7, 0x5f, 0xf9, 0x93, 0x01, 0x8f, 0xb1, 0xc2, 0x68,
-	0x1b, 0x97, 0x25, 0xe7, 0x67, 0x0d, 0x34, 0xcc, 0xb1, 0xc3, 0x57, 0xa0, 0x49, 0x13, 0xec, 0x33,
-	0x1a, 0xe8, 0xf1, 0x58, 0x1f, 0xc2, 0xd9, 0xb4, 0xb7, 0x75, 0x8d, 0x63, 0xf6, 0xce, 0x29, 0x0c,
-	0x07, 0xcd, 0x11, 0x78, 0x08, 0x5a, 0x91, 0x4f, 0x3c, 0x32, 0xc6, 0x49, 0x42, 0x99, 0x9e, 0x89,
-	0x8d, 0xe1, 0xb3, 0xd9, 0xb4, 0x07, 0x4d, 0x62, 0xc9, 0x74, 0x10, 0x88, 0x7c, 0x32, 0x32, 0x0b,
-	0x38, 0x00, 0xeb, 0xb9, 0x97, 0x72, 0xa1, 0xac, 0x9a, 0x4e, 0x75, 0x66, 0xd3, 0x5e, 0x7b, 0x91,
-	0xca, 0x1d, 0x07, 0x35, 0x23, 0x9f, 0x9c, 0x73, 0xa1, 0xf2, 0x8d, 0xc2, 0x38, 0xf5, 0x30, 0x21,
-	0x3c, 0x4b, 0x94, 0x3e, 0xe9, 0x95, 0x8d, 0x96, 0x4c, 0x07, 0x81, 0x30, 0x4e, 0x8f, 0xcc, 0x02,
-	0xbe, 0x07, 0x4f, 0xe8, 0x15, 0x25, 0x99, 0xe2, 0xe2, 0x21, 0xbd, 0xa6, 0xd3, 0xbb, 0xb3, 0x69,
-	0xef, 0x79, 0xf1, 0x61, 0x25, 0xc2, 0x41, 0xed, 0xb9, 0xb4, 0xd4, 0xa3, 0xa2, 0x98, 0xf2, 0x4c,
-	0x79, 0x41, 0x31, 0xbe, 0x56, 0xa3, 0x5f, 0xdd, 0xab, 0x2f, 0xf7, 0x94, 0x09, 0x07, 0xb5, 0x0b,
-	0xe9, 0xb8, 0x50, 0xe0, 0x19, 0xe8, 0xe8, 0x41, 0x9b, 0xa3, 0x3e, 0xe3, 0xe4, 0x52, 0x5a, 0x4d,
-	0x5d, 0x65, 0xcf, 0xa6, 0xbd, 0xae, 0xa9, 0x7a, 0x04, 0x72, 0xd0, 0x76, 0xae, 0x7e, 0x34, 0xe2,
-	0x50, 0x6b, 0xc3, 0xd3, 0x9b, 0x3b, 0xbb, 0x7a, 0x7b, 0x67, 0x57, 0x7f, 0xdf, 0xd9, 0xd5, 0xef,
-	0xf7, 0x76, 0xe5, 0xf6, 0xde, 0xae, 0xfc, 0xba, 0xb7, 0x2b, 0x9f, 0x0f, 0xc2, 0x48, 0x8d, 0x33,
-	0x7f, 0x40, 0x78, 0xec, 0xa6, 0x34, 0x0c, 0xaf, 0xbf, 0x4d, 0x5c, 0xc9, 0xe3, 0x98, 0xb2, 0x88,
-	0x0a, 0x77, 0x72, 0xe8, 0x5e, 0x2d, 0x5d, 0x21, 0xae, 0xba, 0x4e, 0xa9, 0xf4, 0x1b, 0xfa, 0xce,
-	0x78, 0xf3, 0x27, 0x00, 0x00, 0xff, 0xff, 0x89, 0x37, 0x67, 0xd6, 0x97, 0x04, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x49, 0xea, 0x24, 0xeb, 0x24, 0x26, 0x9b, 0xb6, 0x6c, 0x5c, 0xc6, 0xf2, 0xa8, 0x4c,
+	0xc9, 0xa1, 0xd8, 0xd3, 0x70, 0xe8, 0xc0, 0x09, 0xdb, 0xa1, 0x21, 0x33, 0xa5, 0x13, 0x36, 0x70,
+	0x81, 0x83, 0x66, 0xbd, 0x5a, 0x64, 0xd1, 0x95, 0x56, 0xa3, 0x5d, 0x19, 0x9b, 0x4f, 0xc1, 0x07,
+	0x61, 0xf8, 0x1c, 0x3d, 0xf6, 0xc8, 0x49, 0xc3, 0x24, 0xdf, 0xc0, 0x9f, 0x80, 0xd1, 0xee, 0xca,
+	0x56, 0x5c, 0x27, 0xdc, 0xb4, 0xef, 0xf7, 0xe7, 0x3d, 0xed, 0x7b, 0x7a, 0x02, 0x4f, 0xc8, 0x94,
+	0x71, 0x92, 0x52, 0x91, 0xbe, 0xed, 0x4d, 0x5e, 0xf4, 0x02, 0x16, 0x33, 0x19, 0xca, 0x6e, 0x92,
+	0x0a, 0x25, 0xe0, 0xfe, 0x12, 0xec, 0x4e, 0x5e, 0xb4, 0xda, 0xb7, 0xb9, 0x15, 0x50, 0xd3, 0x5b,
+	0x0f, 0x03, 0x11, 0x08, 0xfd, 0xd8, 0x2b, 0x9e, 0x4c, 0xd4, 0xfd, 0x7b, 0x07, 0xec, 0x9d, 0x1b,
+	0xdb, 0x2b, 0x45, 0x14, 0x83, 0x5f, 0x80, 0x7a, 0x42, 0x52, 0x12, 0x49, 0x54, 0xeb, 0xd4, 0x4e,
+	0x1a, 0xa7, 0x8f, 0xba, 0xb7, 0xd2, 0x74, 0x2f, 0x35, 0x88, 0x2d, 0x09, 0xfe, 0x02, 0x1e, 0xd2,
+	0x31, 0x09, 0x63, 0x8f, 0x8a, 0xf8, 0xd7, 0x30, 0xc8, 0x52, 0xa2, 0x42, 0x11, 0x4b, 0xf4, 0x91,
+	0x16, 0xbb, 0x2b, 0xe2, 0x61, 0x41, 0x1d, 0xde, 0x62, 0x0e, 0xb6, 0xde, 0xe5, 0xce, 0x06, 0x3e,
+	0xa2, 0x1f, 0x42, 0xf0, 0x2b, 0x00, 0x28, 0xe3, 0x9c, 0xa4, 0x5e, 0xe8, 0x4b, 0xb4, 0xd9, 0xd9,
+	0x3c, 0x69, 0x9c, 0xb6, 0x56, 0x2d, 0x35, 0xe1, 0xe2, 0xec, 0x8a, 0x29, 0xbc, 0x6b, 0xd8, 0x17,
+	0xbe, 0x84, 0xaf, 0x41, 0x53, 0xd2, 0x31, 0xf3, 0x33, 0xce, 0x7c, 0xaf, 0xe0, 0x4a, 0xb4, 0xa5,
+	0x4b, 0x7a, 0xba, 0xa2, 0xbf, 0x2a, 0x59, 0x7d, 0x1d, 0x1e, 0x16, 0x54, 0x7c, 0xb0, 0xd0, 0xea,
+	0x33, 0x1c, 0x82, 0xbd, 0x82, 0xef, 0xa5, 0x4c, 0x66, 0x5c, 0x49, 0xf4, 0x40, 0x5b, 0x75, 0x56,
+	0xac, 0x96, 0x0e, 0xd8, 0xf0, 0x70, 0x83, 0x2e, 0x0f, 0x90, 0x95, 0xed, 0x2c, 0xee, 0x4a, 0xa5,
+	0x84, 0x2a, 0x8f, 0x12, 0xce, 0xbd, 0x58, 0xc4, 0x94, 0x49, 0x54, 0xd7, 0xaf, 0xf7, 0xec, 0x0e,
+	0x4f, 0x23, 0x18, 0x12, 0xce, 0xdf, 0x14, 0x74, 0x8c, 0xc8, 0x7a, 0x40, 0xc2, 0x4b, 0x70, 0x64,
+	0xd3, 0x64, 0x49, 0x90, 0x12, 0x9f, 0x79, 0x3e, 0x51, 0x04, 0x6d, 0x77, 0x36, 0xef, 0x2c, 0xf9,
+	0x27, 0x43, 0x3c, 0x23, 0x8a, 0xe0, 0x43, 0xb2, 0x1a, 0x82, 0x09, 0x68, 0xd9, 0x36, 0x48, 0xc6,
+	0x19, 0x55, 0x22, 0xf5, 0x08, 0xe7, 0xe2, 0x77, 0x1e, 0x4a, 0x25, 0xd1, 0x8e, 0x36, 0x7e, 0xbe,
+	0xbe, 0x6e, 0x2d, 0xbb, 0xb2, 0xaa, 0x7e, 0x29, 0xb2, 0x3d, 0x47, 0x74, 0x3d, 0x2c, 0xe1, 0x2b,
+	0x70, 0x98, 0x90, 0x4c, 0x16, 0xad, 0x5b, 0xf6, 0x7f, 0xf7, 0x7f, 0xfb, 0xdf, 0x34, 0xa2, 0xe1,
+	0x62, 0x0a, 0x12, 0xd0, 0x0a, 0xc4, 0x84, 0xa5, 0x31, 0x89, 0x29, 0xf3, 0x56, 0x07, 0x02, 0xac,
+	0xad, 0xfc, 0x7c, 0x21, 0x58, 0x33, 0x1a, 0x65, 0xe5, 0xc1, 0x87, 0x24, 0x33, 0x29, 0xdf, 0x82,
+	0x86, 0x2d, 0x99, 0x8c, 0x42, 0x89, 0x1a, 0x3a, 0x45, 0xfb, 0x9e, 0xcb, 0xe9, 0x0f, 0x2e, 0xac,
+	0xa9, 0x9d, 0xf5, 0xfe, 0x28, 0x94, 0xf0, 0x07, 0xd0, 0x8c, 0x48, 0x4c, 0x82, 0xc5, 0x0d, 0x48,
+	0xb4, 0xd7, 0xd9, 0x5c, 0xf3, 0x45, 0x19, 0xab, 0xef, 0x0d, 0xd7, 0x38, 0x5a, 0xbb, 0x83, 0xa8,
+	0x1a, 0x34, 0x95, 0x99, 0x19, 0xe6, 0x64, 0x26, 0xd1, 0xfe, 0x7d, 0x95, 0xe9, 0xa9, 0xe5, 0x64,
+	0xb6, 0xa8, 0xac, 0x0c, 0x48, 0xf7, 0xaf, 0x3a, 0xa8, 0x9b, 0x1d, 0x00, 0x9f, 0x83, 0x6d, 0x16,
+	0x93, 0x11, 0x67, 0xbe, 0xde, 0x15, 0x3b, 0x03, 0x38, 0xcf, 0x9d, 0x83, 0x19, 0x89, 0xf8, 0xd7,
+	0xae, 0x05, 0x5c, 0x5c, 0x52, 0xe0, 0x4b, 0xd0, 0x08, 0x47, 0xd4, 0xa3, 0x63, 0x12, 0xc7, 0x8c,
+	0xeb, 0x05, 0xb1, 0x3b, 0x78, 0x3c, 0xcf, 0x1d, 0x68, 0x14, 0x15, 0xd0, 0xc5, 0x20, 0x1c, 0xd1,
+	0xa1, 0x39, 0xc0, 0x2e, 0xd8, 0x29, 0xb0, 0x44, 0xa4, 0x0a, 0x6d, 0x6a, 0xd5, 0xd1, 0x3c, 0x77,
+	0x9a, 0x4b, 0x55, 0x81, 0xb8, 0x78, 0x3b, 0x1c, 0xd1, 0x4b, 0x91, 0xaa, 0x22, 0x51, 0x10, 0x25,
+	0x1e, 0xa1, 0x54, 0x64, 0xb1, 0x42, 0x5b, 0xab, 0x89, 0x2a, 0xa0, 0x8b, 0x41, 0x10, 0x25, 0x7d,
+	0x73, 0x80, 0xaf, 0xc0, 0xc7, 0x6c, 0xca, 0x68, 0xa6, 0x07, 0xdc, 0xaa, 0x1f, 0x68, 0xf5, 0x93,
+	0x79, 0xee, 0x7c, 0x62, 0x5f, 0x6c, 0x85, 0xe1, 0xe2, 0x66, 0x19, 0xaa, 0xf8, 0xa8, 0x30, 0x62,
+	0x22, 0x53, 0x9e, 0x6f, 0x77, 0x19, 0xaa, 0x77, 0x6a, 0x27, 0x5b, 0x55, 0x9f, 0x55, 0x86, 0x8b,
+	0x9b, 0x36, 0x74, 0x66, 0x23, 0xf0, 0x0d, 0x38, 0xd2, 0x1d, 0x2b, 0xa9, 0x23, 0x2e, 0xe8, 0x5b,
+	0x89, 0xb6, 0xb5, 0x55, 0x7b, 0x9e, 0x3b, 0x2d, 0x63, 0xb5, 0x86, 0xe4, 0xe2, 0xc3, 0x22, 0xfa,
+	0xa3, 0x09, 0x0e, 0x74, 0x0c, 0x8e, 0xc1, 0xa7, 0x95, 0x2d, 0xe6, 0xa5, 0x4c, 0xb1, 0xb8, 0x48,
+	0x54, 0x1a, 0xef, 0x68, 0xe3, 0xcf, 0xe7, 0xb9, 0xf3, 0xb4, 0x62, 0x7c, 0x07, 0xdb, 0xc5, 0xc7,
+	0xcb, 0xe5, 0x86, 0x4b, 0xd0, 0x66, 0x2a, 0x56, 0x5d, 0x4a, 0xc7, 0xe1, 0x84, 0x79, 0x49, 0x9a,
+	0xc5, 0xf6, 0x8b, 0x5b, 0xac, 0xcf, 0x5d, 0x3d, 0x2d, 0xcf, 0xe6, 0xb9, 0xe3, 0x9a, 0x44, 0xf7,
+	0x90, 0x5d, 0x8c, 0x2c, 0x7a, 0xa9, 0xc1, 0xca, 0x7a, 0x85, 0xdf, 0x80, 0x03, 0xfd, 0xc5, 0x7b,
+	0x41, 0x46, 0x52, 0x3f, 0x24, 0x31, 0x02, 0xba, 0x5d, 0xc7, 0xf3, 0xdc, 0x79, 0x64, 0x9c, 0x6f,
+	0xe3, 0x2e, 0xde, 0xd7, 0x81, 0x73, 0x7b, 0x86, 0x1e, 0x38, 0x8e, 0xc8, 0xd4, 0x24, 0xf4, 0x19,
+	0xf1, 0x79, 0x18, 0x33, 0x6f, 0x2c, 0xd2, 0xf0, 0x0f, 0x11, 0xa3, 0x86, 0xbe, 0x8f, 0xcf, 0xe6,
+	0xb9, 0xd3, 0x31, 0x66, 0x77, 0x52, 0x5d, 0xfc, 0x38, 0x22, 0xd3, 0xa2, 0xb4, 0x33, 0x8b, 0x7c,
+	0x67, 0x80, 0xc1, 0xeb, 0x77, 0xd7, 0xed, 0xda, 0xfb, 0xeb, 0x76, 0xed, 0xdf, 0xeb, 0x76, 0xed,
+	0xcf, 0x9b, 0xf6, 0xc6, 0xfb, 0x9b, 0xf6, 0xc6, 0x3f, 0x37, 0xed, 0x8d, 0x9f, 0x4f, 0x83, 0x50,
+	0x8d, 0xb3, 0x51, 0x97, 0x8a, 0xa8, 0x97, 0xb0, 0x20, 0x98, 0xfd, 0x36, 0xe9, 0x49, 0x11, 0x45,
+	0x8c, 0x87, 0x2c, 0xed, 0x4d, 0x5e, 0xf6, 0xa6, 0x95, 0x7f, 0x78, 0x4f, 0xcd, 0x12, 0x26, 0x47,
+	0x75, 0xfd, 0xd3, 0xfe, 0xf2, 0xbf, 0x01, 0x00, 0x77, 0xba, 0x8f, 0xfe, 0x18, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -278,6 +382,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CorkRelays) > 0 {
+		for iNdEx := len(m.CorkRelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkRelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ManagedCellars) > 0 {
+		for iNdEx := len(m.ManagedCellars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedCellars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CellarAbis) > 0 {
+		for iNdEx := len(m.CellarAbis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarAbis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.GovernanceScheduledCorks) > 0 {
+		for iNdEx := len(m.GovernanceScheduledCorks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceScheduledCorks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PausedCellarIds) > 0 {
+		for iNdEx := len(m.PausedCellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedCellarIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CellarSelectorAllowlists) > 0 {
+		for iNdEx := len(m.CellarSelectorAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarSelectorAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.AxelarUpgradeData) > 0 {
 		for iNdEx := len(m.AxelarUpgradeData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -389,6 +577,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCorkDeadlineHorizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCorkDeadlineHorizon))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PauseGuardian) > 0 {
+		i -= len(m.PauseGuardian)
+		copy(dAtA[i:], m.PauseGuardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PauseGuardian)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ArchivePrunedCorkResults {
+		i--
+		if m.ArchivePrunedCorkResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorkResultRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CorkResultRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CorkTimeoutBlocks != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CorkTimeoutBlocks))
 		i--
@@ -489,6 +704,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CellarSelectorAllowlists) > 0 {
+		for _, e := range m.CellarSelectorAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedCellarIds) > 0 {
+		for _, e := range m.PausedCellarIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceScheduledCorks) > 0 {
+		for _, e := range m.GovernanceScheduledCorks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CellarAbis) > 0 {
+		for _, e := range m.CellarAbis {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ManagedCellars) > 0 {
+		for _, e := range m.ManagedCellars {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorkRelays) > 0 {
+		for _, e := range m.CorkRelays {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -523,6 +774,19 @@ func (m *Params) Size() (n int) {
 	if m.CorkTimeoutBlocks != 0 {
 		n += 1 + sovGenesis(uint64(m.CorkTimeoutBlocks))
 	}
+	if m.CorkResultRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.CorkResultRetentionBlocks))
+	}
+	if m.ArchivePrunedCorkResults {
+		n += 2
+	}
+	l = len(m.PauseGuardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxCorkDeadlineHorizon != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxCorkDeadlineHorizon))
+	}
 	return n
 }
 
@@ -804,6 +1068,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarSelectorAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarSelectorAllowlists = append(m.CellarSelectorAllowlists, AxelarCellarSelectorAllowlist{})
+			if err := m.CellarSelectorAllowlists[len(m.CellarSelectorAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedCellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedCellarIds = append(m.PausedCellarIds, &CellarIDSet{})
+			if err := m.PausedCellarIds[len(m.PausedCellarIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceScheduledCorks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceScheduledCorks = append(m.GovernanceScheduledCorks, GovernanceScheduledAxelarCork{})
+			if err := m.GovernanceScheduledCorks[len(m.GovernanceScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarAbis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarAbis = append(m.CellarAbis, AxelarCellarABI{})
+			if err := m.CellarAbis[len(m.CellarAbis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedCellars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedCellars = append(m.ManagedCellars, AxelarManagedCellar{})
+			if err := m.ManagedCellars[len(m.ManagedCellars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkRelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkRelays = append(m.CorkRelays, AxelarCorkRelay{})
+			if err := m.CorkRelays[len(m.CorkRelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1040,6 +1508,96 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResultRetentionBlocks", wireType)
+			}
+			m.CorkResultRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorkResultRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivePrunedCorkResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchivePrunedCorkResults = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCorkDeadlineHorizon", wireType)
+			}
+			m.MaxCorkDeadlineHorizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCorkDeadlineHorizon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
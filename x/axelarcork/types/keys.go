@@ -29,7 +29,7 @@ const (
 	// CorkForAddressKeyPrefix - <prefix><chain_id><val_address><address> -> <cork>
 	CorkForAddressKeyPrefix // key for corks
 
-	// CellarIDsKeyPrefix - <prefix><chain_id> -> []string
+	// CellarIDsKeyPrefix - <prefix><chain_id> -> CellarIDSet -- replaced by CellarKeyPrefix as of axelarcork v3, left to preserve ID values
 	CellarIDsKeyPrefix
 
 	// ScheduledCorkKeyPrefix - <prefix><chain_id><block_height><val_address><address> -> <cork>
@@ -41,7 +41,7 @@ const (
 	// ChainConfigurationPrefix - <prefix><chain_id> -> ChainConfiguration
 	ChainConfigurationPrefix
 
-	// WinningCorkPrefix - <prefix><chain_id> -> AxelarCork
+	// WinningCorkPrefix - <prefix><chain_id><contract_address><block_height><queue_nonce> -> AxelarCork
 	WinningCorkPrefix
 
 	// AxelarContractCallNoncePrefix - <prefix><chain_id><contract_address> -> <nonce>
@@ -49,6 +49,42 @@ const (
 
 	// AxelarProxyUpgradeDataPrefix - <prefix><chain_id> -> <payload>
 	AxelarProxyUpgradeDataPrefix
+
+	// ScheduledCorkIDIndexPrefix - <prefix><chain_id><id><block_height><val_address><address> -> []byte{}
+	ScheduledCorkIDIndexPrefix
+
+	// CellarSelectorAllowlistPrefix - <prefix><chain_id><cellar_address> -> AxelarCellarSelectorAllowlist
+	CellarSelectorAllowlistPrefix
+
+	// PausedCellarPrefix - <prefix><chain_id><cellar_address> -> []byte{}
+	PausedCellarPrefix
+
+	// GovernanceScheduledCorkPrefix - <prefix><chain_id><block_height><id> -> GovernanceScheduledAxelarCork
+	GovernanceScheduledCorkPrefix
+
+	// CellarABIPrefix - <prefix><chain_id><cellar_address> -> AxelarCellarABI
+	CellarABIPrefix
+
+	// CellarKeyPrefix - <prefix><chain_id><cellar_address> -> AxelarManagedCellar
+	CellarKeyPrefix
+
+	// PendingCellarProposalKeyPrefix - <prefix><chain_id><cellar_address> -> []byte{}
+	PendingCellarProposalKeyPrefix
+
+	// CorkRelayPrefix - <prefix><chain_id><id> -> AxelarCorkRelay
+	CorkRelayPrefix
+
+	// PendingCorkRelayPrefix - <prefix><sequence><source_channel> -> <chain_id><id>
+	PendingCorkRelayPrefix
+
+	// WinningCorkQueueNoncePrefix - <prefix><chain_id><contract_address> -> <queue_nonce>
+	WinningCorkQueueNoncePrefix
+
+	// RelayingWinningCorkPrefix - <prefix><chain_id><contract_address><contract_call_nonce> -> <block_height><queue_nonce>
+	RelayingWinningCorkPrefix
+
+	// CorkResultHeightIndexPrefix - <prefix><chain_id><block_height><id> -> []byte{}
+	CorkResultHeightIndexPrefix
 )
 
 // GetCorkValidatorKeyPrefix returns the key prefix for cork commits for a validator
@@ -58,12 +94,6 @@ func GetCorkValidatorKeyPrefix(chainID uint64, val sdk.ValAddress) []byte {
 	return bytes.Join([][]byte{{CorkForAddressKeyPrefix}, cid, val.Bytes()}, []byte{})
 }
 
-func MakeCellarIDsKey(chainID uint64) []byte {
-	cid := make([]byte, 8)
-	binary.BigEndian.PutUint64(cid, chainID)
-	return bytes.Join([][]byte{{CellarIDsKeyPrefix}, cid}, []byte{})
-}
-
 func GetScheduledAxelarCorkKeyPrefix(chainID uint64) []byte {
 	cid := make([]byte, 8)
 	binary.BigEndian.PutUint64(cid, chainID)
@@ -79,6 +109,17 @@ func GetScheduledAxelarCorkKey(chainID uint64, blockHeight uint64, id []byte, va
 	return bytes.Join([][]byte{GetScheduledAxelarCorkKeyPrefix(chainID), blockHeightBytes, id, val.Bytes(), contract.Bytes()}, []byte{})
 }
 
+func GetScheduledAxelarCorkIDIndexPrefix(chainID uint64, id []byte) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{ScheduledCorkIDIndexPrefix}, cid, id}, []byte{})
+}
+
+func GetScheduledAxelarCorkIDIndexKey(chainID uint64, blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) []byte {
+	blockHeightBytes := sdk.Uint64ToBigEndian(blockHeight)
+	return bytes.Join([][]byte{GetScheduledAxelarCorkIDIndexPrefix(chainID, id), blockHeightBytes, val.Bytes(), contract.Bytes()}, []byte{})
+}
+
 func GetAxelarCorkResultPrefix(chainID uint64) []byte {
 	cid := make([]byte, 8)
 	binary.BigEndian.PutUint64(cid, chainID)
@@ -89,6 +130,16 @@ func GetAxelarCorkResultKey(chainID uint64, id []byte) []byte {
 	return append(GetAxelarCorkResultPrefix(chainID), id...)
 }
 
+func GetAxelarCorkResultHeightIndexPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{CorkResultHeightIndexPrefix}, cid}, []byte{})
+}
+
+func GetAxelarCorkResultHeightIndexKey(chainID uint64, blockHeight uint64, id []byte) []byte {
+	return bytes.Join([][]byte{GetAxelarCorkResultHeightIndexPrefix(chainID), sdk.Uint64ToBigEndian(blockHeight), id}, []byte{})
+}
+
 func ChainConfigurationKey(chainID uint64) []byte {
 	cid := make([]byte, 8)
 	binary.BigEndian.PutUint64(cid, chainID)
@@ -101,10 +152,24 @@ func GetWinningAxelarCorkKeyPrefix(chainID uint64) []byte {
 	return bytes.Join([][]byte{{WinningCorkPrefix}, cid}, []byte{})
 }
 
-func GetWinningAxelarCorkKey(chainID uint64, blockheight uint64, address common.Address) []byte {
-	bh := make([]byte, 8)
-	binary.BigEndian.PutUint64(bh, blockheight)
-	return bytes.Join([][]byte{GetWinningAxelarCorkKeyPrefix(chainID), bh, address.Bytes()}, []byte{})
+func GetWinningAxelarCorkQueuePrefix(chainID uint64, address common.Address) []byte {
+	return append(GetWinningAxelarCorkKeyPrefix(chainID), address.Bytes()...)
+}
+
+func GetWinningAxelarCorkKey(chainID uint64, address common.Address, blockHeight uint64, queueNonce uint64) []byte {
+	return bytes.Join([][]byte{GetWinningAxelarCorkQueuePrefix(chainID, address), sdk.Uint64ToBigEndian(blockHeight), sdk.Uint64ToBigEndian(queueNonce)}, []byte{})
+}
+
+func GetWinningAxelarCorkQueueNonceKey(chainID uint64, address common.Address) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{WinningCorkQueueNoncePrefix}, cid, address.Bytes()}, []byte{})
+}
+
+func GetRelayingWinningAxelarCorkKey(chainID uint64, address common.Address, contractCallNonce uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{RelayingWinningCorkPrefix}, cid, address.Bytes(), sdk.Uint64ToBigEndian(contractCallNonce)}, []byte{})
 }
 
 func GetAxelarContractCallNonceKey(chainID uint64, contractAddress common.Address) []byte {
@@ -118,3 +183,79 @@ func GetAxelarProxyUpgradeDataKey(chainID uint64) []byte {
 	binary.BigEndian.PutUint64(cid, chainID)
 	return bytes.Join([][]byte{{AxelarProxyUpgradeDataPrefix}, cid}, []byte{})
 }
+
+func GetCellarSelectorAllowlistKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{CellarSelectorAllowlistPrefix}, cid}, []byte{})
+}
+
+func GetCellarSelectorAllowlistKey(chainID uint64, cellar common.Address) []byte {
+	return append(GetCellarSelectorAllowlistKeyPrefix(chainID), cellar.Bytes()...)
+}
+
+func GetPausedCellarKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{PausedCellarPrefix}, cid}, []byte{})
+}
+
+func GetPausedCellarKey(chainID uint64, cellar common.Address) []byte {
+	return append(GetPausedCellarKeyPrefix(chainID), cellar.Bytes()...)
+}
+
+func GetGovernanceScheduledAxelarCorkKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{GovernanceScheduledCorkPrefix}, cid}, []byte{})
+}
+
+func GetGovernanceScheduledAxelarCorkKeyByBlockHeightPrefix(chainID uint64, blockHeight uint64) []byte {
+	return append(GetGovernanceScheduledAxelarCorkKeyPrefix(chainID), sdk.Uint64ToBigEndian(blockHeight)...)
+}
+
+func GetGovernanceScheduledAxelarCorkKey(chainID uint64, blockHeight uint64, id []byte) []byte {
+	return append(GetGovernanceScheduledAxelarCorkKeyByBlockHeightPrefix(chainID, blockHeight), id...)
+}
+
+func GetCellarABIKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{CellarABIPrefix}, cid}, []byte{})
+}
+
+func GetCellarABIKey(chainID uint64, cellar common.Address) []byte {
+	return append(GetCellarABIKeyPrefix(chainID), cellar.Bytes()...)
+}
+
+func GetCellarKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{CellarKeyPrefix}, cid}, []byte{})
+}
+
+func GetCellarKey(chainID uint64, cellar common.Address) []byte {
+	return append(GetCellarKeyPrefix(chainID), cellar.Bytes()...)
+}
+
+func GetPendingCellarProposalKeyPrefix() []byte {
+	return []byte{PendingCellarProposalKeyPrefix}
+}
+
+func GetPendingCellarProposalKey(chainID uint64, cellar common.Address) []byte {
+	return bytes.Join([][]byte{GetPendingCellarProposalKeyPrefix(), sdk.Uint64ToBigEndian(chainID), cellar.Bytes()}, []byte{})
+}
+
+func GetAxelarCorkRelayKeyPrefix(chainID uint64) []byte {
+	cid := make([]byte, 8)
+	binary.BigEndian.PutUint64(cid, chainID)
+	return bytes.Join([][]byte{{CorkRelayPrefix}, cid}, []byte{})
+}
+
+func GetAxelarCorkRelayKey(chainID uint64, id []byte) []byte {
+	return append(GetAxelarCorkRelayKeyPrefix(chainID), id...)
+}
+
+func GetPendingAxelarCorkRelayKey(sourceChannel string, sequence uint64) []byte {
+	return bytes.Join([][]byte{{PendingCorkRelayPrefix}, sdk.Uint64ToBigEndian(sequence), []byte(sourceChannel)}, []byte{})
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +15,7 @@ var (
 	_ sdk.Msg = &MsgRelayAxelarCorkRequest{}
 	_ sdk.Msg = &MsgBumpAxelarCorkGasRequest{}
 	_ sdk.Msg = &MsgCancelAxelarCorkRequest{}
+	_ sdk.Msg = &MsgSetAxelarCellarPausedRequest{}
 	_ sdk.Msg = &MsgRelayAxelarCorkRequest{}
 )
 
@@ -23,6 +25,7 @@ const (
 	TypeMsgBumpAxelarCorkGasRequest       = "axelar_cork_bump_gas"
 	TypeMsgCancelAxelarCorkRequest        = "axelar_cancel_cork"
 	TypeMsgRelayAxelarProxyUpgradeRequest = "axelar_proxy_upgrade_relay"
+	TypeMsgSetAxelarCellarPausedRequest   = "axelar_set_cellar_paused"
 )
 
 //////////////////////////////////
@@ -90,14 +93,16 @@ func (m *MsgScheduleAxelarCorkRequest) MustGetSigner() sdk.AccAddress {
 // MsgRelayAxelarCorkRequest //
 ///////////////////////////////
 
-// NewMsgRelayAxelarCorkRequest returns a new MsgRelayAxelarCorkRequest
-func NewMsgRelayAxelarCorkRequest(signer sdk.Address, token sdk.Coin, fee uint64, chainID uint64, address common.Address) (*MsgRelayAxelarCorkRequest, error) {
+// NewMsgRelayAxelarCorkRequest returns a new MsgRelayAxelarCorkRequest. An empty cork ID relays the cork at the head
+// of the target contract's queue.
+func NewMsgRelayAxelarCorkRequest(signer sdk.Address, token sdk.Coin, fee uint64, chainID uint64, address common.Address, corkID string) (*MsgRelayAxelarCorkRequest, error) {
 	return &MsgRelayAxelarCorkRequest{
 		Signer:                signer.String(),
 		Token:                 token,
 		Fee:                   fee,
 		ChainId:               chainID,
 		TargetContractAddress: address.String(),
+		CorkId:                corkID,
 	}, nil
 }
 
@@ -133,6 +138,13 @@ func (m *MsgRelayAxelarCorkRequest) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.TargetContractAddress)
 	}
 
+	if m.CorkId != "" {
+		// the length of a keccak256 hash string
+		if id, err := hex.DecodeString(m.CorkId); err != nil || len(id) != 32 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cork ID %s, must be a hex encoded keccak256 hash", m.CorkId)
+		}
+	}
+
 	return nil
 }
 
@@ -330,3 +342,59 @@ func (m *MsgCancelAxelarCorkRequest) MustGetSigner() sdk.AccAddress {
 	}
 	return addr
 }
+
+/////////////////////////////////////
+// MsgSetAxelarCellarPausedRequest //
+/////////////////////////////////////
+
+// NewMsgSetAxelarCellarPausedRequest returns a new MsgSetAxelarCellarPausedRequest
+func NewMsgSetAxelarCellarPausedRequest(signer sdk.AccAddress, chainID uint64, cellar common.Address, paused bool) (*MsgSetAxelarCellarPausedRequest, error) {
+	return &MsgSetAxelarCellarPausedRequest{
+		Signer:   signer.String(),
+		ChainId:  chainID,
+		CellarId: cellar.String(),
+		Paused:   paused,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgSetAxelarCellarPausedRequest) Route() string { return ModuleName }
+
+// Type implements sdk.Msg
+func (m *MsgSetAxelarCellarPausedRequest) Type() string { return TypeMsgSetAxelarCellarPausedRequest }
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetAxelarCellarPausedRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.CellarId)
+	}
+
+	if m.ChainId == 0 {
+		return fmt.Errorf("chain ID must be greater than zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetAxelarCellarPausedRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetAxelarCellarPausedRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.MustGetSigner()}
+}
+
+// MustGetSigner returns the signer address
+func (m *MsgSetAxelarCellarPausedRequest) MustGetSigner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
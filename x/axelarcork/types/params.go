@@ -13,13 +13,17 @@ import (
 
 // Parameter keys
 var (
-	KeyEnabled           = []byte("enabled")
-	KeyIBCChannel        = []byte("ibcchannel")
-	KeyIBCPort           = []byte("ibcport")
-	KeyGMPAccount        = []byte("gmpaccount")
-	KeyExecutorAccount   = []byte("executoraccount")
-	KeyTimeoutDuration   = []byte("timeoutduration")
-	KeyCorkTimeoutBlocks = []byte("corktimeoutblocks")
+	KeyEnabled                   = []byte("enabled")
+	KeyIBCChannel                = []byte("ibcchannel")
+	KeyIBCPort                   = []byte("ibcport")
+	KeyGMPAccount                = []byte("gmpaccount")
+	KeyExecutorAccount           = []byte("executoraccount")
+	KeyTimeoutDuration           = []byte("timeoutduration")
+	KeyCorkTimeoutBlocks         = []byte("corktimeoutblocks")
+	KeyCorkResultRetentionBlocks = []byte("corkresultretentionblocks")
+	KeyArchivePrunedCorkResults  = []byte("archiveprunedcorkresults")
+	KeyPauseGuardian             = []byte("pauseguardian")
+	KeyMaxCorkDeadlineHorizon    = []byte("maxcorkdeadlinehorizon")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -39,6 +43,12 @@ func DefaultParams() Params {
 		ExecutorAccount:   "",
 		TimeoutDuration:   0,
 		CorkTimeoutBlocks: 10000,
+		// roughly one week of blocks
+		CorkResultRetentionBlocks: 100800,
+		ArchivePrunedCorkResults:  true,
+		PauseGuardian:             "",
+		// thirty days
+		MaxCorkDeadlineHorizon: 2592000,
 	}
 }
 
@@ -52,6 +62,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyExecutorAccount, &p.ExecutorAccount, validateExecutorAccount),
 		paramtypes.NewParamSetPair(KeyTimeoutDuration, &p.TimeoutDuration, validateTimeoutDuration),
 		paramtypes.NewParamSetPair(KeyCorkTimeoutBlocks, &p.CorkTimeoutBlocks, validateCorkTimeoutBlocks),
+		paramtypes.NewParamSetPair(KeyCorkResultRetentionBlocks, &p.CorkResultRetentionBlocks, validateCorkResultRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyArchivePrunedCorkResults, &p.ArchivePrunedCorkResults, validateArchivePrunedCorkResults),
+		paramtypes.NewParamSetPair(KeyPauseGuardian, &p.PauseGuardian, validatePauseGuardian),
+		paramtypes.NewParamSetPair(KeyMaxCorkDeadlineHorizon, &p.MaxCorkDeadlineHorizon, validateMaxCorkDeadlineHorizon),
 	}
 }
 
@@ -82,6 +96,19 @@ func (p *Params) ValidateBasic() error {
 		}
 	}
 
+	if err := validateCorkResultRetentionBlocks(p.CorkResultRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateArchivePrunedCorkResults(p.ArchivePrunedCorkResults); err != nil {
+		return err
+	}
+	if err := validatePauseGuardian(p.PauseGuardian); err != nil {
+		return err
+	}
+	if err := validateMaxCorkDeadlineHorizon(p.MaxCorkDeadlineHorizon); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -179,3 +206,45 @@ func validateCorkTimeoutBlocks(i interface{}) error {
 
 	return nil
 }
+
+func validateCorkResultRetentionBlocks(i interface{}) error {
+	// zero disables pruning
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateArchivePrunedCorkResults(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePauseGuardian(i interface{}) error {
+	pauseGuardian, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty means there is no guardian
+	if pauseGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(pauseGuardian); err != nil {
+			return fmt.Errorf("invalid pause guardian address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxCorkDeadlineHorizon(i interface{}) error {
+	// zero disables the maximum
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
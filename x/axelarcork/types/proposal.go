@@ -22,6 +22,10 @@ const (
 	ProposalTypeRemoveChainConfiguration         = "RemoveAxelarChainConfiguration"
 	ProposalTypeUpgradeAxelarProxyContract       = "UpgradeAxelarProxyContract"
 	ProposalTypeCancelAxelarProxyContractUpgrade = "CancelAxelarProxyContractUpgrade"
+	ProposalTypeSetAxelarCellarSelectorAllowlist = "SetAxelarCellarSelectorAllowlist"
+	ProposalTypeSetAxelarCellarPaused            = "SetAxelarCellarPaused"
+	ProposalTypeSetAxelarCellarABI               = "SetAxelarCellarABI"
+	ProposalTypeSetAxelarCellarMetadata          = "SetAxelarCellarMetadata"
 )
 
 var _ govtypesv1beta1.Content = &AddAxelarManagedCellarIDsProposal{}
@@ -32,6 +36,10 @@ var _ govtypesv1beta1.Content = &AddChainConfigurationProposal{}
 var _ govtypesv1beta1.Content = &RemoveChainConfigurationProposal{}
 var _ govtypesv1beta1.Content = &UpgradeAxelarProxyContractProposal{}
 var _ govtypesv1beta1.Content = &CancelAxelarProxyContractUpgradeProposal{}
+var _ govtypesv1beta1.Content = &SetAxelarCellarSelectorAllowlistProposal{}
+var _ govtypesv1beta1.Content = &SetAxelarCellarPausedProposal{}
+var _ govtypesv1beta1.Content = &SetAxelarCellarABIProposal{}
+var _ govtypesv1beta1.Content = &SetAxelarCellarMetadataProposal{}
 
 func init() {
 	// The RegisterProposalTypeCodec function was mysteriously removed by in 0.46.0 even though
@@ -64,15 +72,28 @@ func init() {
 
 	govtypesv1beta1.RegisterProposalType(ProposalTypeCancelAxelarProxyContractUpgrade)
 	govtypesv1beta1.ModuleCdc.RegisterConcrete(&CancelAxelarProxyContractUpgradeProposal{}, "sommelier/CancelAxelarProxyContractUpgradeProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetAxelarCellarSelectorAllowlist)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetAxelarCellarSelectorAllowlistProposal{}, "sommelier/SetAxelarCellarSelectorAllowlistProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetAxelarCellarPaused)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetAxelarCellarPausedProposal{}, "sommelier/SetAxelarCellarPausedProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetAxelarCellarABI)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetAxelarCellarABIProposal{}, "sommelier/SetAxelarCellarABIProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetAxelarCellarMetadata)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetAxelarCellarMetadataProposal{}, "sommelier/SetAxelarCellarMetadataProposal", nil)
 }
 
-func NewAddAxelarManagedCellarIDsProposal(title string, description string, chainID uint64, cellarIds *CellarIDSet, publisherDomain string) *AddAxelarManagedCellarIDsProposal {
+func NewAddAxelarManagedCellarIDsProposal(title string, description string, chainID uint64, cellarIds *CellarIDSet, publisherDomain string, cellarMetadata []CellarMetadataEntry) *AddAxelarManagedCellarIDsProposal {
 	return &AddAxelarManagedCellarIDsProposal{
 		Title:           title,
 		Description:     description,
 		CellarIds:       cellarIds,
 		ChainId:         chainID,
 		PublisherDomain: publisherDomain,
+		CellarMetadata:  cellarMetadata,
 	}
 }
 
@@ -101,6 +122,28 @@ func (m *AddAxelarManagedCellarIDsProposal) ValidateBasic() error {
 		return err
 	}
 
+	cellarIDs := make(map[common.Address]bool, len(m.CellarIds.Ids))
+	for _, id := range m.CellarIds.Ids {
+		cellarIDs[common.HexToAddress(id)] = true
+	}
+
+	seen := make(map[common.Address]bool, len(m.CellarMetadata))
+	for _, entry := range m.CellarMetadata {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(entry.CellarId)
+		if !cellarIDs[address] {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "cellar %s is not being added", entry.CellarId)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "duplicate metadata for cellar %s", entry.CellarId)
+		}
+		seen[address] = true
+	}
+
 	return nil
 }
 
@@ -137,7 +180,7 @@ func (m *RemoveAxelarManagedCellarIDsProposal) ValidateBasic() error {
 	return nil
 }
 
-func NewAxelarScheduledCorkProposal(title string, description string, blockHeight uint64, chainID uint64, targetContractAddress string, contractCallProtoJSON string, deadline uint64) *AxelarScheduledCorkProposal {
+func NewAxelarScheduledCorkProposal(title string, description string, blockHeight uint64, chainID uint64, targetContractAddress string, contractCallProtoJSON string, deadline uint64, encodedContractCall []byte) *AxelarScheduledCorkProposal {
 	return &AxelarScheduledCorkProposal{
 		Title:                 title,
 		Description:           description,
@@ -146,6 +189,7 @@ func NewAxelarScheduledCorkProposal(title string, description string, blockHeigh
 		TargetContractAddress: targetContractAddress,
 		ContractCallProtoJson: contractCallProtoJSON,
 		Deadline:              deadline,
+		EncodedContractCall:   encodedContractCall,
 	}
 }
 
@@ -174,11 +218,11 @@ func (m *AxelarScheduledCorkProposal) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.TargetContractAddress)
 	}
 
-	if len(m.ContractCallProtoJson) == 0 {
+	if len(m.ContractCallProtoJson) == 0 && len(m.EncodedContractCall) == 0 {
 		return errorsmod.Wrapf(ErrInvalidJSON, "cannot have empty contract call")
 	}
 
-	if !json.Valid([]byte(m.ContractCallProtoJson)) {
+	if len(m.ContractCallProtoJson) != 0 && !json.Valid([]byte(m.ContractCallProtoJson)) {
 		return errorsmod.Wrapf(ErrInvalidJSON, "%s", m.ContractCallProtoJson)
 	}
 
@@ -343,3 +387,146 @@ func (m *CancelAxelarProxyContractUpgradeProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewSetAxelarCellarSelectorAllowlistProposal(title string, description string, chainID uint64, cellarID string, selectors []string) *SetAxelarCellarSelectorAllowlistProposal {
+	return &SetAxelarCellarSelectorAllowlistProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		CellarId:    cellarID,
+		Selectors:   selectors,
+	}
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) ProposalType() string {
+	return ProposalTypeSetAxelarCellarSelectorAllowlist
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.CellarId)
+	}
+
+	_, err := NormalizeSelectors(m.Selectors)
+	return err
+}
+
+func NewSetAxelarCellarPausedProposal(title string, description string, chainID uint64, cellarID string, paused bool) *SetAxelarCellarPausedProposal {
+	return &SetAxelarCellarPausedProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		CellarId:    cellarID,
+		Paused:      paused,
+	}
+}
+
+func (m *SetAxelarCellarPausedProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetAxelarCellarPausedProposal) ProposalType() string {
+	return ProposalTypeSetAxelarCellarPaused
+}
+
+func (m *SetAxelarCellarPausedProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.CellarId)
+	}
+
+	return nil
+}
+
+func NewSetAxelarCellarABIProposal(title string, description string, chainID uint64, cellarID string, abi string) *SetAxelarCellarABIProposal {
+	return &SetAxelarCellarABIProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		CellarId:    cellarID,
+		Abi:         abi,
+	}
+}
+
+func (m *SetAxelarCellarABIProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetAxelarCellarABIProposal) ProposalType() string {
+	return ProposalTypeSetAxelarCellarABI
+}
+
+// ValidateBasic accepts an empty ABI, which removes the cellar's registered ABI
+func (m *SetAxelarCellarABIProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.CellarId)
+	}
+
+	if m.Abi == "" {
+		return nil
+	}
+
+	_, err := ParseABI(m.Abi)
+	return err
+}
+
+func NewSetAxelarCellarMetadataProposal(title string, description string, chainID uint64, cellarID string, metadata CellarMetadata) *SetAxelarCellarMetadataProposal {
+	return &SetAxelarCellarMetadataProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		CellarId:    cellarID,
+		Metadata:    metadata,
+	}
+}
+
+func (m *SetAxelarCellarMetadataProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetAxelarCellarMetadataProposal) ProposalType() string {
+	return ProposalTypeSetAxelarCellarMetadata
+}
+
+func (m *SetAxelarCellarMetadataProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.ChainId == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEVMAddress, "%s", m.CellarId)
+	}
+
+	return m.Metadata.ValidateBasic()
+}
@@ -30,6 +30,8 @@ type AddAxelarManagedCellarIDsProposal struct {
 	ChainId         uint64       `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	CellarIds       *CellarIDSet `protobuf:"bytes,4,opt,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
 	PublisherDomain string       `protobuf:"bytes,5,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	// optional metadata for the added cellars, each entry must name a cellar in cellar_ids
+	CellarMetadata []CellarMetadataEntry `protobuf:"bytes,6,rep,name=cellar_metadata,json=cellarMetadata,proto3" json:"cellar_metadata"`
 }
 
 func (m *AddAxelarManagedCellarIDsProposal) Reset()         { *m = AddAxelarManagedCellarIDsProposal{} }
@@ -100,14 +102,22 @@ func (m *AddAxelarManagedCellarIDsProposal) GetPublisherDomain() string {
 	return ""
 }
 
+func (m *AddAxelarManagedCellarIDsProposal) GetCellarMetadata() []CellarMetadataEntry {
+	if m != nil {
+		return m.CellarMetadata
+	}
+	return nil
+}
+
 // AddAxelarManagedCellarIDsProposalWithDeposit is a specific definition for CLI commands
 type AddAxelarManagedCellarIDsProposalWithDeposit struct {
-	Title           string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ChainId         uint64   `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	CellarIds       []string `protobuf:"bytes,4,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
-	PublisherDomain string   `protobuf:"bytes,5,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
-	Deposit         string   `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Title           string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId         uint64                `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarIds       []string              `protobuf:"bytes,4,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	PublisherDomain string                `protobuf:"bytes,5,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	Deposit         string                `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	CellarMetadata  []CellarMetadataEntry `protobuf:"bytes,7,rep,name=cellar_metadata,json=cellarMetadata,proto3" json:"cellar_metadata"`
 }
 
 func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Reset() {
@@ -189,6 +199,13 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) GetCellarMetadata() []CellarMetadataEntry {
+	if m != nil {
+		return m.CellarMetadata
+	}
+	return nil
+}
+
 type RemoveAxelarManagedCellarIDsProposal struct {
 	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -362,10 +379,16 @@ type AxelarScheduledCorkProposal struct {
 	//
 	// You can use the Steward CLI to generate the required JSON rather than constructing it by hand
 	// https://github.com/peggyjv/steward
+	//
+	// When the proposal passes, the cellar call is ABI encoded by the contract call encoder registered for its
+	// cellar type unless encoded_contract_call is set. The proposal fails to execute if no encoder is registered for the
+	// cellar type. If block_height has already passed, the proposal is only a signal for Steward to act on.
 	ContractCallProtoJson string `protobuf:"bytes,6,opt,name=contract_call_proto_json,json=contractCallProtoJson,proto3" json:"contract_call_proto_json,omitempty"`
 	// unix timestamp before which the contract call must be executed.
 	// enforced by the Axelar proxy contract
 	Deadline uint64 `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// ABI encoded contract call made relayable at block_height, optional when contract_call_proto_json can be encoded
+	EncodedContractCall []byte `protobuf:"bytes,8,opt,name=encoded_contract_call,json=encodedContractCall,proto3" json:"encoded_contract_call,omitempty"`
 }
 
 func (m *AxelarScheduledCorkProposal) Reset()         { *m = AxelarScheduledCorkProposal{} }
@@ -450,6 +473,13 @@ func (m *AxelarScheduledCorkProposal) GetDeadline() uint64 {
 	return 0
 }
 
+func (m *AxelarScheduledCorkProposal) GetEncodedContractCall() []byte {
+	if m != nil {
+		return m.EncodedContractCall
+	}
+	return nil
+}
+
 // AxelarScheduledCorkProposalWithDeposit is a specific definition for CLI commands
 type AxelarScheduledCorkProposalWithDeposit struct {
 	Title                 string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -460,6 +490,8 @@ type AxelarScheduledCorkProposalWithDeposit struct {
 	ContractCallProtoJson string `protobuf:"bytes,6,opt,name=contract_call_proto_json,json=contractCallProtoJson,proto3" json:"contract_call_proto_json,omitempty"`
 	Deadline              uint64 `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Deposit               string `protobuf:"bytes,8,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// 0x prefixed, hex encoded contract call
+	EncodedContractCall string `protobuf:"bytes,9,opt,name=encoded_contract_call,json=encodedContractCall,proto3" json:"encoded_contract_call,omitempty"`
 }
 
 func (m *AxelarScheduledCorkProposalWithDeposit) Reset() {
@@ -553,6 +585,13 @@ func (m *AxelarScheduledCorkProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
+func (m *AxelarScheduledCorkProposalWithDeposit) GetEncodedContractCall() string {
+	if m != nil {
+		return m.EncodedContractCall
+	}
+	return ""
+}
+
 type AxelarCommunityPoolSpendProposal struct {
 	Title       string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -1219,448 +1258,829 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) GetDeposit() strin
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AddAxelarManagedCellarIDsProposal)(nil), "axelarcork.v1.AddAxelarManagedCellarIDsProposal")
-	proto.RegisterType((*AddAxelarManagedCellarIDsProposalWithDeposit)(nil), "axelarcork.v1.AddAxelarManagedCellarIDsProposalWithDeposit")
-	proto.RegisterType((*RemoveAxelarManagedCellarIDsProposal)(nil), "axelarcork.v1.RemoveAxelarManagedCellarIDsProposal")
-	proto.RegisterType((*RemoveAxelarManagedCellarIDsProposalWithDeposit)(nil), "axelarcork.v1.RemoveAxelarManagedCellarIDsProposalWithDeposit")
-	proto.RegisterType((*AxelarScheduledCorkProposal)(nil), "axelarcork.v1.AxelarScheduledCorkProposal")
-	proto.RegisterType((*AxelarScheduledCorkProposalWithDeposit)(nil), "axelarcork.v1.AxelarScheduledCorkProposalWithDeposit")
-	proto.RegisterType((*AxelarCommunityPoolSpendProposal)(nil), "axelarcork.v1.AxelarCommunityPoolSpendProposal")
-	proto.RegisterType((*AxelarCommunityPoolSpendProposalForCLI)(nil), "axelarcork.v1.AxelarCommunityPoolSpendProposalForCLI")
-	proto.RegisterType((*AddChainConfigurationProposal)(nil), "axelarcork.v1.AddChainConfigurationProposal")
-	proto.RegisterType((*AddChainConfigurationProposalWithDeposit)(nil), "axelarcork.v1.AddChainConfigurationProposalWithDeposit")
-	proto.RegisterType((*RemoveChainConfigurationProposal)(nil), "axelarcork.v1.RemoveChainConfigurationProposal")
-	proto.RegisterType((*RemoveChainConfigurationProposalWithDeposit)(nil), "axelarcork.v1.RemoveChainConfigurationProposalWithDeposit")
-	proto.RegisterType((*UpgradeAxelarProxyContractProposal)(nil), "axelarcork.v1.UpgradeAxelarProxyContractProposal")
-	proto.RegisterType((*UpgradeAxelarProxyContractProposalWithDeposit)(nil), "axelarcork.v1.UpgradeAxelarProxyContractProposalWithDeposit")
-	proto.RegisterType((*CancelAxelarProxyContractUpgradeProposal)(nil), "axelarcork.v1.CancelAxelarProxyContractUpgradeProposal")
-	proto.RegisterType((*CancelAxelarProxyContractUpgradeProposalWithDeposit)(nil), "axelarcork.v1.CancelAxelarProxyContractUpgradeProposalWithDeposit")
+// Replaces the function selectors validators may call on a cellar. An empty selector list removes the
+// allowlist, allowing any function to be called.
+type SetAxelarCellarSelectorAllowlistProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64   `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string   `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Selectors   []string `protobuf:"bytes,5,rep,name=selectors,proto3" json:"selectors,omitempty"`
 }
 
-func init() { proto.RegisterFile("axelarcork/v1/proposal.proto", fileDescriptor_5ffc5027adef0afd) }
-
-var fileDescriptor_5ffc5027adef0afd = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0xce, 0x0f, 0x8f, 0x53, 0x92, 0x6e, 0x53, 0xd8, 0x86, 0xd6, 0xeb, 0xac, 0x50,
-	0xe5, 0x42, 0xd9, 0x55, 0x5c, 0x89, 0x40, 0x6e, 0xf1, 0x46, 0x88, 0xa0, 0x22, 0x45, 0x8e, 0x10,
-	0x12, 0x17, 0x6b, 0xbc, 0x33, 0xac, 0xa7, 0xd9, 0x9d, 0x59, 0xcd, 0xae, 0xdd, 0xf8, 0xc0, 0xbd,
-	0x47, 0x8e, 0x88, 0x93, 0x05, 0x47, 0xae, 0x5c, 0x39, 0x22, 0xe5, 0x18, 0x6e, 0x08, 0x21, 0x0b,
-	0x25, 0x42, 0xe2, 0xec, 0xbf, 0x00, 0x79, 0x66, 0xed, 0xec, 0x26, 0x8d, 0x53, 0x48, 0xad, 0x88,
-	0xde, 0x3c, 0xef, 0x7b, 0xbb, 0xf3, 0x7d, 0xdf, 0xbc, 0x7d, 0x6f, 0x0c, 0xef, 0xa1, 0x03, 0xe2,
-	0x23, 0xe1, 0x72, 0xb1, 0x6f, 0x77, 0xd6, 0xed, 0x50, 0xf0, 0x90, 0x47, 0xc8, 0xb7, 0x42, 0xc1,
-	0x63, 0xae, 0xdd, 0x3c, 0x45, 0xad, 0xce, 0xfa, 0x6a, 0x29, 0x9b, 0x9c, 0x02, 0x65, 0xfa, 0xea,
-	0x8a, 0xc7, 0x3d, 0x2e, 0x7f, 0xda, 0xc3, 0x5f, 0x49, 0xb4, 0xe4, 0xf2, 0x28, 0xe0, 0x91, 0xdd,
-	0x44, 0x11, 0xb1, 0x3b, 0xeb, 0x4d, 0x12, 0xa3, 0x75, 0xdb, 0xe5, 0x94, 0x29, 0xdc, 0xfc, 0x03,
-	0xc0, 0xb5, 0x2d, 0x8c, 0xb7, 0xe4, 0xdb, 0x3e, 0x43, 0x0c, 0x79, 0x04, 0x3b, 0xc4, 0xf7, 0x91,
-	0xd8, 0xd9, 0x8e, 0x76, 0x13, 0x42, 0xda, 0x0a, 0x9c, 0x8d, 0x69, 0xec, 0x13, 0x1d, 0x94, 0x41,
-	0xa5, 0x50, 0x57, 0x0b, 0xad, 0x0c, 0x8b, 0x98, 0x44, 0xae, 0xa0, 0x61, 0x4c, 0x39, 0xd3, 0x67,
-	0x24, 0x96, 0x0e, 0x69, 0x77, 0xe1, 0x82, 0xdb, 0x42, 0x94, 0x35, 0x28, 0xd6, 0x6f, 0x94, 0x41,
-	0x25, 0x5f, 0x9f, 0x97, 0xeb, 0x1d, 0xac, 0x7d, 0x04, 0xa1, 0x2b, 0xf7, 0x69, 0x50, 0x1c, 0xe9,
-	0xf9, 0x32, 0xa8, 0x14, 0xab, 0xab, 0x56, 0x46, 0xb2, 0x35, 0x22, 0xb2, 0x47, 0xe2, 0x7a, 0x41,
-	0x65, 0xef, 0xe0, 0x48, 0x7b, 0x08, 0x97, 0xc3, 0x76, 0xd3, 0xa7, 0x51, 0x8b, 0x88, 0x06, 0xe6,
-	0x01, 0xa2, 0x4c, 0x9f, 0x95, 0x9b, 0x2f, 0x8d, 0xe3, 0xdb, 0x32, 0x6c, 0xfe, 0x05, 0xe0, 0xa3,
-	0x4b, 0xe5, 0x7d, 0x41, 0xe3, 0xd6, 0x36, 0x09, 0x79, 0x44, 0xe3, 0x69, 0x28, 0xbd, 0x7f, 0x46,
-	0xe9, 0x8d, 0x4a, 0xe1, 0xbf, 0xa9, 0xd1, 0x74, 0x38, 0x8f, 0x15, 0x4f, 0x7d, 0x4e, 0x66, 0x8c,
-	0x96, 0xe6, 0x4f, 0x00, 0xbe, 0x53, 0x27, 0x01, 0xef, 0x90, 0xff, 0xd3, 0x49, 0x9a, 0x3f, 0x03,
-	0x68, 0xbf, 0x0c, 0xed, 0xeb, 0x3d, 0xa1, 0x94, 0xed, 0xb3, 0x59, 0xdb, 0x7b, 0x33, 0xf0, 0x6d,
-	0xc5, 0x7c, 0xcf, 0x6d, 0x11, 0xdc, 0xf6, 0x09, 0x76, 0xb8, 0xd8, 0xbf, 0xb2, 0xdb, 0x6b, 0x70,
-	0xb1, 0xe9, 0x73, 0x77, 0xbf, 0xd1, 0x22, 0xd4, 0x6b, 0xc5, 0x09, 0xdf, 0xa2, 0x8c, 0x7d, 0x22,
-	0x43, 0x19, 0x39, 0xf9, 0xac, 0x9c, 0x0f, 0xe0, 0x5b, 0x31, 0x12, 0x1e, 0x89, 0x1b, 0x2e, 0x67,
-	0xb1, 0x40, 0x6e, 0xdc, 0x40, 0x18, 0x0b, 0x12, 0x45, 0x09, 0xff, 0x3b, 0x0a, 0x76, 0x12, 0x74,
-	0x4b, 0x81, 0xda, 0x06, 0xd4, 0xc7, 0x0f, 0xb8, 0xc8, 0xf7, 0x1b, 0xb2, 0x45, 0x34, 0x9e, 0x46,
-	0x9c, 0x25, 0xf5, 0x76, 0x67, 0x84, 0x3b, 0xc8, 0xf7, 0x77, 0x87, 0xe8, 0xa7, 0x11, 0x67, 0xda,
-	0x2a, 0x5c, 0xc0, 0x04, 0x61, 0x9f, 0x32, 0xa2, 0xcf, 0x4b, 0x2e, 0xe3, 0xb5, 0xf9, 0xcb, 0x0c,
-	0x7c, 0x30, 0xc1, 0xa2, 0x57, 0x71, 0xb2, 0xaf, 0x8f, 0x5b, 0xe9, 0x52, 0x5b, 0xc8, 0x96, 0xda,
-	0xef, 0x00, 0x96, 0x95, 0x8f, 0x0e, 0x0f, 0x82, 0x36, 0xa3, 0x71, 0x77, 0x97, 0x73, 0x7f, 0x2f,
-	0x24, 0x0c, 0x5f, 0xb9, 0xde, 0xee, 0xc1, 0x82, 0x20, 0x2e, 0x0d, 0x29, 0x61, 0xca, 0xbe, 0x42,
-	0xfd, 0x34, 0x30, 0xc9, 0xbc, 0x0d, 0x38, 0x87, 0x02, 0xde, 0x66, 0xea, 0xcb, 0x28, 0x56, 0xef,
-	0x5a, 0x6a, 0xde, 0x58, 0xc3, 0x79, 0x63, 0x25, 0xf3, 0xc6, 0x72, 0x38, 0x65, 0xb5, 0xfc, 0x61,
-	0xdf, 0xc8, 0xd5, 0x93, 0xf4, 0xcd, 0xc5, 0xe7, 0x3d, 0x03, 0x7c, 0xdb, 0x33, 0xc0, 0xdf, 0x3d,
-	0x23, 0x67, 0xfe, 0x3a, 0x2e, 0x92, 0x8b, 0xc5, 0x7d, 0xcc, 0x85, 0xf3, 0x64, 0x47, 0x7b, 0x90,
-	0x91, 0x58, 0x5b, 0x1e, 0xf4, 0x8d, 0xc5, 0x2e, 0x0a, 0xfc, 0x4d, 0x53, 0x86, 0xcd, 0x91, 0xe8,
-	0x0f, 0x5f, 0x20, 0xba, 0xf6, 0xe6, 0xa0, 0x6f, 0x68, 0x2a, 0x3b, 0x05, 0x9a, 0x59, 0x33, 0xaa,
-	0xe7, 0xcc, 0xa8, 0xad, 0x0c, 0xfa, 0xc6, 0xb2, 0x7a, 0x6e, 0x0c, 0x99, 0x69, 0x8b, 0xac, 0xb3,
-	0x16, 0xd5, 0x6e, 0x0f, 0xfa, 0xc6, 0x92, 0x7a, 0x64, 0x84, 0x98, 0xa7, 0xbe, 0x3d, 0xcc, 0xf8,
-	0x56, 0xa8, 0xdd, 0x1a, 0xf4, 0x8d, 0x9b, 0x2a, 0x5b, 0xc5, 0xcd, 0x91, 0x53, 0xda, 0xa3, 0x33,
-	0x4d, 0xbf, 0xa6, 0x0d, 0xfa, 0xc6, 0x1b, 0x23, 0x11, 0xaa, 0x38, 0xc6, 0x65, 0xb2, 0xb9, 0xf0,
-	0xbc, 0x67, 0xe4, 0x86, 0xbe, 0x9a, 0x3f, 0x02, 0x78, 0x7f, 0x0b, 0x63, 0x67, 0xb8, 0xa3, 0xc3,
-	0xd9, 0x57, 0xd4, 0x6b, 0x0b, 0x34, 0x14, 0x78, 0xe5, 0x6a, 0xa9, 0xc3, 0xdb, 0x4a, 0x92, 0x9b,
-	0x7e, 0xad, 0xb4, 0xaa, 0x58, 0x5d, 0x3b, 0xdb, 0xf9, 0xcf, 0xed, 0x5f, 0xd7, 0xdc, 0x73, 0x31,
-	0xf3, 0x08, 0xc0, 0xca, 0x44, 0xb6, 0xaf, 0xa2, 0x51, 0x4c, 0x81, 0x78, 0xfa, 0x8b, 0xcd, 0x67,
-	0xbf, 0xd8, 0x36, 0x2c, 0xab, 0xd9, 0x36, 0x85, 0x23, 0xb8, 0x78, 0x98, 0x99, 0xdf, 0x01, 0xf8,
-	0xde, 0x65, 0xfb, 0x4e, 0x79, 0x9e, 0x5e, 0xec, 0xc9, 0x0f, 0x00, 0x9a, 0x9f, 0x87, 0x9e, 0x40,
-	0x38, 0x99, 0xf8, 0xbb, 0x82, 0x1f, 0x74, 0x47, 0xad, 0x75, 0x9a, 0xb7, 0x94, 0x77, 0xe1, 0x2d,
-	0x46, 0x9e, 0x0d, 0x9b, 0xf4, 0x41, 0x77, 0xdc, 0xe0, 0x15, 0xbb, 0x25, 0x46, 0x9e, 0x49, 0x1e,
-	0x49, 0x6b, 0x37, 0x0f, 0x01, 0x7c, 0xff, 0x72, 0x96, 0x53, 0x36, 0xf1, 0x5f, 0x10, 0x9e, 0x70,
-	0x43, 0xf9, 0x1a, 0x56, 0x1c, 0xc4, 0x5c, 0xe2, 0xbf, 0x40, 0x48, 0x22, 0x71, 0x9a, 0xc5, 0xf8,
-	0x3d, 0x80, 0x8f, 0x5f, 0x76, 0xff, 0xeb, 0x2a, 0xca, 0xda, 0x93, 0xc3, 0xe3, 0x12, 0x38, 0x3a,
-	0x2e, 0x81, 0x3f, 0x8f, 0x4b, 0xe0, 0x9b, 0x93, 0x52, 0xee, 0xe8, 0xa4, 0x94, 0xfb, 0xed, 0xa4,
-	0x94, 0xfb, 0xb2, 0xea, 0xd1, 0xb8, 0xd5, 0x6e, 0x5a, 0x2e, 0x0f, 0xec, 0x90, 0x78, 0x5e, 0xf7,
-	0x69, 0xc7, 0x8e, 0x78, 0x10, 0x10, 0x9f, 0x12, 0x61, 0x77, 0x36, 0xec, 0x83, 0xd4, 0xff, 0x30,
-	0x3b, 0xee, 0x86, 0x24, 0x6a, 0xce, 0xc9, 0x7b, 0xc0, 0xe3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x3a, 0x37, 0xd0, 0x38, 0xdd, 0x0d, 0x00, 0x00,
+func (m *SetAxelarCellarSelectorAllowlistProposal) Reset() {
+	*m = SetAxelarCellarSelectorAllowlistProposal{}
 }
-
-func (m *AddAxelarManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarSelectorAllowlistProposal) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarSelectorAllowlistProposal) ProtoMessage()    {}
+func (*SetAxelarCellarSelectorAllowlistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{16}
+}
+func (m *SetAxelarCellarSelectorAllowlistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarSelectorAllowlistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AddAxelarManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetAxelarCellarSelectorAllowlistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposal.Merge(m, src)
+}
+func (m *SetAxelarCellarSelectorAllowlistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarSelectorAllowlistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposal.DiscardUnknown(m)
 }
 
-func (m *AddAxelarManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublisherDomain) > 0 {
-		i -= len(m.PublisherDomain)
-		copy(dAtA[i:], m.PublisherDomain)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CellarIds != nil {
-		{
-			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposal proto.InternalMessageInfo
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarSelectorAllowlistProposal) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddAxelarManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetAxelarCellarSelectorAllowlistProposalWithDeposit struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64   `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string   `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Selectors   []string `protobuf:"bytes,5,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	Deposit     string   `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (m *AddAxelarManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PublisherDomain) > 0 {
-		i -= len(m.PublisherDomain)
-		copy(dAtA[i:], m.PublisherDomain)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) Reset() {
+	*m = SetAxelarCellarSelectorAllowlistProposalWithDeposit{}
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SetAxelarCellarSelectorAllowlistProposalWithDeposit) ProtoMessage() {}
+func (*SetAxelarCellarSelectorAllowlistProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{17}
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposalWithDeposit.Merge(m, src)
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarSelectorAllowlistProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CellarIds != nil {
-		{
-			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+// Pauses or resumes a cellar. Scheduled corks for a paused cellar are neither accepted nor made relayable.
+type SetAxelarCellarPausedProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused      bool   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *SetAxelarCellarPausedProposal) Reset()         { *m = SetAxelarCellarPausedProposal{} }
+func (m *SetAxelarCellarPausedProposal) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarPausedProposal) ProtoMessage()    {}
+func (*SetAxelarCellarPausedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{18}
+}
+func (m *SetAxelarCellarPausedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarPausedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarPausedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetAxelarCellarPausedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarPausedProposal.Merge(m, src)
+}
+func (m *SetAxelarCellarPausedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarPausedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarPausedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarPausedProposal proto.InternalMessageInfo
+
+func (m *SetAxelarCellarPausedProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetAxelarCellarPausedProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarPausedProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetAxelarCellarPausedProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
+func (m *SetAxelarCellarPausedProposal) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return false
+}
+
+type SetAxelarCellarPausedProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused      bool   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	Deposit     string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) Reset() {
+	*m = SetAxelarCellarPausedProposalWithDeposit{}
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarPausedProposalWithDeposit) ProtoMessage()    {}
+func (*SetAxelarCellarPausedProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{19}
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarPausedProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarPausedProposalWithDeposit.Merge(m, src)
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarPausedProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarPausedProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarPausedProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AxelarScheduledCorkProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AxelarScheduledCorkProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func (m *AxelarScheduledCorkProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Deadline != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Deadline))
-		i--
-		dAtA[i] = 0x38
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if len(m.ContractCallProtoJson) > 0 {
-		i -= len(m.ContractCallProtoJson)
-		copy(dAtA[i:], m.ContractCallProtoJson)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
-		i--
-		dAtA[i] = 0x32
+	return false
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+// SetAxelarCellarABIProposal registers the contract ABI used to decode the contract calls of corks targeting a
+// cellar. An empty ABI removes the cellar's registered ABI.
+type SetAxelarCellarABIProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// JSON encoded contract ABI
+	Abi string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *SetAxelarCellarABIProposal) Reset()         { *m = SetAxelarCellarABIProposal{} }
+func (m *SetAxelarCellarABIProposal) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarABIProposal) ProtoMessage()    {}
+func (*SetAxelarCellarABIProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{20}
+}
+func (m *SetAxelarCellarABIProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarABIProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarABIProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *SetAxelarCellarABIProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarABIProposal.Merge(m, src)
+}
+func (m *SetAxelarCellarABIProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarABIProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarABIProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarABIProposal proto.InternalMessageInfo
+
+func (m *SetAxelarCellarABIProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SetAxelarCellarABIProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AxelarScheduledCorkProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetAxelarCellarABIProposal) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AxelarScheduledCorkProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetAxelarCellarABIProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Abi         string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	Deposit     string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (m *AxelarScheduledCorkProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (m *SetAxelarCellarABIProposalWithDeposit) Reset()         { *m = SetAxelarCellarABIProposalWithDeposit{} }
+func (m *SetAxelarCellarABIProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarABIProposalWithDeposit) ProtoMessage()    {}
+func (*SetAxelarCellarABIProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{21}
+}
+func (m *SetAxelarCellarABIProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarABIProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarABIProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAxelarCellarABIProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarABIProposalWithDeposit.Merge(m, src)
+}
+func (m *SetAxelarCellarABIProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarABIProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarABIProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarABIProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
+// SetAxelarCellarMetadataProposal replaces the metadata of a managed cellar
+type SetAxelarCellarMetadataProposal struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64         `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string         `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata    CellarMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *SetAxelarCellarMetadataProposal) Reset()         { *m = SetAxelarCellarMetadataProposal{} }
+func (m *SetAxelarCellarMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*SetAxelarCellarMetadataProposal) ProtoMessage()    {}
+func (*SetAxelarCellarMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{22}
+}
+func (m *SetAxelarCellarMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAxelarCellarMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarMetadataProposal.Merge(m, src)
+}
+func (m *SetAxelarCellarMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarMetadataProposal proto.InternalMessageInfo
+
+func (m *SetAxelarCellarMetadataProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SetAxelarCellarMetadataProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposal) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return CellarMetadata{}
+}
+
+type SetAxelarCellarMetadataProposalWithDeposit struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     uint64         `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId    string         `protobuf:"bytes,4,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata    CellarMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
+	Deposit     string         `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) Reset() {
+	*m = SetAxelarCellarMetadataProposalWithDeposit{}
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SetAxelarCellarMetadataProposalWithDeposit) ProtoMessage() {}
+func (*SetAxelarCellarMetadataProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffc5027adef0afd, []int{23}
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAxelarCellarMetadataProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAxelarCellarMetadataProposalWithDeposit.Merge(m, src)
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAxelarCellarMetadataProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAxelarCellarMetadataProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAxelarCellarMetadataProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return CellarMetadata{}
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AddAxelarManagedCellarIDsProposal)(nil), "axelarcork.v1.AddAxelarManagedCellarIDsProposal")
+	proto.RegisterType((*AddAxelarManagedCellarIDsProposalWithDeposit)(nil), "axelarcork.v1.AddAxelarManagedCellarIDsProposalWithDeposit")
+	proto.RegisterType((*RemoveAxelarManagedCellarIDsProposal)(nil), "axelarcork.v1.RemoveAxelarManagedCellarIDsProposal")
+	proto.RegisterType((*RemoveAxelarManagedCellarIDsProposalWithDeposit)(nil), "axelarcork.v1.RemoveAxelarManagedCellarIDsProposalWithDeposit")
+	proto.RegisterType((*AxelarScheduledCorkProposal)(nil), "axelarcork.v1.AxelarScheduledCorkProposal")
+	proto.RegisterType((*AxelarScheduledCorkProposalWithDeposit)(nil), "axelarcork.v1.AxelarScheduledCorkProposalWithDeposit")
+	proto.RegisterType((*AxelarCommunityPoolSpendProposal)(nil), "axelarcork.v1.AxelarCommunityPoolSpendProposal")
+	proto.RegisterType((*AxelarCommunityPoolSpendProposalForCLI)(nil), "axelarcork.v1.AxelarCommunityPoolSpendProposalForCLI")
+	proto.RegisterType((*AddChainConfigurationProposal)(nil), "axelarcork.v1.AddChainConfigurationProposal")
+	proto.RegisterType((*AddChainConfigurationProposalWithDeposit)(nil), "axelarcork.v1.AddChainConfigurationProposalWithDeposit")
+	proto.RegisterType((*RemoveChainConfigurationProposal)(nil), "axelarcork.v1.RemoveChainConfigurationProposal")
+	proto.RegisterType((*RemoveChainConfigurationProposalWithDeposit)(nil), "axelarcork.v1.RemoveChainConfigurationProposalWithDeposit")
+	proto.RegisterType((*UpgradeAxelarProxyContractProposal)(nil), "axelarcork.v1.UpgradeAxelarProxyContractProposal")
+	proto.RegisterType((*UpgradeAxelarProxyContractProposalWithDeposit)(nil), "axelarcork.v1.UpgradeAxelarProxyContractProposalWithDeposit")
+	proto.RegisterType((*CancelAxelarProxyContractUpgradeProposal)(nil), "axelarcork.v1.CancelAxelarProxyContractUpgradeProposal")
+	proto.RegisterType((*CancelAxelarProxyContractUpgradeProposalWithDeposit)(nil), "axelarcork.v1.CancelAxelarProxyContractUpgradeProposalWithDeposit")
+	proto.RegisterType((*SetAxelarCellarSelectorAllowlistProposal)(nil), "axelarcork.v1.SetAxelarCellarSelectorAllowlistProposal")
+	proto.RegisterType((*SetAxelarCellarSelectorAllowlistProposalWithDeposit)(nil), "axelarcork.v1.SetAxelarCellarSelectorAllowlistProposalWithDeposit")
+	proto.RegisterType((*SetAxelarCellarPausedProposal)(nil), "axelarcork.v1.SetAxelarCellarPausedProposal")
+	proto.RegisterType((*SetAxelarCellarPausedProposalWithDeposit)(nil), "axelarcork.v1.SetAxelarCellarPausedProposalWithDeposit")
+	proto.RegisterType((*SetAxelarCellarABIProposal)(nil), "axelarcork.v1.SetAxelarCellarABIProposal")
+	proto.RegisterType((*SetAxelarCellarABIProposalWithDeposit)(nil), "axelarcork.v1.SetAxelarCellarABIProposalWithDeposit")
+	proto.RegisterType((*SetAxelarCellarMetadataProposal)(nil), "axelarcork.v1.SetAxelarCellarMetadataProposal")
+	proto.RegisterType((*SetAxelarCellarMetadataProposalWithDeposit)(nil), "axelarcork.v1.SetAxelarCellarMetadataProposalWithDeposit")
+}
+
+func init() { proto.RegisterFile("axelarcork/v1/proposal.proto", fileDescriptor_5ffc5027adef0afd) }
+
+var fileDescriptor_5ffc5027adef0afd = []byte{
+	// 1193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x31, 0x6c, 0x23, 0x45,
+	0x14, 0xf5, 0x24, 0xb9, 0xc4, 0xfe, 0xce, 0x5d, 0x72, 0x7b, 0x97, 0xc3, 0x97, 0xbb, 0x78, 0x9d,
+	0x11, 0x9c, 0x7c, 0xc7, 0xe1, 0x55, 0x1c, 0x89, 0x40, 0x1a, 0x64, 0x3b, 0x20, 0x82, 0xee, 0xa4,
+	0xe0, 0x08, 0x21, 0xd1, 0x58, 0xe3, 0x9d, 0xc1, 0x9e, 0xcb, 0x7a, 0x67, 0xb5, 0xbb, 0x76, 0xe2,
+	0x82, 0xfe, 0x4a, 0x4a, 0x44, 0x81, 0x22, 0x28, 0x28, 0x28, 0x68, 0x28, 0xae, 0xa1, 0xa2, 0x49,
+	0x41, 0x11, 0x10, 0x05, 0x42, 0xc2, 0x42, 0x49, 0x43, 0xed, 0x06, 0xd1, 0x21, 0xcf, 0xae, 0x37,
+	0x5e, 0x27, 0x76, 0xc2, 0xe5, 0x2c, 0x0b, 0xba, 0x9d, 0xff, 0xff, 0xee, 0xbc, 0xf7, 0xe6, 0xef,
+	0xff, 0x7f, 0x17, 0xee, 0x92, 0x3d, 0x66, 0x10, 0x5b, 0x17, 0xf6, 0x8e, 0xd6, 0x58, 0xd1, 0x2c,
+	0x5b, 0x58, 0xc2, 0x21, 0x46, 0xc6, 0xb2, 0x85, 0x2b, 0x94, 0xab, 0x27, 0xde, 0x4c, 0x63, 0x65,
+	0x31, 0x19, 0x0e, 0xee, 0x71, 0xca, 0xf0, 0xc5, 0x9b, 0x15, 0x51, 0x11, 0xf2, 0x52, 0xeb, 0x5c,
+	0xf9, 0xd6, 0xa4, 0x2e, 0x9c, 0x9a, 0x70, 0xb4, 0x32, 0x71, 0x98, 0xd6, 0x58, 0x29, 0x33, 0x97,
+	0xac, 0x68, 0xba, 0xe0, 0xa6, 0xe7, 0xc7, 0xdf, 0x4e, 0xc0, 0x72, 0x8e, 0xd2, 0x9c, 0x7c, 0xda,
+	0x63, 0x62, 0x92, 0x0a, 0xa3, 0x05, 0x66, 0x18, 0xc4, 0xde, 0xdc, 0x70, 0xb6, 0x7c, 0x40, 0xca,
+	0x4d, 0xb8, 0xe2, 0x72, 0xd7, 0x60, 0x09, 0x94, 0x42, 0xe9, 0x58, 0xd1, 0x5b, 0x28, 0x29, 0x88,
+	0x53, 0xe6, 0xe8, 0x36, 0xb7, 0x5c, 0x2e, 0xcc, 0xc4, 0x84, 0xf4, 0xf5, 0x9a, 0x94, 0xdb, 0x10,
+	0xd5, 0xab, 0x84, 0x9b, 0x25, 0x4e, 0x13, 0x93, 0x29, 0x94, 0x9e, 0x2a, 0xce, 0xc8, 0xf5, 0x26,
+	0x55, 0xde, 0x04, 0xd0, 0xe5, 0x3e, 0x25, 0x4e, 0x9d, 0xc4, 0x54, 0x0a, 0xa5, 0xe3, 0xd9, 0xc5,
+	0x4c, 0x88, 0x72, 0xa6, 0x0b, 0x64, 0x9b, 0xb9, 0xc5, 0x98, 0x17, 0xbd, 0x49, 0x1d, 0xe5, 0x3e,
+	0xcc, 0x5b, 0xf5, 0xb2, 0xc1, 0x9d, 0x2a, 0xb3, 0x4b, 0x54, 0xd4, 0x08, 0x37, 0x13, 0x57, 0xe4,
+	0xe6, 0x73, 0x81, 0x7d, 0x43, 0x9a, 0x95, 0xf7, 0x61, 0xce, 0xdf, 0xa5, 0xc6, 0x5c, 0x42, 0x89,
+	0x4b, 0x12, 0xd3, 0xa9, 0xc9, 0x74, 0x3c, 0x8b, 0xcf, 0xdc, 0xea, 0xb1, 0x1f, 0xf4, 0xb6, 0xe9,
+	0xda, 0xcd, 0xfc, 0xd4, 0x41, 0x4b, 0x8d, 0x14, 0xaf, 0xe9, 0x21, 0x17, 0x7e, 0x36, 0x01, 0x0f,
+	0xcf, 0x55, 0xec, 0x43, 0xee, 0x56, 0x37, 0x98, 0x25, 0x1c, 0xee, 0x8e, 0x42, 0xbc, 0xa5, 0x3e,
+	0xf1, 0x26, 0xd3, 0xb1, 0xe7, 0x14, 0x28, 0x01, 0x33, 0xd4, 0xc3, 0x99, 0x98, 0x96, 0x11, 0xdd,
+	0xe5, 0x59, 0xd2, 0xcd, 0x5c, 0x52, 0xba, 0xef, 0x10, 0xbc, 0x5c, 0x64, 0x35, 0xd1, 0x60, 0xff,
+	0xa5, 0x7c, 0xc3, 0xdf, 0x23, 0xd0, 0x2e, 0x02, 0x7b, 0xbc, 0x87, 0xde, 0x73, 0x92, 0x57, 0x42,
+	0x27, 0x89, 0x7f, 0x99, 0x80, 0x3b, 0x1e, 0xf2, 0x6d, 0xbd, 0xca, 0x68, 0xdd, 0x60, 0xb4, 0x20,
+	0xec, 0x9d, 0x4b, 0xab, 0xbd, 0x0c, 0xb3, 0x65, 0x43, 0xe8, 0x3b, 0xa5, 0x2a, 0xe3, 0x95, 0xaa,
+	0xeb, 0xe3, 0x8d, 0x4b, 0xdb, 0xbb, 0xd2, 0x14, 0xa2, 0x33, 0x15, 0xa6, 0xf3, 0x3a, 0xbc, 0xe4,
+	0x12, 0xbb, 0xc2, 0xdc, 0x92, 0x2e, 0x4c, 0xd7, 0x26, 0xba, 0x5b, 0x22, 0x94, 0xda, 0xcc, 0x71,
+	0x7c, 0xfc, 0x0b, 0x9e, 0xbb, 0xe0, 0x7b, 0x73, 0x9e, 0x53, 0x59, 0x83, 0x44, 0x70, 0x83, 0x4e,
+	0x0c, 0xa3, 0x24, 0x0b, 0x59, 0xe9, 0x89, 0x23, 0x4c, 0x3f, 0x85, 0x17, 0xba, 0xfe, 0x02, 0x31,
+	0x8c, 0xad, 0x8e, 0xf7, 0x3d, 0x47, 0x98, 0xca, 0x22, 0x44, 0x29, 0x23, 0xd4, 0xe0, 0x26, 0x4b,
+	0xcc, 0x48, 0x2c, 0xc1, 0x5a, 0xc9, 0xc2, 0x02, 0x33, 0x75, 0x41, 0x19, 0x2d, 0x85, 0x1e, 0x9e,
+	0x88, 0xa6, 0x50, 0x7a, 0xb6, 0x78, 0xc3, 0x77, 0x16, 0x7a, 0x1e, 0x8c, 0xff, 0x9e, 0x80, 0x7b,
+	0x43, 0x64, 0x7d, 0x11, 0xd9, 0xf0, 0x3f, 0x52, 0xb8, 0x27, 0x3d, 0xa3, 0xe1, 0x42, 0x33, 0x50,
+	0xfb, 0x98, 0x8c, 0x3b, 0x53, 0xfb, 0xdf, 0x10, 0xa4, 0x3c, 0xed, 0x0b, 0xa2, 0x56, 0xab, 0x9b,
+	0xdc, 0x6d, 0x6e, 0x09, 0x61, 0x6c, 0x5b, 0xcc, 0xa4, 0x97, 0xce, 0xeb, 0xbb, 0x10, 0xb3, 0x99,
+	0xce, 0x2d, 0xce, 0x4c, 0x4f, 0xf2, 0x58, 0xf1, 0xc4, 0x30, 0x4c, 0xf0, 0x35, 0x98, 0x26, 0x35,
+	0x51, 0x37, 0xbd, 0x37, 0x30, 0x9e, 0xbd, 0x9d, 0xf1, 0xba, 0x6f, 0xa6, 0xd3, 0x7d, 0x33, 0x7e,
+	0xf7, 0xcd, 0x14, 0x04, 0x37, 0xfd, 0x02, 0xe9, 0x87, 0xaf, 0xcf, 0x3e, 0xdd, 0x57, 0xd1, 0x67,
+	0xfb, 0x2a, 0xfa, 0x73, 0x5f, 0x8d, 0xe0, 0x9f, 0x82, 0xc4, 0x1a, 0x4c, 0xee, 0x1d, 0x61, 0x17,
+	0x1e, 0x6d, 0x2a, 0xf7, 0x42, 0x14, 0xf3, 0xf3, 0xed, 0x96, 0x3a, 0xdb, 0x24, 0x35, 0x63, 0x1d,
+	0x4b, 0x33, 0xee, 0x92, 0x7e, 0xe3, 0x0c, 0xd2, 0xf9, 0x5b, 0xed, 0x96, 0xaa, 0x78, 0xd1, 0x3d,
+	0x4e, 0x1c, 0x16, 0x23, 0x7b, 0x4a, 0x8c, 0xfc, 0xcd, 0x76, 0x4b, 0x9d, 0xf7, 0xee, 0x0b, 0x5c,
+	0xb8, 0x57, 0xa2, 0x4c, 0xbf, 0x44, 0xf9, 0x1b, 0xed, 0x96, 0x3a, 0xe7, 0xdd, 0xd2, 0xf5, 0xe0,
+	0x13, 0xdd, 0xee, 0x87, 0x74, 0x8b, 0xe5, 0xaf, 0xb7, 0x5b, 0xea, 0x55, 0x2f, 0xda, 0xb3, 0xe3,
+	0xae, 0x52, 0xca, 0xc3, 0xbe, 0x7e, 0x95, 0x57, 0xda, 0x2d, 0xf5, 0x5a, 0x97, 0x84, 0x57, 0xef,
+	0x82, 0xd4, 0x5a, 0x8f, 0x3e, 0xdd, 0x57, 0x23, 0x1d, 0x5d, 0xf1, 0x37, 0x08, 0x96, 0x72, 0x94,
+	0x16, 0x3a, 0x3b, 0x16, 0x84, 0xf9, 0x31, 0xaf, 0xd4, 0x6d, 0xd2, 0x21, 0x78, 0xe9, 0x6c, 0x29,
+	0xc2, 0x0d, 0x8f, 0x92, 0xde, 0xfb, 0x58, 0x29, 0x55, 0x3c, 0xbb, 0xdc, 0xdf, 0x61, 0x4e, 0xed,
+	0x5f, 0x54, 0xf4, 0x53, 0x36, 0x7c, 0x88, 0x20, 0x3d, 0x14, 0xed, 0x8b, 0x28, 0x2e, 0x23, 0x00,
+	0xde, 0xfb, 0x96, 0x4f, 0x85, 0x9b, 0x50, 0x1d, 0x52, 0x5e, 0x0f, 0x1d, 0xc1, 0x11, 0x0c, 0x6e,
+	0x9a, 0xf8, 0x73, 0x04, 0xaf, 0x9e, 0xb7, 0xef, 0x88, 0xfb, 0xf6, 0x60, 0x4d, 0xbe, 0x42, 0x80,
+	0x3f, 0xb0, 0x2a, 0x36, 0xa1, 0xfe, 0x64, 0xb1, 0x65, 0x8b, 0xbd, 0x66, 0xb7, 0xd0, 0x8d, 0x72,
+	0x1a, 0x7a, 0x00, 0xd7, 0x4d, 0xb6, 0xdb, 0x29, 0xec, 0x7b, 0xcd, 0xa0, 0x29, 0x78, 0xe8, 0xe6,
+	0x4c, 0xb6, 0x2b, 0x71, 0xf8, 0xed, 0x00, 0x1f, 0x20, 0x78, 0xed, 0x7c, 0x94, 0x23, 0x16, 0xf1,
+	0x5f, 0x00, 0x1e, 0x32, 0x09, 0x7d, 0x02, 0xe9, 0x02, 0x31, 0x75, 0x66, 0x9c, 0x41, 0xc4, 0xa7,
+	0x38, 0xca, 0x64, 0xfc, 0x12, 0xc1, 0xea, 0x45, 0xf7, 0x1f, 0x5b, 0x52, 0x3e, 0x43, 0x90, 0xde,
+	0x66, 0xae, 0xdf, 0x80, 0xe4, 0x78, 0xb9, 0xcd, 0x0c, 0xa6, 0xbb, 0xc2, 0xce, 0x19, 0x86, 0xd8,
+	0x35, 0xb8, 0x33, 0xd2, 0xd4, 0xbc, 0x03, 0xb1, 0x60, 0xcc, 0xf5, 0xb1, 0x45, 0xbb, 0x53, 0x6e,
+	0xa7, 0x35, 0x3b, 0x3e, 0x98, 0xce, 0x10, 0x23, 0x47, 0xe0, 0xc0, 0x80, 0x7f, 0x47, 0xb0, 0x7a,
+	0x51, 0xe8, 0x23, 0xd6, 0xf7, 0xf9, 0x59, 0x0c, 0xfe, 0x24, 0xc3, 0x5f, 0x23, 0x58, 0xea, 0xe3,
+	0xb7, 0x45, 0xea, 0x0e, 0xa3, 0x63, 0x3b, 0x8f, 0x5b, 0x30, 0x6d, 0x49, 0x04, 0xf2, 0x4d, 0x8b,
+	0x16, 0xfd, 0x15, 0xfe, 0xf1, 0x74, 0x12, 0x85, 0x91, 0x8e, 0x53, 0xfe, 0x01, 0xa0, 0x87, 0x08,
+	0xff, 0x05, 0x82, 0xc5, 0x3e, 0x3a, 0xb9, 0xfc, 0xe6, 0xd8, 0x54, 0x9f, 0x87, 0x49, 0x52, 0xe6,
+	0x7e, 0x71, 0xeb, 0x5c, 0xe2, 0x1f, 0x10, 0xbc, 0x32, 0x18, 0xe0, 0x38, 0xc5, 0x3e, 0x85, 0x75,
+	0x88, 0xcc, 0x3f, 0x23, 0x50, 0xfb, 0x58, 0x74, 0xff, 0x1d, 0x8c, 0x4d, 0xeb, 0xb7, 0x20, 0x1a,
+	0xfc, 0xff, 0xf0, 0xa6, 0xfa, 0xa5, 0xa1, 0xff, 0x3f, 0xfc, 0xc9, 0x3e, 0xb8, 0x09, 0xff, 0x85,
+	0xe0, 0xc1, 0x39, 0xa4, 0xc6, 0x79, 0x3e, 0x97, 0xe5, 0x37, 0xf8, 0x38, 0xf3, 0x8f, 0x0e, 0x8e,
+	0x92, 0xe8, 0xf0, 0x28, 0x89, 0xfe, 0x38, 0x4a, 0xa2, 0x4f, 0x8f, 0x93, 0x91, 0xc3, 0xe3, 0x64,
+	0xe4, 0xd7, 0xe3, 0x64, 0xe4, 0xa3, 0x6c, 0x85, 0xbb, 0xd5, 0x7a, 0x39, 0xa3, 0x8b, 0x9a, 0x66,
+	0xb1, 0x4a, 0xa5, 0xf9, 0xa4, 0xa1, 0x39, 0xa2, 0x56, 0x63, 0x06, 0x67, 0xb6, 0xd6, 0x58, 0xd3,
+	0xf6, 0x7a, 0xfe, 0x6f, 0x6a, 0x6e, 0xd3, 0x62, 0x4e, 0x79, 0x5a, 0x7e, 0x85, 0xae, 0xfe, 0x33,
+	0x00, 0x85, 0xa7, 0xa3, 0x57, 0x35, 0x15, 0x00, 0x00,
+}
+
+func (m *AddAxelarManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddAxelarManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddAxelarManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Deadline != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Deadline))
-		i--
-		dAtA[i] = 0x38
+	if len(m.CellarMetadata) > 0 {
+		for iNdEx := len(m.CellarMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.ContractCallProtoJson) > 0 {
-		i -= len(m.ContractCallProtoJson)
-		copy(dAtA[i:], m.ContractCallProtoJson)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
+	if m.CellarIds != nil {
+		{
+			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
@@ -1680,7 +2100,7 @@ func (m *AxelarScheduledCorkProposalWithDeposit) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *AxelarCommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1690,75 +2110,30 @@ func (m *AxelarCommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AxelarCommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AxelarCommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProposal(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AxelarCommunityPoolSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.CellarMetadata) > 0 {
+		for iNdEx := len(m.CellarMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if len(m.Deposit) > 0 {
 		i -= len(m.Deposit)
 		copy(dAtA[i:], m.Deposit)
@@ -1766,24 +2141,26 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byt
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Amount)))
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
 		i--
 		dAtA[i] = 0x2a
 	}
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -1802,7 +2179,7 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *AddChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
+func (m *RemoveAxelarManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1812,19 +2189,19 @@ func (m *AddChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddChainConfigurationProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAxelarManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAxelarManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainConfiguration != nil {
+	if m.CellarIds != nil {
 		{
-			size, err := m.ChainConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1832,7 +2209,12 @@ func (m *AddChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintProposal(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -1851,7 +2233,7 @@ func (m *AddChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *AddChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1861,12 +2243,12 @@ func (m *AddChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *AddChainConfigurationProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1876,19 +2258,21 @@ func (m *AddChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []b
 		copy(dAtA[i:], m.Deposit)
 		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.ChainConfiguration != nil {
-		{
-			size, err := m.ChainConfiguration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -1907,7 +2291,7 @@ func (m *AddChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
+func (m *AxelarScheduledCorkProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1917,19 +2301,50 @@ func (m *RemoveChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveChainConfigurationProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AxelarScheduledCorkProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AxelarScheduledCorkProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContractCallProtoJson) > 0 {
+		i -= len(m.ContractCallProtoJson)
+		copy(dAtA[i:], m.ContractCallProtoJson)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
@@ -1949,7 +2364,7 @@ func (m *RemoveChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *AxelarScheduledCorkProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1959,26 +2374,57 @@ func (m *RemoveChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *RemoveChainConfigurationProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AxelarScheduledCorkProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AxelarScheduledCorkProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Deposit) > 0 {
 		i -= len(m.Deposit)
 		copy(dAtA[i:], m.Deposit)
 		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x42
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContractCallProtoJson) > 0 {
+		i -= len(m.ContractCallProtoJson)
+		copy(dAtA[i:], m.ContractCallProtoJson)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
@@ -1998,7 +2444,7 @@ func (m *RemoveChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeAxelarProxyContractProposal) Marshal() (dAtA []byte, err error) {
+func (m *AxelarCommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2008,27 +2454,37 @@ func (m *UpgradeAxelarProxyContractProposal) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *UpgradeAxelarProxyContractProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AxelarCommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpgradeAxelarProxyContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AxelarCommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewProxyAddress) > 0 {
-		i -= len(m.NewProxyAddress)
-		copy(dAtA[i:], m.NewProxyAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.NewProxyAddress)))
-		i--
-		dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -2047,7 +2503,7 @@ func (m *UpgradeAxelarProxyContractProposal) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeAxelarProxyContractProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *AxelarCommunityPoolSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2057,12 +2513,12 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AxelarCommunityPoolSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2072,19 +2528,26 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalToSizedBuffer(dAt
 		copy(dAtA[i:], m.Deposit)
 		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
 	}
-	if len(m.NewProxyAddress) > 0 {
-		i -= len(m.NewProxyAddress)
-		copy(dAtA[i:], m.NewProxyAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.NewProxyAddress)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Amount)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -2103,7 +2566,7 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposal) Marshal() (dAtA []byte, err error) {
+func (m *AddChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2113,20 +2576,27 @@ func (m *CancelAxelarProxyContractUpgradeProposal) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddChainConfigurationProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+	if m.ChainConfiguration != nil {
+		{
+			size, err := m.ChainConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -2145,7 +2615,7 @@ func (m *CancelAxelarProxyContractUpgradeProposal) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *AddChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2155,12 +2625,12 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddChainConfigurationProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2172,10 +2642,17 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalToSizedBuff
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+	if m.ChainConfiguration != nil {
+		{
+			size, err := m.ChainConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -2194,409 +2671,834 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RemoveChainConfigurationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AddAxelarManagedCellarIDsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RemoveChainConfigurationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveChainConfigurationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CellarIds != nil {
-		l = m.CellarIds.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PublisherDomain)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveChainConfigurationProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveChainConfigurationProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveChainConfigurationProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
-	}
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
-		}
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PublisherDomain)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpgradeAxelarProxyContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeAxelarProxyContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeAxelarProxyContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.NewProxyAddress) > 0 {
+		i -= len(m.NewProxyAddress)
+		copy(dAtA[i:], m.NewProxyAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.NewProxyAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CellarIds != nil {
-		l = m.CellarIds.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpgradeAxelarProxyContractProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeAxelarProxyContractProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.NewProxyAddress) > 0 {
+		i -= len(m.NewProxyAddress)
+		copy(dAtA[i:], m.NewProxyAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.NewProxyAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarScheduledCorkProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelAxelarProxyContractUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovProposal(uint64(m.BlockHeight))
-	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
-	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ContractCallProtoJson)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Deadline != 0 {
-		n += 1 + sovProposal(uint64(m.Deadline))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarScheduledCorkProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovProposal(uint64(m.BlockHeight))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
-	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.ContractCallProtoJson)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Deadline != 0 {
-		n += 1 + sovProposal(uint64(m.Deadline))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarCommunityPoolSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAxelarCellarSelectorAllowlistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposal(uint64(l))
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AxelarCommunityPoolSpendProposalForCLI) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddChainConfigurationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.ChainConfiguration != nil {
-		l = m.ChainConfiguration.Size()
-		n += 1 + l + sovProposal(uint64(l))
+func (m *SetAxelarCellarPausedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddChainConfigurationProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.ChainConfiguration != nil {
-		l = m.ChainConfiguration.Size()
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	return n
+func (m *SetAxelarCellarPausedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveChainConfigurationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetAxelarCellarPausedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveChainConfigurationProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAxelarCellarPausedProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeAxelarProxyContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAxelarCellarABIProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarABIProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarABIProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NewProxyAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeAxelarProxyContractProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAxelarCellarABIProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovProposal(uint64(m.ChainId))
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NewProxyAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetAxelarCellarMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddAxelarManagedCellarIDsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	if m.CellarIds != nil {
+		l = m.CellarIds.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarMetadata) > 0 {
+		for _, e := range m.CellarMetadata {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Title)
@@ -2610,10 +3512,54 @@ func (m *CancelAxelarProxyContractUpgradeProposal) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovProposal(uint64(m.ChainId))
 	}
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarMetadata) > 0 {
+		for _, e := range m.CellarMetadata {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Size() (n int) {
+func (m *RemoveAxelarManagedCellarIDsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	if m.CellarIds != nil {
+		l = m.CellarIds.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2630,6 +3576,12 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovProposal(uint64(m.ChainId))
 	}
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	l = len(m.Deposit)
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
@@ -2637,13 +3589,2434 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AxelarScheduledCorkProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovProposal(uint64(m.BlockHeight))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.ContractCallProtoJson)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovProposal(uint64(m.Deadline))
+	}
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
 }
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AxelarScheduledCorkProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovProposal(uint64(m.BlockHeight))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.ContractCallProtoJson)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovProposal(uint64(m.Deadline))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *AxelarCommunityPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *AxelarCommunityPoolSpendProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *AddChainConfigurationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainConfiguration != nil {
+		l = m.ChainConfiguration.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *AddChainConfigurationProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainConfiguration != nil {
+		l = m.ChainConfiguration.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveChainConfigurationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *RemoveChainConfigurationProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeAxelarProxyContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.NewProxyAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeAxelarProxyContractProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.NewProxyAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAxelarCellarPausedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetAxelarCellarPausedProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAxelarCellarABIProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAxelarCellarABIProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAxelarCellarMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SetAxelarCellarMetadataProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovProposal(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellarIds == nil {
+				m.CellarIds = &CellarIDSet{}
+			}
+			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarMetadata = append(m.CellarMetadata, CellarMetadataEntry{})
+			if err := m.CellarMetadata[len(m.CellarMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarMetadata = append(m.CellarMetadata, CellarMetadataEntry{})
+			if err := m.CellarMetadata[len(m.CellarMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellarIds == nil {
+				m.CellarIds = &CellarIDSet{}
+			}
+			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarScheduledCorkProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarScheduledCorkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedContractCall == nil {
+				m.EncodedContractCall = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarScheduledCorkProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarScheduledCorkProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+func (m *AddChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2666,10 +6039,10 @@ func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddChainConfigurationProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddChainConfigurationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2737,27 +6110,8 @@ func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfiguration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2784,45 +6138,13 @@ func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CellarIds == nil {
-				m.CellarIds = &CellarIDSet{}
+			if m.ChainConfiguration == nil {
+				m.ChainConfiguration = &ChainConfiguration{}
 			}
-			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2844,7 +6166,7 @@ func (m *AddAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *AddChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,10 +6189,10 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddChainConfigurationProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddAxelarManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddChainConfigurationProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2938,10 +6260,10 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfiguration", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2951,14 +6273,31 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainConfiguration == nil {
+				m.ChainConfiguration = &ChainConfiguration{}
+			}
+			if err := m.ChainConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2986,11 +6325,61 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveChainConfigurationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveChainConfigurationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3018,11 +6407,11 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3050,8 +6439,27 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3073,7 +6481,7 @@ func (m *AddAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,10 +6504,10 @@ func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveChainConfigurationProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveChainConfigurationProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3187,9 +6595,9 @@ func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3199,27 +6607,23 @@ func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CellarIds == nil {
-				m.CellarIds = &CellarIDSet{}
-			}
-			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3242,7 +6646,7 @@ func (m *RemoveAxelarManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3265,10 +6669,10 @@ func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveAxelarManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3343,52 +6747,20 @@ func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte)
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProxyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3416,7 +6788,7 @@ func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.NewProxyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3439,7 +6811,7 @@ func (m *RemoveAxelarManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
+func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,10 +6834,10 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarScheduledCorkProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarScheduledCorkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3533,25 +6905,6 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -3570,9 +6923,9 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProxyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3600,11 +6953,11 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			m.NewProxyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3632,27 +6985,8 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
-			}
-			m.Deadline = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3674,7 +7008,7 @@ func (m *AxelarScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *CancelAxelarProxyContractUpgradeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3697,10 +7031,10 @@ func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarScheduledCorkProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarScheduledCorkProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3768,25 +7102,6 @@ func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -3805,121 +7120,6 @@ func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
-			}
-			m.Deadline = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3941,7 +7141,7 @@ func (m *AxelarScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3963,48 +7163,16 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4032,11 +7200,11 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4064,9 +7232,9 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -4085,11 +7253,11 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -4099,24 +7267,23 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4139,7 +7306,7 @@ func (m *AxelarCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarSelectorAllowlistProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,10 +7329,10 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposalForCLI: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarSelectorAllowlistProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarCommunityPoolSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarSelectorAllowlistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4233,38 +7400,6 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -4283,9 +7418,9 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4313,11 +7448,11 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4345,7 +7480,7 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4368,7 +7503,7 @@ func (m *AxelarCommunityPoolSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddChainConfigurationProposal) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarSelectorAllowlistProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,10 +7526,10 @@ func (m *AddChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddChainConfigurationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarSelectorAllowlistProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddChainConfigurationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarSelectorAllowlistProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4462,10 +7597,10 @@ func (m *AddChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfiguration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -4475,81 +7610,14 @@ func (m *AddChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChainConfiguration == nil {
-				m.ChainConfiguration = &ChainConfiguration{}
-			}
-			if err := m.ChainConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProposal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProposal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddChainConfigurationProposalWithDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddChainConfigurationProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4577,11 +7645,11 @@ func (m *AddChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4609,45 +7677,9 @@ func (m *AddChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfiguration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChainConfiguration == nil {
-				m.ChainConfiguration = &ChainConfiguration{}
-			}
-			if err := m.ChainConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -4700,7 +7732,7 @@ func (m *AddChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarPausedProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,10 +7755,10 @@ func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveChainConfigurationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarPausedProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveChainConfigurationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarPausedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4763,7 +7795,58 @@ func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4791,13 +7874,13 @@ func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -4807,11 +7890,12 @@ func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -4833,7 +7917,7 @@ func (m *RemoveChainConfigurationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarPausedProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,10 +7940,10 @@ func (m *RemoveChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveChainConfigurationProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarPausedProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveChainConfigurationProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarPausedProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4946,6 +8030,58 @@ func (m *RemoveChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) err
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -4998,7 +8134,7 @@ func (m *RemoveChainConfigurationProposalWithDeposit) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarABIProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5021,10 +8157,10 @@ func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarABIProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarABIProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5112,7 +8248,7 @@ func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewProxyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5140,7 +8276,39 @@ func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewProxyAddress = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5163,7 +8331,7 @@ func (m *UpgradeAxelarProxyContractProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarABIProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5186,10 +8354,10 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarABIProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeAxelarProxyContractProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarABIProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5277,7 +8445,7 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) e
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewProxyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5305,9 +8473,41 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewProxyAddress = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -5360,7 +8560,7 @@ func (m *UpgradeAxelarProxyContractProposalWithDeposit) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *CancelAxelarProxyContractUpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5383,10 +8583,10 @@ func (m *CancelAxelarProxyContractUpgradeProposal) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5472,6 +8672,71 @@ func (m *CancelAxelarProxyContractUpgradeProposal) Unmarshal(dAtA []byte) error
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -5493,7 +8758,7 @@ func (m *CancelAxelarProxyContractUpgradeProposal) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetAxelarCellarMetadataProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5516,10 +8781,10 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAxelarCellarMetadataProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelAxelarProxyContractUpgradeProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAxelarCellarMetadataProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5606,6 +8871,71 @@ func (m *CancelAxelarProxyContractUpgradeProposalWithDeposit) Unmarshal(dAtA []b
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -56,6 +56,20 @@ func TestScheduledCorkProposalValidation(t *testing.T) {
 			expPass: false,
 			err:     errorsmod.Wrap(ErrInvalidJSON, "cannot have empty contract call"),
 		},
+		{
+			name: "Encoded contract call without proto JSON",
+			scheduledCorkProposal: AxelarScheduledCorkProposal{
+				Title:                 "Scheduled AxelarCork",
+				Description:           "Schedules a cork via governance",
+				BlockHeight:           1,
+				ChainId:               42161,
+				EncodedContractCall:   []byte{0x1f, 0x2c, 0x3d, 0x4e},
+				TargetContractAddress: "0x0000000000000000000000000000000000000000",
+				Deadline:              1706225320,
+			},
+			expPass: true,
+			err:     nil,
+		},
 		{
 			name: "Invalid JSON",
 			scheduledCorkProposal: AxelarScheduledCorkProposal{
@@ -83,3 +97,132 @@ func TestScheduledCorkProposalValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestSetAxelarCellarABIProposalValidation(t *testing.T) {
+	cellarABI := `[{"type":"function","name":"setRebalanceDeviation","inputs":[{"name":"newDeviation","type":"uint256"}],"outputs":[]}]`
+
+	testCases := []struct {
+		name     string
+		proposal SetAxelarCellarABIProposal
+		expPass  bool
+	}{
+		{
+			name: "Happy path",
+			proposal: SetAxelarCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				ChainId:     42161,
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Abi:         cellarABI,
+			},
+			expPass: true,
+		},
+		{
+			name: "Empty ABI removes the registered ABI",
+			proposal: SetAxelarCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Removes a cellar ABI via governance",
+				ChainId:     42161,
+				CellarId:    "0x0000000000000000000000000000000000000000",
+			},
+			expPass: true,
+		},
+		{
+			name: "Chain ID zero",
+			proposal: SetAxelarCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Abi:         cellarABI,
+			},
+			expPass: false,
+		},
+		{
+			name: "ABI invalid",
+			proposal: SetAxelarCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				ChainId:     42161,
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Abi:         `[{"type":"event","name":"Paused","inputs":[],"anonymous":false}]`,
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSetAxelarCellarMetadataProposalValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal SetAxelarCellarMetadataProposal
+		expPass  bool
+	}{
+		{
+			name: "Happy path",
+			proposal: SetAxelarCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				ChainId:     42161,
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Metadata:    CellarMetadata{Name: "Dollary-doos LP", AssetDenom: "usdc", StrategySpecUrl: "https://example.com/spec"},
+			},
+			expPass: true,
+		},
+		{
+			name: "Chain ID zero",
+			proposal: SetAxelarCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+			},
+			expPass: false,
+		},
+		{
+			name: "Strategy spec URL invalid",
+			proposal: SetAxelarCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				ChainId:     42161,
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Metadata:    CellarMetadata{StrategySpecUrl: "ftp://example.com"},
+			},
+			expPass: false,
+		},
+		{
+			name: "Cellar address invalid",
+			proposal: SetAxelarCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				ChainId:     42161,
+				CellarId:    "0x01",
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	cellarID := "0x123801a7D398351b8bE11C439e05C5B3259aeC9B"
+	addProposal := NewAddAxelarManagedCellarIDsProposal("Add Cellar", "Adds a cellar via governance", 42161, &CellarIDSet{ChainId: 42161, Ids: []string{cellarID}}, "example.com",
+		[]CellarMetadataEntry{{CellarId: cellarID, Metadata: CellarMetadata{Name: "Dollary-doos LP"}}})
+	require.NoError(t, addProposal.ValidateBasic())
+
+	addProposal.CellarMetadata = append(addProposal.CellarMetadata, CellarMetadataEntry{CellarId: "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"})
+	require.ErrorIs(t, addProposal.ValidateBasic(), ErrInvalidCellarMetadata)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -29,6 +30,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ApprovalFilter restricts cork result queries by approval status
+type ApprovalFilter int32
+
+const (
+	// results are returned regardless of approval status
+	ApprovalFilter_APPROVAL_FILTER_ANY ApprovalFilter = 0
+	// only approved results are returned
+	ApprovalFilter_APPROVAL_FILTER_APPROVED ApprovalFilter = 1
+	// only results that failed to reach the vote threshold are returned
+	ApprovalFilter_APPROVAL_FILTER_REJECTED ApprovalFilter = 2
+)
+
+var ApprovalFilter_name = map[int32]string{
+	0: "APPROVAL_FILTER_ANY",
+	1: "APPROVAL_FILTER_APPROVED",
+	2: "APPROVAL_FILTER_REJECTED",
+}
+
+var ApprovalFilter_value = map[string]int32{
+	"APPROVAL_FILTER_ANY":      0,
+	"APPROVAL_FILTER_APPROVED": 1,
+	"APPROVAL_FILTER_REJECTED": 2,
+}
+
+func (x ApprovalFilter) String() string {
+	return proto.EnumName(ApprovalFilter_name, int32(x))
+}
+
+func (ApprovalFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params gRPC method.
 type QueryParamsRequest struct {
 }
@@ -196,7 +229,8 @@ func (m *QueryCellarIDsResponse) GetCellarIds() []*CellarIDSet {
 
 // QueryCellarIDsByChainIDRequest is the request type for Query/QueryCellarIDsByChainID gRPC method.
 type QueryCellarIDsByChainIDRequest struct {
-	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ChainId    uint64            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
 func (m *QueryCellarIDsByChainIDRequest) Reset()         { *m = QueryCellarIDsByChainIDRequest{} }
@@ -239,9 +273,17 @@ func (m *QueryCellarIDsByChainIDRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryCellarIDsByChainIDRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
 // QueryCellarIDsByChainIDResponse is the response type for Query/QueryCellarIDsByChainID gRPC method.
 type QueryCellarIDsByChainIDResponse struct {
-	CellarIds []string `protobuf:"bytes,1,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	CellarIds  []string           `protobuf:"bytes,1,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
 func (m *QueryCellarIDsByChainIDResponse) Reset()         { *m = QueryCellarIDsByChainIDResponse{} }
@@ -284,9 +326,27 @@ func (m *QueryCellarIDsByChainIDResponse) GetCellarIds() []string {
 	return nil
 }
 
+func (m *QueryCellarIDsByChainIDResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
 // QueryScheduledCorksRequest
 type QueryScheduledCorksRequest struct {
-	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ChainId    uint64            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// only return corks targeting this cellar address when set
+	TargetContractAddress string `protobuf:"bytes,3,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// only return corks scheduled by this validator address when set
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	// only return corks scheduled at or after this height when non-zero
+	MinBlockHeight uint64 `protobuf:"varint,5,opt,name=min_block_height,json=minBlockHeight,proto3" json:"min_block_height,omitempty"`
+	// only return corks scheduled at or before this height when non-zero
+	MaxBlockHeight uint64 `protobuf:"varint,6,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,7,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksRequest) Reset()         { *m = QueryScheduledCorksRequest{} }
@@ -329,9 +389,54 @@ func (m *QueryScheduledCorksRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryScheduledCorksRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksRequest) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *QueryScheduledCorksRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *QueryScheduledCorksRequest) GetMinBlockHeight() uint64 {
+	if m != nil {
+		return m.MinBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryScheduledCorksRequest) GetMaxBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryScheduledCorksRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksResponse
 type QueryScheduledCorksResponse struct {
-	Corks []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksResponse) Reset()         { *m = QueryScheduledCorksResponse{} }
@@ -374,6 +479,20 @@ func (m *QueryScheduledCorksResponse) GetCorks() []*ScheduledAxelarCork {
 	return nil
 }
 
+func (m *QueryScheduledCorksResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 // QueryScheduledBlockHeightsRequest
 type QueryScheduledBlockHeightsRequest struct {
 	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -466,8 +585,11 @@ func (m *QueryScheduledBlockHeightsResponse) GetBlockHeights() []uint64 {
 
 // QueryScheduledCorksByBlockHeightRequest
 type QueryScheduledCorksByBlockHeightRequest struct {
-	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	ChainId     uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockHeight uint64            `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ChainId     uint64            `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination  query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,4,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksByBlockHeightRequest) Reset() {
@@ -519,9 +641,26 @@ func (m *QueryScheduledCorksByBlockHeightRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryScheduledCorksByBlockHeightRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksByBlockHeightResponse
 type QueryScheduledCorksByBlockHeightResponse struct {
-	Corks []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksByBlockHeightResponse) Reset() {
@@ -566,10 +705,27 @@ func (m *QueryScheduledCorksByBlockHeightResponse) GetCorks() []*ScheduledAxelar
 	return nil
 }
 
+func (m *QueryScheduledCorksByBlockHeightResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 // QueryScheduledCorksByIDRequest
 type QueryScheduledCorksByIDRequest struct {
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChainId uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId    uint64            `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,4,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksByIDRequest) Reset()         { *m = QueryScheduledCorksByIDRequest{} }
@@ -619,9 +775,26 @@ func (m *QueryScheduledCorksByIDRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryScheduledCorksByIDRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksByIDRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksByIDResponse
 type QueryScheduledCorksByIDResponse struct {
-	Corks []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksByIDResponse) Reset()         { *m = QueryScheduledCorksByIDResponse{} }
@@ -664,9 +837,25 @@ func (m *QueryScheduledCorksByIDResponse) GetCorks() []*ScheduledAxelarCork {
 	return nil
 }
 
+func (m *QueryScheduledCorksByIDResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksByIDResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 type QueryCorkResultRequest struct {
 	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ChainId uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// decode the cork's contract call with its cellar's registered ABI
+	DecodeCall bool `protobuf:"varint,3,opt,name=decode_call,json=decodeCall,proto3" json:"decode_call,omitempty"`
 }
 
 func (m *QueryCorkResultRequest) Reset()         { *m = QueryCorkResultRequest{} }
@@ -716,8 +905,17 @@ func (m *QueryCorkResultRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryCorkResultRequest) GetDecodeCall() bool {
+	if m != nil {
+		return m.DecodeCall
+	}
+	return false
+}
+
 type QueryCorkResultResponse struct {
 	CorkResult *AxelarCorkResult `protobuf:"bytes,1,opt,name=corkResult,proto3" json:"corkResult,omitempty"`
+	// decoded contract call, when requested and the cellar has a registered ABI
+	DecodedCall *DecodedContractCall `protobuf:"bytes,2,opt,name=decoded_call,json=decodedCall,proto3" json:"decoded_call,omitempty"`
 }
 
 func (m *QueryCorkResultResponse) Reset()         { *m = QueryCorkResultResponse{} }
@@ -760,8 +958,25 @@ func (m *QueryCorkResultResponse) GetCorkResult() *AxelarCorkResult {
 	return nil
 }
 
+func (m *QueryCorkResultResponse) GetDecodedCall() *DecodedContractCall {
+	if m != nil {
+		return m.DecodedCall
+	}
+	return nil
+}
+
 type QueryCorkResultsRequest struct {
-	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ChainId    uint64            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// only return results for corks targeting this cellar address when set
+	TargetContractAddress string `protobuf:"bytes,3,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// only return results tallied at or after this height when non-zero
+	MinBlockHeight uint64 `protobuf:"varint,4,opt,name=min_block_height,json=minBlockHeight,proto3" json:"min_block_height,omitempty"`
+	// only return results tallied at or before this height when non-zero
+	MaxBlockHeight uint64         `protobuf:"varint,5,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	Approval       ApprovalFilter `protobuf:"varint,6,opt,name=approval,proto3,enum=axelarcork.v1.ApprovalFilter" json:"approval,omitempty"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,7,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryCorkResultsRequest) Reset()         { *m = QueryCorkResultsRequest{} }
@@ -804,8 +1019,53 @@ func (m *QueryCorkResultsRequest) GetChainId() uint64 {
 	return 0
 }
 
+func (m *QueryCorkResultsRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryCorkResultsRequest) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *QueryCorkResultsRequest) GetMinBlockHeight() uint64 {
+	if m != nil {
+		return m.MinBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryCorkResultsRequest) GetMaxBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryCorkResultsRequest) GetApproval() ApprovalFilter {
+	if m != nil {
+		return m.Approval
+	}
+	return ApprovalFilter_APPROVAL_FILTER_ANY
+}
+
+func (m *QueryCorkResultsRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 type QueryCorkResultsResponse struct {
 	CorkResults []*AxelarCorkResult `protobuf:"bytes,1,rep,name=corkResults,proto3" json:"corkResults,omitempty"`
+	Pagination  query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryCorkResultsResponse) Reset()         { *m = QueryCorkResultsResponse{} }
@@ -848,6 +1108,20 @@ func (m *QueryCorkResultsResponse) GetCorkResults() []*AxelarCorkResult {
 	return nil
 }
 
+func (m *QueryCorkResultsResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryCorkResultsResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 type QueryChainConfigurationsRequest struct {
 }
 
@@ -1088,1699 +1362,8907 @@ func (m *QueryAxelarProxyUpgradeDataResponse) GetProxyUpgradeData() []*AxelarUpg
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "axelarcork.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "axelarcork.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCellarIDsRequest)(nil), "axelarcork.v1.QueryCellarIDsRequest")
-	proto.RegisterType((*QueryCellarIDsResponse)(nil), "axelarcork.v1.QueryCellarIDsResponse")
-	proto.RegisterType((*QueryCellarIDsByChainIDRequest)(nil), "axelarcork.v1.QueryCellarIDsByChainIDRequest")
-	proto.RegisterType((*QueryCellarIDsByChainIDResponse)(nil), "axelarcork.v1.QueryCellarIDsByChainIDResponse")
-	proto.RegisterType((*QueryScheduledCorksRequest)(nil), "axelarcork.v1.QueryScheduledCorksRequest")
-	proto.RegisterType((*QueryScheduledCorksResponse)(nil), "axelarcork.v1.QueryScheduledCorksResponse")
-	proto.RegisterType((*QueryScheduledBlockHeightsRequest)(nil), "axelarcork.v1.QueryScheduledBlockHeightsRequest")
-	proto.RegisterType((*QueryScheduledBlockHeightsResponse)(nil), "axelarcork.v1.QueryScheduledBlockHeightsResponse")
-	proto.RegisterType((*QueryScheduledCorksByBlockHeightRequest)(nil), "axelarcork.v1.QueryScheduledCorksByBlockHeightRequest")
-	proto.RegisterType((*QueryScheduledCorksByBlockHeightResponse)(nil), "axelarcork.v1.QueryScheduledCorksByBlockHeightResponse")
-	proto.RegisterType((*QueryScheduledCorksByIDRequest)(nil), "axelarcork.v1.QueryScheduledCorksByIDRequest")
-	proto.RegisterType((*QueryScheduledCorksByIDResponse)(nil), "axelarcork.v1.QueryScheduledCorksByIDResponse")
-	proto.RegisterType((*QueryCorkResultRequest)(nil), "axelarcork.v1.QueryCorkResultRequest")
-	proto.RegisterType((*QueryCorkResultResponse)(nil), "axelarcork.v1.QueryCorkResultResponse")
-	proto.RegisterType((*QueryCorkResultsRequest)(nil), "axelarcork.v1.QueryCorkResultsRequest")
-	proto.RegisterType((*QueryCorkResultsResponse)(nil), "axelarcork.v1.QueryCorkResultsResponse")
-	proto.RegisterType((*QueryChainConfigurationsRequest)(nil), "axelarcork.v1.QueryChainConfigurationsRequest")
-	proto.RegisterType((*QueryChainConfigurationsResponse)(nil), "axelarcork.v1.QueryChainConfigurationsResponse")
-	proto.RegisterType((*QueryAxelarContractCallNoncesRequest)(nil), "axelarcork.v1.QueryAxelarContractCallNoncesRequest")
-	proto.RegisterType((*QueryAxelarContractCallNoncesResponse)(nil), "axelarcork.v1.QueryAxelarContractCallNoncesResponse")
-	proto.RegisterType((*QueryAxelarProxyUpgradeDataRequest)(nil), "axelarcork.v1.QueryAxelarProxyUpgradeDataRequest")
-	proto.RegisterType((*QueryAxelarProxyUpgradeDataResponse)(nil), "axelarcork.v1.QueryAxelarProxyUpgradeDataResponse")
+type QueryCellarSelectorAllowlistRequest struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("axelarcork/v1/query.proto", fileDescriptor_7d10e4f1065c484f) }
-
-var fileDescriptor_7d10e4f1065c484f = []byte{
-	// 1107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xd2, 0x0d, 0x7a, 0xb2, 0x95, 0xea, 0xd0, 0xb1, 0xd5, 0x65, 0x69, 0xe3, 0xfe,
-	0xa4, 0xa3, 0x71, 0x93, 0x6c, 0x74, 0x68, 0x12, 0xb0, 0xa4, 0x12, 0x04, 0xd0, 0x18, 0xa9, 0x10,
-	0x68, 0x08, 0x59, 0x8e, 0x7d, 0x71, 0x4c, 0x1d, 0x3b, 0xb3, 0x9d, 0xd2, 0x68, 0xda, 0x03, 0x3c,
-	0xf2, 0x84, 0x80, 0x67, 0xc4, 0xbf, 0x00, 0x12, 0x0f, 0x88, 0x27, 0xde, 0xf6, 0x38, 0x89, 0x17,
-	0x9e, 0x10, 0x6a, 0xf7, 0x87, 0x20, 0x5f, 0x5f, 0xa7, 0xfe, 0x99, 0x38, 0xf4, 0xad, 0xbd, 0xe7,
-	0x9c, 0xef, 0xf9, 0xdc, 0x9b, 0x9b, 0xf3, 0xbd, 0x81, 0x45, 0xf9, 0x98, 0x18, 0xb2, 0xad, 0x58,
-	0xf6, 0xa1, 0x78, 0x54, 0x11, 0x1f, 0xf6, 0x89, 0x3d, 0x28, 0xf7, 0x6c, 0xcb, 0xb5, 0xf0, 0xf2,
-	0x59, 0xa8, 0x7c, 0x54, 0xe1, 0x17, 0x34, 0x4b, 0xb3, 0x68, 0x44, 0xf4, 0xfe, 0xf2, 0x93, 0xf8,
-	0x57, 0x34, 0xcb, 0xd2, 0x0c, 0x22, 0xca, 0x3d, 0x5d, 0x94, 0x4d, 0xd3, 0x72, 0x65, 0x57, 0xb7,
-	0x4c, 0x87, 0x45, 0x97, 0xa2, 0xea, 0x1a, 0x31, 0x89, 0xa3, 0x07, 0xc1, 0x62, 0x34, 0x18, 0xea,
-	0x46, 0xe3, 0xc2, 0x02, 0xe0, 0x47, 0x1e, 0xce, 0x7d, 0xd9, 0x96, 0xbb, 0x4e, 0x8b, 0x3c, 0xec,
-	0x13, 0xc7, 0x15, 0xde, 0x83, 0x97, 0x22, 0xab, 0x4e, 0xcf, 0x32, 0x1d, 0x82, 0x35, 0xb8, 0xd8,
-	0xa3, 0x2b, 0xd7, 0xb8, 0x15, 0x6e, 0xab, 0x50, 0xbd, 0x52, 0x8e, 0xd0, 0x97, 0xfd, 0xf4, 0xfa,
-	0xcc, 0x93, 0x7f, 0x96, 0xa7, 0x5a, 0x2c, 0x55, 0xb8, 0x0a, 0x57, 0xa8, 0x56, 0x83, 0x18, 0x86,
-	0x6c, 0x37, 0xf7, 0x87, 0x4d, 0x0e, 0xe0, 0xe5, 0x78, 0x80, 0xf5, 0x79, 0x03, 0x40, 0xa1, 0x8b,
-	0x92, 0xae, 0x7a, 0xbd, 0x9e, 0xdb, 0x2a, 0x54, 0xf9, 0x58, 0xaf, 0xa0, 0xea, 0x80, 0xb8, 0xad,
-	0x59, 0x3f, 0xbb, 0xa9, 0x3a, 0xc2, 0x1d, 0x28, 0x46, 0x45, 0xeb, 0x83, 0x46, 0x47, 0xd6, 0xcd,
-	0xe6, 0x3e, 0x6b, 0x8b, 0x8b, 0xf0, 0x82, 0xe2, 0xad, 0x48, 0xba, 0x4a, 0xb7, 0x31, 0xd3, 0x7a,
-	0x9e, 0xfe, 0xdf, 0x54, 0x85, 0xb7, 0x61, 0x39, 0xb3, 0x98, 0xa1, 0x5d, 0x4f, 0xa0, 0xcd, 0x86,
-	0xdb, 0xef, 0x01, 0x4f, 0x15, 0x0e, 0x94, 0x0e, 0x51, 0xfb, 0x06, 0x51, 0x1b, 0x96, 0x7d, 0xe8,
-	0xe4, 0x68, 0xfd, 0x09, 0x2c, 0xa5, 0x16, 0xb2, 0xb6, 0xb7, 0xe1, 0x82, 0xb7, 0xf1, 0xe0, 0x30,
-	0x84, 0xd8, 0x61, 0x0c, 0xab, 0xee, 0xd2, 0x65, 0xaf, 0xb6, 0xe5, 0x17, 0x08, 0x6f, 0x42, 0x29,
-	0x2a, 0x5c, 0x37, 0x2c, 0xe5, 0xf0, 0x5d, 0xa2, 0x6b, 0x1d, 0x37, 0x0f, 0x58, 0x13, 0x84, 0x51,
-	0xf5, 0x8c, 0x6f, 0x15, 0x2e, 0xb7, 0xbd, 0x75, 0xa9, 0xe3, 0x07, 0x28, 0xe7, 0x4c, 0xeb, 0x52,
-	0x3b, 0x94, 0x2c, 0x68, 0xb0, 0x99, 0xb2, 0xc7, 0xfa, 0x20, 0xa4, 0x18, 0x00, 0x95, 0xe0, 0x52,
-	0x58, 0x8f, 0x41, 0x15, 0x42, 0x72, 0x11, 0xe6, 0xe9, 0x28, 0xb3, 0x0a, 0x5b, 0xe3, 0x1b, 0x9d,
-	0xfb, 0x64, 0xdf, 0x67, 0x57, 0x2d, 0xde, 0xe5, 0xec, 0xaa, 0xcd, 0xc1, 0x34, 0x3b, 0xd0, 0xd9,
-	0xd6, 0xb4, 0xae, 0x8e, 0x42, 0xfe, 0x8c, 0x5d, 0xbd, 0x34, 0xb1, 0x73, 0x93, 0x36, 0x82, 0x6f,
-	0x9a, 0xb7, 0x46, 0x9c, 0xbe, 0xe1, 0xfe, 0x0f, 0xc2, 0x07, 0x70, 0x35, 0x21, 0xc2, 0xc8, 0xde,
-	0x02, 0x50, 0x86, 0xab, 0x6c, 0x36, 0x2c, 0xc7, 0xf0, 0x42, 0x54, 0x7e, 0x71, 0xa8, 0x44, 0xb8,
-	0x99, 0xd0, 0xce, 0x73, 0x35, 0x3f, 0x87, 0x6b, 0xc9, 0x2a, 0x86, 0x74, 0x17, 0x0a, 0x67, 0xfa,
-	0xc1, 0x91, 0x8d, 0x65, 0x0a, 0xd7, 0x08, 0xa5, 0x60, 0x1a, 0x78, 0xed, 0x1a, 0x96, 0xf9, 0x85,
-	0xae, 0xf5, 0x6d, 0x7f, 0xf2, 0x06, 0x23, 0xac, 0x0b, 0x2b, 0xd9, 0x29, 0x8c, 0xa4, 0x09, 0x73,
-	0x4a, 0x24, 0xc2, 0x60, 0x4a, 0xf1, 0x81, 0x96, 0xd0, 0x68, 0xc5, 0x0a, 0x85, 0x0d, 0x58, 0xa3,
-	0xed, 0x02, 0x6e, 0xd3, 0xb5, 0x65, 0xc5, 0x6d, 0xc8, 0x86, 0x71, 0xcf, 0x32, 0x15, 0x32, 0xc4,
-	0xfa, 0x9a, 0x83, 0xf5, 0x31, 0x89, 0x0c, 0xee, 0x53, 0x58, 0x50, 0x58, 0x54, 0x52, 0x64, 0xc3,
-	0x90, 0x4c, 0x1a, 0x67, 0x88, 0x1b, 0x19, 0xe7, 0x15, 0x93, 0x6b, 0xa1, 0x92, 0xe8, 0x20, 0xac,
-	0xb1, 0xb9, 0xe1, 0xd7, 0xdc, 0xb7, 0xad, 0xe3, 0xc1, 0xc7, 0x3d, 0xcd, 0x96, 0x55, 0xb2, 0x2f,
-	0xbb, 0x72, 0x40, 0xda, 0x87, 0xd5, 0x91, 0x59, 0x0c, 0xf3, 0x1e, 0x60, 0xcf, 0x8b, 0x49, 0x7d,
-	0x3f, 0x28, 0xa9, 0xb2, 0x2b, 0x33, 0xc8, 0x95, 0x54, 0xc8, 0xb0, 0xca, 0x7c, 0x2f, 0xa6, 0x5b,
-	0xfd, 0x79, 0x1e, 0x2e, 0xd0, 0xbe, 0xf8, 0x15, 0x14, 0x42, 0x4e, 0x87, 0xf1, 0x0f, 0x25, 0xe9,
-	0x8d, 0xbc, 0x30, 0x2a, 0xc5, 0xe7, 0x15, 0x4a, 0xdf, 0xfc, 0xf5, 0xec, 0x87, 0xe9, 0x25, 0x5c,
-	0x14, 0x1d, 0xab, 0xdb, 0x25, 0x86, 0x4e, 0x6c, 0x31, 0xb0, 0x60, 0xdf, 0x16, 0xf1, 0x5b, 0x0e,
-	0xe6, 0xa2, 0x66, 0x83, 0x6b, 0x69, 0xca, 0x71, 0xdb, 0xe4, 0xd7, 0xc7, 0x64, 0x31, 0x84, 0x1b,
-	0x14, 0x61, 0x1d, 0x57, 0x43, 0x08, 0xd1, 0xb7, 0xc0, 0x99, 0x8f, 0xe1, 0x2f, 0x5c, 0xf0, 0x05,
-	0x4c, 0x38, 0x1f, 0xee, 0x8c, 0xec, 0x17, 0xb7, 0x57, 0xbe, 0x9c, 0x37, 0x9d, 0x71, 0xee, 0x51,
-	0xce, 0x0a, 0x8a, 0x39, 0x38, 0xa5, 0xf6, 0x40, 0x0a, 0x86, 0x01, 0xfe, 0xc4, 0xb1, 0x47, 0x4a,
-	0x74, 0x64, 0xe2, 0xab, 0x69, 0x00, 0xa9, 0x7e, 0xcc, 0x6f, 0xe7, 0x49, 0x65, 0x9c, 0xbb, 0x94,
-	0x73, 0x1b, 0xb7, 0x32, 0x39, 0x9d, 0xa0, 0x50, 0xa2, 0x53, 0x17, 0xff, 0xe0, 0xe2, 0x8f, 0x81,
-	0xb0, 0x75, 0xe2, 0xee, 0xc8, 0xe6, 0x29, 0x2e, 0xcd, 0x57, 0x26, 0xa8, 0x60, 0xd4, 0xb7, 0x29,
-	0x75, 0x15, 0x77, 0x73, 0x50, 0x47, 0x0c, 0x1c, 0x9f, 0x71, 0x6c, 0xb6, 0x8d, 0x30, 0x51, 0x7c,
-	0x7d, 0xfc, 0x01, 0xa6, 0xd9, 0x3b, 0xbf, 0x37, 0x71, 0x1d, 0xdb, 0xcf, 0x87, 0x74, 0x3f, 0x4d,
-	0x7c, 0x27, 0xef, 0xa7, 0xe0, 0x5d, 0x99, 0xf0, 0xc6, 0xc4, 0x47, 0xe1, 0xff, 0x1e, 0xe3, 0x6f,
-	0xc1, 0xcd, 0x4f, 0x1a, 0x6f, 0xfa, 0xcd, 0xcf, 0x74, 0xfb, 0xf4, 0x9b, 0x9f, 0xed, 0xe7, 0xc2,
-	0x1d, 0xba, 0x97, 0x5b, 0x58, 0x9b, 0x64, 0x2f, 0xba, 0x2a, 0x3e, 0xd2, 0xd5, 0xc7, 0xf8, 0x23,
-	0x07, 0x2f, 0xc6, 0xcc, 0x0f, 0xd3, 0x27, 0x43, 0xdc, 0xf3, 0xf9, 0x8d, 0x71, 0x69, 0x8c, 0xaf,
-	0x4a, 0xf9, 0x5e, 0xc3, 0xed, 0xec, 0x6f, 0xa6, 0x65, 0x1f, 0x4a, 0xb6, 0x6f, 0x97, 0x3e, 0xd6,
-	0xf7, 0x1c, 0xcc, 0xc7, 0x3d, 0x19, 0xc7, 0x34, 0x1c, 0xde, 0xef, 0xcd, 0xb1, 0x79, 0x8c, 0x6c,
-	0x87, 0x92, 0x6d, 0xe2, 0x7a, 0x2e, 0x32, 0xfc, 0x95, 0x0b, 0x1e, 0x0a, 0x49, 0x9b, 0xc6, 0xf4,
-	0x79, 0x95, 0x69, 0xf9, 0xbc, 0x98, 0x3b, 0x9f, 0xc1, 0xde, 0xa2, 0xb0, 0x22, 0xee, 0x64, 0xc3,
-	0xd2, 0x91, 0x16, 0xf5, 0x7a, 0xfc, 0x93, 0x83, 0xeb, 0x23, 0x3d, 0x1c, 0x6b, 0x69, 0x24, 0x63,
-	0x9e, 0x06, 0xfc, 0xcd, 0xc9, 0x8a, 0xf2, 0xef, 0x21, 0xe5, 0x15, 0x81, 0xbf, 0x73, 0xec, 0x57,
-	0x4d, 0xba, 0xbd, 0x63, 0x25, 0x1b, 0x26, 0xe3, 0xc1, 0xc0, 0x57, 0x27, 0x29, 0x61, 0xf4, 0x35,
-	0x4a, 0xbf, 0x83, 0x37, 0x32, 0xe9, 0x93, 0x8f, 0x8b, 0xfa, 0x07, 0x4f, 0x4e, 0x8a, 0xdc, 0xd3,
-	0x93, 0x22, 0xf7, 0xef, 0x49, 0x91, 0xfb, 0xee, 0xb4, 0x38, 0xf5, 0xf4, 0xb4, 0x38, 0xf5, 0xf7,
-	0x69, 0x71, 0xea, 0x41, 0x55, 0xd3, 0xdd, 0x4e, 0xbf, 0x5d, 0x56, 0xac, 0xae, 0xd8, 0x23, 0x9a,
-	0x36, 0xf8, 0xf2, 0x28, 0x24, 0x7c, 0xb4, 0x27, 0x1e, 0x87, 0xd5, 0xdd, 0x41, 0x8f, 0x38, 0xed,
-	0x8b, 0xf4, 0xd7, 0x76, 0xed, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xf1, 0xab, 0x27, 0x0a,
-	0x10, 0x00, 0x00,
+func (m *QueryCellarSelectorAllowlistRequest) Reset()         { *m = QueryCellarSelectorAllowlistRequest{} }
+func (m *QueryCellarSelectorAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistRequest) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{24}
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistRequest.Merge(m, src)
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCellarSelectorAllowlistRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// QueryParams queries the axelar cork module parameters.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryCellarIDs queries approved cellar ids of all supported chains
-	QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error)
-	// QueryCellarIDsByChainID returns all cellars and current tick ranges
-	QueryCellarIDsByChainID(ctx context.Context, in *QueryCellarIDsByChainIDRequest, opts ...grpc.CallOption) (*QueryCellarIDsByChainIDResponse, error)
-	// QueryScheduledCorks returns all scheduled corks
-	QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error)
-	// QueryScheduledBlockHeights returns all scheduled block heights
-	QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks at a block height
-	QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error)
-	// QueryScheduledCorks returns all scheduled corks with the specified ID
-	QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error)
-	QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error)
-	QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error)
-	QueryChainConfigurations(ctx context.Context, in *QueryChainConfigurationsRequest, opts ...grpc.CallOption) (*QueryChainConfigurationsResponse, error)
-	QueryAxelarContractCallNonces(ctx context.Context, in *QueryAxelarContractCallNoncesRequest, opts ...grpc.CallOption) (*QueryAxelarContractCallNoncesResponse, error)
-	QueryAxelarProxyUpgradeData(ctx context.Context, in *QueryAxelarProxyUpgradeDataRequest, opts ...grpc.CallOption) (*QueryAxelarProxyUpgradeDataResponse, error)
+func (m *QueryCellarSelectorAllowlistRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCellarSelectorAllowlistRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryCellarSelectorAllowlistResponse struct {
+	// empty if the cellar has no allowlist, in which case any function may be called
+	Selectors []string `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarSelectorAllowlistResponse) Reset()         { *m = QueryCellarSelectorAllowlistResponse{} }
+func (m *QueryCellarSelectorAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistResponse) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{25}
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistResponse.Merge(m, src)
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error) {
-	out := new(QueryCellarIDsResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarSelectorAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistResponse) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryCellarIDsByChainID(ctx context.Context, in *QueryCellarIDsByChainIDRequest, opts ...grpc.CallOption) (*QueryCellarIDsByChainIDResponse, error) {
-	out := new(QueryCellarIDsByChainIDResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarIDsByChainID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCellarSelectorAllowlistsRequest struct {
+	// only return allowlists for this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error) {
-	out := new(QueryScheduledCorksResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarSelectorAllowlistsRequest) Reset()         { *m = QueryCellarSelectorAllowlistsRequest{} }
+func (m *QueryCellarSelectorAllowlistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistsRequest) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{26}
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.Merge(m, src)
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error) {
-	out := new(QueryScheduledBlockHeightsResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledBlockHeights", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarSelectorAllowlistsRequest proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error) {
-	out := new(QueryScheduledCorksByBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorksByBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCellarSelectorAllowlistsResponse struct {
+	Allowlists []AxelarCellarSelectorAllowlist `protobuf:"bytes,1,rep,name=allowlists,proto3" json:"allowlists"`
 }
 
-func (c *queryClient) QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error) {
-	out := new(QueryScheduledCorksByIDResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorksByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarSelectorAllowlistsResponse) Reset()         { *m = QueryCellarSelectorAllowlistsResponse{} }
+func (m *QueryCellarSelectorAllowlistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistsResponse) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{27}
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.Merge(m, src)
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error) {
-	out := new(QueryCorkResultResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkResult", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarSelectorAllowlistsResponse proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistsResponse) GetAllowlists() []AxelarCellarSelectorAllowlist {
+	if m != nil {
+		return m.Allowlists
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error) {
-	out := new(QueryCorkResultsResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkResults", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCellarPauseStateRequest struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-func (c *queryClient) QueryChainConfigurations(ctx context.Context, in *QueryChainConfigurationsRequest, opts ...grpc.CallOption) (*QueryChainConfigurationsResponse, error) {
-	out := new(QueryChainConfigurationsResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryChainConfigurations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarPauseStateRequest) Reset()         { *m = QueryCellarPauseStateRequest{} }
+func (m *QueryCellarPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarPauseStateRequest) ProtoMessage()    {}
+func (*QueryCellarPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{28}
+}
+func (m *QueryCellarPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarPauseStateRequest.Merge(m, src)
+}
+func (m *QueryCellarPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarPauseStateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryAxelarContractCallNonces(ctx context.Context, in *QueryAxelarContractCallNoncesRequest, opts ...grpc.CallOption) (*QueryAxelarContractCallNoncesResponse, error) {
-	out := new(QueryAxelarContractCallNoncesResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryAxelarContractCallNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarPauseStateRequest proto.InternalMessageInfo
+
+func (m *QueryCellarPauseStateRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryAxelarProxyUpgradeData(ctx context.Context, in *QueryAxelarProxyUpgradeDataRequest, opts ...grpc.CallOption) (*QueryAxelarProxyUpgradeDataResponse, error) {
-	out := new(QueryAxelarProxyUpgradeDataResponse)
-	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryAxelarProxyUpgradeData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarPauseStateRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// QueryParams queries the axelar cork module parameters.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryCellarIDs queries approved cellar ids of all supported chains
-	QueryCellarIDs(context.Context, *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error)
-	// QueryCellarIDsByChainID returns all cellars and current tick ranges
-	QueryCellarIDsByChainID(context.Context, *QueryCellarIDsByChainIDRequest) (*QueryCellarIDsByChainIDResponse, error)
-	// QueryScheduledCorks returns all scheduled corks
-	QueryScheduledCorks(context.Context, *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error)
-	// QueryScheduledBlockHeights returns all scheduled block heights
-	QueryScheduledBlockHeights(context.Context, *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks at a block height
-	QueryScheduledCorksByBlockHeight(context.Context, *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error)
-	// QueryScheduledCorks returns all scheduled corks with the specified ID
-	QueryScheduledCorksByID(context.Context, *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error)
-	QueryCorkResult(context.Context, *QueryCorkResultRequest) (*QueryCorkResultResponse, error)
-	QueryCorkResults(context.Context, *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error)
-	QueryChainConfigurations(context.Context, *QueryChainConfigurationsRequest) (*QueryChainConfigurationsResponse, error)
-	QueryAxelarContractCallNonces(context.Context, *QueryAxelarContractCallNoncesRequest) (*QueryAxelarContractCallNoncesResponse, error)
-	QueryAxelarProxyUpgradeData(context.Context, *QueryAxelarProxyUpgradeDataRequest) (*QueryAxelarProxyUpgradeDataResponse, error)
+type QueryCellarPauseStateResponse struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCellarPauseStateResponse) Reset()         { *m = QueryCellarPauseStateResponse{} }
+func (m *QueryCellarPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarPauseStateResponse) ProtoMessage()    {}
+func (*QueryCellarPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{29}
 }
-
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryCellarPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryCellarIDs(ctx context.Context, req *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDs not implemented")
+func (m *QueryCellarPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryCellarIDsByChainID(ctx context.Context, req *QueryCellarIDsByChainIDRequest) (*QueryCellarIDsByChainIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDsByChainID not implemented")
+func (m *QueryCellarPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarPauseStateResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryScheduledCorks(ctx context.Context, req *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorks not implemented")
+func (m *QueryCellarPauseStateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryScheduledBlockHeights(ctx context.Context, req *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledBlockHeights not implemented")
+func (m *QueryCellarPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarPauseStateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryScheduledCorksByBlockHeight(ctx context.Context, req *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByBlockHeight not implemented")
+
+var xxx_messageInfo_QueryCellarPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryCellarPauseStateResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
-func (*UnimplementedQueryServer) QueryScheduledCorksByID(ctx context.Context, req *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByID not implemented")
+
+type QueryPausedCellarsRequest struct {
+	// only return paused cellars on this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryCorkResult(ctx context.Context, req *QueryCorkResultRequest) (*QueryCorkResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResult not implemented")
+
+func (m *QueryPausedCellarsRequest) Reset()         { *m = QueryPausedCellarsRequest{} }
+func (m *QueryPausedCellarsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCellarsRequest) ProtoMessage()    {}
+func (*QueryPausedCellarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{30}
 }
-func (*UnimplementedQueryServer) QueryCorkResults(ctx context.Context, req *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResults not implemented")
+func (m *QueryPausedCellarsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryChainConfigurations(ctx context.Context, req *QueryChainConfigurationsRequest) (*QueryChainConfigurationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryChainConfigurations not implemented")
+func (m *QueryPausedCellarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCellarsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryAxelarContractCallNonces(ctx context.Context, req *QueryAxelarContractCallNoncesRequest) (*QueryAxelarContractCallNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAxelarContractCallNonces not implemented")
+func (m *QueryPausedCellarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCellarsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryAxelarProxyUpgradeData(ctx context.Context, req *QueryAxelarProxyUpgradeDataRequest) (*QueryAxelarProxyUpgradeDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAxelarProxyUpgradeData not implemented")
+func (m *QueryPausedCellarsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPausedCellarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCellarsRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryPausedCellarsRequest proto.InternalMessageInfo
 
-func _Query_QueryCellarIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCellarIDsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCellarIDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryCellarIDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCellarIDs(ctx, req.(*QueryCellarIDsRequest))
+func (m *QueryPausedCellarsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryCellarIDsByChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCellarIDsByChainIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCellarIDsByChainID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryCellarIDsByChainID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCellarIDsByChainID(ctx, req.(*QueryCellarIDsByChainIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPausedCellarsResponse struct {
+	PausedCellarIds []*CellarIDSet `protobuf:"bytes,1,rep,name=paused_cellar_ids,json=pausedCellarIds,proto3" json:"paused_cellar_ids,omitempty"`
 }
 
-func _Query_QueryScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorks(ctx, req.(*QueryScheduledCorksRequest))
+func (m *QueryPausedCellarsResponse) Reset()         { *m = QueryPausedCellarsResponse{} }
+func (m *QueryPausedCellarsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCellarsResponse) ProtoMessage()    {}
+func (*QueryPausedCellarsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{31}
+}
+func (m *QueryPausedCellarsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedCellarsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCellarsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPausedCellarsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCellarsResponse.Merge(m, src)
+}
+func (m *QueryPausedCellarsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedCellarsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCellarsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryScheduledBlockHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledBlockHeightsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryScheduledBlockHeights",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, req.(*QueryScheduledBlockHeightsRequest))
+var xxx_messageInfo_QueryPausedCellarsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedCellarsResponse) GetPausedCellarIds() []*CellarIDSet {
+	if m != nil {
+		return m.PausedCellarIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryScheduledCorksByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksByBlockHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorksByBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, req.(*QueryScheduledCorksByBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGovernanceScheduledAxelarCorksRequest struct {
+	// only return corks for this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_QueryScheduledCorksByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorksByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorksByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorksByID(ctx, req.(*QueryScheduledCorksByIDRequest))
+func (m *QueryGovernanceScheduledAxelarCorksRequest) Reset() {
+	*m = QueryGovernanceScheduledAxelarCorksRequest{}
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGovernanceScheduledAxelarCorksRequest) ProtoMessage() {}
+func (*QueryGovernanceScheduledAxelarCorksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{32}
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceScheduledAxelarCorksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceScheduledAxelarCorksRequest.Merge(m, src)
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceScheduledAxelarCorksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceScheduledAxelarCorksRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryCorkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCorkResultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCorkResult(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryCorkResult",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCorkResult(ctx, req.(*QueryCorkResultRequest))
+var xxx_messageInfo_QueryGovernanceScheduledAxelarCorksRequest proto.InternalMessageInfo
+
+func (m *QueryGovernanceScheduledAxelarCorksRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryCorkResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCorkResultsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCorkResults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryCorkResults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCorkResults(ctx, req.(*QueryCorkResultsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGovernanceScheduledAxelarCorksResponse struct {
+	Corks []GovernanceScheduledAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks"`
 }
 
-func _Query_QueryChainConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChainConfigurationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryChainConfigurations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryChainConfigurations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryChainConfigurations(ctx, req.(*QueryChainConfigurationsRequest))
+func (m *QueryGovernanceScheduledAxelarCorksResponse) Reset() {
+	*m = QueryGovernanceScheduledAxelarCorksResponse{}
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGovernanceScheduledAxelarCorksResponse) ProtoMessage() {}
+func (*QueryGovernanceScheduledAxelarCorksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{33}
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceScheduledAxelarCorksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceScheduledAxelarCorksResponse.Merge(m, src)
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceScheduledAxelarCorksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceScheduledAxelarCorksResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryAxelarContractCallNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAxelarContractCallNoncesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAxelarContractCallNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryAxelarContractCallNonces",
+var xxx_messageInfo_QueryGovernanceScheduledAxelarCorksResponse proto.InternalMessageInfo
+
+func (m *QueryGovernanceScheduledAxelarCorksResponse) GetCorks() []GovernanceScheduledAxelarCork {
+	if m != nil {
+		return m.Corks
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAxelarContractCallNonces(ctx, req.(*QueryAxelarContractCallNoncesRequest))
+	return nil
+}
+
+type QueryCellarABIRequest struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+}
+
+func (m *QueryCellarABIRequest) Reset()         { *m = QueryCellarABIRequest{} }
+func (m *QueryCellarABIRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIRequest) ProtoMessage()    {}
+func (*QueryCellarABIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{34}
+}
+func (m *QueryCellarABIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCellarABIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIRequest.Merge(m, src)
+}
+func (m *QueryCellarABIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryAxelarProxyUpgradeData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAxelarProxyUpgradeDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarABIRequest proto.InternalMessageInfo
+
+func (m *QueryCellarABIRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAxelarProxyUpgradeData(ctx, in)
+	return 0
+}
+
+func (m *QueryCellarABIRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/axelarcork.v1.Query/QueryAxelarProxyUpgradeData",
+	return ""
+}
+
+type QueryCellarABIResponse struct {
+	// empty if no ABI has been registered for the cellar
+	Abi string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *QueryCellarABIResponse) Reset()         { *m = QueryCellarABIResponse{} }
+func (m *QueryCellarABIResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIResponse) ProtoMessage()    {}
+func (*QueryCellarABIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{35}
+}
+func (m *QueryCellarABIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAxelarProxyUpgradeData(ctx, req.(*QueryAxelarProxyUpgradeDataRequest))
+}
+func (m *QueryCellarABIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIResponse.Merge(m, src)
+}
+func (m *QueryCellarABIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCellarABIResponse proto.InternalMessageInfo
+
+func (m *QueryCellarABIResponse) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "axelarcork.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryCellarIDs",
-			Handler:    _Query_QueryCellarIDs_Handler,
-		},
-		{
-			MethodName: "QueryCellarIDsByChainID",
-			Handler:    _Query_QueryCellarIDsByChainID_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorks",
-			Handler:    _Query_QueryScheduledCorks_Handler,
-		},
-		{
-			MethodName: "QueryScheduledBlockHeights",
-			Handler:    _Query_QueryScheduledBlockHeights_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorksByBlockHeight",
-			Handler:    _Query_QueryScheduledCorksByBlockHeight_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorksByID",
-			Handler:    _Query_QueryScheduledCorksByID_Handler,
-		},
-		{
-			MethodName: "QueryCorkResult",
-			Handler:    _Query_QueryCorkResult_Handler,
-		},
-		{
-			MethodName: "QueryCorkResults",
-			Handler:    _Query_QueryCorkResults_Handler,
-		},
-		{
-			MethodName: "QueryChainConfigurations",
-			Handler:    _Query_QueryChainConfigurations_Handler,
-		},
-		{
-			MethodName: "QueryAxelarContractCallNonces",
-			Handler:    _Query_QueryAxelarContractCallNonces_Handler,
-		},
-		{
-			MethodName: "QueryAxelarProxyUpgradeData",
-			Handler:    _Query_QueryAxelarProxyUpgradeData_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "axelarcork/v1/query.proto",
+type QueryCellarABIsRequest struct {
+	// only return ABIs for this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCellarABIsRequest) Reset()         { *m = QueryCellarABIsRequest{} }
+func (m *QueryCellarABIsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIsRequest) ProtoMessage()    {}
+func (*QueryCellarABIsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{36}
+}
+func (m *QueryCellarABIsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCellarABIsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarABIsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarABIsRequest proto.InternalMessageInfo
+
+func (m *QueryCellarABIsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCellarABIsResponse struct {
+	Abis []AxelarCellarABI `protobuf:"bytes,1,rep,name=abis,proto3" json:"abis"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCellarABIsResponse) Reset()         { *m = QueryCellarABIsResponse{} }
+func (m *QueryCellarABIsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIsResponse) ProtoMessage()    {}
+func (*QueryCellarABIsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{37}
+}
+func (m *QueryCellarABIsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCellarIDsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCellarABIsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIsResponse.Merge(m, src)
 }
-
-func (m *QueryCellarIDsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCellarABIsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryCellarIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarABIsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCellarIDsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarABIsResponse proto.InternalMessageInfo
+
+func (m *QueryCellarABIsResponse) GetAbis() []AxelarCellarABI {
+	if m != nil {
+		return m.Abis
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCellarIDsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryManagedCellarRequest struct {
+	ChainId  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,2,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-func (m *QueryCellarIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CellarIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryManagedCellarRequest) Reset()         { *m = QueryManagedCellarRequest{} }
+func (m *QueryManagedCellarRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarRequest) ProtoMessage()    {}
+func (*QueryManagedCellarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{38}
+}
+func (m *QueryManagedCellarRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCellarIDsByChainIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryManagedCellarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarRequest.Merge(m, src)
 }
-
-func (m *QueryCellarIDsByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryManagedCellarRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCellarIDsByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryManagedCellarRequest proto.InternalMessageInfo
+
+func (m *QueryManagedCellarRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCellarIDsByChainIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryManagedCellarRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCellarIDsByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryManagedCellarResponse struct {
+	Cellar AxelarManagedCellar `protobuf:"bytes,1,opt,name=cellar,proto3" json:"cellar"`
 }
 
-func (m *QueryCellarIDsByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryManagedCellarResponse) Reset()         { *m = QueryManagedCellarResponse{} }
+func (m *QueryManagedCellarResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarResponse) ProtoMessage()    {}
+func (*QueryManagedCellarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{39}
+}
+func (m *QueryManagedCellarResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryManagedCellarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarResponse.Merge(m, src)
+}
+func (m *QueryManagedCellarResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryManagedCellarResponse proto.InternalMessageInfo
+
+func (m *QueryManagedCellarResponse) GetCellar() AxelarManagedCellar {
+	if m != nil {
+		return m.Cellar
 	}
-	return dAtA[:n], nil
+	return AxelarManagedCellar{}
 }
 
-func (m *QueryScheduledCorksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryManagedCellarsRequest struct {
+	// only return cellars on this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryScheduledCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryManagedCellarsRequest) Reset()         { *m = QueryManagedCellarsRequest{} }
+func (m *QueryManagedCellarsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarsRequest) ProtoMessage()    {}
+func (*QueryManagedCellarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{40}
+}
+func (m *QueryManagedCellarsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryManagedCellarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarsRequest.Merge(m, src)
+}
+func (m *QueryManagedCellarsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryManagedCellarsRequest proto.InternalMessageInfo
+
+func (m *QueryManagedCellarsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryScheduledCorksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryManagedCellarsResponse struct {
+	Cellars []AxelarManagedCellar `protobuf:"bytes,1,rep,name=cellars,proto3" json:"cellars"`
 }
 
-func (m *QueryScheduledCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryManagedCellarsResponse) Reset()         { *m = QueryManagedCellarsResponse{} }
+func (m *QueryManagedCellarsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarsResponse) ProtoMessage()    {}
+func (*QueryManagedCellarsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{41}
+}
+func (m *QueryManagedCellarsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryManagedCellarsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarsResponse.Merge(m, src)
+}
+func (m *QueryManagedCellarsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryManagedCellarsResponse proto.InternalMessageInfo
+
+func (m *QueryManagedCellarsResponse) GetCellars() []AxelarManagedCellar {
+	if m != nil {
+		return m.Cellars
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledBlockHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkRelayRequest struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// hex encoded cork ID
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryScheduledBlockHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCorkRelayRequest) Reset()         { *m = QueryCorkRelayRequest{} }
+func (m *QueryCorkRelayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkRelayRequest) ProtoMessage()    {}
+func (*QueryCorkRelayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{42}
+}
+func (m *QueryCorkRelayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkRelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkRelayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkRelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkRelayRequest.Merge(m, src)
+}
+func (m *QueryCorkRelayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkRelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkRelayRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkRelayRequest proto.InternalMessageInfo
+
+func (m *QueryCorkRelayRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryScheduledBlockHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCorkRelayRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *QueryScheduledBlockHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockHeights) > 0 {
-		dAtA3 := make([]byte, len(m.BlockHeights)*10)
-		var j2 int
-		for _, num := range m.BlockHeights {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+type QueryCorkRelayResponse struct {
+	Relay AxelarCorkRelay `protobuf:"bytes,1,opt,name=relay,proto3" json:"relay"`
+}
+
+func (m *QueryCorkRelayResponse) Reset()         { *m = QueryCorkRelayResponse{} }
+func (m *QueryCorkRelayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkRelayResponse) ProtoMessage()    {}
+func (*QueryCorkRelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{43}
+}
+func (m *QueryCorkRelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkRelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkRelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkRelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkRelayResponse.Merge(m, src)
+}
+func (m *QueryCorkRelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkRelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkRelayResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkRelayResponse proto.InternalMessageInfo
+
+func (m *QueryCorkRelayResponse) GetRelay() AxelarCorkRelay {
+	if m != nil {
+		return m.Relay
 	}
-	return dAtA[:n], nil
+	return AxelarCorkRelay{}
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkRelaysRequest struct {
+	// only return relays for this chain when non-zero
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCorkRelaysRequest) Reset()         { *m = QueryCorkRelaysRequest{} }
+func (m *QueryCorkRelaysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkRelaysRequest) ProtoMessage()    {}
+func (*QueryCorkRelaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{44}
+}
+func (m *QueryCorkRelaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkRelaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkRelaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkRelaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkRelaysRequest.Merge(m, src)
+}
+func (m *QueryCorkRelaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkRelaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkRelaysRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkRelaysRequest proto.InternalMessageInfo
+
+func (m *QueryCorkRelaysRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkRelaysResponse struct {
+	Relays []AxelarCorkRelay `protobuf:"bytes,1,rep,name=relays,proto3" json:"relays"`
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCorkRelaysResponse) Reset()         { *m = QueryCorkRelaysResponse{} }
+func (m *QueryCorkRelaysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkRelaysResponse) ProtoMessage()    {}
+func (*QueryCorkRelaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{45}
+}
+func (m *QueryCorkRelaysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkRelaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkRelaysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkRelaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkRelaysResponse.Merge(m, src)
+}
+func (m *QueryCorkRelaysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkRelaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkRelaysResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkRelaysResponse proto.InternalMessageInfo
+
+func (m *QueryCorkRelaysResponse) GetRelays() []AxelarCorkRelay {
+	if m != nil {
+		return m.Relays
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledCorksByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCorkTallyRequest previews the tally of a chain's corks scheduled for a block height using current validator
+// power. Exactly one of block_height and id must be set.
+type QueryCorkTallyRequest struct {
+	ChainId     uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// hex encoded cork ID, only this cork's tally is returned
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryScheduledCorksByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCorkTallyRequest) Reset()         { *m = QueryCorkTallyRequest{} }
+func (m *QueryCorkTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkTallyRequest) ProtoMessage()    {}
+func (*QueryCorkTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{46}
+}
+func (m *QueryCorkTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkTallyRequest.Merge(m, src)
+}
+func (m *QueryCorkTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkTallyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkTallyRequest proto.InternalMessageInfo
+
+func (m *QueryCorkTallyRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryScheduledCorksByIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCorkTallyRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryScheduledCorksByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryCorkTallyRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCorkResultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// AxelarCorkTally is the voting power currently behind a scheduled cork
+type AxelarCorkTally struct {
+	Cork        AxelarCork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork"`
+	Id          string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	BlockHeight uint64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// summed consensus power of the validators that scheduled the cork
+	Power uint64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+	// power as a percentage of the last total power
+	ApprovalPercentage string `protobuf:"bytes,5,opt,name=approval_percentage,json=approvalPercentage,proto3" json:"approval_percentage,omitempty"`
+	VoteThreshold      string `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// true if the cork would pass were it tallied now
+	Approved bool     `protobuf:"varint,7,opt,name=approved,proto3" json:"approved,omitempty"`
+	Voters   []string `protobuf:"bytes,8,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *AxelarCorkTally) Reset()         { *m = AxelarCorkTally{} }
+func (m *AxelarCorkTally) String() string { return proto.CompactTextString(m) }
+func (*AxelarCorkTally) ProtoMessage()    {}
+func (*AxelarCorkTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{47}
+}
+func (m *AxelarCorkTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AxelarCorkTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AxelarCorkTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCorkResultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarCorkTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AxelarCorkTally.Merge(m, src)
+}
+func (m *AxelarCorkTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *AxelarCorkTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_AxelarCorkTally.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_AxelarCorkTally proto.InternalMessageInfo
+
+func (m *AxelarCorkTally) GetCork() AxelarCork {
+	if m != nil {
+		return m.Cork
 	}
-	return len(dAtA) - i, nil
+	return AxelarCork{}
 }
 
-func (m *QueryCorkResultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarCorkTally) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCorkResultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarCorkTally) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryCorkResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CorkResult != nil {
-		{
-			size, err := m.CorkResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *AxelarCorkTally) GetPower() uint64 {
+	if m != nil {
+		return m.Power
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCorkResultsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarCorkTally) GetApprovalPercentage() string {
+	if m != nil {
+		return m.ApprovalPercentage
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCorkResultsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AxelarCorkTally) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
+	}
+	return ""
 }
 
-func (m *QueryCorkResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *AxelarCorkTally) GetApproved() bool {
+	if m != nil {
+		return m.Approved
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryCorkResultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AxelarCorkTally) GetVoters() []string {
+	if m != nil {
+		return m.Voters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCorkResultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkTallyResponse struct {
+	Tallies []AxelarCorkTally `protobuf:"bytes,1,rep,name=tallies,proto3" json:"tallies"`
+	// last total consensus power of the validator set
+	TotalPower uint64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
 }
 
-func (m *QueryCorkResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CorkResults) > 0 {
-		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCorkTallyResponse) Reset()         { *m = QueryCorkTallyResponse{} }
+func (m *QueryCorkTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkTallyResponse) ProtoMessage()    {}
+func (*QueryCorkTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{48}
+}
+func (m *QueryCorkTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryChainConfigurationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCorkTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkTallyResponse.Merge(m, src)
 }
-
-func (m *QueryChainConfigurationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCorkTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkTallyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryChainConfigurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCorkTallyResponse proto.InternalMessageInfo
+
+func (m *QueryCorkTallyResponse) GetTallies() []AxelarCorkTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
 }
 
-func (m *QueryChainConfigurationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCorkTallyResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryChainConfigurationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WinningAxelarCork is an approved cork that can be relayed to its target contract
+type WinningAxelarCork struct {
+	Cork AxelarCork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork"`
+	Id   string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// block height at which the cork was approved
+	ApprovalHeight uint64 `protobuf:"varint,3,opt,name=approval_height,json=approvalHeight,proto3" json:"approval_height,omitempty"`
+	// block height at which the cork stops being relayable, derived from the CorkTimeoutBlocks param
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// nonce the next contract call to the target contract will be relayed with
+	NextNonce uint64 `protobuf:"varint,5,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+	// position of the cork in its cellar's queue, corks are relayed in order of approval height and then queue nonce
+	QueueNonce uint64 `protobuf:"varint,6,opt,name=queue_nonce,json=queueNonce,proto3" json:"queue_nonce,omitempty"`
 }
 
-func (m *QueryChainConfigurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Configurations) > 0 {
-		for iNdEx := len(m.Configurations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Configurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *WinningAxelarCork) Reset()         { *m = WinningAxelarCork{} }
+func (m *WinningAxelarCork) String() string { return proto.CompactTextString(m) }
+func (*WinningAxelarCork) ProtoMessage()    {}
+func (*WinningAxelarCork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{49}
+}
+func (m *WinningAxelarCork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WinningAxelarCork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WinningAxelarCork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAxelarContractCallNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *WinningAxelarCork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WinningAxelarCork.Merge(m, src)
 }
-
-func (m *QueryAxelarContractCallNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WinningAxelarCork) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAxelarContractCallNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *WinningAxelarCork) XXX_DiscardUnknown() {
+	xxx_messageInfo_WinningAxelarCork.DiscardUnknown(m)
 }
 
-func (m *QueryAxelarContractCallNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_WinningAxelarCork proto.InternalMessageInfo
 
-func (m *QueryAxelarContractCallNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WinningAxelarCork) GetCork() AxelarCork {
+	if m != nil {
+		return m.Cork
+	}
+	return AxelarCork{}
 }
 
-func (m *QueryAxelarContractCallNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractCallNonces) > 0 {
-		for iNdEx := len(m.ContractCallNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractCallNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *WinningAxelarCork) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAxelarProxyUpgradeDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WinningAxelarCork) GetApprovalHeight() uint64 {
+	if m != nil {
+		return m.ApprovalHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAxelarProxyUpgradeDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WinningAxelarCork) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
 }
 
-func (m *QueryAxelarProxyUpgradeDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *WinningAxelarCork) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
+	}
+	return 0
 }
 
-func (m *QueryAxelarProxyUpgradeDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WinningAxelarCork) GetQueueNonce() uint64 {
+	if m != nil {
+		return m.QueueNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAxelarProxyUpgradeDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWinningAxelarCorkRequest returns the cork at the head of a cellar's queue of winning corks
+type QueryWinningAxelarCorkRequest struct {
+	ChainId         uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *QueryAxelarProxyUpgradeDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProxyUpgradeData) > 0 {
-		for iNdEx := len(m.ProxyUpgradeData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProxyUpgradeData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryWinningAxelarCorkRequest) Reset()         { *m = QueryWinningAxelarCorkRequest{} }
+func (m *QueryWinningAxelarCorkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWinningAxelarCorkRequest) ProtoMessage()    {}
+func (*QueryWinningAxelarCorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{50}
+}
+func (m *QueryWinningAxelarCorkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWinningAxelarCorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWinningAxelarCorkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryWinningAxelarCorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWinningAxelarCorkRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryWinningAxelarCorkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWinningAxelarCorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWinningAxelarCorkRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryWinningAxelarCorkRequest proto.InternalMessageInfo
+
+func (m *QueryWinningAxelarCorkRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryCellarIDsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWinningAxelarCorkRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryCellarIDsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for _, e := range m.CellarIds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryWinningAxelarCorkResponse struct {
+	Cork WinningAxelarCork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork"`
+}
+
+func (m *QueryWinningAxelarCorkResponse) Reset()         { *m = QueryWinningAxelarCorkResponse{} }
+func (m *QueryWinningAxelarCorkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWinningAxelarCorkResponse) ProtoMessage()    {}
+func (*QueryWinningAxelarCorkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{51}
+}
+func (m *QueryWinningAxelarCorkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWinningAxelarCorkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWinningAxelarCorkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryWinningAxelarCorkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWinningAxelarCorkResponse.Merge(m, src)
+}
+func (m *QueryWinningAxelarCorkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWinningAxelarCorkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWinningAxelarCorkResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCellarIDsByChainIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+var xxx_messageInfo_QueryWinningAxelarCorkResponse proto.InternalMessageInfo
+
+func (m *QueryWinningAxelarCorkResponse) GetCork() WinningAxelarCork {
+	if m != nil {
+		return m.Cork
 	}
-	return n
+	return WinningAxelarCork{}
 }
 
-func (m *QueryCellarIDsByChainIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+type QueryWinningAxelarCorksRequest struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryWinningAxelarCorksRequest) Reset()         { *m = QueryWinningAxelarCorksRequest{} }
+func (m *QueryWinningAxelarCorksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWinningAxelarCorksRequest) ProtoMessage()    {}
+func (*QueryWinningAxelarCorksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{52}
+}
+func (m *QueryWinningAxelarCorksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWinningAxelarCorksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWinningAxelarCorksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryWinningAxelarCorksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWinningAxelarCorksRequest.Merge(m, src)
+}
+func (m *QueryWinningAxelarCorksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWinningAxelarCorksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWinningAxelarCorksRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+var xxx_messageInfo_QueryWinningAxelarCorksRequest proto.InternalMessageInfo
+
+func (m *QueryWinningAxelarCorksRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryScheduledCorksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryWinningAxelarCorksResponse struct {
+	Corks []WinningAxelarCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks"`
+}
+
+func (m *QueryWinningAxelarCorksResponse) Reset()         { *m = QueryWinningAxelarCorksResponse{} }
+func (m *QueryWinningAxelarCorksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWinningAxelarCorksResponse) ProtoMessage()    {}
+func (*QueryWinningAxelarCorksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d10e4f1065c484f, []int{53}
+}
+func (m *QueryWinningAxelarCorksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWinningAxelarCorksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWinningAxelarCorksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryWinningAxelarCorksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWinningAxelarCorksResponse.Merge(m, src)
+}
+func (m *QueryWinningAxelarCorksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWinningAxelarCorksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWinningAxelarCorksResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+var xxx_messageInfo_QueryWinningAxelarCorksResponse proto.InternalMessageInfo
+
+func (m *QueryWinningAxelarCorksResponse) GetCorks() []WinningAxelarCork {
+	if m != nil {
+		return m.Corks
 	}
-	return n
+	return nil
 }
 
-func (m *QueryScheduledBlockHeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BlockHeights) > 0 {
-		l = 0
-		for _, e := range m.BlockHeights {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	return n
+func init() {
+	proto.RegisterEnum("axelarcork.v1.ApprovalFilter", ApprovalFilter_name, ApprovalFilter_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "axelarcork.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "axelarcork.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCellarIDsRequest)(nil), "axelarcork.v1.QueryCellarIDsRequest")
+	proto.RegisterType((*QueryCellarIDsResponse)(nil), "axelarcork.v1.QueryCellarIDsResponse")
+	proto.RegisterType((*QueryCellarIDsByChainIDRequest)(nil), "axelarcork.v1.QueryCellarIDsByChainIDRequest")
+	proto.RegisterType((*QueryCellarIDsByChainIDResponse)(nil), "axelarcork.v1.QueryCellarIDsByChainIDResponse")
+	proto.RegisterType((*QueryScheduledCorksRequest)(nil), "axelarcork.v1.QueryScheduledCorksRequest")
+	proto.RegisterType((*QueryScheduledCorksResponse)(nil), "axelarcork.v1.QueryScheduledCorksResponse")
+	proto.RegisterType((*QueryScheduledBlockHeightsRequest)(nil), "axelarcork.v1.QueryScheduledBlockHeightsRequest")
+	proto.RegisterType((*QueryScheduledBlockHeightsResponse)(nil), "axelarcork.v1.QueryScheduledBlockHeightsResponse")
+	proto.RegisterType((*QueryScheduledCorksByBlockHeightRequest)(nil), "axelarcork.v1.QueryScheduledCorksByBlockHeightRequest")
+	proto.RegisterType((*QueryScheduledCorksByBlockHeightResponse)(nil), "axelarcork.v1.QueryScheduledCorksByBlockHeightResponse")
+	proto.RegisterType((*QueryScheduledCorksByIDRequest)(nil), "axelarcork.v1.QueryScheduledCorksByIDRequest")
+	proto.RegisterType((*QueryScheduledCorksByIDResponse)(nil), "axelarcork.v1.QueryScheduledCorksByIDResponse")
+	proto.RegisterType((*QueryCorkResultRequest)(nil), "axelarcork.v1.QueryCorkResultRequest")
+	proto.RegisterType((*QueryCorkResultResponse)(nil), "axelarcork.v1.QueryCorkResultResponse")
+	proto.RegisterType((*QueryCorkResultsRequest)(nil), "axelarcork.v1.QueryCorkResultsRequest")
+	proto.RegisterType((*QueryCorkResultsResponse)(nil), "axelarcork.v1.QueryCorkResultsResponse")
+	proto.RegisterType((*QueryChainConfigurationsRequest)(nil), "axelarcork.v1.QueryChainConfigurationsRequest")
+	proto.RegisterType((*QueryChainConfigurationsResponse)(nil), "axelarcork.v1.QueryChainConfigurationsResponse")
+	proto.RegisterType((*QueryAxelarContractCallNoncesRequest)(nil), "axelarcork.v1.QueryAxelarContractCallNoncesRequest")
+	proto.RegisterType((*QueryAxelarContractCallNoncesResponse)(nil), "axelarcork.v1.QueryAxelarContractCallNoncesResponse")
+	proto.RegisterType((*QueryAxelarProxyUpgradeDataRequest)(nil), "axelarcork.v1.QueryAxelarProxyUpgradeDataRequest")
+	proto.RegisterType((*QueryAxelarProxyUpgradeDataResponse)(nil), "axelarcork.v1.QueryAxelarProxyUpgradeDataResponse")
+	proto.RegisterType((*QueryCellarSelectorAllowlistRequest)(nil), "axelarcork.v1.QueryCellarSelectorAllowlistRequest")
+	proto.RegisterType((*QueryCellarSelectorAllowlistResponse)(nil), "axelarcork.v1.QueryCellarSelectorAllowlistResponse")
+	proto.RegisterType((*QueryCellarSelectorAllowlistsRequest)(nil), "axelarcork.v1.QueryCellarSelectorAllowlistsRequest")
+	proto.RegisterType((*QueryCellarSelectorAllowlistsResponse)(nil), "axelarcork.v1.QueryCellarSelectorAllowlistsResponse")
+	proto.RegisterType((*QueryCellarPauseStateRequest)(nil), "axelarcork.v1.QueryCellarPauseStateRequest")
+	proto.RegisterType((*QueryCellarPauseStateResponse)(nil), "axelarcork.v1.QueryCellarPauseStateResponse")
+	proto.RegisterType((*QueryPausedCellarsRequest)(nil), "axelarcork.v1.QueryPausedCellarsRequest")
+	proto.RegisterType((*QueryPausedCellarsResponse)(nil), "axelarcork.v1.QueryPausedCellarsResponse")
+	proto.RegisterType((*QueryGovernanceScheduledAxelarCorksRequest)(nil), "axelarcork.v1.QueryGovernanceScheduledAxelarCorksRequest")
+	proto.RegisterType((*QueryGovernanceScheduledAxelarCorksResponse)(nil), "axelarcork.v1.QueryGovernanceScheduledAxelarCorksResponse")
+	proto.RegisterType((*QueryCellarABIRequest)(nil), "axelarcork.v1.QueryCellarABIRequest")
+	proto.RegisterType((*QueryCellarABIResponse)(nil), "axelarcork.v1.QueryCellarABIResponse")
+	proto.RegisterType((*QueryCellarABIsRequest)(nil), "axelarcork.v1.QueryCellarABIsRequest")
+	proto.RegisterType((*QueryCellarABIsResponse)(nil), "axelarcork.v1.QueryCellarABIsResponse")
+	proto.RegisterType((*QueryManagedCellarRequest)(nil), "axelarcork.v1.QueryManagedCellarRequest")
+	proto.RegisterType((*QueryManagedCellarResponse)(nil), "axelarcork.v1.QueryManagedCellarResponse")
+	proto.RegisterType((*QueryManagedCellarsRequest)(nil), "axelarcork.v1.QueryManagedCellarsRequest")
+	proto.RegisterType((*QueryManagedCellarsResponse)(nil), "axelarcork.v1.QueryManagedCellarsResponse")
+	proto.RegisterType((*QueryCorkRelayRequest)(nil), "axelarcork.v1.QueryCorkRelayRequest")
+	proto.RegisterType((*QueryCorkRelayResponse)(nil), "axelarcork.v1.QueryCorkRelayResponse")
+	proto.RegisterType((*QueryCorkRelaysRequest)(nil), "axelarcork.v1.QueryCorkRelaysRequest")
+	proto.RegisterType((*QueryCorkRelaysResponse)(nil), "axelarcork.v1.QueryCorkRelaysResponse")
+	proto.RegisterType((*QueryCorkTallyRequest)(nil), "axelarcork.v1.QueryCorkTallyRequest")
+	proto.RegisterType((*AxelarCorkTally)(nil), "axelarcork.v1.AxelarCorkTally")
+	proto.RegisterType((*QueryCorkTallyResponse)(nil), "axelarcork.v1.QueryCorkTallyResponse")
+	proto.RegisterType((*WinningAxelarCork)(nil), "axelarcork.v1.WinningAxelarCork")
+	proto.RegisterType((*QueryWinningAxelarCorkRequest)(nil), "axelarcork.v1.QueryWinningAxelarCorkRequest")
+	proto.RegisterType((*QueryWinningAxelarCorkResponse)(nil), "axelarcork.v1.QueryWinningAxelarCorkResponse")
+	proto.RegisterType((*QueryWinningAxelarCorksRequest)(nil), "axelarcork.v1.QueryWinningAxelarCorksRequest")
+	proto.RegisterType((*QueryWinningAxelarCorksResponse)(nil), "axelarcork.v1.QueryWinningAxelarCorksResponse")
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	return n
+func init() { proto.RegisterFile("axelarcork/v1/query.proto", fileDescriptor_7d10e4f1065c484f) }
+
+var fileDescriptor_7d10e4f1065c484f = []byte{
+	// 2566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x73, 0x1c, 0x47,
+	0x19, 0xf7, 0xac, 0x24, 0x5b, 0xfb, 0xc9, 0x96, 0x95, 0xf6, 0x43, 0xf2, 0xd8, 0x5e, 0x4b, 0x23,
+	0xcb, 0x92, 0x65, 0x7b, 0xd7, 0x92, 0x1c, 0x3b, 0x8e, 0x43, 0xc2, 0xea, 0x61, 0x67, 0x83, 0x1f,
+	0x62, 0x64, 0x12, 0xa0, 0x80, 0xa9, 0xd1, 0x4e, 0xb3, 0x1a, 0x3c, 0xda, 0x59, 0xcf, 0xcc, 0xca,
+	0xda, 0x72, 0xf9, 0x40, 0x6e, 0x70, 0x00, 0x8a, 0xc7, 0x85, 0x54, 0x51, 0x05, 0xff, 0x01, 0xa1,
+	0x80, 0xa2, 0xe0, 0x00, 0xc5, 0x25, 0xc7, 0x14, 0xe1, 0x90, 0x13, 0x45, 0xd9, 0x29, 0x4e, 0x5c,
+	0x39, 0x50, 0x5c, 0xa8, 0xe9, 0xfe, 0x7a, 0x76, 0x9e, 0xbb, 0xa3, 0x38, 0x45, 0x85, 0xdc, 0xb4,
+	0xdd, 0xdf, 0xe3, 0xf7, 0x3d, 0x66, 0xfa, 0xeb, 0xdf, 0x08, 0x4e, 0xe8, 0xbb, 0xd4, 0xd2, 0x9d,
+	0xba, 0xed, 0x3c, 0xa8, 0xec, 0x2c, 0x54, 0x1e, 0xb6, 0xa9, 0xd3, 0x29, 0xb7, 0x1c, 0xdb, 0xb3,
+	0xc9, 0xa1, 0xee, 0x56, 0x79, 0x67, 0x41, 0x3e, 0xda, 0xb0, 0x1b, 0x36, 0xdb, 0xa9, 0xf8, 0x7f,
+	0x71, 0x21, 0xf9, 0x54, 0xc3, 0xb6, 0x1b, 0x16, 0xad, 0xe8, 0x2d, 0xb3, 0xa2, 0x37, 0x9b, 0xb6,
+	0xa7, 0x7b, 0xa6, 0xdd, 0x74, 0x71, 0xf7, 0x64, 0xd4, 0x7a, 0x83, 0x36, 0xa9, 0x6b, 0x8a, 0xcd,
+	0x52, 0x74, 0x33, 0xe4, 0x8d, 0xef, 0xcf, 0xd7, 0x6d, 0x77, 0xdb, 0x76, 0x2b, 0x9b, 0xba, 0x4b,
+	0x39, 0xb0, 0xca, 0xce, 0xc2, 0x26, 0xf5, 0xf4, 0x85, 0x4a, 0x4b, 0x6f, 0x98, 0x4d, 0xe6, 0x89,
+	0xcb, 0x2a, 0x47, 0x81, 0x7c, 0xd1, 0x97, 0x58, 0xd7, 0x1d, 0x7d, 0xdb, 0x55, 0xe9, 0xc3, 0x36,
+	0x75, 0x3d, 0xe5, 0x0d, 0x38, 0x12, 0x59, 0x75, 0x5b, 0x76, 0xd3, 0xa5, 0x64, 0x09, 0xf6, 0xb7,
+	0xd8, 0xca, 0x84, 0x34, 0x29, 0xcd, 0x8d, 0x2c, 0x1e, 0x2b, 0x47, 0x22, 0x2d, 0x73, 0xf1, 0xe5,
+	0xc1, 0xf7, 0xfe, 0x76, 0x66, 0x9f, 0x8a, 0xa2, 0xca, 0x38, 0x1c, 0x63, 0xb6, 0x56, 0xa8, 0x65,
+	0xe9, 0x4e, 0x6d, 0x35, 0x70, 0xb2, 0x01, 0xc7, 0xe3, 0x1b, 0xe8, 0xe7, 0x3a, 0x40, 0x9d, 0x2d,
+	0x6a, 0xa6, 0xe1, 0xfb, 0x1a, 0x98, 0x1b, 0x59, 0x94, 0x63, 0xbe, 0x84, 0xd6, 0x06, 0xf5, 0xd4,
+	0x22, 0x97, 0xae, 0x19, 0xae, 0xf2, 0x1d, 0x09, 0x4a, 0x51, 0xab, 0xcb, 0x9d, 0x95, 0x2d, 0xdd,
+	0x6c, 0xd6, 0x56, 0xd1, 0x2f, 0x39, 0x01, 0xc3, 0x75, 0x7f, 0x45, 0x33, 0x0d, 0x16, 0xc7, 0xa0,
+	0x7a, 0x80, 0xfd, 0xae, 0x19, 0xe4, 0x36, 0x40, 0x37, 0x43, 0x13, 0x05, 0x16, 0xe4, 0xb9, 0x32,
+	0x4f, 0x67, 0xd9, 0x4f, 0x67, 0x99, 0xd7, 0x19, 0xd3, 0x59, 0x5e, 0xd7, 0x1b, 0x14, 0xcd, 0x62,
+	0xd4, 0x21, 0x7d, 0xe5, 0xfb, 0x12, 0x9c, 0xc9, 0xc4, 0x82, 0xa1, 0x9e, 0x4e, 0x84, 0x5a, 0x0c,
+	0x85, 0x43, 0xee, 0xa4, 0x00, 0x9a, 0xed, 0x0b, 0x88, 0xdb, 0x4e, 0x41, 0xf4, 0x97, 0x02, 0xc8,
+	0x0c, 0xd1, 0x46, 0x7d, 0x8b, 0x1a, 0x6d, 0x8b, 0x1a, 0x2b, 0xb6, 0xf3, 0xc0, 0xfd, 0x5f, 0x67,
+	0x86, 0x5c, 0x85, 0x71, 0x4f, 0x77, 0x1a, 0xd4, 0xd3, 0xea, 0x76, 0xd3, 0x73, 0xf4, 0xba, 0xa7,
+	0xe9, 0x86, 0xe1, 0x50, 0xd7, 0x9d, 0x18, 0x98, 0x94, 0xe6, 0x8a, 0xea, 0x31, 0xbe, 0xbd, 0x82,
+	0xbb, 0x55, 0xbe, 0x49, 0x4e, 0x41, 0x71, 0x47, 0xb7, 0x4c, 0x43, 0xf7, 0x6c, 0x67, 0x62, 0x90,
+	0x49, 0x76, 0x17, 0xc8, 0x1c, 0x8c, 0x6d, 0x9b, 0x4d, 0x6d, 0xd3, 0xb2, 0xeb, 0x0f, 0xb4, 0x2d,
+	0x6a, 0x36, 0xb6, 0xbc, 0x89, 0x21, 0x16, 0xc6, 0xe8, 0xb6, 0xd9, 0x5c, 0xf6, 0x97, 0x5f, 0x67,
+	0xab, 0x4c, 0x52, 0xdf, 0x8d, 0x4a, 0xee, 0x47, 0x49, 0x7d, 0x37, 0x2c, 0x39, 0x05, 0x07, 0x0d,
+	0x5a, 0xb7, 0x0d, 0xaa, 0xd5, 0x75, 0xcb, 0x72, 0x27, 0x0e, 0x4c, 0x4a, 0x73, 0xc3, 0xea, 0x08,
+	0x5f, 0x5b, 0xf1, 0x97, 0x94, 0x7f, 0x49, 0x70, 0x32, 0x35, 0xa9, 0x58, 0xe2, 0x97, 0x60, 0xc8,
+	0x6f, 0x5a, 0xd1, 0xc8, 0x4a, 0xac, 0x91, 0x03, 0xad, 0x2a, 0x5b, 0xf6, 0x75, 0x55, 0xae, 0xf0,
+	0x09, 0x57, 0x9f, 0xdc, 0x81, 0x43, 0x1c, 0xb7, 0x81, 0xc1, 0x0c, 0xa4, 0x02, 0x5a, 0xe5, 0x32,
+	0x22, 0xf7, 0x7e, 0x90, 0x68, 0x0c, 0x53, 0x61, 0xf0, 0xb8, 0x5f, 0x85, 0xa9, 0x68, 0xd8, 0xa1,
+	0xbc, 0xe5, 0x68, 0x29, 0xa5, 0x06, 0x4a, 0x2f, 0x7d, 0xcc, 0xde, 0x34, 0x1c, 0x0a, 0x97, 0x89,
+	0x67, 0x71, 0x50, 0x3d, 0xb8, 0x19, 0x12, 0x56, 0x3e, 0x94, 0x60, 0x36, 0xa5, 0x04, 0xcb, 0x9d,
+	0x90, 0x49, 0x81, 0x68, 0x0a, 0x0e, 0x46, 0xea, 0xce, 0x51, 0x8d, 0x84, 0xec, 0x45, 0x40, 0x17,
+	0x7a, 0x3d, 0x07, 0x03, 0xcf, 0xf9, 0x1c, 0xc4, 0xbb, 0x6b, 0x30, 0xd9, 0x5d, 0x6f, 0x17, 0x60,
+	0xae, 0x7f, 0x68, 0x9f, 0xf1, 0x56, 0xfb, 0x83, 0x78, 0xab, 0xc7, 0x93, 0xd0, 0x7d, 0xab, 0x8f,
+	0x42, 0x01, 0x5b, 0xac, 0xa8, 0x16, 0x4c, 0xe3, 0x53, 0x55, 0xc3, 0x7f, 0x8b, 0x83, 0x20, 0x0d,
+	0xfe, 0x67, 0xbc, 0x74, 0x86, 0x38, 0xe5, 0x7d, 0xc4, 0xd4, 0x6d, 0x5b, 0xde, 0xc7, 0xa8, 0xd8,
+	0x19, 0x18, 0x09, 0xe5, 0x98, 0x95, 0x6c, 0x58, 0x85, 0x6e, 0x8a, 0x95, 0x9f, 0x4b, 0x30, 0x9e,
+	0x70, 0x83, 0x99, 0x7d, 0x0d, 0xa0, 0x1e, 0xac, 0xe2, 0xe4, 0x72, 0x26, 0x16, 0x4d, 0x28, 0xab,
+	0x5c, 0x39, 0xa4, 0x42, 0xd6, 0xe0, 0x60, 0x38, 0x23, 0x98, 0xe2, 0x1c, 0x09, 0x51, 0x47, 0x42,
+	0xa9, 0x50, 0xfe, 0x59, 0x48, 0x60, 0xfc, 0xff, 0x39, 0x79, 0xd3, 0xce, 0xd6, 0xc1, 0xdc, 0x67,
+	0xeb, 0x50, 0xea, 0xd9, 0x7a, 0x1d, 0x86, 0xf5, 0x56, 0xcb, 0xb1, 0x77, 0x74, 0x8b, 0x9d, 0xbe,
+	0xa3, 0x8b, 0xa7, 0xe3, 0x65, 0xc1, 0xed, 0x9b, 0xa6, 0xe5, 0x51, 0x47, 0x0d, 0xc4, 0xf3, 0x1c,
+	0xcb, 0xff, 0x91, 0x60, 0x22, 0x99, 0x6e, 0xec, 0x89, 0x2a, 0x8c, 0x74, 0x0b, 0x2c, 0x9e, 0xb9,
+	0xbe, 0x4d, 0x11, 0xd6, 0xf9, 0x94, 0x3f, 0x76, 0x53, 0x62, 0xf4, 0xf4, 0xbb, 0x68, 0xc5, 0x6e,
+	0x7e, 0xd3, 0x6c, 0xb4, 0x1d, 0xe6, 0x29, 0x98, 0xbf, 0xb7, 0x61, 0x32, 0x5b, 0x04, 0xf3, 0x54,
+	0x83, 0xd1, 0x7a, 0x64, 0x07, 0x53, 0x35, 0x15, 0x9f, 0xc6, 0x13, 0x36, 0xd4, 0x98, 0xa2, 0x72,
+	0x0e, 0xce, 0x32, 0x77, 0x22, 0xab, 0xdd, 0x00, 0xee, 0xda, 0xcd, 0x3a, 0x0d, 0x60, 0x7d, 0x5b,
+	0x82, 0x99, 0x3e, 0x82, 0x08, 0xee, 0xcb, 0x70, 0x34, 0x68, 0x62, 0x3f, 0x67, 0x5a, 0x93, 0xed,
+	0x23, 0xc4, 0x73, 0x19, 0xd5, 0x8c, 0x99, 0x53, 0x49, 0x3d, 0xe1, 0x41, 0x39, 0x8b, 0xa3, 0x09,
+	0xd7, 0x59, 0x77, 0xec, 0xdd, 0xce, 0x97, 0x5a, 0x0d, 0x47, 0x37, 0xe8, 0xaa, 0xee, 0xe9, 0x02,
+	0x69, 0x1b, 0xa6, 0x7b, 0x4a, 0x21, 0xcc, 0xbb, 0x40, 0x5a, 0xfe, 0x9e, 0xd6, 0xe6, 0x9b, 0x9a,
+	0xa1, 0x7b, 0x3a, 0x82, 0x9c, 0x4c, 0x05, 0x19, 0xb6, 0x32, 0xd6, 0x8a, 0xd9, 0x55, 0xbe, 0x0e,
+	0xd3, 0xa1, 0x5b, 0xc5, 0x06, 0xb5, 0x68, 0xdd, 0xb3, 0x9d, 0xaa, 0x65, 0xd9, 0x8f, 0x2c, 0xd3,
+	0xf5, 0x72, 0xbc, 0x52, 0x4e, 0x42, 0x31, 0xb8, 0x74, 0xb0, 0xce, 0x2d, 0xaa, 0xc3, 0xe2, 0xce,
+	0xa1, 0xac, 0xc2, 0xd9, 0xde, 0xe6, 0x31, 0xac, 0x53, 0x50, 0x74, 0x71, 0x33, 0xb8, 0xb8, 0x04,
+	0x0b, 0x4a, 0xb5, 0xb7, 0x95, 0x3c, 0xf3, 0xe1, 0x63, 0x98, 0xe9, 0x63, 0x02, 0x91, 0xa8, 0x00,
+	0x7a, 0xb0, 0x8a, 0x89, 0xbd, 0x98, 0x5e, 0xfd, 0x74, 0x53, 0xe2, 0x71, 0xec, 0x5a, 0x51, 0xde,
+	0x84, 0x53, 0x21, 0xe7, 0xeb, 0x7a, 0xdb, 0xa5, 0x1b, 0x9e, 0xee, 0xd1, 0xe7, 0xcd, 0xee, 0x35,
+	0x38, 0x9d, 0x61, 0x17, 0x83, 0x39, 0xee, 0xdf, 0xb1, 0xdb, 0x2e, 0xe5, 0x66, 0x87, 0x55, 0xfc,
+	0xa5, 0x5c, 0x85, 0x13, 0x78, 0x25, 0xf7, 0x7f, 0x72, 0xf5, 0x3c, 0x59, 0x34, 0x40, 0x4e, 0xd3,
+	0x43, 0x6f, 0x37, 0xe1, 0x05, 0x6e, 0x5f, 0xdb, 0xd3, 0x85, 0xfb, 0x70, 0x2b, 0x64, 0xcd, 0xbf,
+	0x76, 0xdf, 0x82, 0x79, 0xe6, 0xe5, 0x96, 0xbd, 0x43, 0x9d, 0xa6, 0xde, 0xac, 0xd3, 0x94, 0x81,
+	0x25, 0x0f, 0xdc, 0x47, 0x70, 0x21, 0x97, 0x21, 0xc4, 0xff, 0x7a, 0x74, 0x6a, 0x8a, 0x57, 0xbd,
+	0xa7, 0x15, 0xac, 0x3a, 0x37, 0xa0, 0xdc, 0x8b, 0xd0, 0x14, 0xd5, 0xe5, 0xda, 0xf3, 0x56, 0x7a,
+	0x1e, 0x8e, 0xc7, 0x0d, 0x22, 0xe8, 0x31, 0x18, 0xd0, 0x37, 0x4d, 0x9c, 0x7c, 0xfc, 0x3f, 0x95,
+	0xa5, 0xb8, 0x6c, 0x9e, 0x54, 0x6d, 0xc0, 0x78, 0x42, 0x29, 0x18, 0x26, 0x07, 0xf5, 0x4d, 0x53,
+	0x64, 0xa5, 0xd4, 0xe3, 0x59, 0xa8, 0x2e, 0xd7, 0x30, 0x0f, 0x4c, 0x43, 0xd9, 0xc0, 0x36, 0xbb,
+	0xa3, 0x37, 0xf5, 0x86, 0xa8, 0xf0, 0xf3, 0xa6, 0xe2, 0x1b, 0x20, 0xa7, 0x19, 0x45, 0xb0, 0x9f,
+	0x87, 0xfd, 0x5c, 0x12, 0x67, 0x33, 0x25, 0x15, 0x6e, 0x44, 0x57, 0x50, 0x4c, 0x5c, 0x4f, 0xb9,
+	0x96, 0x66, 0x3f, 0x4f, 0x0a, 0x75, 0x38, 0x99, 0xaa, 0x88, 0xc8, 0x96, 0xe1, 0x00, 0xf7, 0x90,
+	0x35, 0x95, 0x67, 0x43, 0x13, 0x8a, 0xca, 0xb2, 0xe8, 0x2b, 0x36, 0x3a, 0x58, 0x7a, 0x27, 0x47,
+	0x32, 0xf9, 0x64, 0x5c, 0x10, 0x93, 0xb1, 0x72, 0x1f, 0x8e, 0xc7, 0x6d, 0x20, 0xc2, 0x97, 0x61,
+	0xc8, 0xf1, 0x17, 0x30, 0x75, 0xa5, 0x1e, 0x13, 0x8c, 0xa5, 0x77, 0x44, 0xc7, 0x33, 0x95, 0x6e,
+	0xd3, 0x89, 0xed, 0x3c, 0x19, 0x7b, 0x0b, 0xc6, 0x13, 0x4a, 0x88, 0xe5, 0x15, 0xd8, 0xcf, 0x0c,
+	0xf7, 0x69, 0xbb, 0x18, 0x18, 0xd4, 0x51, 0x68, 0x28, 0x4f, 0xf7, 0x75, 0xcb, 0xca, 0x93, 0xa7,
+	0xf8, 0x55, 0xbe, 0x90, 0xbc, 0xca, 0xf3, 0x54, 0x0e, 0x04, 0xa9, 0x7c, 0xa7, 0x00, 0x87, 0xbb,
+	0x40, 0x98, 0x23, 0xb2, 0x04, 0x83, 0x3e, 0x46, 0xcc, 0xe1, 0x89, 0x4c, 0xd8, 0xe2, 0x41, 0xf1,
+	0x77, 0xe2, 0x35, 0x4a, 0x60, 0x19, 0x48, 0x62, 0x39, 0x0a, 0x43, 0x2d, 0xfb, 0x11, 0x75, 0x70,
+	0x70, 0xe6, 0x3f, 0x48, 0x05, 0x8e, 0x88, 0xb1, 0x56, 0x6b, 0x51, 0xa7, 0x4e, 0x9b, 0x9e, 0xde,
+	0xa0, 0x6c, 0x64, 0x2e, 0xaa, 0x44, 0x6c, 0xad, 0x07, 0x3b, 0x64, 0x06, 0x46, 0x77, 0x6c, 0x8f,
+	0x6a, 0xde, 0x96, 0x43, 0xdd, 0x2d, 0xdb, 0x32, 0xd8, 0xf0, 0x5c, 0x54, 0x0f, 0xf9, 0xab, 0xf7,
+	0xc5, 0x22, 0x91, 0xc5, 0x74, 0x4d, 0x0d, 0x1c, 0x8f, 0x83, 0xdf, 0xfe, 0x21, 0xe3, 0x0b, 0x3b,
+	0xee, 0xc4, 0x30, 0x3b, 0xb8, 0xf1, 0x97, 0xd2, 0x09, 0xb5, 0x04, 0x16, 0x01, 0x8b, 0xfb, 0x2a,
+	0x1c, 0xf0, 0x74, 0xcb, 0x32, 0x69, 0xff, 0xea, 0x32, 0x45, 0xf1, 0x18, 0xa0, 0x92, 0x7f, 0x83,
+	0xf3, 0x6c, 0xcf, 0x0f, 0x91, 0x65, 0x80, 0x57, 0x0a, 0xd8, 0xd2, 0xba, 0xbf, 0xa2, 0xfc, 0x43,
+	0x82, 0x17, 0xde, 0x32, 0x9b, 0x4d, 0xb3, 0xd9, 0xe8, 0x9a, 0xfa, 0x64, 0x4a, 0x33, 0x0b, 0x87,
+	0x83, 0x0c, 0x47, 0xaa, 0x33, 0x2a, 0x96, 0xb1, 0x40, 0xd3, 0x70, 0x88, 0xee, 0xb6, 0x4c, 0xa7,
+	0x13, 0xbd, 0xe1, 0x1c, 0xe4, 0x8b, 0x28, 0x74, 0x1a, 0xa0, 0x49, 0x77, 0x3d, 0x3e, 0x6c, 0xe2,
+	0xcd, 0xa6, 0xe8, 0xaf, 0xb0, 0xd9, 0xd1, 0x0f, 0xf4, 0x61, 0x9b, 0xb6, 0x29, 0xee, 0x73, 0x56,
+	0x11, 0xd8, 0x12, 0x13, 0x50, 0x28, 0x4e, 0x00, 0x89, 0x60, 0x73, 0x34, 0xfc, 0x79, 0x18, 0x4b,
+	0x5c, 0xdb, 0x78, 0x9c, 0x87, 0xeb, 0xd1, 0x0b, 0x9b, 0xf2, 0x35, 0x28, 0x65, 0xb9, 0x09, 0xde,
+	0x1d, 0xe1, 0xdc, 0xc6, 0x27, 0xd1, 0x84, 0x5e, 0x38, 0xc5, 0xca, 0x8d, 0x2c, 0xeb, 0x79, 0xde,
+	0x21, 0x1a, 0x9c, 0xc9, 0x54, 0x0e, 0xde, 0x25, 0x91, 0x73, 0x3d, 0x2f, 0x38, 0xae, 0x34, 0x4f,
+	0x61, 0x34, 0x7a, 0x73, 0x24, 0xe3, 0x70, 0xa4, 0xba, 0xbe, 0xae, 0xde, 0x7b, 0xb3, 0x7a, 0x5b,
+	0xbb, 0x59, 0xbb, 0x7d, 0x7f, 0x4d, 0xd5, 0xaa, 0x77, 0xbf, 0x32, 0xb6, 0x8f, 0x9c, 0x82, 0x89,
+	0xc4, 0x06, 0xfb, 0xbd, 0xb6, 0x3a, 0x26, 0xa5, 0xed, 0xaa, 0x6b, 0x6f, 0xac, 0xad, 0xdc, 0x5f,
+	0x5b, 0x1d, 0x2b, 0x2c, 0xfe, 0x66, 0x1a, 0x86, 0x58, 0x20, 0xe4, 0x11, 0x8c, 0x84, 0xbe, 0x97,
+	0x90, 0xf8, 0xed, 0x28, 0xf9, 0x85, 0x45, 0x56, 0x7a, 0x89, 0xf0, 0x24, 0x28, 0x53, 0x6f, 0x7f,
+	0xf0, 0xd1, 0x8f, 0x0a, 0x27, 0xc9, 0x89, 0x8a, 0x6b, 0x6f, 0x6f, 0x53, 0xcb, 0xa4, 0x4e, 0x45,
+	0x7c, 0xf4, 0xe1, 0x1f, 0x57, 0xc8, 0x77, 0x25, 0x18, 0x8d, 0x7e, 0x62, 0x20, 0x67, 0xd3, 0x2c,
+	0xc7, 0x3f, 0xbe, 0xc8, 0x33, 0x7d, 0xa4, 0x10, 0xc2, 0x05, 0x06, 0x61, 0x86, 0x4c, 0x87, 0x20,
+	0x44, 0xbf, 0x3e, 0x75, 0xe7, 0x46, 0xf2, 0x4b, 0x09, 0xc6, 0x33, 0xbe, 0x77, 0x90, 0x4b, 0x3d,
+	0xfd, 0xc5, 0xbf, 0xd1, 0xc8, 0xe5, 0xbc, 0xe2, 0x88, 0xf3, 0x1a, 0xc3, 0xb9, 0x40, 0x2a, 0x39,
+	0x70, 0x6a, 0x9b, 0x1d, 0x4d, 0xb4, 0x26, 0xf9, 0x99, 0x84, 0x9f, 0xba, 0xa2, 0xd4, 0x1c, 0x39,
+	0x9f, 0x06, 0x20, 0xf5, 0xab, 0x89, 0x3c, 0x9f, 0x47, 0x14, 0x71, 0x5e, 0x66, 0x38, 0xe7, 0xc9,
+	0x5c, 0x26, 0x4e, 0x57, 0x28, 0x6a, 0x9c, 0xdd, 0xfb, 0xbd, 0x14, 0xff, 0x64, 0x13, 0xa6, 0xc9,
+	0xc9, 0xe5, 0x9e, 0xce, 0x53, 0x18, 0x79, 0x79, 0x61, 0x0f, 0x1a, 0x88, 0xfa, 0x25, 0x86, 0x7a,
+	0x91, 0x5c, 0xce, 0x81, 0x3a, 0x42, 0xd6, 0x93, 0x8f, 0x24, 0x98, 0x8c, 0x3a, 0x48, 0xb2, 0xd7,
+	0xe4, 0x6a, 0xff, 0x04, 0xa6, 0x31, 0xf9, 0xf2, 0xb5, 0x3d, 0xeb, 0x61, 0x3c, 0xf7, 0x58, 0x3c,
+	0x35, 0x72, 0x2b, 0x6f, 0x15, 0xfc, 0x96, 0x09, 0x07, 0x56, 0x79, 0x1c, 0xfe, 0xf5, 0x84, 0xfc,
+	0x5a, 0x74, 0x7e, 0x92, 0xe0, 0x4d, 0xef, 0xfc, 0x4c, 0x1e, 0x5b, 0x2e, 0xe7, 0x15, 0xc7, 0x58,
+	0x6e, 0xb0, 0x58, 0x5e, 0x24, 0x4b, 0x7b, 0x89, 0xc5, 0x34, 0x2a, 0x8f, 0x4d, 0xe3, 0x09, 0xf9,
+	0xb1, 0x04, 0x87, 0x63, 0x1c, 0x19, 0x49, 0x7f, 0x33, 0xc4, 0xd9, 0x5b, 0xf9, 0x5c, 0x3f, 0x31,
+	0xc4, 0xb7, 0xc8, 0xf0, 0x5d, 0x24, 0xf3, 0xd9, 0x4f, 0xa6, 0xed, 0x3c, 0xd0, 0x1c, 0xa6, 0xe5,
+	0x72, 0x58, 0x3f, 0x94, 0x60, 0x2c, 0x66, 0xcf, 0x25, 0x7d, 0x1c, 0x06, 0xfd, 0x3d, 0xdb, 0x57,
+	0x0e, 0x91, 0x5d, 0x62, 0xc8, 0x66, 0xc9, 0x4c, 0x2e, 0x64, 0xe4, 0xdd, 0x80, 0x4f, 0x4c, 0xf2,
+	0x65, 0x24, 0xfd, 0x7d, 0x95, 0xc9, 0xbd, 0xc9, 0x95, 0xdc, 0xf2, 0x08, 0xf6, 0x45, 0x06, 0xb6,
+	0x42, 0x2e, 0x65, 0x83, 0x65, 0xaf, 0xb4, 0x28, 0xe9, 0x46, 0xfe, 0x24, 0xe1, 0xb4, 0x91, 0x45,
+	0xa6, 0x91, 0xa5, 0x34, 0x24, 0x7d, 0x38, 0x3a, 0xf9, 0xca, 0xde, 0x94, 0xf2, 0xc7, 0x90, 0x42,
+	0xe7, 0x91, 0xdf, 0x89, 0xef, 0xab, 0xe9, 0x3c, 0x1b, 0x59, 0xc8, 0x06, 0x93, 0xc1, 0xdc, 0xc9,
+	0x8b, 0x7b, 0x51, 0x41, 0xf4, 0x4b, 0x0c, 0xfd, 0x25, 0x72, 0x21, 0x13, 0x7d, 0x92, 0xe5, 0x23,
+	0x7f, 0x95, 0x22, 0x3c, 0x52, 0x82, 0x79, 0x22, 0x8b, 0xd9, 0x07, 0x5d, 0x16, 0xb3, 0x27, 0x2f,
+	0xed, 0x49, 0x07, 0xe1, 0x7f, 0x81, 0xc1, 0x5f, 0x23, 0x2b, 0xd9, 0xef, 0x09, 0xd4, 0xd5, 0xba,
+	0x34, 0x58, 0xe5, 0xb1, 0x38, 0x28, 0x9f, 0x54, 0x1e, 0x07, 0x27, 0xe8, 0x13, 0xf2, 0x47, 0x09,
+	0x4e, 0xf7, 0xf2, 0x9a, 0xd1, 0x56, 0x7d, 0xc8, 0x40, 0xf9, 0xca, 0xde, 0x94, 0x30, 0xb2, 0x2b,
+	0x2c, 0xb2, 0x32, 0xb9, 0xb8, 0x97, 0xc8, 0xc8, 0x6f, 0xa5, 0x08, 0xe1, 0xd3, 0x65, 0xe2, 0xc8,
+	0x85, 0x6c, 0x14, 0x09, 0x1e, 0x50, 0xbe, 0x98, 0x4f, 0x18, 0xa1, 0xae, 0x30, 0xa8, 0x9f, 0x23,
+	0x37, 0xb2, 0x7b, 0xc8, 0x57, 0xd2, 0x5c, 0x5f, 0x2b, 0x2b, 0xf9, 0x3f, 0x95, 0x82, 0xff, 0xd9,
+	0x09, 0x51, 0x7a, 0x64, 0x2e, 0x7d, 0xa2, 0x4c, 0xb2, 0x85, 0xf2, 0xf9, 0x1c, 0x92, 0x08, 0xb8,
+	0xc2, 0x00, 0x9f, 0x27, 0xb3, 0xbd, 0x01, 0x0b, 0xfa, 0xd0, 0x25, 0x1f, 0x88, 0xce, 0x48, 0xa1,
+	0xde, 0xf8, 0x64, 0x75, 0x3d, 0xcd, 0x7b, 0x2e, 0xde, 0x50, 0x7e, 0xf9, 0xe3, 0xa8, 0xe6, 0x3e,
+	0x27, 0x1b, 0x81, 0x21, 0x2d, 0x3e, 0x84, 0xfd, 0x22, 0x3a, 0x66, 0x57, 0x97, 0x6b, 0xbd, 0xc6,
+	0xec, 0x2e, 0x79, 0x28, 0xcf, 0xf4, 0x91, 0xca, 0xdd, 0x17, 0x58, 0x7f, 0x9f, 0xa3, 0xcb, 0xea,
+	0x8b, 0xef, 0x05, 0x87, 0xb9, 0xb0, 0xef, 0x92, 0xde, 0xfe, 0xdd, 0xde, 0x87, 0x79, 0x82, 0x57,
+	0x54, 0x2e, 0x32, 0x9c, 0xe7, 0xc8, 0xd9, 0x3c, 0x38, 0xc9, 0xbb, 0xa2, 0x51, 0x23, 0x04, 0x59,
+	0x7a, 0xa3, 0xa6, 0xf1, 0x8d, 0xf2, 0xf9, 0x1c, 0x92, 0x88, 0xec, 0x16, 0x43, 0x56, 0x25, 0xaf,
+	0x65, 0x22, 0xdb, 0xe6, 0x7a, 0xa2, 0x53, 0xb3, 0xb2, 0x18, 0x5c, 0x08, 0x22, 0x7e, 0x32, 0x2e,
+	0x04, 0xa9, 0x84, 0xa3, 0x3c, 0x9f, 0x47, 0x34, 0xf7, 0x85, 0x20, 0x86, 0x9b, 0xbc, 0x13, 0xf4,
+	0xa2, 0x60, 0xd2, 0x32, 0x7a, 0x31, 0x46, 0x38, 0xca, 0x33, 0x7d, 0xa4, 0x10, 0xd1, 0x2b, 0x0c,
+	0xd1, 0x55, 0x72, 0xa5, 0xdf, 0x58, 0xe4, 0xd3, 0x76, 0x91, 0x2c, 0x46, 0x9b, 0x50, 0x18, 0x76,
+	0x49, 0x6f, 0xc7, 0x6e, 0x8e, 0x89, 0x32, 0xcc, 0x33, 0xe6, 0x69, 0xc2, 0x2e, 0x40, 0xf2, 0x93,
+	0x70, 0xba, 0x38, 0xdf, 0x97, 0x99, 0xae, 0x30, 0xef, 0x28, 0xcf, 0xf4, 0x91, 0x42, 0x34, 0x57,
+	0x19, 0x9a, 0xcb, 0xa4, 0xdc, 0x1b, 0x8d, 0xe7, 0x2b, 0x85, 0xb2, 0x45, 0xfe, 0x2c, 0x21, 0xd7,
+	0x96, 0x24, 0xbd, 0x52, 0xcf, 0x94, 0x2c, 0xba, 0x48, 0xbe, 0x94, 0x53, 0x1a, 0xf1, 0xde, 0x66,
+	0x78, 0x6f, 0x92, 0xd5, 0x4c, 0xbc, 0x8f, 0xb8, 0x2e, 0x7f, 0xf5, 0x45, 0x1f, 0x93, 0x18, 0xf7,
+	0xf4, 0x84, 0xfc, 0x4a, 0x5c, 0x7c, 0x12, 0x0e, 0x5d, 0x92, 0x0f, 0x98, 0xdb, 0xf3, 0xe2, 0x93,
+	0x4d, 0x11, 0x29, 0xd7, 0x59, 0x20, 0x4b, 0x64, 0x61, 0xcf, 0x81, 0x2c, 0xdf, 0x7e, 0xef, 0x69,
+	0x49, 0x7a, 0xff, 0x69, 0x49, 0xfa, 0xfb, 0xd3, 0x92, 0xf4, 0x83, 0x67, 0xa5, 0x7d, 0xef, 0x3f,
+	0x2b, 0xed, 0xfb, 0xf0, 0x59, 0x69, 0xdf, 0x57, 0x17, 0x1b, 0xa6, 0xb7, 0xd5, 0xde, 0x2c, 0xd7,
+	0xed, 0xed, 0x4a, 0x8b, 0x36, 0x1a, 0x9d, 0x6f, 0xed, 0x84, 0xcc, 0xef, 0x5c, 0xab, 0xec, 0x86,
+	0x7d, 0x78, 0x9d, 0x16, 0x75, 0x37, 0xf7, 0xb3, 0xff, 0xa4, 0x5d, 0xfa, 0xef, 0x00, 0xb1, 0x4f,
+	0x5c, 0xa3, 0x12, 0x2c, 0x00, 0x00,
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryParams queries the axelar cork module parameters.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryCellarIDs queries approved cellar ids of all supported chains
+	QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error)
+	// QueryCellarIDsByChainID returns all cellars and current tick ranges
+	QueryCellarIDsByChainID(ctx context.Context, in *QueryCellarIDsByChainIDRequest, opts ...grpc.CallOption) (*QueryCellarIDsByChainIDResponse, error)
+	// QueryScheduledCorks returns all scheduled corks
+	QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error)
+	// QueryScheduledBlockHeights returns all scheduled block heights
+	QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks at a block height
+	QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error)
+	// QueryScheduledCorks returns all scheduled corks with the specified ID
+	QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error)
+	QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error)
+	QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error)
+	QueryChainConfigurations(ctx context.Context, in *QueryChainConfigurationsRequest, opts ...grpc.CallOption) (*QueryChainConfigurationsResponse, error)
+	QueryAxelarContractCallNonces(ctx context.Context, in *QueryAxelarContractCallNoncesRequest, opts ...grpc.CallOption) (*QueryAxelarContractCallNoncesResponse, error)
+	QueryAxelarProxyUpgradeData(ctx context.Context, in *QueryAxelarProxyUpgradeDataRequest, opts ...grpc.CallOption) (*QueryAxelarProxyUpgradeDataResponse, error)
+	QueryCellarSelectorAllowlist(ctx context.Context, in *QueryCellarSelectorAllowlistRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistResponse, error)
+	QueryCellarSelectorAllowlists(ctx context.Context, in *QueryCellarSelectorAllowlistsRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistsResponse, error)
+	QueryCellarPauseState(ctx context.Context, in *QueryCellarPauseStateRequest, opts ...grpc.CallOption) (*QueryCellarPauseStateResponse, error)
+	QueryPausedCellars(ctx context.Context, in *QueryPausedCellarsRequest, opts ...grpc.CallOption) (*QueryPausedCellarsResponse, error)
+	QueryGovernanceScheduledCorks(ctx context.Context, in *QueryGovernanceScheduledAxelarCorksRequest, opts ...grpc.CallOption) (*QueryGovernanceScheduledAxelarCorksResponse, error)
+	QueryCellarABI(ctx context.Context, in *QueryCellarABIRequest, opts ...grpc.CallOption) (*QueryCellarABIResponse, error)
+	QueryCellarABIs(ctx context.Context, in *QueryCellarABIsRequest, opts ...grpc.CallOption) (*QueryCellarABIsResponse, error)
+	QueryManagedCellar(ctx context.Context, in *QueryManagedCellarRequest, opts ...grpc.CallOption) (*QueryManagedCellarResponse, error)
+	QueryManagedCellars(ctx context.Context, in *QueryManagedCellarsRequest, opts ...grpc.CallOption) (*QueryManagedCellarsResponse, error)
+	QueryCorkRelay(ctx context.Context, in *QueryCorkRelayRequest, opts ...grpc.CallOption) (*QueryCorkRelayResponse, error)
+	QueryCorkRelays(ctx context.Context, in *QueryCorkRelaysRequest, opts ...grpc.CallOption) (*QueryCorkRelaysResponse, error)
+	QueryCorkTally(ctx context.Context, in *QueryCorkTallyRequest, opts ...grpc.CallOption) (*QueryCorkTallyResponse, error)
+	QueryWinningAxelarCork(ctx context.Context, in *QueryWinningAxelarCorkRequest, opts ...grpc.CallOption) (*QueryWinningAxelarCorkResponse, error)
+	QueryWinningAxelarCorks(ctx context.Context, in *QueryWinningAxelarCorksRequest, opts ...grpc.CallOption) (*QueryWinningAxelarCorksResponse, error)
 }
 
-func (m *QueryScheduledCorksByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryScheduledCorksByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryCorkResultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCorkResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error) {
+	out := new(QueryCellarIDsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CorkResult != nil {
-		l = m.CorkResult.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarIDsByChainID(ctx context.Context, in *QueryCellarIDsByChainIDRequest, opts ...grpc.CallOption) (*QueryCellarIDsByChainIDResponse, error) {
+	out := new(QueryCellarIDsByChainIDResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarIDsByChainID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCorkResultsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error) {
+	out := new(QueryScheduledCorksResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error) {
+	out := new(QueryScheduledBlockHeightsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledBlockHeights", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCorkResultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error) {
+	out := new(QueryScheduledCorksByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorksByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CorkResults) > 0 {
-		for _, e := range m.CorkResults {
-			l = e.Size()
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error) {
+	out := new(QueryScheduledCorksByIDResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryScheduledCorksByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error) {
+	out := new(QueryCorkResultResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error) {
+	out := new(QueryCorkResultsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryChainConfigurations(ctx context.Context, in *QueryChainConfigurationsRequest, opts ...grpc.CallOption) (*QueryChainConfigurationsResponse, error) {
+	out := new(QueryChainConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryChainConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAxelarContractCallNonces(ctx context.Context, in *QueryAxelarContractCallNoncesRequest, opts ...grpc.CallOption) (*QueryAxelarContractCallNoncesResponse, error) {
+	out := new(QueryAxelarContractCallNoncesResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryAxelarContractCallNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAxelarProxyUpgradeData(ctx context.Context, in *QueryAxelarProxyUpgradeDataRequest, opts ...grpc.CallOption) (*QueryAxelarProxyUpgradeDataResponse, error) {
+	out := new(QueryAxelarProxyUpgradeDataResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryAxelarProxyUpgradeData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarSelectorAllowlist(ctx context.Context, in *QueryCellarSelectorAllowlistRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistResponse, error) {
+	out := new(QueryCellarSelectorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarSelectorAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarSelectorAllowlists(ctx context.Context, in *QueryCellarSelectorAllowlistsRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistsResponse, error) {
+	out := new(QueryCellarSelectorAllowlistsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarSelectorAllowlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarPauseState(ctx context.Context, in *QueryCellarPauseStateRequest, opts ...grpc.CallOption) (*QueryCellarPauseStateResponse, error) {
+	out := new(QueryCellarPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPausedCellars(ctx context.Context, in *QueryPausedCellarsRequest, opts ...grpc.CallOption) (*QueryPausedCellarsResponse, error) {
+	out := new(QueryPausedCellarsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryPausedCellars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryGovernanceScheduledCorks(ctx context.Context, in *QueryGovernanceScheduledAxelarCorksRequest, opts ...grpc.CallOption) (*QueryGovernanceScheduledAxelarCorksResponse, error) {
+	out := new(QueryGovernanceScheduledAxelarCorksResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryGovernanceScheduledCorks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarABI(ctx context.Context, in *QueryCellarABIRequest, opts ...grpc.CallOption) (*QueryCellarABIResponse, error) {
+	out := new(QueryCellarABIResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarABIs(ctx context.Context, in *QueryCellarABIsRequest, opts ...grpc.CallOption) (*QueryCellarABIsResponse, error) {
+	out := new(QueryCellarABIsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCellarABIs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryManagedCellar(ctx context.Context, in *QueryManagedCellarRequest, opts ...grpc.CallOption) (*QueryManagedCellarResponse, error) {
+	out := new(QueryManagedCellarResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryManagedCellar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryManagedCellars(ctx context.Context, in *QueryManagedCellarsRequest, opts ...grpc.CallOption) (*QueryManagedCellarsResponse, error) {
+	out := new(QueryManagedCellarsResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryManagedCellars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkRelay(ctx context.Context, in *QueryCorkRelayRequest, opts ...grpc.CallOption) (*QueryCorkRelayResponse, error) {
+	out := new(QueryCorkRelayResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkRelays(ctx context.Context, in *QueryCorkRelaysRequest, opts ...grpc.CallOption) (*QueryCorkRelaysResponse, error) {
+	out := new(QueryCorkRelaysResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkRelays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkTally(ctx context.Context, in *QueryCorkTallyRequest, opts ...grpc.CallOption) (*QueryCorkTallyResponse, error) {
+	out := new(QueryCorkTallyResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryCorkTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryWinningAxelarCork(ctx context.Context, in *QueryWinningAxelarCorkRequest, opts ...grpc.CallOption) (*QueryWinningAxelarCorkResponse, error) {
+	out := new(QueryWinningAxelarCorkResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryWinningAxelarCork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryWinningAxelarCorks(ctx context.Context, in *QueryWinningAxelarCorksRequest, opts ...grpc.CallOption) (*QueryWinningAxelarCorksResponse, error) {
+	out := new(QueryWinningAxelarCorksResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Query/QueryWinningAxelarCorks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryParams queries the axelar cork module parameters.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryCellarIDs queries approved cellar ids of all supported chains
+	QueryCellarIDs(context.Context, *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error)
+	// QueryCellarIDsByChainID returns all cellars and current tick ranges
+	QueryCellarIDsByChainID(context.Context, *QueryCellarIDsByChainIDRequest) (*QueryCellarIDsByChainIDResponse, error)
+	// QueryScheduledCorks returns all scheduled corks
+	QueryScheduledCorks(context.Context, *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error)
+	// QueryScheduledBlockHeights returns all scheduled block heights
+	QueryScheduledBlockHeights(context.Context, *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks at a block height
+	QueryScheduledCorksByBlockHeight(context.Context, *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error)
+	// QueryScheduledCorks returns all scheduled corks with the specified ID
+	QueryScheduledCorksByID(context.Context, *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error)
+	QueryCorkResult(context.Context, *QueryCorkResultRequest) (*QueryCorkResultResponse, error)
+	QueryCorkResults(context.Context, *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error)
+	QueryChainConfigurations(context.Context, *QueryChainConfigurationsRequest) (*QueryChainConfigurationsResponse, error)
+	QueryAxelarContractCallNonces(context.Context, *QueryAxelarContractCallNoncesRequest) (*QueryAxelarContractCallNoncesResponse, error)
+	QueryAxelarProxyUpgradeData(context.Context, *QueryAxelarProxyUpgradeDataRequest) (*QueryAxelarProxyUpgradeDataResponse, error)
+	QueryCellarSelectorAllowlist(context.Context, *QueryCellarSelectorAllowlistRequest) (*QueryCellarSelectorAllowlistResponse, error)
+	QueryCellarSelectorAllowlists(context.Context, *QueryCellarSelectorAllowlistsRequest) (*QueryCellarSelectorAllowlistsResponse, error)
+	QueryCellarPauseState(context.Context, *QueryCellarPauseStateRequest) (*QueryCellarPauseStateResponse, error)
+	QueryPausedCellars(context.Context, *QueryPausedCellarsRequest) (*QueryPausedCellarsResponse, error)
+	QueryGovernanceScheduledCorks(context.Context, *QueryGovernanceScheduledAxelarCorksRequest) (*QueryGovernanceScheduledAxelarCorksResponse, error)
+	QueryCellarABI(context.Context, *QueryCellarABIRequest) (*QueryCellarABIResponse, error)
+	QueryCellarABIs(context.Context, *QueryCellarABIsRequest) (*QueryCellarABIsResponse, error)
+	QueryManagedCellar(context.Context, *QueryManagedCellarRequest) (*QueryManagedCellarResponse, error)
+	QueryManagedCellars(context.Context, *QueryManagedCellarsRequest) (*QueryManagedCellarsResponse, error)
+	QueryCorkRelay(context.Context, *QueryCorkRelayRequest) (*QueryCorkRelayResponse, error)
+	QueryCorkRelays(context.Context, *QueryCorkRelaysRequest) (*QueryCorkRelaysResponse, error)
+	QueryCorkTally(context.Context, *QueryCorkTallyRequest) (*QueryCorkTallyResponse, error)
+	QueryWinningAxelarCork(context.Context, *QueryWinningAxelarCorkRequest) (*QueryWinningAxelarCorkResponse, error)
+	QueryWinningAxelarCorks(context.Context, *QueryWinningAxelarCorksRequest) (*QueryWinningAxelarCorksResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarIDs(ctx context.Context, req *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDs not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarIDsByChainID(ctx context.Context, req *QueryCellarIDsByChainIDRequest) (*QueryCellarIDsByChainIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDsByChainID not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorks(ctx context.Context, req *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorks not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledBlockHeights(ctx context.Context, req *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledBlockHeights not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorksByBlockHeight(ctx context.Context, req *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorksByID(ctx context.Context, req *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByID not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkResult(ctx context.Context, req *QueryCorkResultRequest) (*QueryCorkResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResult not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkResults(ctx context.Context, req *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResults not implemented")
+}
+func (*UnimplementedQueryServer) QueryChainConfigurations(ctx context.Context, req *QueryChainConfigurationsRequest) (*QueryChainConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryChainConfigurations not implemented")
+}
+func (*UnimplementedQueryServer) QueryAxelarContractCallNonces(ctx context.Context, req *QueryAxelarContractCallNoncesRequest) (*QueryAxelarContractCallNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAxelarContractCallNonces not implemented")
+}
+func (*UnimplementedQueryServer) QueryAxelarProxyUpgradeData(ctx context.Context, req *QueryAxelarProxyUpgradeDataRequest) (*QueryAxelarProxyUpgradeDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAxelarProxyUpgradeData not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarSelectorAllowlist(ctx context.Context, req *QueryCellarSelectorAllowlistRequest) (*QueryCellarSelectorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarSelectorAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarSelectorAllowlists(ctx context.Context, req *QueryCellarSelectorAllowlistsRequest) (*QueryCellarSelectorAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarSelectorAllowlists not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarPauseState(ctx context.Context, req *QueryCellarPauseStateRequest) (*QueryCellarPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarPauseState not implemented")
+}
+func (*UnimplementedQueryServer) QueryPausedCellars(ctx context.Context, req *QueryPausedCellarsRequest) (*QueryPausedCellarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPausedCellars not implemented")
+}
+func (*UnimplementedQueryServer) QueryGovernanceScheduledCorks(ctx context.Context, req *QueryGovernanceScheduledAxelarCorksRequest) (*QueryGovernanceScheduledAxelarCorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGovernanceScheduledCorks not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarABI(ctx context.Context, req *QueryCellarABIRequest) (*QueryCellarABIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarABI not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarABIs(ctx context.Context, req *QueryCellarABIsRequest) (*QueryCellarABIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarABIs not implemented")
+}
+func (*UnimplementedQueryServer) QueryManagedCellar(ctx context.Context, req *QueryManagedCellarRequest) (*QueryManagedCellarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryManagedCellar not implemented")
+}
+func (*UnimplementedQueryServer) QueryManagedCellars(ctx context.Context, req *QueryManagedCellarsRequest) (*QueryManagedCellarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryManagedCellars not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkRelay(ctx context.Context, req *QueryCorkRelayRequest) (*QueryCorkRelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkRelay not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkRelays(ctx context.Context, req *QueryCorkRelaysRequest) (*QueryCorkRelaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkRelays not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkTally(ctx context.Context, req *QueryCorkTallyRequest) (*QueryCorkTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkTally not implemented")
+}
+func (*UnimplementedQueryServer) QueryWinningAxelarCork(ctx context.Context, req *QueryWinningAxelarCorkRequest) (*QueryWinningAxelarCorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWinningAxelarCork not implemented")
+}
+func (*UnimplementedQueryServer) QueryWinningAxelarCorks(ctx context.Context, req *QueryWinningAxelarCorksRequest) (*QueryWinningAxelarCorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWinningAxelarCorks not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarIDs(ctx, req.(*QueryCellarIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarIDsByChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarIDsByChainIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarIDsByChainID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarIDsByChainID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarIDsByChainID(ctx, req.(*QueryCellarIDsByChainIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorks(ctx, req.(*QueryScheduledCorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledBlockHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledBlockHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryScheduledBlockHeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, req.(*QueryScheduledBlockHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorksByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorksByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, req.(*QueryScheduledCorksByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorksByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorksByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryScheduledCorksByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorksByID(ctx, req.(*QueryScheduledCorksByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCorkResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkResult(ctx, req.(*QueryCorkResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCorkResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkResults(ctx, req.(*QueryCorkResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryChainConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryChainConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryChainConfigurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryChainConfigurations(ctx, req.(*QueryChainConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAxelarContractCallNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAxelarContractCallNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAxelarContractCallNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryAxelarContractCallNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAxelarContractCallNonces(ctx, req.(*QueryAxelarContractCallNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAxelarProxyUpgradeData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAxelarProxyUpgradeDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAxelarProxyUpgradeData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryAxelarProxyUpgradeData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAxelarProxyUpgradeData(ctx, req.(*QueryAxelarProxyUpgradeDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarSelectorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarSelectorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarSelectorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarSelectorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarSelectorAllowlist(ctx, req.(*QueryCellarSelectorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarSelectorAllowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarSelectorAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarSelectorAllowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarSelectorAllowlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarSelectorAllowlists(ctx, req.(*QueryCellarSelectorAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarPauseState(ctx, req.(*QueryCellarPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPausedCellars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedCellarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPausedCellars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryPausedCellars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPausedCellars(ctx, req.(*QueryPausedCellarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryGovernanceScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceScheduledAxelarCorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGovernanceScheduledCorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryGovernanceScheduledCorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGovernanceScheduledCorks(ctx, req.(*QueryGovernanceScheduledAxelarCorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarABI(ctx, req.(*QueryCellarABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarABIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarABIsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarABIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCellarABIs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarABIs(ctx, req.(*QueryCellarABIsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryManagedCellar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagedCellarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryManagedCellar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryManagedCellar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryManagedCellar(ctx, req.(*QueryManagedCellarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryManagedCellars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagedCellarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryManagedCellars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryManagedCellars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryManagedCellars(ctx, req.(*QueryManagedCellarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkRelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCorkRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkRelay(ctx, req.(*QueryCorkRelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkRelays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkRelaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkRelays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCorkRelays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkRelays(ctx, req.(*QueryCorkRelaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryCorkTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkTally(ctx, req.(*QueryCorkTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryWinningAxelarCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWinningAxelarCorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWinningAxelarCork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryWinningAxelarCork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWinningAxelarCork(ctx, req.(*QueryWinningAxelarCorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryWinningAxelarCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWinningAxelarCorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWinningAxelarCorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Query/QueryWinningAxelarCorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWinningAxelarCorks(ctx, req.(*QueryWinningAxelarCorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "axelarcork.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryCellarIDs",
+			Handler:    _Query_QueryCellarIDs_Handler,
+		},
+		{
+			MethodName: "QueryCellarIDsByChainID",
+			Handler:    _Query_QueryCellarIDsByChainID_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorks",
+			Handler:    _Query_QueryScheduledCorks_Handler,
+		},
+		{
+			MethodName: "QueryScheduledBlockHeights",
+			Handler:    _Query_QueryScheduledBlockHeights_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorksByBlockHeight",
+			Handler:    _Query_QueryScheduledCorksByBlockHeight_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorksByID",
+			Handler:    _Query_QueryScheduledCorksByID_Handler,
+		},
+		{
+			MethodName: "QueryCorkResult",
+			Handler:    _Query_QueryCorkResult_Handler,
+		},
+		{
+			MethodName: "QueryCorkResults",
+			Handler:    _Query_QueryCorkResults_Handler,
+		},
+		{
+			MethodName: "QueryChainConfigurations",
+			Handler:    _Query_QueryChainConfigurations_Handler,
+		},
+		{
+			MethodName: "QueryAxelarContractCallNonces",
+			Handler:    _Query_QueryAxelarContractCallNonces_Handler,
+		},
+		{
+			MethodName: "QueryAxelarProxyUpgradeData",
+			Handler:    _Query_QueryAxelarProxyUpgradeData_Handler,
+		},
+		{
+			MethodName: "QueryCellarSelectorAllowlist",
+			Handler:    _Query_QueryCellarSelectorAllowlist_Handler,
+		},
+		{
+			MethodName: "QueryCellarSelectorAllowlists",
+			Handler:    _Query_QueryCellarSelectorAllowlists_Handler,
+		},
+		{
+			MethodName: "QueryCellarPauseState",
+			Handler:    _Query_QueryCellarPauseState_Handler,
+		},
+		{
+			MethodName: "QueryPausedCellars",
+			Handler:    _Query_QueryPausedCellars_Handler,
+		},
+		{
+			MethodName: "QueryGovernanceScheduledCorks",
+			Handler:    _Query_QueryGovernanceScheduledCorks_Handler,
+		},
+		{
+			MethodName: "QueryCellarABI",
+			Handler:    _Query_QueryCellarABI_Handler,
+		},
+		{
+			MethodName: "QueryCellarABIs",
+			Handler:    _Query_QueryCellarABIs_Handler,
+		},
+		{
+			MethodName: "QueryManagedCellar",
+			Handler:    _Query_QueryManagedCellar_Handler,
+		},
+		{
+			MethodName: "QueryManagedCellars",
+			Handler:    _Query_QueryManagedCellars_Handler,
+		},
+		{
+			MethodName: "QueryCorkRelay",
+			Handler:    _Query_QueryCorkRelay_Handler,
+		},
+		{
+			MethodName: "QueryCorkRelays",
+			Handler:    _Query_QueryCorkRelays_Handler,
+		},
+		{
+			MethodName: "QueryCorkTally",
+			Handler:    _Query_QueryCorkTally_Handler,
+		},
+		{
+			MethodName: "QueryWinningAxelarCork",
+			Handler:    _Query_QueryWinningAxelarCork_Handler,
+		},
+		{
+			MethodName: "QueryWinningAxelarCorks",
+			Handler:    _Query_QueryWinningAxelarCorks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "axelarcork/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsByChainIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsByChainIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledBlockHeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledBlockHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledBlockHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledBlockHeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledBlockHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledBlockHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHeights) > 0 {
+		dAtA7 := make([]byte, len(m.BlockHeights)*10)
+		var j6 int
+		for _, num := range m.BlockHeights {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCall {
+		i--
+		if m.DecodeCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodedCall != nil {
+		{
+			size, err := m.DecodedCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorkResult != nil {
+		{
+			size, err := m.CorkResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Approval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Approval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CorkResults) > 0 {
+		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainConfigurationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainConfigurationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainConfigurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainConfigurationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainConfigurationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainConfigurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configurations) > 0 {
+		for iNdEx := len(m.Configurations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAxelarContractCallNoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAxelarContractCallNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAxelarContractCallNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAxelarContractCallNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAxelarContractCallNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAxelarContractCallNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractCallNonces) > 0 {
+		for iNdEx := len(m.ContractCallNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCallNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAxelarProxyUpgradeDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAxelarProxyUpgradeDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAxelarProxyUpgradeDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAxelarProxyUpgradeDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAxelarProxyUpgradeDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAxelarProxyUpgradeDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyUpgradeData) > 0 {
+		for iNdEx := len(m.ProxyUpgradeData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyUpgradeData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlists) > 0 {
+		for iNdEx := len(m.Allowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCellarsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCellarsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCellarsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCellarsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCellarsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCellarsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedCellarIds) > 0 {
+		for iNdEx := len(m.PausedCellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedCellarIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abis) > 0 {
+		for iNdEx := len(m.Abis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Abis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cellar.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cellars) > 0 {
+		for iNdEx := len(m.Cellars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cellars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Relay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkRelaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkRelaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkRelaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkRelaysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkRelaysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkRelaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relays) > 0 {
+		for iNdEx := len(m.Relays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AxelarCorkTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AxelarCorkTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AxelarCorkTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VoteThreshold) > 0 {
+		i -= len(m.VoteThreshold)
+		copy(dAtA[i:], m.VoteThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApprovalPercentage) > 0 {
+		i -= len(m.ApprovalPercentage)
+		copy(dAtA[i:], m.ApprovalPercentage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ApprovalPercentage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WinningAxelarCork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WinningAxelarCork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WinningAxelarCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueueNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueueNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApprovalHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ApprovalHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWinningAxelarCorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWinningAxelarCorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWinningAxelarCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWinningAxelarCorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWinningAxelarCorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWinningAxelarCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWinningAxelarCorksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWinningAxelarCorksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWinningAxelarCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWinningAxelarCorksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWinningAxelarCorksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWinningAxelarCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCellarIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCellarIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for _, e := range m.CellarIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarIDsByChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCellarIDsByChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCorksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockHeight))
+	}
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledBlockHeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryScheduledBlockHeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeights) > 0 {
+		l = 0
+		for _, e := range m.BlockHeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.DecodeCall {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCorkResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorkResult != nil {
+		l = m.CorkResult.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DecodedCall != nil {
+		l = m.DecodedCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorkResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockHeight))
+	}
+	if m.Approval != 0 {
+		n += 1 + sovQuery(uint64(m.Approval))
+	}
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCorkResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CorkResults) > 0 {
+		for _, e := range m.CorkResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChainConfigurationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainConfigurationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configurations) > 0 {
+		for _, e := range m.Configurations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAxelarContractCallNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAxelarContractCallNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractCallNonces) > 0 {
+		for _, e := range m.ContractCallNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAxelarProxyUpgradeDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAxelarProxyUpgradeDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProxyUpgradeData) > 0 {
+		for _, e := range m.ProxyUpgradeData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlists) > 0 {
+		for _, e := range m.Allowlists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPausedCellarsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPausedCellarsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedCellarIds) > 0 {
+		for _, e := range m.PausedCellarIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGovernanceScheduledAxelarCorksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarABIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarABIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarABIsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryCellarABIsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Abis) > 0 {
+		for _, e := range m.Abis {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryManagedCellarRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagedCellarResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cellar.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryManagedCellarsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryManagedCellarsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cellars) > 0 {
+		for _, e := range m.Cellars {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorkRelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Relay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCorkRelaysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryCorkRelaysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relays) > 0 {
+		for _, e := range m.Relays {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AxelarCorkTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cork.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = len(m.ApprovalPercentage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoteThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *WinningAxelarCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cork.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ApprovalHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ApprovalHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiryHeight))
+	}
+	if m.NextNonce != 0 {
+		n += 1 + sovQuery(uint64(m.NextNonce))
+	}
+	if m.QueueNonce != 0 {
+		n += 1 + sovQuery(uint64(m.QueueNonce))
+	}
+	return n
+}
+
+func (m *QueryWinningAxelarCorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWinningAxelarCorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cork.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWinningAxelarCorksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryWinningAxelarCorksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, &CellarIDSet{})
+			if err := m.CellarIds[len(m.CellarIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsByChainIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsByChainIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsByChainIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsByChainIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
+			}
+			m.MinBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockHeights = append(m.BlockHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockHeights) == 0 {
+					m.BlockHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockHeights = append(m.BlockHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CorkResult == nil {
+				m.CorkResult = &AxelarCorkResult{}
+			}
+			if err := m.CorkResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedCall == nil {
+				m.DecodedCall = &DecodedContractCall{}
+			}
+			if err := m.DecodedCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
+			}
+			m.MinBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			m.Approval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approval |= ApprovalFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkResults = append(m.CorkResults, &AxelarCorkResult{})
+			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainConfigurationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainConfigurationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainConfigurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainConfigurationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainConfigurationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainConfigurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configurations = append(m.Configurations, &ChainConfiguration{})
+			if err := m.Configurations[len(m.Configurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAxelarContractCallNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAxelarContractCallNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAxelarContractCallNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAxelarContractCallNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAxelarContractCallNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAxelarContractCallNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallNonces = append(m.ContractCallNonces, &AxelarContractCallNonce{})
+			if err := m.ContractCallNonces[len(m.ContractCallNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAxelarProxyUpgradeDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAxelarProxyUpgradeDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUpgradeData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyUpgradeData = append(m.ProxyUpgradeData, &AxelarUpgradeData{})
+			if err := m.ProxyUpgradeData[len(m.ProxyUpgradeData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlists = append(m.Allowlists, AxelarCellarSelectorAllowlist{})
+			if err := m.Allowlists[len(m.Allowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarPauseStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarPauseStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryChainConfigurationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryChainConfigurationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Configurations) > 0 {
-		for _, e := range m.Configurations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCellarPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryAxelarContractCallNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryAxelarContractCallNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractCallNonces) > 0 {
-		for _, e := range m.ContractCallNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarPauseStateResponse: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *QueryAxelarProxyUpgradeDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryAxelarProxyUpgradeDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ProxyUpgradeData) > 0 {
-		for _, e := range m.ProxyUpgradeData {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedCellarsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,12 +10285,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedCellarsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedCellarsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2830,7 +10331,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedCellarsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2853,15 +10354,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedCellarsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedCellarsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedCellarIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2888,7 +10389,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PausedCellarIds = append(m.PausedCellarIds, &CellarIDSet{})
+			if err := m.PausedCellarIds[len(m.PausedCellarIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2913,7 +10415,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGovernanceScheduledAxelarCorksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2936,12 +10438,31 @@ func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovernanceScheduledAxelarCorksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovernanceScheduledAxelarCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2963,7 +10484,7 @@ func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGovernanceScheduledAxelarCorksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2986,15 +10507,15 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovernanceScheduledAxelarCorksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovernanceScheduledAxelarCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3021,8 +10542,8 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, &CellarIDSet{})
-			if err := m.CellarIds[len(m.CellarIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Corks = append(m.Corks, GovernanceScheduledAxelarCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3047,7 +10568,7 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsByChainIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3070,10 +10591,10 @@ func (m *QueryCellarIDsByChainIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsByChainIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3095,6 +10616,38 @@ func (m *QueryCellarIDsByChainIDRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3116,7 +10669,7 @@ func (m *QueryCellarIDsByChainIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsByChainIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3139,15 +10692,15 @@ func (m *QueryCellarIDsByChainIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsByChainIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3175,7 +10728,7 @@ func (m *QueryCellarIDsByChainIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3198,7 +10751,7 @@ func (m *QueryCellarIDsByChainIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,10 +10774,10 @@ func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3267,7 +10820,7 @@ func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3290,15 +10843,15 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3325,8 +10878,8 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Abis = append(m.Abis, AxelarCellarABI{})
+			if err := m.Abis[len(m.Abis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3351,7 +10904,7 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3374,10 +10927,10 @@ func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3399,6 +10952,38 @@ func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3420,7 +11005,7 @@ func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3443,88 +11028,45 @@ func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlockHeights = append(m.BlockHeights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cellar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlockHeights) == 0 {
-					m.BlockHeights = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlockHeights = append(m.BlockHeights, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cellar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3546,7 +11088,7 @@ func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3569,32 +11111,13 @@ func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -3634,7 +11157,7 @@ func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3657,15 +11180,15 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cellars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3692,8 +11215,8 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cellars = append(m.Cellars, AxelarManagedCellar{})
+			if err := m.Cellars[len(m.Cellars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3718,7 +11241,7 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkRelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3741,13 +11264,32 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkRelayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -3779,25 +11321,6 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3819,7 +11342,7 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkRelayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3842,15 +11365,15 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkRelayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3877,8 +11400,7 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledAxelarCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Relay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3903,68 +11425,36 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkRelaysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkRelaysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkRelaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -4004,7 +11494,7 @@ func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkRelaysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4027,15 +11517,15 @@ func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkRelaysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkRelaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4062,10 +11552,8 @@ func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CorkResult == nil {
-				m.CorkResult = &AxelarCorkResult{}
-			}
-			if err := m.CorkResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relays = append(m.Relays, AxelarCorkRelay{})
+			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4090,7 +11578,7 @@ func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4113,10 +11601,10 @@ func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkTallyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4138,6 +11626,57 @@ func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4159,7 +11698,7 @@ func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
+func (m *AxelarCorkTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4182,17 +11721,204 @@ func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarCorkTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarCorkTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4202,25 +11928,23 @@ func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorkResults = append(m.CorkResults, &AxelarCorkResult{})
-			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4243,7 +11967,7 @@ func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainConfigurationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkTallyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4266,12 +11990,65 @@ func (m *QueryChainConfigurationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainConfigurationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkTallyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainConfigurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, AxelarCorkTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4293,7 +12070,7 @@ func (m *QueryChainConfigurationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainConfigurationsResponse) Unmarshal(dAtA []byte) error {
+func (m *WinningAxelarCork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4316,15 +12093,15 @@ func (m *QueryChainConfigurationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainConfigurationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WinningAxelarCork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainConfigurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WinningAxelarCork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configurations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4351,11 +12128,118 @@ func (m *QueryChainConfigurationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configurations = append(m.Configurations, &ChainConfiguration{})
-			if err := m.Configurations[len(m.Configurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalHeight", wireType)
+			}
+			m.ApprovalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+			}
+			m.NextNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueNonce", wireType)
+			}
+			m.QueueNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4377,7 +12261,7 @@ func (m *QueryChainConfigurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAxelarContractCallNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWinningAxelarCorkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4400,12 +12284,63 @@ func (m *QueryAxelarContractCallNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAxelarContractCallNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWinningAxelarCorkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAxelarContractCallNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWinningAxelarCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4427,7 +12362,7 @@ func (m *QueryAxelarContractCallNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAxelarContractCallNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWinningAxelarCorkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4450,15 +12385,15 @@ func (m *QueryAxelarContractCallNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAxelarContractCallNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWinningAxelarCorkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAxelarContractCallNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWinningAxelarCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4485,8 +12420,7 @@ func (m *QueryAxelarContractCallNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractCallNonces = append(m.ContractCallNonces, &AxelarContractCallNonce{})
-			if err := m.ContractCallNonces[len(m.ContractCallNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4511,7 +12445,7 @@ func (m *QueryAxelarContractCallNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAxelarProxyUpgradeDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWinningAxelarCorksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4534,12 +12468,31 @@ func (m *QueryAxelarProxyUpgradeDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWinningAxelarCorksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWinningAxelarCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4561,7 +12514,7 @@ func (m *QueryAxelarProxyUpgradeDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAxelarProxyUpgradeDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWinningAxelarCorksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4584,15 +12537,15 @@ func (m *QueryAxelarProxyUpgradeDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWinningAxelarCorksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAxelarProxyUpgradeDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWinningAxelarCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUpgradeData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4619,8 +12572,8 @@ func (m *QueryAxelarProxyUpgradeDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyUpgradeData = append(m.ProxyUpgradeData, &AxelarUpgradeData{})
-			if err := m.ProxyUpgradeData[len(m.ProxyUpgradeData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Corks = append(m.Corks, WinningAxelarCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
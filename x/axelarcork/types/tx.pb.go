@@ -153,6 +153,8 @@ type MsgRelayAxelarCorkRequest struct {
 	Fee                   uint64     `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
 	ChainId               uint64     `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	TargetContractAddress string     `protobuf:"bytes,5,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// hex encoded ID of a specific queued cork to relay, the head of the cellar's queue is relayed when empty
+	CorkId string `protobuf:"bytes,6,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
 }
 
 func (m *MsgRelayAxelarCorkRequest) Reset()         { *m = MsgRelayAxelarCorkRequest{} }
@@ -223,6 +225,13 @@ func (m *MsgRelayAxelarCorkRequest) GetTargetContractAddress() string {
 	return ""
 }
 
+func (m *MsgRelayAxelarCorkRequest) GetCorkId() string {
+	if m != nil {
+		return m.CorkId
+	}
+	return ""
+}
+
 type MsgRelayAxelarCorkResponse struct {
 }
 
@@ -555,6 +564,112 @@ func (m *MsgCancelAxelarCorkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelAxelarCorkResponse proto.InternalMessageInfo
 
+// MsgSetAxelarCellarPausedRequest - sdk.Msg for the pause guardian to pause or resume a cellar
+type MsgSetAxelarCellarPausedRequest struct {
+	// must be the pause guardian
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId  uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CellarId string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused   bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) Reset()         { *m = MsgSetAxelarCellarPausedRequest{} }
+func (m *MsgSetAxelarCellarPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAxelarCellarPausedRequest) ProtoMessage()    {}
+func (*MsgSetAxelarCellarPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7efa2af5736321fb, []int{10}
+}
+func (m *MsgSetAxelarCellarPausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAxelarCellarPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAxelarCellarPausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAxelarCellarPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAxelarCellarPausedRequest.Merge(m, src)
+}
+func (m *MsgSetAxelarCellarPausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAxelarCellarPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAxelarCellarPausedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAxelarCellarPausedRequest proto.InternalMessageInfo
+
+func (m *MsgSetAxelarCellarPausedRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type MsgSetAxelarCellarPausedResponse struct {
+}
+
+func (m *MsgSetAxelarCellarPausedResponse) Reset()         { *m = MsgSetAxelarCellarPausedResponse{} }
+func (m *MsgSetAxelarCellarPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAxelarCellarPausedResponse) ProtoMessage()    {}
+func (*MsgSetAxelarCellarPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7efa2af5736321fb, []int{11}
+}
+func (m *MsgSetAxelarCellarPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAxelarCellarPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAxelarCellarPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAxelarCellarPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAxelarCellarPausedResponse.Merge(m, src)
+}
+func (m *MsgSetAxelarCellarPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAxelarCellarPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAxelarCellarPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAxelarCellarPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgScheduleAxelarCorkRequest)(nil), "axelarcork.v1.MsgScheduleAxelarCorkRequest")
 	proto.RegisterType((*MsgScheduleAxelarCorkResponse)(nil), "axelarcork.v1.MsgScheduleAxelarCorkResponse")
@@ -566,51 +681,59 @@ func init() {
 	proto.RegisterType((*MsgBumpAxelarCorkGasResponse)(nil), "axelarcork.v1.MsgBumpAxelarCorkGasResponse")
 	proto.RegisterType((*MsgCancelAxelarCorkRequest)(nil), "axelarcork.v1.MsgCancelAxelarCorkRequest")
 	proto.RegisterType((*MsgCancelAxelarCorkResponse)(nil), "axelarcork.v1.MsgCancelAxelarCorkResponse")
+	proto.RegisterType((*MsgSetAxelarCellarPausedRequest)(nil), "axelarcork.v1.MsgSetAxelarCellarPausedRequest")
+	proto.RegisterType((*MsgSetAxelarCellarPausedResponse)(nil), "axelarcork.v1.MsgSetAxelarCellarPausedResponse")
 }
 
 func init() { proto.RegisterFile("axelarcork/v1/tx.proto", fileDescriptor_7efa2af5736321fb) }
 
 var fileDescriptor_7efa2af5736321fb = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0xd4, 0x69, 0xbf, 0x2f, 0xd3, 0x82, 0x90, 0x81, 0x92, 0x9a, 0xd6, 0xb4, 0x16, 0x8b,
-	0xd0, 0x82, 0x47, 0x09, 0x02, 0xd6, 0x4d, 0x16, 0x50, 0x89, 0x48, 0xc8, 0x88, 0x0d, 0x9b, 0x30,
-	0xb1, 0x6f, 0x27, 0x26, 0xb6, 0xc7, 0x78, 0x26, 0x51, 0xf2, 0x04, 0x2c, 0xd8, 0xf0, 0x06, 0x2c,
-	0xd8, 0xf0, 0x28, 0xdd, 0xd1, 0x25, 0x2b, 0x84, 0x92, 0x17, 0x41, 0x1e, 0xbb, 0x4a, 0x42, 0x7e,
-	0x94, 0x0d, 0x12, 0xbb, 0x99, 0x7b, 0xcf, 0x9d, 0x7b, 0xce, 0xfd, 0x19, 0xbc, 0x4b, 0x07, 0x10,
-	0xd0, 0xc4, 0xe5, 0x49, 0x97, 0xf4, 0xab, 0x44, 0x0e, 0xec, 0x38, 0xe1, 0x92, 0xeb, 0xd7, 0x26,
-	0x76, 0xbb, 0x5f, 0x35, 0x4c, 0x97, 0x8b, 0x90, 0x0b, 0xd2, 0xa6, 0x02, 0x48, 0xbf, 0xda, 0x06,
-	0x49, 0xab, 0xc4, 0xe5, 0x7e, 0x94, 0xc1, 0x0d, 0x73, 0xf6, 0x99, 0xa9, 0xe0, 0xcc, 0x7f, 0x8b,
-	0x71, 0xc6, 0xd5, 0x91, 0xa4, 0xa7, 0xcc, 0x6a, 0x7d, 0x45, 0x78, 0xbf, 0x29, 0xd8, 0x6b, 0xb7,
-	0x03, 0x5e, 0x2f, 0x80, 0x53, 0x15, 0xd5, 0xe0, 0x49, 0xd7, 0x81, 0x0f, 0x3d, 0x10, 0x52, 0x7f,
-	0x84, 0x8b, 0xe9, 0x23, 0x65, 0x74, 0x88, 0x2a, 0xdb, 0xb5, 0x3d, 0x7b, 0x86, 0x94, 0x3d, 0x85,
-	0x57, 0x30, 0x7d, 0x0f, 0xff, 0xef, 0x76, 0xa8, 0x1f, 0xb5, 0x7c, 0xaf, 0xbc, 0x71, 0x88, 0x2a,
-	0x45, 0xe7, 0x3f, 0x75, 0x3f, 0xf3, 0xf4, 0x23, 0xbc, 0xd3, 0x0e, 0xb8, 0xdb, 0x6d, 0x75, 0xc0,
-	0x67, 0x1d, 0x59, 0xd6, 0x94, 0x7b, 0x5b, 0xd9, 0x5e, 0x28, 0x93, 0xbe, 0x8b, 0xb7, 0x84, 0xcf,
-	0x22, 0x48, 0xca, 0xc5, 0x43, 0x54, 0x29, 0x39, 0xf9, 0xcd, 0x22, 0xf8, 0x60, 0x09, 0x49, 0x11,
-	0xf3, 0x48, 0x80, 0x7e, 0x1d, 0x6f, 0xf8, 0x9e, 0xe2, 0x58, 0x72, 0x36, 0x7c, 0xcf, 0xfa, 0x8e,
-	0xf0, 0x5e, 0x53, 0x30, 0x07, 0x02, 0x3a, 0x9c, 0xd7, 0x34, 0x49, 0x83, 0xa6, 0xd3, 0xe8, 0x4f,
-	0xf0, 0xa6, 0xe4, 0x5d, 0x88, 0x14, 0xf3, 0x54, 0x6c, 0x56, 0x72, 0x3b, 0x2d, 0xb9, 0x9d, 0x97,
-	0xdc, 0x6e, 0x70, 0x3f, 0xaa, 0x17, 0x2f, 0x7e, 0xde, 0x2b, 0x38, 0x19, 0x5a, 0xbf, 0x81, 0xb5,
-	0x73, 0x80, 0x5c, 0x4f, 0x7a, 0x9c, 0xa9, 0x42, 0x71, 0xb6, 0x0a, 0x4f, 0xf1, 0x1d, 0x49, 0x13,
-	0x06, 0xb2, 0xe5, 0xf2, 0x48, 0x26, 0xd4, 0x95, 0x2d, 0xea, 0x79, 0x09, 0x08, 0x51, 0xde, 0x54,
-	0x64, 0x6e, 0x67, 0xee, 0x46, 0xee, 0x3d, 0xcd, 0x9c, 0xd6, 0x3e, 0x36, 0x16, 0x09, 0xca, 0xf4,
-	0x5b, 0x5f, 0x10, 0x3e, 0x9a, 0x75, 0xbf, 0x4a, 0xf8, 0x60, 0xf8, 0x26, 0x66, 0x09, 0xf5, 0xe0,
-	0x1f, 0xd0, 0x6d, 0xdd, 0xc7, 0xd6, 0x2a, 0x82, 0xb9, 0x8e, 0x4f, 0x08, 0xdf, 0x6d, 0x0a, 0x56,
-	0xef, 0x85, 0xf1, 0x44, 0xe5, 0x73, 0x2a, 0xfe, 0x92, 0x82, 0x03, 0x8c, 0x43, 0x10, 0x82, 0x32,
-	0x48, 0x19, 0x6b, 0xea, 0xc9, 0x52, 0x6e, 0x39, 0xf3, 0x2c, 0x53, 0xed, 0xc6, 0x02, 0x32, 0x39,
-	0xdb, 0x8f, 0x48, 0x35, 0xa5, 0x41, 0x23, 0x17, 0x82, 0xf5, 0xc7, 0x6c, 0xc5, 0x8e, 0xac, 0x98,
-	0x0e, 0x6d, 0xd5, 0x74, 0x1c, 0xa8, 0xb2, 0xcd, 0x13, 0xc9, 0x88, 0xd6, 0xbe, 0x69, 0x58, 0x6b,
-	0x0a, 0xa6, 0xfb, 0x78, 0xe7, 0x6a, 0x89, 0x52, 0xbf, 0x7e, 0xf2, 0xc7, 0x3a, 0xaf, 0xfa, 0x09,
-	0x8c, 0x87, 0xeb, 0x81, 0xf3, 0x8d, 0x7c, 0x87, 0x4b, 0xaa, 0xd9, 0x2a, 0x4f, 0x65, 0x3e, 0x74,
-	0xf1, 0x6a, 0x1a, 0x0f, 0xd6, 0x40, 0xe6, 0x19, 0xce, 0xf1, 0x76, 0xda, 0x9a, 0xbc, 0x29, 0xfa,
-	0xf1, 0x7c, 0xe4, 0xb2, 0x31, 0x32, 0x4e, 0xd6, 0xc2, 0xe6, 0x79, 0x02, 0x7c, 0x33, 0x2b, 0xec,
-	0x95, 0x5a, 0x4f, 0x69, 0x5a, 0xc0, 0x74, 0xc9, 0x20, 0x18, 0xc7, 0xeb, 0x40, 0xb3, 0x6c, 0xf5,
-	0x97, 0x17, 0x23, 0x13, 0x5d, 0x8e, 0x4c, 0xf4, 0x6b, 0x64, 0xa2, 0xcf, 0x63, 0xb3, 0x70, 0x39,
-	0x36, 0x0b, 0x3f, 0xc6, 0x66, 0xe1, 0x6d, 0x8d, 0xf9, 0xb2, 0xd3, 0x6b, 0xdb, 0x2e, 0x0f, 0x49,
-	0x0c, 0x8c, 0x0d, 0xdf, 0xf7, 0x89, 0xe0, 0x61, 0x08, 0x81, 0x0f, 0x09, 0xe9, 0x3f, 0x23, 0x83,
-	0xa9, 0x4f, 0x9f, 0xc8, 0x61, 0x0c, 0xa2, 0xbd, 0xa5, 0x7e, 0xf9, 0xc7, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x7b, 0xa5, 0x46, 0xdf, 0x64, 0x06, 0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x93, 0xf4, 0x4f, 0xa6, 0xfd, 0xfd, 0x00, 0x03, 0x6d, 0xea, 0xb6, 0x6e, 0x6a, 0x71,
+	0x08, 0x2d, 0xd8, 0xa4, 0x08, 0x38, 0xb7, 0x39, 0x40, 0x24, 0x22, 0xa1, 0x54, 0x5c, 0xb8, 0x84,
+	0x8d, 0x3d, 0xdd, 0x98, 0x38, 0xde, 0xe0, 0xdd, 0x44, 0xc9, 0x13, 0x20, 0xc4, 0x85, 0x13, 0x57,
+	0x0e, 0xbc, 0x4c, 0x8f, 0x3d, 0x72, 0x42, 0xa8, 0xbd, 0xf0, 0x18, 0xc8, 0xeb, 0xad, 0x92, 0xd0,
+	0x24, 0x0a, 0x42, 0x48, 0xdc, 0xbc, 0x33, 0xb3, 0x33, 0xdf, 0x7c, 0xfb, 0xcd, 0x18, 0xd6, 0x48,
+	0x1f, 0x03, 0x12, 0xb9, 0x2c, 0x6a, 0x39, 0xbd, 0x92, 0x23, 0xfa, 0x76, 0x27, 0x62, 0x82, 0xe9,
+	0xff, 0x0d, 0xed, 0x76, 0xaf, 0x64, 0x98, 0x2e, 0xe3, 0x6d, 0xc6, 0x9d, 0x06, 0xe1, 0xe8, 0xf4,
+	0x4a, 0x0d, 0x14, 0xa4, 0xe4, 0xb8, 0xcc, 0x0f, 0x93, 0x70, 0xc3, 0x1c, 0x4f, 0x33, 0x72, 0x39,
+	0xf1, 0xdf, 0xa2, 0x8c, 0x32, 0xf9, 0xe9, 0xc4, 0x5f, 0x89, 0xd5, 0xfa, 0xa2, 0xc1, 0x56, 0x95,
+	0xd3, 0x63, 0xb7, 0x89, 0x5e, 0x37, 0xc0, 0x43, 0x79, 0xab, 0xcc, 0xa2, 0x56, 0x0d, 0xdf, 0x76,
+	0x91, 0x0b, 0xfd, 0x3e, 0x64, 0xe3, 0x24, 0x79, 0xad, 0xa0, 0x15, 0x57, 0x0e, 0x36, 0xec, 0x31,
+	0x50, 0xf6, 0x48, 0xbc, 0x0c, 0xd3, 0x37, 0x60, 0xd9, 0x6d, 0x12, 0x3f, 0xac, 0xfb, 0x5e, 0x3e,
+	0x5d, 0xd0, 0x8a, 0xd9, 0xda, 0x92, 0x3c, 0x57, 0x3c, 0x7d, 0x17, 0x56, 0x1b, 0x01, 0x73, 0x5b,
+	0xf5, 0x26, 0xfa, 0xb4, 0x29, 0xf2, 0x19, 0xe9, 0x5e, 0x91, 0xb6, 0x67, 0xd2, 0xa4, 0xaf, 0xc1,
+	0x22, 0xf7, 0x69, 0x88, 0x51, 0x3e, 0x5b, 0xd0, 0x8a, 0xb9, 0x9a, 0x3a, 0x59, 0x0e, 0x6c, 0x4f,
+	0x01, 0xc9, 0x3b, 0x2c, 0xe4, 0xa8, 0xff, 0x0f, 0x69, 0xdf, 0x93, 0x18, 0x73, 0xb5, 0xb4, 0xef,
+	0x59, 0x3f, 0x34, 0xd8, 0xa8, 0x72, 0x5a, 0xc3, 0x80, 0x0c, 0xae, 0xf6, 0x34, 0x2c, 0xa3, 0x8d,
+	0x96, 0xd1, 0x1f, 0xc1, 0x82, 0x60, 0x2d, 0x0c, 0xf3, 0x69, 0xd5, 0x6c, 0x42, 0xb9, 0x1d, 0x53,
+	0x6e, 0x2b, 0xca, 0xed, 0x32, 0xf3, 0xc3, 0xa3, 0xec, 0xe9, 0xb7, 0x9d, 0x54, 0x2d, 0x89, 0xd6,
+	0xaf, 0x43, 0xe6, 0x04, 0x51, 0xf5, 0x13, 0x7f, 0x8e, 0xb1, 0x90, 0x1d, 0x67, 0xe1, 0x31, 0xac,
+	0x0b, 0x12, 0x51, 0x14, 0x75, 0x97, 0x85, 0x22, 0x22, 0xae, 0xa8, 0x13, 0xcf, 0x8b, 0x90, 0xf3,
+	0xfc, 0x82, 0x04, 0x73, 0x3b, 0x71, 0x97, 0x95, 0xf7, 0x30, 0x71, 0xea, 0xeb, 0xb0, 0x14, 0x13,
+	0x1c, 0x67, 0x5c, 0x4c, 0x40, 0xc7, 0xc7, 0x8a, 0x67, 0x6d, 0x81, 0x31, 0xa9, 0xd3, 0x84, 0x18,
+	0xeb, 0xb3, 0x06, 0xbb, 0xe3, 0xee, 0x17, 0x11, 0xeb, 0x0f, 0x5e, 0x76, 0x68, 0x44, 0x3c, 0xfc,
+	0x07, 0x08, 0xb1, 0xee, 0x80, 0x35, 0x0b, 0xa0, 0xea, 0xe3, 0x83, 0x06, 0x9b, 0x55, 0x4e, 0x8f,
+	0xba, 0xed, 0xce, 0xb0, 0xcb, 0xa7, 0x84, 0xff, 0xa5, 0x0e, 0xb6, 0x01, 0xda, 0xc8, 0x39, 0xa1,
+	0x18, 0x23, 0xce, 0xc8, 0x94, 0x39, 0x65, 0xa9, 0x78, 0x96, 0x09, 0x5b, 0x93, 0xc1, 0x28, 0xb4,
+	0xef, 0x34, 0xf9, 0x28, 0x65, 0x12, 0xba, 0x18, 0xcc, 0xaf, 0xbf, 0x19, 0xc3, 0x33, 0x43, 0x36,
+	0x99, 0x19, 0xb2, 0xb1, 0xb6, 0x61, 0x73, 0x22, 0x10, 0x05, 0xf4, 0xbd, 0x06, 0x3b, 0xf1, 0x64,
+	0xa1, 0x50, 0x4e, 0x0c, 0xe2, 0x17, 0x20, 0x5d, 0x8e, 0xde, 0x1f, 0xa0, 0xdd, 0x84, 0x9c, 0x2b,
+	0x33, 0x0d, 0xd9, 0x5b, 0x4e, 0x0c, 0x15, 0x2f, 0xce, 0xd7, 0x91, 0x05, 0xa4, 0x12, 0x96, 0x6b,
+	0xea, 0x64, 0x59, 0x50, 0x98, 0x0e, 0x25, 0xc1, 0x7b, 0xf0, 0x69, 0x01, 0x32, 0x55, 0x4e, 0x75,
+	0x1f, 0x56, 0x2f, 0xb7, 0x41, 0xdc, 0x8f, 0xbe, 0xff, 0xcb, 0x5e, 0x9a, 0xb5, 0xd2, 0x8c, 0x7b,
+	0xf3, 0x05, 0xab, 0xd5, 0xf2, 0x1a, 0x72, 0x52, 0x9c, 0xb2, 0x4e, 0xf1, 0xea, 0xd5, 0xc9, 0x3b,
+	0xc6, 0xb8, 0x3b, 0x47, 0xa4, 0xaa, 0xd0, 0x87, 0x1b, 0xd2, 0x35, 0x2a, 0x7c, 0xfd, 0xc1, 0xcc,
+	0xfb, 0x13, 0x86, 0xd8, 0x28, 0xfd, 0xc6, 0x0d, 0x55, 0xf9, 0x04, 0x56, 0x62, 0x11, 0x2b, 0xf9,
+	0xea, 0x7b, 0x57, 0x33, 0x4c, 0x1b, 0x38, 0x63, 0x7f, 0xae, 0x58, 0x55, 0x27, 0x80, 0x9b, 0x89,
+	0x04, 0x2f, 0x79, 0xf6, 0x24, 0x9b, 0x13, 0x38, 0x9a, 0x32, 0x32, 0xc6, 0xde, 0x3c, 0xa1, 0xaa,
+	0x5a, 0x04, 0xd7, 0x8e, 0x51, 0x8c, 0xea, 0x47, 0xb7, 0x27, 0x3c, 0xf9, 0x0c, 0xcd, 0x1b, 0xce,
+	0xdc, 0xf1, 0x49, 0xcd, 0xa3, 0xe7, 0xa7, 0xe7, 0xa6, 0x76, 0x76, 0x6e, 0x6a, 0xdf, 0xcf, 0x4d,
+	0xed, 0xe3, 0x85, 0x99, 0x3a, 0xbb, 0x30, 0x53, 0x5f, 0x2f, 0xcc, 0xd4, 0xab, 0x03, 0xea, 0x8b,
+	0x66, 0xb7, 0x61, 0xbb, 0xac, 0xed, 0x74, 0x90, 0xd2, 0xc1, 0x9b, 0x9e, 0xc3, 0x59, 0xbb, 0x8d,
+	0x81, 0x8f, 0x91, 0xd3, 0x7b, 0xe2, 0xf4, 0x47, 0xfe, 0xd5, 0x8e, 0x18, 0x74, 0x90, 0x37, 0x16,
+	0xe5, 0xcf, 0xf9, 0xe1, 0xcf, 0x01, 0x00, 0xa8, 0xbb, 0x9e, 0x58, 0x1b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -627,8 +750,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	ScheduleCork(ctx context.Context, in *MsgScheduleAxelarCorkRequest, opts ...grpc.CallOption) (*MsgScheduleAxelarCorkResponse, error)
 	RelayCork(ctx context.Context, in *MsgRelayAxelarCorkRequest, opts ...grpc.CallOption) (*MsgRelayAxelarCorkResponse, error)
+	RelayProxyUpgrade(ctx context.Context, in *MsgRelayAxelarProxyUpgradeRequest, opts ...grpc.CallOption) (*MsgRelayAxelarProxyUpgradeResponse, error)
 	BumpCorkGas(ctx context.Context, in *MsgBumpAxelarCorkGasRequest, opts ...grpc.CallOption) (*MsgBumpAxelarCorkGasResponse, error)
 	CancelScheduledCork(ctx context.Context, in *MsgCancelAxelarCorkRequest, opts ...grpc.CallOption) (*MsgCancelAxelarCorkResponse, error)
+	SetCellarPaused(ctx context.Context, in *MsgSetAxelarCellarPausedRequest, opts ...grpc.CallOption) (*MsgSetAxelarCellarPausedResponse, error)
 }
 
 type msgClient struct {
@@ -657,6 +782,15 @@ func (c *msgClient) RelayCork(ctx context.Context, in *MsgRelayAxelarCorkRequest
 	return out, nil
 }
 
+func (c *msgClient) RelayProxyUpgrade(ctx context.Context, in *MsgRelayAxelarProxyUpgradeRequest, opts ...grpc.CallOption) (*MsgRelayAxelarProxyUpgradeResponse, error) {
+	out := new(MsgRelayAxelarProxyUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Msg/RelayProxyUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) BumpCorkGas(ctx context.Context, in *MsgBumpAxelarCorkGasRequest, opts ...grpc.CallOption) (*MsgBumpAxelarCorkGasResponse, error) {
 	out := new(MsgBumpAxelarCorkGasResponse)
 	err := c.cc.Invoke(ctx, "/axelarcork.v1.Msg/BumpCorkGas", in, out, opts...)
@@ -675,12 +809,23 @@ func (c *msgClient) CancelScheduledCork(ctx context.Context, in *MsgCancelAxelar
 	return out, nil
 }
 
+func (c *msgClient) SetCellarPaused(ctx context.Context, in *MsgSetAxelarCellarPausedRequest, opts ...grpc.CallOption) (*MsgSetAxelarCellarPausedResponse, error) {
+	out := new(MsgSetAxelarCellarPausedResponse)
+	err := c.cc.Invoke(ctx, "/axelarcork.v1.Msg/SetCellarPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ScheduleCork(context.Context, *MsgScheduleAxelarCorkRequest) (*MsgScheduleAxelarCorkResponse, error)
 	RelayCork(context.Context, *MsgRelayAxelarCorkRequest) (*MsgRelayAxelarCorkResponse, error)
+	RelayProxyUpgrade(context.Context, *MsgRelayAxelarProxyUpgradeRequest) (*MsgRelayAxelarProxyUpgradeResponse, error)
 	BumpCorkGas(context.Context, *MsgBumpAxelarCorkGasRequest) (*MsgBumpAxelarCorkGasResponse, error)
 	CancelScheduledCork(context.Context, *MsgCancelAxelarCorkRequest) (*MsgCancelAxelarCorkResponse, error)
+	SetCellarPaused(context.Context, *MsgSetAxelarCellarPausedRequest) (*MsgSetAxelarCellarPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -693,12 +838,18 @@ func (*UnimplementedMsgServer) ScheduleCork(ctx context.Context, req *MsgSchedul
 func (*UnimplementedMsgServer) RelayCork(ctx context.Context, req *MsgRelayAxelarCorkRequest) (*MsgRelayAxelarCorkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelayCork not implemented")
 }
+func (*UnimplementedMsgServer) RelayProxyUpgrade(ctx context.Context, req *MsgRelayAxelarProxyUpgradeRequest) (*MsgRelayAxelarProxyUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayProxyUpgrade not implemented")
+}
 func (*UnimplementedMsgServer) BumpCorkGas(ctx context.Context, req *MsgBumpAxelarCorkGasRequest) (*MsgBumpAxelarCorkGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpCorkGas not implemented")
 }
 func (*UnimplementedMsgServer) CancelScheduledCork(ctx context.Context, req *MsgCancelAxelarCorkRequest) (*MsgCancelAxelarCorkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledCork not implemented")
 }
+func (*UnimplementedMsgServer) SetCellarPaused(ctx context.Context, req *MsgSetAxelarCellarPausedRequest) (*MsgSetAxelarCellarPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCellarPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -740,6 +891,24 @@ func _Msg_RelayCork_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RelayProxyUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRelayAxelarProxyUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RelayProxyUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Msg/RelayProxyUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RelayProxyUpgrade(ctx, req.(*MsgRelayAxelarProxyUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_BumpCorkGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBumpAxelarCorkGasRequest)
 	if err := dec(in); err != nil {
@@ -776,6 +945,24 @@ func _Msg_CancelScheduledCork_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCellarPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAxelarCellarPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCellarPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarcork.v1.Msg/SetCellarPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCellarPaused(ctx, req.(*MsgSetAxelarCellarPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelarcork.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -788,6 +975,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RelayCork",
 			Handler:    _Msg_RelayCork_Handler,
 		},
+		{
+			MethodName: "RelayProxyUpgrade",
+			Handler:    _Msg_RelayProxyUpgrade_Handler,
+		},
 		{
 			MethodName: "BumpCorkGas",
 			Handler:    _Msg_BumpCorkGas_Handler,
@@ -796,6 +987,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelScheduledCork",
 			Handler:    _Msg_CancelScheduledCork_Handler,
 		},
+		{
+			MethodName: "SetCellarPaused",
+			Handler:    _Msg_SetCellarPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelarcork/v1/tx.proto",
@@ -903,6 +1098,13 @@ func (m *MsgRelayAxelarCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.TargetContractAddress) > 0 {
 		i -= len(m.TargetContractAddress)
 		copy(dAtA[i:], m.TargetContractAddress)
@@ -1171,6 +1373,81 @@ func (m *MsgCancelAxelarCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAxelarCellarPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAxelarCellarPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAxelarCellarPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAxelarCellarPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAxelarCellarPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1240,6 +1517,10 @@ func (m *MsgRelayAxelarCorkRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1339,6 +1620,38 @@ func (m *MsgCancelAxelarCorkResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAxelarCellarPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAxelarCellarPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1747,6 +2060,38 @@ func (m *MsgRelayAxelarCorkRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2401,6 +2746,209 @@ func (m *MsgCancelAxelarCorkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAxelarCellarPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAxelarCellarPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAxelarCellarPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAxelarCellarPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAxelarCellarPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAxelarCellarPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
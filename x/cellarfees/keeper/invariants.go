@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/sommelier/v7/x/cellarfees/types"
+)
+
+// RegisterInvariants registers all cellarfees invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "fee-accrual-counters", FeeAccrualCountersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cellarfees module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return FeeAccrualCountersInvariant(k)(ctx)
+	}
+}
+
+// FeeAccrualCountersInvariant checks that the fee accrual counters are sorted by denom without duplicates, since a
+// duplicate denom entry can result in fees not being auctioned
+func FeeAccrualCountersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		counters := k.GetFeeAccrualCounters(ctx).Counters
+		for i := 1; i < len(counters); i++ {
+			if counters[i-1].Denom >= counters[i].Denom {
+				broken = true
+				msg += fmt.Sprintf("\tcounter for %s at index %d is not ordered after counter for %s\n", counters[i].Denom, i, counters[i-1].Denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "fee accrual counters",
+			fmt.Sprintf("fee accrual counters not sorted by unique denom:\n%s", msg)), broken
+	}
+}
@@ -108,6 +108,24 @@ func (suite *KeeperTestSuite) TestKeeperGettingSettingFeeAccrualCounters() {
 	require.Equal(expected, cellarfeesKeeper.GetFeeAccrualCounters(ctx))
 }
 
+func (suite *KeeperTestSuite) TestFeeAccrualCountersInvariant() {
+	ctx, cellarfeesKeeper := suite.ctx, suite.cellarfeesKeeper
+	require := suite.Require()
+
+	counters := cellarfeesTypes.DefaultFeeAccrualCounters()
+	counters.IncrementCounter("denom2")
+	counters.IncrementCounter("denom1")
+	counters.IncrementCounter("denom2")
+	cellarfeesKeeper.SetFeeAccrualCounters(ctx, counters)
+	_, broken := FeeAccrualCountersInvariant(cellarfeesKeeper)(ctx)
+	require.False(broken)
+
+	counters.Counters = append(counters.Counters, cellarfeesTypes.FeeAccrualCounter{Denom: "denom1", Count: 1})
+	cellarfeesKeeper.SetFeeAccrualCounters(ctx, counters)
+	_, broken = FeeAccrualCountersInvariant(cellarfeesKeeper)(ctx)
+	require.True(broken)
+}
+
 func (suite *KeeperTestSuite) TestKeeperGettingSettingLastRewardSupplyPeak() {
 	ctx, cellarfeesKeeper := suite.ctx, suite.cellarfeesKeeper
 	require := suite.Require()
@@ -0,0 +1,95 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/peggyjv/sommelier/v7/x/cellarfees/types"
+)
+
+// Simulation parameter constants
+const (
+	FeeAccrualAuctionThreshold = "fee_accrual_auction_threshold"
+	RewardEmissionPeriod       = "reward_emission_period"
+	InitialPriceDecreaseRate   = "initial_price_decrease_rate"
+	PriceDecreaseBlockInterval = "price_decrease_block_interval"
+	AuctionInterval            = "auction_interval"
+)
+
+// GenFeeAccrualAuctionThreshold randomized FeeAccrualAuctionThreshold
+func GenFeeAccrualAuctionThreshold(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 10))
+}
+
+// GenRewardEmissionPeriod randomized RewardEmissionPeriod
+func GenRewardEmissionPeriod(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 500000))
+}
+
+// GenInitialPriceDecreaseRate randomized InitialPriceDecreaseRate
+func GenInitialPriceDecreaseRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 1000)), 6)
+}
+
+// GenPriceDecreaseBlockInterval randomized PriceDecreaseBlockInterval
+func GenPriceDecreaseBlockInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+// GenAuctionInterval randomized AuctionInterval
+func GenAuctionInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// RandomizedGenState generates a random GenesisState for cellarfees
+func RandomizedGenState(simState *module.SimulationState) {
+	var feeAccrualAuctionThreshold uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeAccrualAuctionThreshold, &feeAccrualAuctionThreshold, simState.Rand,
+		func(r *rand.Rand) { feeAccrualAuctionThreshold = GenFeeAccrualAuctionThreshold(r) },
+	)
+
+	var rewardEmissionPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardEmissionPeriod, &rewardEmissionPeriod, simState.Rand,
+		func(r *rand.Rand) { rewardEmissionPeriod = GenRewardEmissionPeriod(r) },
+	)
+
+	var initialPriceDecreaseRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InitialPriceDecreaseRate, &initialPriceDecreaseRate, simState.Rand,
+		func(r *rand.Rand) { initialPriceDecreaseRate = GenInitialPriceDecreaseRate(r) },
+	)
+
+	var priceDecreaseBlockInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PriceDecreaseBlockInterval, &priceDecreaseBlockInterval, simState.Rand,
+		func(r *rand.Rand) { priceDecreaseBlockInterval = GenPriceDecreaseBlockInterval(r) },
+	)
+
+	var auctionInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuctionInterval, &auctionInterval, simState.Rand,
+		func(r *rand.Rand) { auctionInterval = GenAuctionInterval(r) },
+	)
+
+	cellarfeesGenesis := types.DefaultGenesisState()
+	cellarfeesGenesis.Params = types.Params{
+		FeeAccrualAuctionThreshold: feeAccrualAuctionThreshold,
+		RewardEmissionPeriod:       rewardEmissionPeriod,
+		InitialPriceDecreaseRate:   initialPriceDecreaseRate,
+		PriceDecreaseBlockInterval: priceDecreaseBlockInterval,
+		AuctionInterval:            auctionInterval,
+	}
+
+	bz, err := json.MarshalIndent(&cellarfeesGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated cellarfees parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&cellarfeesGenesis)
+}
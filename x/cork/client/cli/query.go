@@ -6,10 +6,24 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagTargetContract = "target-contract"
+	FlagValidator      = "validator"
+	FlagMinBlockHeight = "min-block-height"
+	FlagMaxBlockHeight = "max-block-height"
+	FlagApproval       = "approval"
+	FlagDecode         = "decode"
+)
+
+// decodeFlagUsage is the usage of the flag that asks for contract calls to be decoded with their cellar's ABI
+const decodeFlagUsage = "decode contract calls using their cellar's registered ABI"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	corkQueryCmd := &cobra.Command{
@@ -29,6 +43,23 @@ func GetQueryCmd() *cobra.Command {
 		queryScheduledCorksByID(),
 		queryCorkResult(),
 		queryCorkResults(),
+		queryCellarVoteThreshold(),
+		queryValidatorCorkCount(),
+		queryCorkExecutionStatus(),
+		queryCellarSelectorAllowlist(),
+		queryCellarSelectorAllowlists(),
+		queryCellarPauseState(),
+		queryPausedCellars(),
+		queryCorkCommitments(),
+		queryCorkParticipation(),
+		queryValidatorParticipation(),
+		queryValidatorParticipations(),
+		queryGovernanceScheduledCorks(),
+		queryCellarABI(),
+		queryCellarABIs(),
+		queryCorkTally(),
+		queryManagedCellar(),
+		queryManagedCellars(),
 	}...)
 
 	return corkQueryCmd
@@ -76,8 +107,13 @@ func queryCellarIDs() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(ctx)
-			req := &types.QueryCellarIDsRequest{}
+			req := &types.QueryCellarIDsRequest{Pagination: *pageReq}
 
 			res, err := queryClient.QueryCellarIDs(cmd.Context(), req)
 			if err != nil {
@@ -89,6 +125,7 @@ func queryCellarIDs() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cellar ids")
 
 	return cmd
 }
@@ -105,9 +142,20 @@ func queryScheduledCorks() *cobra.Command {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(ctx)
-			req := &types.QueryScheduledCorksRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledCorksRequest{Pagination: *pageReq}
+			if err := readScheduledCorkFilters(cmd, &req.TargetContractAddress, &req.Validator, &req.MinBlockHeight, &req.MaxBlockHeight); err != nil {
+				return err
+			}
+			if req.DecodeCalls, err = cmd.Flags().GetBool(FlagDecode); err != nil {
+				return err
+			}
 
+			queryClient := types.NewQueryClient(ctx)
 			res, err := queryClient.QueryScheduledCorks(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -118,6 +166,12 @@ func queryScheduledCorks() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().String(FlagTargetContract, "", "only return corks targeting this contract address")
+	cmd.Flags().String(FlagValidator, "", "only return corks scheduled by this validator address")
+	cmd.Flags().Uint64(FlagMinBlockHeight, 0, "only return corks scheduled at or after this block height")
+	cmd.Flags().Uint64(FlagMaxBlockHeight, 0, "only return corks scheduled at or before this block height")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -140,8 +194,20 @@ func queryScheduledCorksByBlockHeight() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(ctx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryScheduledCorksByBlockHeightRequest{
 				BlockHeight: height.Uint64(),
+				Pagination:  *pageReq,
+				DecodeCalls: decode,
 			}
 
 			res, err := queryClient.QueryScheduledCorksByBlockHeight(cmd.Context(), req)
@@ -154,6 +220,8 @@ func queryScheduledCorksByBlockHeight() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -207,8 +275,20 @@ func queryScheduledCorksByID() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(ctx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryScheduledCorksByIDRequest{
-				Id: id,
+				Id:          id,
+				Pagination:  *pageReq,
+				DecodeCalls: decode,
 			}
 
 			res, err := queryClient.QueryScheduledCorksByID(cmd.Context(), req)
@@ -221,6 +301,8 @@ func queryScheduledCorksByID() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled corks")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -243,9 +325,15 @@ func queryCorkResult() *cobra.Command {
 				return fmt.Errorf("invalid ID length, must be a keccak256 hash")
 			}
 
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(ctx)
 			req := &types.QueryCorkResultRequest{
-				Id: corkID,
+				Id:         corkID,
+				DecodeCall: decode,
 			}
 
 			res, err := queryClient.QueryCorkResult(cmd.Context(), req)
@@ -258,6 +346,7 @@ func queryCorkResult() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
 
 	return cmd
 }
@@ -274,9 +363,20 @@ func queryCorkResults() *cobra.Command {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(ctx)
-			req := &types.QueryCorkResultsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCorkResultsRequest{Pagination: *pageReq}
+			if err := readCorkResultFilters(cmd, &req.TargetContractAddress, &req.MinBlockHeight, &req.MaxBlockHeight, &req.Approval); err != nil {
+				return err
+			}
+			if req.DecodeCalls, err = cmd.Flags().GetBool(FlagDecode); err != nil {
+				return err
+			}
 
+			queryClient := types.NewQueryClient(ctx)
 			res, err := queryClient.QueryCorkResults(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -287,6 +387,647 @@ func queryCorkResults() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cork results")
+	cmd.Flags().String(FlagTargetContract, "", "only return results for corks targeting this contract address")
+	cmd.Flags().Uint64(FlagMinBlockHeight, 0, "only return results tallied at or after this block height")
+	cmd.Flags().Uint64(FlagMaxBlockHeight, 0, "only return results tallied at or before this block height")
+	cmd.Flags().String(FlagApproval, "any", "only return results with this approval status (any|approved|rejected)")
+	cmd.Flags().Bool(FlagDecode, false, decodeFlagUsage)
+
+	return cmd
+}
+
+func queryCellarVoteThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-vote-threshold [cellar-id]",
+		Aliases: []string{"cvt"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the vote threshold that applies to corks for a cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarVoteThresholdRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarVoteThreshold(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryValidatorCorkCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator-cork-count [validator-address]",
+		Aliases: []string{"vcc"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the number of corks a validator has scheduled and its remaining quota",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryValidatorCorkCountRequest{
+				Validator: args[0],
+			}
+
+			res, err := queryClient.QueryValidatorCorkCount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkExecutionStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-execution-status [cellar-id]",
+		Aliases: []string{"ces"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the Ethereum execution status of approved corks for a cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCorkExecutionStatusRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCorkExecutionStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarSelectorAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-selector-allowlist [cellar-id]",
+		Aliases: []string{"csa"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the function selectors corks may call on a cellar, empty if any function is allowed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarSelectorAllowlistRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarSelectorAllowlist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarSelectorAllowlists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-selector-allowlists",
+		Aliases: []string{"csas"},
+		Args:    cobra.NoArgs,
+		Short:   "query the function selector allowlists of all cellars",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarSelectorAllowlistsRequest{}
+
+			res, err := queryClient.QueryCellarSelectorAllowlists(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-pause-state [cellar-id]",
+		Aliases: []string{"cps"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query whether a cellar is paused",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarPauseStateRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarPauseState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryPausedCellars() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-cellars",
+		Aliases: []string{"pcs"},
+		Args:    cobra.NoArgs,
+		Short:   "query the IDs of all paused cellars",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryPausedCellarsRequest{}
+
+			res, err := queryClient.QueryPausedCellars(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkCommitments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-commitments [block-height]",
+		Aliases: []string{"ccs"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "query validators' cork vote commitments, optionally limited to a single block height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCorkCommitmentsRequest{}
+			if len(args) == 1 {
+				blockHeight, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.BlockHeight = blockHeight.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCorkCommitments(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-participation [cork-id]",
+		Aliases: []string{"cp"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query which bonded validators voted for a tallied cork",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCorkParticipationRequest{Id: args[0]}
+
+			res, err := queryClient.QueryCorkParticipation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryValidatorParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator-participation [validator-address]",
+		Aliases: []string{"vp"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query a validator's cork vote participation rate over the participation window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryValidatorParticipationRequest{Validator: args[0]}
+
+			res, err := queryClient.QueryValidatorParticipation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryValidatorParticipations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator-participations",
+		Aliases: []string{"vps"},
+		Args:    cobra.NoArgs,
+		Short:   "query every tracked validator's cork vote participation rate",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryValidatorParticipationsRequest{}
+
+			res, err := queryClient.QueryValidatorParticipations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryGovernanceScheduledCorks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "governance-scheduled-corks",
+		Aliases: []string{"gscs"},
+		Args:    cobra.NoArgs,
+		Short:   "query corks scheduled by governance that have yet to be executed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryGovernanceScheduledCorksRequest{}
+
+			res, err := queryClient.QueryGovernanceScheduledCorks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readScheduledCorkFilters reads and validates the scheduled cork filter flags
+func queryCellarABI() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-abi [cellar-id]",
+		Aliases: []string{"cabi"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the governance registered contract ABI of a cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarABIRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryCellarABI(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCellarABIs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cellar-abis",
+		Aliases: []string{"cabis"},
+		Args:    cobra.NoArgs,
+		Short:   "query the governance registered contract ABIs of all cellars",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryCellarABIsRequest{}
+
+			res, err := queryClient.QueryCellarABIs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryCorkTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cork-tally [block-height-or-cork-id]",
+		Aliases: []string{"ct"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "preview the tally of scheduled corks using current validator power",
+		Long:    "preview the tally of the corks scheduled for a block height, or of a single scheduled cork given its keccak256 cork ID, using current validator power",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCorkTallyRequest{}
+			// the length of a keccak256 hash string
+			if len(args[0]) == 64 {
+				req.Id = args[0]
+			} else {
+				height, err := math.ParseUint(args[0])
+				if err != nil {
+					return err
+				}
+
+				req.BlockHeight = height.Uint64()
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+
+			res, err := queryClient.QueryCorkTally(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readScheduledCorkFilters(cmd *cobra.Command, target *string, validator *string, minHeight *uint64, maxHeight *uint64) error {
+	var err error
+	if *target, err = cmd.Flags().GetString(FlagTargetContract); err != nil {
+		return err
+	}
+	if *target != "" && !common.IsHexAddress(*target) {
+		return fmt.Errorf("%s is not a valid ethereum address", *target)
+	}
+
+	if *validator, err = cmd.Flags().GetString(FlagValidator); err != nil {
+		return err
+	}
+	if *validator != "" {
+		if _, err := sdk.ValAddressFromBech32(*validator); err != nil {
+			return err
+		}
+	}
+
+	return readBlockHeightRange(cmd, minHeight, maxHeight)
+}
+
+// readCorkResultFilters reads and validates the cork result filter flags
+func readCorkResultFilters(cmd *cobra.Command, target *string, minHeight *uint64, maxHeight *uint64, approval *types.ApprovalFilter) error {
+	var err error
+	if *target, err = cmd.Flags().GetString(FlagTargetContract); err != nil {
+		return err
+	}
+	if *target != "" && !common.IsHexAddress(*target) {
+		return fmt.Errorf("%s is not a valid ethereum address", *target)
+	}
+
+	approvalStr, err := cmd.Flags().GetString(FlagApproval)
+	if err != nil {
+		return err
+	}
+
+	switch approvalStr {
+	case "", "any":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_ANY
+	case "approved":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_APPROVED
+	case "rejected":
+		*approval = types.ApprovalFilter_APPROVAL_FILTER_REJECTED
+	default:
+		return fmt.Errorf("invalid approval filter %s, must be one of any, approved or rejected", approvalStr)
+	}
+
+	return readBlockHeightRange(cmd, minHeight, maxHeight)
+}
+
+func readBlockHeightRange(cmd *cobra.Command, minHeight *uint64, maxHeight *uint64) error {
+	var err error
+	if *minHeight, err = cmd.Flags().GetUint64(FlagMinBlockHeight); err != nil {
+		return err
+	}
+	if *maxHeight, err = cmd.Flags().GetUint64(FlagMaxBlockHeight); err != nil {
+		return err
+	}
+	if *maxHeight != 0 && *minHeight > *maxHeight {
+		return fmt.Errorf("min block height %d is greater than max block height %d", *minHeight, *maxHeight)
+	}
+
+	return nil
+}
+
+func queryManagedCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "managed-cellar [cellar-id]",
+		Aliases: []string{"mc"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the record and metadata of a managed cellar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cellarID := args[0]
+			if !common.IsHexAddress(cellarID) {
+				return fmt.Errorf("%s is not a valid ethereum address", cellarID)
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryManagedCellarRequest{
+				CellarId: cellarID,
+			}
+
+			res, err := queryClient.QueryManagedCellar(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryManagedCellars() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "managed-cellars",
+		Aliases: []string{"mcs"},
+		Args:    cobra.NoArgs,
+		Short:   "query the records and metadata of all managed cellars",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(ctx)
+			req := &types.QueryManagedCellarsRequest{Pagination: *pageReq}
+
+			res, err := queryClient.QueryManagedCellars(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "managed cellars")
 
 	return cmd
 }
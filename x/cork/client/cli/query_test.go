@@ -54,3 +54,42 @@ func TestQueryCorkResultCmd(t *testing.T) {
 		require.Equal(t, tc.err.Error(), err.Error())
 	}
 }
+
+func TestQueryCorkResultsCmd(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		err  error
+	}{
+		{
+			name: "Invalid approval filter",
+			args: []string{
+				"--approval=maybe",
+			},
+			err: fmt.Errorf("invalid approval filter maybe, must be one of any, approved or rejected"),
+		},
+		{
+			name: "Invalid target contract",
+			args: []string{
+				"--target-contract=bad",
+			},
+			err: fmt.Errorf("bad is not a valid ethereum address"),
+		},
+		{
+			name: "Invalid block height range",
+			args: []string{
+				"--min-block-height=10",
+				"--max-block-height=5",
+			},
+			err: fmt.Errorf("min block height 10 is greater than max block height 5"),
+		},
+	}
+
+	for _, tc := range testCases {
+		cmd := *queryCorkResults()
+		cmd.SetArgs(tc.args)
+		err := cmd.Execute()
+
+		require.Equal(t, tc.err.Error(), err.Error(), tc.name)
+	}
+}
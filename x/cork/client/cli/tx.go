@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -14,6 +19,8 @@ import (
 	types "github.com/peggyjv/sommelier/v7/x/cork/types"
 	pubsubtypes "github.com/peggyjv/sommelier/v7/x/pubsub/types"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -26,10 +33,311 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// todo(mvid): figure out what is useful, implement
+	corkTxCmd.AddCommand(
+		CmdScheduleCorkVote(),
+		CmdCancelScheduledCork(),
+		CmdPauseCellar(),
+		CmdResumeCellar(),
+		CmdCommitCork(),
+		CmdRevealCork(),
+	)
+
 	return corkTxCmd
 }
 
+//////////////
+// Commands //
+//////////////
+
+const (
+	FlagCalldata  = "calldata"
+	FlagSignature = "signature"
+	FlagArgs      = "args"
+)
+
+func CmdScheduleCorkVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-cork-vote [contract-address] [block-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Schedule a cork vote for a managed cellar at a future block height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule a cork vote from your validator without running Steward.
+The contract call is given either as raw hex calldata with --%[1]s, or as a function signature
+with --%[2]s and a JSON array of arguments with --%[3]s, which is ABI encoded for you.
+The cellar must be managed and the block height must be in the future.
+
+Example:
+$ %[4]s tx cork schedule-cork-vote 0x123801a7D398351b8bE11C439e05C5B3259aeC9B 1000000 --%[2]s="setShareLockPeriod(uint256)" --%[3]s='["86400"]' --from=<key>
+$ %[4]s tx cork schedule-cork-vote 0x123801a7D398351b8bE11C439e05C5B3259aeC9B 1000000 --%[1]s=0x9c552ca80000000000000000000000000000000000000000000000000000000000015180 --from=<key>
+`, FlagCalldata, FlagSignature, FlagArgs, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr := args[0]
+			if !common.IsHexAddress(contractAddr) {
+				return fmt.Errorf("contract address %s is invalid", contractAddr)
+			}
+
+			blockHeight, err := math.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+
+			calldata, err := cmd.Flags().GetString(FlagCalldata)
+			if err != nil {
+				return err
+			}
+			signature, err := cmd.Flags().GetString(FlagSignature)
+			if err != nil {
+				return err
+			}
+			callArgs, err := cmd.Flags().GetString(FlagArgs)
+			if err != nil {
+				return err
+			}
+
+			var contractCall []byte
+			switch {
+			case calldata != "" && signature != "":
+				return fmt.Errorf("only one of --%s and --%s may be set", FlagCalldata, FlagSignature)
+			case calldata != "":
+				if callArgs != "" {
+					return fmt.Errorf("--%s can only be used with --%s", FlagArgs, FlagSignature)
+				}
+				contractCall, err = hex.DecodeString(strings.TrimPrefix(calldata, "0x"))
+				if err != nil {
+					return err
+				}
+			case signature != "":
+				contractCall, err = EncodeContractCall(signature, callArgs)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("one of --%s or --%s must be set", FlagCalldata, FlagSignature)
+			}
+
+			msg, err := types.NewMsgScheduleCorkRequest(contractCall, common.HexToAddress(contractAddr), blockHeight.Uint64(), clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				if err := validateScheduledCork(cmd.Context(), clientCtx, contractAddr, blockHeight.Uint64()); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCalldata, "", "hex encoded contract call data, including the function selector")
+	cmd.Flags().String(FlagSignature, "", "function signature to encode the call for, e.g. \"setShareLockPeriod(uint256)\"")
+	cmd.Flags().String(FlagArgs, "", "JSON array of arguments for --signature, e.g. '[\"86400\"]'")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// validateScheduledCork checks against the chain that the target contract is a managed cellar
+// and that the block height has not already been reached.
+func validateScheduledCork(ctx context.Context, clientCtx client.Context, contractAddr string, blockHeight uint64) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	if _, err := queryClient.QueryManagedCellar(ctx, &types.QueryManagedCellarRequest{CellarId: contractAddr}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("cellar %s is not managed by the cork module", contractAddr)
+		}
+
+		return err
+	}
+
+	height, err := rpc.GetChainHeight(clientCtx)
+	if err != nil {
+		return err
+	}
+	if blockHeight <= uint64(height) {
+		return fmt.Errorf("block height %d is not in the future, current height is %d", blockHeight, height)
+	}
+
+	return nil
+}
+
+func CmdCancelScheduledCork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-cork [cork-id] [block-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a cork previously scheduled by your validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockHeight, err := math.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelScheduledCorkRequest{
+				Id:          args[0],
+				BlockHeight: blockHeight.Uint64(),
+				Signer:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-cellar [cellar-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pause a cellar as the pause guardian, blocking new and scheduled corks from executing",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setCellarPaused(cmd, args[0], true)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResumeCellar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-cellar [cellar-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Resume a paused cellar as the pause guardian",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setCellarPaused(cmd, args[0], false)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func setCellarPaused(cmd *cobra.Command, cellarID string, paused bool) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	msg := types.MsgSetCellarPausedRequest{
+		CellarId: cellarID,
+		Paused:   paused,
+		Signer:   clientCtx.GetFromAddress().String(),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+}
+
+func CmdCommitCork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-cork [cellar-id] [block-height] [commitment]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Commit to a cork vote for a commit-reveal cellar without disclosing the cork",
+		Long: strings.TrimSpace(`Commit to a cork vote for a cellar that requires commit-reveal voting.
+The commitment is the hex encoded keccak256 hash of the cork ID, a secret salt of at least 16 bytes
+and the validator's address bytes. It must be submitted before the reveal window for the block height opens.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockHeight, err := math.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCommitCorkRequest{
+				CellarId:    args[0],
+				BlockHeight: blockHeight.Uint64(),
+				Commitment:  strings.TrimPrefix(args[2], "0x"),
+				Signer:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevealCork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-cork [contract-address] [block-height] [contract-call] [salt]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Reveal a committed cork vote, scheduling the cork if it matches the commitment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("%s is not a valid ethereum address", args[0])
+			}
+
+			blockHeight, err := math.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+
+			contractCall, err := hex.DecodeString(strings.TrimPrefix(args[2], "0x"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevealCorkRequest{
+				Cork: &types.Cork{
+					EncodedContractCall:   contractCall,
+					TargetContractAddress: common.HexToAddress(args[0]).String(),
+				},
+				BlockHeight: blockHeight.Uint64(),
+				Salt:        strings.TrimPrefix(args[3], "0x"),
+				Signer:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitAddProposal implements the command to submit a cellar id addition proposal
 func GetCmdSubmitAddProposal() *cobra.Command {
 
@@ -51,8 +359,21 @@ Where proposal.json contains:
   "description": "I have a hunch",
   "cellar_ids": ["0x123801a7D398351b8bE11C439e05C5B3259aeC9B", "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"],
   "publisher_domain": "example.com",
-  "deposit": "10000usomm"
+  "deposit": "10000usomm",
+  "cellar_metadata": [
+    {
+      "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+      "metadata": {
+        "name": "Dollary-doos LP",
+        "version": "v2.5",
+        "asset_denom": "usdc",
+        "strategy_spec_url": "https://example.com/strategies/dollary-doos-lp"
+      }
+    }
+  ]
 }
+
+The cellar_metadata field is optional.
 `,
 				version.AppName,
 			),
@@ -93,7 +414,11 @@ Where proposal.json contains:
 				proposal.Description,
 				&types.CellarIDSet{Ids: proposal.CellarIds},
 				proposal.PublisherDomain,
+				proposal.CellarMetadata,
 			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
 
 			from := clientCtx.GetFromAddress()
 			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
@@ -199,10 +524,13 @@ Where proposal.json contains:
   "block_height": 100000,
   "target_contract_address": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
   "contract_call_proto_json": "{\"cellar_id\":\"0x123801a7D398351b8bE11C439e05C5B3259aeC9B\",\"<cellar_type_name>\":{\"some_fuction\":{\"function_args\":{}},\"block_height\":12345}}",
+  "encoded_contract_call": "0x1f2c3d4e",
   "deposit": "10000usomm"
 }
 
 The contract_call_proto_json field must be the JSON representation of a ScheduleRequest, which is defined in Steward's protos. For more information, see the Steward API docs at https://github.com/peggyjv/steward.
+
+If the proposal passes, the cork is executed at block_height without a validator vote. The optional encoded_contract_call field is the hex encoded contract call to execute. When it is omitted, the contract call is encoded from contract_call_proto_json by the encoder registered for its cellar type.
 `,
 				version.AppName,
 			),
@@ -232,10 +560,363 @@ The contract_call_proto_json field must be the JSON representation of a Schedule
 				return fmt.Errorf("%s is not a valid contract address", proposal.TargetContractAddress)
 			}
 
-			content := types.NewScheduledCorkProposal(proposal.Title, proposal.Description, proposal.BlockHeight, proposal.TargetContractAddress, proposal.ContractCallProtoJson)
+			var encodedContractCall []byte
+			if proposal.EncodedContractCall != "" {
+				if encodedContractCall, err = hex.DecodeString(strings.TrimPrefix(proposal.EncodedContractCall, "0x")); err != nil {
+					return fmt.Errorf("invalid encoded contract call: %w", err)
+				}
+			}
+
+			content := types.NewScheduledCorkProposal(proposal.Title, proposal.Description, proposal.BlockHeight, proposal.TargetContractAddress, proposal.ContractCallProtoJson, encodedContractCall)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetCellarVoteThresholdProposal implements the command to submit a cellar vote threshold proposal
+func GetCmdSubmitSetCellarVoteThresholdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cellar-vote-threshold [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cellar vote threshold proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to override the cork vote threshold for a single cellar along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-cellar-vote-threshold <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar Vote Threshold Proposal",
+  "description": "Require a supermajority for this cellar",
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "vote_threshold": "0.80",
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetCellarVoteThresholdProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(proposal.CellarId) {
+				return fmt.Errorf("%s is not a valid ethereum address", proposal.CellarId)
+			}
+
+			voteThreshold, err := sdk.NewDecFromStr(proposal.VoteThreshold)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetCellarVoteThresholdProposal(proposal.Title, proposal.Description, proposal.CellarId, voteThreshold)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetCellarSelectorAllowlistProposal implements the command to submit a cellar selector allowlist proposal
+func GetCmdSubmitSetCellarSelectorAllowlistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cellar-selector-allowlist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cellar function selector allowlist proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the function selectors corks may call on a cellar along with an initial deposit.
+An empty selector list removes the allowlist so that corks may call any function.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-cellar-selector-allowlist <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar Selector Allowlist Proposal",
+  "description": "Only allow rebalancing on this cellar",
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "selectors": ["0x12345678", "0x9abcdef0"],
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetCellarSelectorAllowlistProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(proposal.CellarId) {
+				return fmt.Errorf("%s is not a valid ethereum address", proposal.CellarId)
+			}
+
+			content := types.NewSetCellarSelectorAllowlistProposal(proposal.Title, proposal.Description, proposal.CellarId, proposal.Selectors)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetCellarPausedProposal implements the command to submit a cellar pause proposal
+func GetCmdSubmitSetCellarPausedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cellar-paused [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cellar pause proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume a cellar along with an initial deposit.
+Corks for a paused cellar can't be scheduled and corks already scheduled for it are not executed.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-cellar-paused <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Pause Dollary-doos LP Cellar Proposal",
+  "description": "Pause the cellar while its strategist key is rotated",
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "paused": true,
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetCellarPausedProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetCellarPausedProposal(proposal.Title, proposal.Description, proposal.CellarId, proposal.Paused)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetCellarABIProposal implements the command to submit a cellar ABI proposal
+func GetCmdSubmitSetCellarABIProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cellar-abi [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cellar ABI proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register the contract ABI of a cellar along with an initial deposit.
+The ABI is used to decode the contract calls of the cellar's corks in query responses. An empty ABI
+removes the cellar's registered ABI. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-cellar-abi <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar ABI Proposal",
+  "description": "Register the ABI of the Dollary-doos LP cellar",
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "abi": "[{\"type\":\"function\",\"name\":\"setFeesDistributor\",\"inputs\":[{\"name\":\"newFeesDistributor\",\"type\":\"bytes32\"}],\"outputs\":[]}]",
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetCellarABIProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetCellarABIProposal(proposal.Title, proposal.Description, proposal.CellarId, proposal.Abi)
 			if err := content.ValidateBasic(); err != nil {
 				return err
 			}
+
+			from := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitSetCellarMetadataProposal implements the command to submit a cellar metadata proposal
+func GetCmdSubmitSetCellarMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cellar-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cellar metadata proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the metadata of a managed cellar along with an initial deposit.
+Every metadata field is optional, fields left out are cleared. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-cellar-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Dollary-doos LP Cellar Metadata Proposal",
+  "description": "Describe the Dollary-doos LP cellar",
+  "cellar_id": "0x123801a7D398351b8bE11C439e05C5B3259aeC9B",
+  "metadata": {
+    "name": "Dollary-doos LP",
+    "version": "v2.5",
+    "asset_denom": "usdc",
+    "strategy_spec_url": "https://example.com/strategies/dollary-doos-lp"
+  },
+  "deposit": "10000usomm"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.SetCellarMetadataProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetCellarMetadataProposal(proposal.Title, proposal.Description, proposal.CellarId, proposal.Metadata)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 			msg, err := govtypesv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
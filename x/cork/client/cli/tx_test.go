@@ -1,9 +1,15 @@
 package cli
 
 import (
+	"fmt"
+	"math/big"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 
@@ -89,3 +95,62 @@ func TestParseSubmitScheduledCorkProposal(t *testing.T) {
 	require.Equal(t, "{\"cellar_id\":\"0x123801a7D398351b8bE11C439e05C5B3259aeC9B\",\"cellar_v1\":{\"some_fuction\":{\"function_args\":{}},\"block_height\":12345}}", proposal.ContractCallProtoJson)
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+func TestEncodeContractCall(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"setShareLockPeriod","inputs":[{"name":"period","type":"uint256"}]},
+		{"type":"function","name":"setup","inputs":[
+			{"name":"position","type":"uint32"},
+			{"name":"adaptor","type":"address"},
+			{"name":"enabled","type":"bool"},
+			{"name":"config","type":"bytes"},
+			{"name":"ids","type":"uint32[]"},
+			{"name":"salt","type":"bytes32"},
+			{"name":"name","type":"string"}
+		]},
+		{"type":"function","name":"initiateShutdown","inputs":[]}
+	]`))
+	require.NoError(t, err)
+
+	adaptor := common.HexToAddress("0x456801a7D398351b8bE11C439e05C5B3259aeC9B")
+	salt := [32]byte{0x01, 0x02}
+
+	expected, err := parsed.Pack("setShareLockPeriod", big.NewInt(86400))
+	require.NoError(t, err)
+	encoded, err := EncodeContractCall("setShareLockPeriod(uint256)", `["86400"]`)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+	encoded, err = EncodeContractCall("setShareLockPeriod(uint256)", `[86400]`)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	expected, err = parsed.Pack("setup", uint32(7), adaptor, true, []byte{0xab, 0xcd}, []uint32{1, 2}, salt, "cellar")
+	require.NoError(t, err)
+	encoded, err = EncodeContractCall(
+		"setup(uint32, address, bool, bytes, uint32[], bytes32, string)",
+		fmt.Sprintf(`[7, "%s", true, "0xabcd", [1, 2], "0x%x", "cellar"]`, adaptor.Hex(), salt),
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	expected, err = parsed.Pack("initiateShutdown")
+	require.NoError(t, err)
+	encoded, err = EncodeContractCall("initiateShutdown()", "")
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	_, err = EncodeContractCall("setShareLockPeriod", `["1"]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setShareLockPeriod(uint256)", `["1", "2"]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setShareLockPeriod(uint256)", `["-1"]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setup(uint8)", `[256]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setup(address)", `["0x1234"]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setup(bytes32)", `["0xabcd"]`)
+	require.Error(t, err)
+	_, err = EncodeContractCall("setup((uint256,address))", `[["1", "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"]]`)
+	require.Error(t, err)
+}
@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EncodeContractCall ABI encodes a call to the function described by signature, e.g.
+// "setFeesDistributor(bytes32)", using the JSON array argsJSON as its arguments. The
+// returned bytes are prefixed with the four byte function selector. Tuple arguments are
+// not supported.
+func EncodeContractCall(signature string, argsJSON string) ([]byte, error) {
+	signature = strings.ReplaceAll(signature, " ", "")
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("invalid function signature %s, expected name(type1,type2,...)", signature)
+	}
+
+	name := signature[:open]
+	params := signature[open+1 : len(signature)-1]
+	if strings.ContainsAny(params, "()") {
+		return nil, fmt.Errorf("tuple arguments are not supported in function signature %s", signature)
+	}
+
+	var inputs abi.Arguments
+	if params != "" {
+		for _, param := range strings.Split(params, ",") {
+			typ, err := abi.NewType(param, "", nil)
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument type %s: %w", param, err)
+			}
+			inputs = append(inputs, abi.Argument{Type: typ})
+		}
+	}
+
+	var rawArgs []json.RawMessage
+	if strings.TrimSpace(argsJSON) != "" {
+		if err := json.Unmarshal([]byte(argsJSON), &rawArgs); err != nil {
+			return nil, fmt.Errorf("arguments must be a JSON array: %w", err)
+		}
+	}
+	if len(rawArgs) != len(inputs) {
+		return nil, fmt.Errorf("function %s takes %d arguments, got %d", signature, len(inputs), len(rawArgs))
+	}
+
+	values := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		value, err := abiValue(input.Type, rawArgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i, input.Type.String(), err)
+		}
+		values[i] = value.Interface()
+	}
+
+	method := abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil)
+	packed, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(method.ID, packed...), nil
+}
+
+// abiValue converts a JSON value into the go type the abi package packs for typ. Integers
+// may be given as JSON numbers or decimal/0x-prefixed hex strings, byte types as hex strings.
+func abiValue(typ abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := parseBigInt(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if typ.T == abi.UintTy && n.Sign() < 0 {
+			return reflect.Value{}, fmt.Errorf("negative value %s for unsigned type", n)
+		}
+		if typ.Size > 64 {
+			return reflect.ValueOf(n), nil
+		}
+		if typ.T == abi.UintTy {
+			if !n.IsUint64() || n.BitLen() > typ.Size {
+				return reflect.Value{}, fmt.Errorf("value %s overflows %s", n, typ.String())
+			}
+			return reflect.ValueOf(n.Uint64()).Convert(typ.GetType()), nil
+		}
+		if !n.IsInt64() || n.BitLen() > typ.Size-1 {
+			return reflect.Value{}, fmt.Errorf("value %s overflows %s", n, typ.String())
+		}
+		return reflect.ValueOf(n.Int64()).Convert(typ.GetType()), nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		if !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("invalid address %s", s)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy, abi.FixedBytesTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if typ.T == abi.BytesTy {
+			return reflect.ValueOf(bz), nil
+		}
+		if len(bz) != typ.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		value := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return reflect.Value{}, err
+		}
+		var value reflect.Value
+		if typ.T == abi.SliceTy {
+			value = reflect.MakeSlice(typ.GetType(), len(elems), len(elems))
+		} else {
+			if len(elems) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+			}
+			value = reflect.New(typ.GetType()).Elem()
+		}
+		for i, elem := range elems {
+			v, err := abiValue(*typ.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			value.Index(i).Set(v)
+		}
+		return value, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported argument type %s", typ.String())
+	}
+}
+
+func parseBigInt(raw json.RawMessage) (*big.Int, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var num json.Number
+		if err := json.Unmarshal(raw, &num); err != nil {
+			return nil, fmt.Errorf("expected an integer, got %s", string(raw))
+		}
+		s = num.String()
+	}
+
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", s)
+	}
+
+	return n, nil
+}
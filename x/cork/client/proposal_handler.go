@@ -6,7 +6,12 @@ import (
 )
 
 var (
-	AddProposalHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitAddProposal)
-	RemoveProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveProposal)
-	ScheduledCorkProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitScheduledCorkProposal)
+	AddProposalHandler                        = govclient.NewProposalHandler(cli.GetCmdSubmitAddProposal)
+	RemoveProposalHandler                     = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveProposal)
+	ScheduledCorkProposalHandler              = govclient.NewProposalHandler(cli.GetCmdSubmitScheduledCorkProposal)
+	SetCellarVoteThresholdProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitSetCellarVoteThresholdProposal)
+	SetCellarSelectorAllowlistProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetCellarSelectorAllowlistProposal)
+	SetCellarPausedProposalHandler            = govclient.NewProposalHandler(cli.GetCmdSubmitSetCellarPausedProposal)
+	SetCellarABIProposalHandler               = govclient.NewProposalHandler(cli.GetCmdSubmitSetCellarABIProposal)
+	SetCellarMetadataProposalHandler          = govclient.NewProposalHandler(cli.GetCmdSubmitSetCellarMetadataProposal)
 )
@@ -19,6 +19,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgScheduleCorkRequest:
 			res, err := k.ScheduleCork(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelScheduledCorkRequest:
+			res, err := k.CancelScheduledCork(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCellarPausedRequest:
+			res, err := k.SetCellarPaused(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCommitCorkRequest:
+			res, err := k.CommitCork(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevealCorkRequest:
+			res, err := k.RevealCork(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cork message type: %T", msg)
 		}
@@ -34,6 +46,16 @@ func NewProposalHandler(k keeper.Keeper) govtypesv1beta1.Handler {
 			return keeper.HandleRemoveManagedCellarsProposal(ctx, k, *c)
 		case *types.ScheduledCorkProposal:
 			return keeper.HandleScheduledCorkProposal(ctx, k, *c)
+		case *types.SetCellarVoteThresholdProposal:
+			return keeper.HandleSetCellarVoteThresholdProposal(ctx, k, *c)
+		case *types.SetCellarSelectorAllowlistProposal:
+			return keeper.HandleSetCellarSelectorAllowlistProposal(ctx, k, *c)
+		case *types.SetCellarPausedProposal:
+			return keeper.HandleSetCellarPausedProposal(ctx, k, *c)
+		case *types.SetCellarABIProposal:
+			return keeper.HandleSetCellarABIProposal(ctx, k, *c)
+		case *types.SetCellarMetadataProposal:
+			return keeper.HandleSetCellarMetadataProposal(ctx, k, *c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cork proposal content type: %T", c)
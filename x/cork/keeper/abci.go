@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,39 +14,206 @@ import (
 // BeginBlocker is called at the beginning of every block
 func (k Keeper) BeginBlocker(ctx sdk.Context) {}
 
-func (k Keeper) submitContractCall(ctx sdk.Context, cork types.Cork) {
+// submitContractCall submits the cork as a contract call and records it so that its execution can be tracked
+// for the corks with the provided IDs
+func (k Keeper) submitContractCall(ctx sdk.Context, cork types.Cork, corkIDs [][]byte) uint64 {
 	k.Logger(ctx).Info("setting outgoing tx for contract call",
 		"address", cork.TargetContractAddress,
 		"encoded contract call", cork.EncodedContractCall)
 	// increment invalidation nonce
 	invalidationNonce := k.IncrementInvalidationNonce(ctx)
+	invalidationScope := cork.InvalidationScope()
 	// submit contract call to bridge
-	k.gravityKeeper.CreateContractCallTx(
+	contractCallTx := k.gravityKeeper.CreateContractCallTx(
 		ctx,
 		invalidationNonce,
-		cork.InvalidationScope(),
+		invalidationScope,
 		common.HexToAddress(cork.TargetContractAddress),
 		cork.EncodedContractCall,
 		[]gravitytypes.ERC20Token{}, // tokens are always zero
 		[]gravitytypes.ERC20Token{},
 	)
+
+	contractCall := types.CorkContractCall{
+		InvalidationNonce:     invalidationNonce,
+		InvalidationScope:     invalidationScope,
+		TargetContractAddress: cork.TargetContractAddress,
+		BlockHeight:           uint64(ctx.BlockHeight()),
+		CorkIds:               corkIDs,
+	}
+	if contractCallTx != nil {
+		contractCall.EthereumTimeoutHeight = contractCallTx.Timeout
+	}
+	k.SetCorkContractCall(ctx, contractCall)
+
+	return invalidationNonce
+}
+
+// submitBatchedContractCalls aggregates corks targeting the batch target contract into multicall(bytes[])
+// contract calls of at most maxBatchSize corks each, and records the batch on each cork's result
+func (k Keeper) submitBatchedContractCalls(ctx sdk.Context, target common.Address, corks []types.Cork, maxBatchSize uint64) {
+	blockHeight := uint64(ctx.BlockHeight())
+	for start := 0; start < len(corks); start += int(maxBatchSize) {
+		end := start + int(maxBatchSize)
+		if end > len(corks) {
+			end = len(corks)
+		}
+		batch := corks[start:end]
+
+		calls := make([][]byte, len(batch))
+		corkIDs := make([][]byte, len(batch))
+		for i, cork := range batch {
+			calls[i] = cork.EncodedContractCall
+			corkIDs[i] = cork.IDHash(blockHeight)
+		}
+
+		// a batch of one gains nothing from the multicall wrapper
+		if len(batch) == 1 {
+			batchNonce := k.submitContractCall(ctx, batch[0], corkIDs)
+			k.setCorkResultBatchNonce(ctx, corkIDs, batchNonce)
+			k.emitCorkBatchEvent(ctx, batchNonce, len(batch))
+			continue
+		}
+
+		payload, err := types.EncodeMulticall(calls)
+		if err != nil {
+			k.Logger(ctx).Error("failed to encode batched contract call, submitting corks individually", "error", err)
+			for i, cork := range batch {
+				batchNonce := k.submitContractCall(ctx, cork, [][]byte{corkIDs[i]})
+				k.setCorkResultBatchNonce(ctx, [][]byte{corkIDs[i]}, batchNonce)
+				k.emitCorkBatchEvent(ctx, batchNonce, 1)
+			}
+			continue
+		}
+
+		batchNonce := k.submitContractCall(ctx, types.Cork{
+			EncodedContractCall:   payload,
+			TargetContractAddress: target.Hex(),
+		}, corkIDs)
+		k.setCorkResultBatchNonce(ctx, corkIDs, batchNonce)
+		k.emitCorkBatchEvent(ctx, batchNonce, len(batch))
+	}
+}
+
+// setCorkResultBatchNonce records the invalidation nonce of the batch the corks with the provided IDs were submitted in
+func (k Keeper) setCorkResultBatchNonce(ctx sdk.Context, corkIDs [][]byte, batchNonce uint64) {
+	for _, corkID := range corkIDs {
+		if corkResult, found := k.GetCorkResult(ctx, corkID); found {
+			corkResult.BatchNonce = batchNonce
+			k.SetCorkResult(ctx, corkID, corkResult)
+		}
+	}
+}
+
+func (k Keeper) emitCorkBatchEvent(ctx sdk.Context, batchNonce uint64, batchSize int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCorkBatch,
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprintf("%d", batchNonce)),
+			sdk.NewAttribute(types.AttributeKeyBatchSize, fmt.Sprintf("%d", batchSize)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
+
+// pruneCorkResults deletes cork results and contract call records that are older than the retention window,
+// optionally emitting an event with the contents of each pruned result so that indexers can keep the history
+func (k Keeper) pruneCorkResults(ctx sdk.Context, params types.Params) {
+	if params.CorkResultRetentionBlocks == 0 || uint64(ctx.BlockHeight()) <= params.CorkResultRetentionBlocks {
+		return
+	}
+
+	pruneHeight := uint64(ctx.BlockHeight()) - params.CorkResultRetentionBlocks
+
+	var prunedIDs [][]byte
+	k.IterateCorkResultIDsUpToHeight(ctx, pruneHeight, func(id []byte, _ uint64) (stop bool) {
+		prunedIDs = append(prunedIDs, id)
+		return false
+	})
+
+	for _, id := range prunedIDs {
+		corkResult, found := k.GetCorkResult(ctx, id)
+		if found && params.ArchivePrunedCorkResults {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCorkResultArchived,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyCorkID, hex.EncodeToString(id)),
+					sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", corkResult.BlockHeight)),
+					sdk.NewAttribute(types.AttributeKeyCork, corkResult.Cork.String()),
+					sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", corkResult.Approved)),
+					sdk.NewAttribute(types.AttributeKeyApprovalPercent, corkResult.ApprovalPercentage),
+					sdk.NewAttribute(types.AttributeKeyExecuted, fmt.Sprintf("%t", corkResult.Executed)),
+				),
+			)
+		}
+
+		k.DeleteCorkResult(ctx, id)
+		k.DeleteCorkParticipation(ctx, id)
+	}
+
+	var prunedNonces []uint64
+	k.IterateCorkContractCallNoncesUpToHeight(ctx, pruneHeight, func(invalidationNonce uint64, _ uint64) (stop bool) {
+		prunedNonces = append(prunedNonces, invalidationNonce)
+		return false
+	})
+
+	for _, nonce := range prunedNonces {
+		k.DeleteCorkContractCall(ctx, nonce)
+	}
+
+	if len(prunedIDs) > 0 {
+		k.Logger(ctx).Info("pruned cork results", "count", len(prunedIDs), "prune height", pruneHeight)
+	}
 }
 
 // EndBlocker defines the oracle logic that executes at the end of every block:
 //
-// 1) Collects all winning votes
+// 1) Collects all winning votes along with the corks governance scheduled for this block height
+//
+// 2) Submits all winning votes and governance scheduled corks as contract calls via the gravity bridge, batching
+// those that target the batch target contract. Corks for paused cellars are skipped.
 //
-// 2) Submits all winning votes as contract calls via the gravity bridge
+// 3) Prunes cork results older than the retention window
 
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParamSet(ctx)
+
 	k.Logger(ctx).Info("tallying scheduled cork votes", "height", fmt.Sprintf("%d", ctx.BlockHeight()))
 	winningScheduledVotes := k.GetApprovedScheduledCorks(ctx)
+	winningScheduledVotes = append(winningScheduledVotes, k.takeGovernanceScheduledCorks(ctx)...)
 	if len(winningScheduledVotes) > 0 {
 		k.Logger(ctx).Info("packaging all winning scheduled cork votes into contract calls",
 			"winning votes", winningScheduledVotes)
-		// todo: implement batch sends to save on gas
+		batchTarget := common.HexToAddress(params.BatchTargetAddress)
+		var batchedVotes []types.Cork
 		for _, wv := range winningScheduledVotes {
-			k.submitContractCall(ctx, wv)
+			if k.IsCellarPaused(ctx, common.HexToAddress(wv.TargetContractAddress)) {
+				corkID := wv.IDHash(uint64(ctx.BlockHeight()))
+				k.Logger(ctx).Info("skipping approved cork for paused cellar",
+					"address", wv.TargetContractAddress,
+					"cork id", hex.EncodeToString(corkID))
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeCorkSkipped,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+						sdk.NewAttribute(types.AttributeKeyCorkID, hex.EncodeToString(corkID)),
+						sdk.NewAttribute(types.AttributeKeyTargetContract, wv.TargetContractAddress),
+					),
+				)
+				continue
+			}
+
+			if params.BatchTargetAddress != "" && common.HexToAddress(wv.TargetContractAddress) == batchTarget {
+				batchedVotes = append(batchedVotes, wv)
+				continue
+			}
+
+			k.submitContractCall(ctx, wv, [][]byte{wv.IDHash(uint64(ctx.BlockHeight()))})
 		}
+
+		k.submitBatchedContractCalls(ctx, batchTarget, batchedVotes, params.MaxBatchSize)
 	}
+
+	k.pruneCorkResults(ctx, params)
 }
@@ -0,0 +1,449 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+	corktestutil "github.com/peggyjv/sommelier/v7/x/cork/testutil"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+func (suite *KeeperTestSuite) TestEndBlockerBatchesCorks() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	batchTargetHex := "0x1111111111111111111111111111111111111111"
+	params := types.DefaultParams()
+	params.VoteThreshold = sdk.ZeroDec()
+	params.BatchTargetAddress = batchTargetHex
+	params.MaxBatchSize = 2
+	corkKeeper.SetParams(ctx, params)
+
+	testHeight := uint64(ctx.BlockHeight())
+	batchedCorks := []types.Cork{
+		{EncodedContractCall: []byte("call1"), TargetContractAddress: batchTargetHex},
+		{EncodedContractCall: []byte("call2"), TargetContractAddress: batchTargetHex},
+		{EncodedContractCall: []byte("call3"), TargetContractAddress: batchTargetHex},
+	}
+	unbatchedCork := types.Cork{EncodedContractCall: []byte("call4"), TargetContractAddress: sampleCellarHex}
+	for _, cork := range append(batchedCorks, unbatchedCork) {
+		corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+	}
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100))
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator).Times(4)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(100)).Times(4)
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt()).Times(4)
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
+
+	// one multicall for a full batch of two, and two plain calls for the remaining batched cork and the unbatched cork
+	var payloads [][]byte
+	suite.gravityKeeper.EXPECT().
+		CreateContractCallTx(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ uint64, _ interface{}, _ common.Address, payload []byte, _ interface{}, _ interface{}) interface{} {
+			payloads = append(payloads, payload)
+			return nil
+		}).Times(3)
+
+	corkKeeper.EndBlocker(ctx)
+	require.Len(payloads, 3)
+
+	// every cork sent to the batch target records its batch, including the one-cork batch
+	batchSizes := make(map[uint64]int)
+	for _, result := range corkKeeper.GetCorkResults(ctx) {
+		if result.Cork.TargetContractAddress != batchTargetHex {
+			require.Zero(result.BatchNonce)
+			continue
+		}
+		require.NotZero(result.BatchNonce)
+		batchSizes[result.BatchNonce]++
+	}
+	var sizes []int
+	for _, size := range batchSizes {
+		sizes = append(sizes, size)
+	}
+	require.ElementsMatch([]int{2, 1}, sizes)
+}
+
+func (suite *KeeperTestSuite) TestCorkExecutionTracking() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.VoteThreshold = sdk.ZeroDec()
+	corkKeeper.SetParams(ctx, params)
+
+	testHeight := uint64(ctx.BlockHeight())
+	cork := types.Cork{EncodedContractCall: []byte("testcall"), TargetContractAddress: sampleCellarHex}
+	corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100))
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(100))
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
+	suite.gravityKeeper.EXPECT().
+		CreateContractCallTx(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&gravitytypes.ContractCallTx{Timeout: 100})
+
+	corkKeeper.EndBlocker(ctx)
+
+	contractCalls := corkKeeper.GetCorkContractCalls(ctx)
+	require.Len(contractCalls, 1)
+	contractCall := contractCalls[0]
+	require.Equal(uint64(100), contractCall.EthereumTimeoutHeight)
+	require.Equal([][]byte{cork.IDHash(testHeight)}, contractCall.CorkIds)
+
+	statusRequest := &types.QueryCorkExecutionStatusRequest{CellarId: sampleCellarHex}
+	suite.gravityKeeper.EXPECT().GetLastObservedEthereumBlockHeight(ctx).Return(gravitytypes.LatestEthereumBlockHeight{EthereumHeight: 50})
+	statusResult, err := corkKeeper.QueryCorkExecutionStatus(sdk.WrapSDKContext(ctx), statusRequest)
+	require.NoError(err)
+	require.Len(statusResult.Pending, 1)
+	require.Empty(statusResult.TimedOut)
+	require.Empty(statusResult.Executed)
+
+	suite.gravityKeeper.EXPECT().GetLastObservedEthereumBlockHeight(ctx).Return(gravitytypes.LatestEthereumBlockHeight{EthereumHeight: 101})
+	statusResult, err = corkKeeper.QueryCorkExecutionStatus(sdk.WrapSDKContext(ctx), statusRequest)
+	require.NoError(err)
+	require.Empty(statusResult.Pending)
+	require.Len(statusResult.TimedOut, 1)
+
+	// an event for a different invalidation scope is ignored
+	hooks := corkKeeper.Hooks()
+	hooks.AfterContractCallExecutedEvent(ctx, gravitytypes.ContractCallExecutedEvent{
+		InvalidationNonce: contractCall.InvalidationNonce,
+		InvalidationScope: []byte("otherscope"),
+		EthereumHeight:    90,
+	})
+	require.Len(corkKeeper.GetCorkContractCalls(ctx), 1)
+
+	hooks.AfterContractCallExecutedEvent(ctx, gravitytypes.ContractCallExecutedEvent{
+		InvalidationNonce: contractCall.InvalidationNonce,
+		InvalidationScope: contractCall.InvalidationScope,
+		EthereumHeight:    90,
+	})
+	require.Empty(corkKeeper.GetCorkContractCalls(ctx))
+
+	result, found := corkKeeper.GetCorkResult(ctx, cork.IDHash(testHeight))
+	require.True(found)
+	require.True(result.Executed)
+	require.Equal(uint64(90), result.EthereumHeight)
+
+	suite.gravityKeeper.EXPECT().GetLastObservedEthereumBlockHeight(ctx).Return(gravitytypes.LatestEthereumBlockHeight{EthereumHeight: 101})
+	statusResult, err = corkKeeper.QueryCorkExecutionStatus(sdk.WrapSDKContext(ctx), statusRequest)
+	require.NoError(err)
+	require.Empty(statusResult.Pending)
+	require.Empty(statusResult.TimedOut)
+	require.Len(statusResult.Executed, 1)
+}
+
+func (suite *KeeperTestSuite) TestPruneCorkResults() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.CorkResultRetentionBlocks = 2
+
+	newResult := func(call string, height uint64) ([]byte, types.CorkResult) {
+		cork := types.Cork{EncodedContractCall: []byte(call), TargetContractAddress: sampleCellarHex}
+		return cork.IDHash(height), types.CorkResult{
+			Cork:               &cork,
+			BlockHeight:        height,
+			Approved:           true,
+			ApprovalPercentage: "100.00",
+		}
+	}
+
+	// ctx height is 5, so results and contract calls from height 3 and below are outside the retention window
+	oldID, oldResult := newResult("oldcall", 3)
+	recentID, recentResult := newResult("recentcall", 4)
+	corkKeeper.SetCorkResult(ctx, oldID, oldResult)
+	corkKeeper.SetCorkResult(ctx, recentID, recentResult)
+	corkKeeper.SetCorkContractCall(ctx, types.CorkContractCall{InvalidationNonce: 1, BlockHeight: 3, CorkIds: [][]byte{oldID}})
+	corkKeeper.SetCorkContractCall(ctx, types.CorkContractCall{InvalidationNonce: 2, BlockHeight: 4, CorkIds: [][]byte{recentID}})
+
+	corkKeeper.pruneCorkResults(ctx, params)
+
+	_, found := corkKeeper.GetCorkResult(ctx, oldID)
+	require.False(found)
+	_, found = corkKeeper.GetCorkResult(ctx, recentID)
+	require.True(found)
+	_, found = corkKeeper.GetCorkContractCall(ctx, 1)
+	require.False(found)
+	_, found = corkKeeper.GetCorkContractCall(ctx, 2)
+	require.True(found)
+
+	// the height indexes only hold the remaining entries
+	var indexedIDs [][]byte
+	corkKeeper.IterateCorkResultIDsUpToHeight(ctx, uint64(ctx.BlockHeight()), func(id []byte, _ uint64) (stop bool) {
+		indexedIDs = append(indexedIDs, id)
+		return false
+	})
+	require.Equal([][]byte{recentID}, indexedIDs)
+	var indexedNonces []uint64
+	corkKeeper.IterateCorkContractCallNoncesUpToHeight(ctx, uint64(ctx.BlockHeight()), func(nonce uint64, _ uint64) (stop bool) {
+		indexedNonces = append(indexedNonces, nonce)
+		return false
+	})
+	require.Equal([]uint64{2}, indexedNonces)
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal(types.EventTypeCorkResultArchived, events[0].Type)
+
+	// pruning without archiving emits no events
+	params.ArchivePrunedCorkResults = false
+	params.CorkResultRetentionBlocks = 1
+	corkKeeper.pruneCorkResults(ctx, params)
+	require.Empty(corkKeeper.GetCorkResults(ctx))
+	require.Len(ctx.EventManager().Events(), 1)
+
+	// zero retention disables pruning
+	corkKeeper.SetCorkResult(ctx, oldID, oldResult)
+	params.CorkResultRetentionBlocks = 0
+	corkKeeper.pruneCorkResults(ctx, params)
+	require.Len(corkKeeper.GetCorkResults(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerSkipsPausedCellar() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.VoteThreshold = sdk.ZeroDec()
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellarPauseState(ctx, sampleCellarAddr, true, sampleOrchAddr.String())
+
+	testHeight := uint64(ctx.BlockHeight())
+	cork := types.Cork{EncodedContractCall: []byte("testcall"), TargetContractAddress: sampleCellarHex}
+	corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100))
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(100))
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
+
+	// no contract call is created for the paused cellar
+	corkKeeper.EndBlocker(ctx)
+
+	require.Empty(corkKeeper.GetCorkContractCalls(ctx))
+	result, found := corkKeeper.GetCorkResult(ctx, cork.IDHash(testHeight))
+	require.True(found)
+	require.True(result.Approved)
+
+	skipped := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCorkSkipped {
+			skipped = true
+		}
+	}
+	require.True(skipped)
+}
+
+func (suite *KeeperTestSuite) TestValidatorParticipation() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.VoteThreshold = sdk.ZeroDec()
+	params.ParticipationWindow = 2
+	params.MinParticipationRate = sdk.NewDecWithPrec(6, 1)
+	params.ParticipationJailingEnabled = true
+	params.ParticipationSlashFraction = sdk.NewDecWithPrec(1, 2)
+	corkKeeper.SetParams(ctx, params)
+
+	absentValAddr := sdk.ValAddress("absentvalidator_____")
+	absentConsAddr := sdk.ConsAddress("absentconsensus_____")
+	absentValidator := corktestutil.NewMockValidatorI(gomock.NewController(suite.T()))
+	absentValidator.EXPECT().GetOperator().Return(absentValAddr).AnyTimes()
+	suite.validator.EXPECT().GetOperator().Return(sampleValAddr).AnyTimes()
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100)).Times(2)
+	suite.stakingKeeper.EXPECT().Validator(gomock.Any(), sampleValAddr).Return(suite.validator).Times(2)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(50)).Times(2)
+	suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.OneInt()).AnyTimes()
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, cb func(int64, stakingtypes.ValidatorI) bool) {
+			cb(0, suite.validator)
+			cb(1, absentValidator)
+		}).Times(2)
+
+	cork := types.Cork{EncodedContractCall: []byte("testcall"), TargetContractAddress: sampleCellarHex}
+	firstHeight := uint64(ctx.BlockHeight())
+	corkKeeper.SetScheduledCork(ctx, firstHeight, sampleValAddr, cork)
+	corkKeeper.GetApprovedScheduledCorks(ctx)
+
+	participation, err := corkKeeper.QueryCorkParticipation(sdk.WrapSDKContext(ctx), &types.QueryCorkParticipationRequest{
+		Id: hex.EncodeToString(cork.IDHash(firstHeight)),
+	})
+	require.NoError(err)
+	require.Equal([]string{sampleValAddr.String()}, participation.Participation.Voters)
+	require.Equal([]string{absentValAddr.String()}, participation.Participation.NonVoters)
+
+	// the absent validator isn't judged until a full window has been observed
+	absentParticipation, found := corkKeeper.GetValidatorParticipation(ctx, absentValAddr)
+	require.True(found)
+	require.Equal(uint64(1), absentParticipation.MissedCounter)
+	require.False(absentParticipation.BelowThreshold)
+
+	// missing a second round drops the absent validator below the minimum, so it is slashed and jailed
+	absentValidator.EXPECT().IsJailed().Return(false)
+	absentValidator.EXPECT().GetConsAddr().Return(absentConsAddr, nil)
+	absentValidator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(50))
+	// jailing goes through the slashing module so that the validator can't unjail before the downtime jail duration
+	jailDuration := 10 * time.Minute
+	suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), absentConsAddr).Return(true)
+	suite.slashingKeeper.EXPECT().Slash(gomock.Any(), absentConsAddr, params.ParticipationSlashFraction, int64(50), int64(firstHeight+1))
+	suite.slashingKeeper.EXPECT().Jail(gomock.Any(), absentConsAddr)
+	suite.slashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(jailDuration)
+	suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), absentConsAddr, ctx.BlockHeader().Time.Add(jailDuration))
+
+	secondCtx := ctx.WithBlockHeight(int64(firstHeight + 1))
+	corkKeeper.SetScheduledCork(secondCtx, firstHeight+1, sampleValAddr, cork)
+	corkKeeper.GetApprovedScheduledCorks(secondCtx)
+
+	var reported, jailed bool
+	for _, event := range secondCtx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeLowParticipation:
+			reported = true
+		case types.EventTypeParticipationJail:
+			jailed = true
+		}
+	}
+	require.True(reported)
+	require.True(jailed)
+
+	// jailing resets the validator's window
+	absentParticipation, found = corkKeeper.GetValidatorParticipation(ctx, absentValAddr)
+	require.True(found)
+	require.Equal(uint64(0), absentParticipation.IndexOffset)
+	require.Empty(corkKeeper.GetValidatorMissedCorkIndexes(ctx, absentValAddr))
+
+	rates, err := corkKeeper.QueryValidatorParticipation(sdk.WrapSDKContext(ctx), &types.QueryValidatorParticipationRequest{
+		Validator: sampleValAddr.String(),
+	})
+	require.NoError(err)
+	require.Equal(sdk.OneDec(), rates.Participation.ParticipationRate)
+	require.Equal(uint64(2), rates.Participation.Participation.IndexOffset)
+}
+
+func (suite *KeeperTestSuite) TestJailForLowParticipationWithoutSigningInfo() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.ParticipationSlashFraction = sdk.NewDecWithPrec(1, 2)
+	consAddr := sdk.ConsAddress("absentconsensus_____")
+	suite.validator.EXPECT().GetConsAddr().Return(consAddr, nil)
+	suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(ctx, consAddr).Return(false)
+
+	// a validator the slashing module doesn't track can't be given an unjail time, so it is neither slashed nor jailed
+	err := corkKeeper.jailForLowParticipation(ctx, params, suite.validator, sdk.ZeroDec())
+	require.ErrorIs(err, types.ErrSigningInfoNotFound)
+	require.Empty(ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestGovernanceScheduledCork() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	corkKeeper.SetParams(ctx, types.DefaultParams())
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	executionHeight := uint64(ctx.BlockHeight() + 5)
+	protoJSON := "{\"cellar_id\":\"" + sampleCellarHex + "\",\"cellar_v1\":{\"trust_position\":{}},\"block_height\":10}"
+	proposal := types.NewScheduledCorkProposal("title", "description", uint64(ctx.BlockHeight()), sampleCellarHex, protoJSON, nil)
+	// proposals for a past height are only a signal for Steward
+	require.NoError(HandleScheduledCorkProposal(ctx, corkKeeper, *proposal))
+	require.Empty(corkKeeper.GetGovernanceScheduledCorks(ctx))
+
+	// proposals without an encoder for their cellar type fail
+	proposal.BlockHeight = executionHeight
+	require.ErrorIs(HandleScheduledCorkProposal(ctx, corkKeeper, *proposal), types.ErrContractCallEncoderNotFound)
+	require.Empty(corkKeeper.GetGovernanceScheduledCorks(ctx))
+
+	encodedCall := []byte("encodedcall")
+	corkKeeper.RegisterContractCallEncoder("cellar_v1", func(callJSON json.RawMessage) ([]byte, error) {
+		require.JSONEq("{\"trust_position\":{}}", string(callJSON))
+		return encodedCall, nil
+	})
+	require.NoError(HandleScheduledCorkProposal(ctx, corkKeeper, *proposal))
+
+	// raw calldata is used as is
+	rawCork := types.Cork{EncodedContractCall: []byte("rawcall"), TargetContractAddress: sampleCellarHex}
+	proposal = types.NewScheduledCorkProposal("title", "description", executionHeight, sampleCellarHex, "", rawCork.EncodedContractCall)
+	require.NoError(HandleScheduledCorkProposal(ctx, corkKeeper, *proposal))
+
+	encodedCork := types.Cork{EncodedContractCall: encodedCall, TargetContractAddress: sampleCellarHex}
+	scheduled := corkKeeper.GetGovernanceScheduledCorks(ctx)
+	require.Len(scheduled, 2)
+	_, found := corkKeeper.GetGovernanceScheduledCork(ctx, executionHeight, encodedCork.IDHash(executionHeight))
+	require.True(found)
+	_, found = corkKeeper.GetGovernanceScheduledCork(ctx, executionHeight, rawCork.IDHash(executionHeight))
+	require.True(found)
+
+	// both corks are submitted at the execution height without any votes
+	executionCtx := ctx.WithBlockHeight(int64(executionHeight))
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100))
+	var payloads [][]byte
+	suite.gravityKeeper.EXPECT().
+		CreateContractCallTx(gomock.Any(), gomock.Any(), gomock.Any(), sampleCellarAddr, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ uint64, _ interface{}, _ common.Address, payload []byte, _ interface{}, _ interface{}) interface{} {
+			payloads = append(payloads, payload)
+			return nil
+		}).Times(2)
+
+	corkKeeper.EndBlocker(executionCtx)
+
+	require.ElementsMatch([][]byte{encodedCall, rawCork.EncodedContractCall}, payloads)
+	require.Empty(corkKeeper.GetGovernanceScheduledCorks(executionCtx))
+
+	result, found := corkKeeper.GetCorkResult(executionCtx, rawCork.IDHash(executionHeight))
+	require.True(found)
+	require.True(result.Approved)
+	require.True(result.Governance)
+	require.NoError(result.ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestRemoveManagedCellarsProposal() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	otherCellarHex := "0x0000000000000000000000000000000000000001"
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: otherCellarHex})
+	corkKeeper.SetCellarVoteThreshold(ctx, sampleCellarAddr, sdk.NewDecWithPrec(5, 1))
+	corkKeeper.SetCellarABI(ctx, types.CellarABI{CellarId: sampleCellarHex, Abi: "[]"})
+	corkKeeper.SetCellarABI(ctx, types.CellarABI{CellarId: otherCellarHex, Abi: "[]"})
+
+	executionHeight := uint64(ctx.BlockHeight() + 5)
+	cellarCork := types.Cork{EncodedContractCall: []byte("call1"), TargetContractAddress: sampleCellarHex}
+	otherCork := types.Cork{EncodedContractCall: []byte("call2"), TargetContractAddress: otherCellarHex}
+	corkKeeper.SetGovernanceScheduledCork(ctx, executionHeight, cellarCork)
+	corkKeeper.SetGovernanceScheduledCork(ctx, executionHeight, otherCork)
+
+	suite.pubsubKeeper.EXPECT().DeleteDefaultSubscription(ctx, NewEthereumSubscriptionID(sampleCellarAddr))
+	proposal := types.NewRemoveManagedCellarIDsProposal("title", "description", &types.CellarIDSet{Ids: []string{sampleCellarHex}})
+	require.NoError(HandleRemoveManagedCellarsProposal(ctx, corkKeeper, *proposal))
+
+	require.False(corkKeeper.HasCellarID(ctx, sampleCellarAddr))
+	_, found := corkKeeper.GetCellarABI(ctx, sampleCellarAddr)
+	require.False(found)
+	_, found = corkKeeper.GetGovernanceScheduledCork(ctx, executionHeight, cellarCork.IDHash(executionHeight))
+	require.False(found)
+
+	// other cellars are unaffected
+	require.True(corkKeeper.HasCellarID(ctx, common.HexToAddress(otherCellarHex)))
+	_, found = corkKeeper.GetCellarABI(ctx, common.HexToAddress(otherCellarHex))
+	require.True(found)
+	_, found = corkKeeper.GetGovernanceScheduledCork(ctx, executionHeight, otherCork.IDHash(executionHeight))
+	require.True(found)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 )
 
@@ -9,22 +10,85 @@ import (
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, k Keeper, gs types.GenesisState) {
 	k.SetParams(ctx, gs.Params)
-	// Set the vote period at initialization
-	k.SetCellarIDs(ctx, gs.CellarIds)
+
+	for _, id := range gs.CellarIds.Ids {
+		k.SetCellar(ctx, types.ManagedCellar{CellarId: id})
+	}
+
+	for _, cellar := range gs.ManagedCellars {
+		k.SetCellar(ctx, cellar)
+	}
+
 	k.SetLatestInvalidationNonce(ctx, gs.InvalidationNonce)
 
 	for _, corkResult := range gs.CorkResults {
 		k.SetCorkResult(ctx, corkResult.Cork.IDHash(corkResult.BlockHeight), *corkResult)
 	}
 
+	for _, threshold := range gs.CellarVoteThresholds {
+		k.SetCellarVoteThreshold(ctx, common.HexToAddress(threshold.CellarId), threshold.VoteThreshold)
+	}
+
 	for _, scheduledCork := range gs.ScheduledCorks {
-		valAddr, err := sdk.ValAddressFromHex(scheduledCork.Validator)
+		valAddr, err := sdk.ValAddressFromBech32(scheduledCork.Validator)
 		if err != nil {
 			panic(err)
 		}
 
 		k.SetScheduledCork(ctx, scheduledCork.BlockHeight, valAddr, *scheduledCork.Cork)
 	}
+
+	for _, corkCount := range gs.ValidatorCorkCounts {
+		valAddr, err := sdk.ValAddressFromBech32(corkCount.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetValidatorCorkCount(ctx, valAddr, corkCount.Count)
+	}
+
+	for _, contractCall := range gs.CorkContractCalls {
+		k.SetCorkContractCall(ctx, contractCall)
+	}
+
+	for _, allowlist := range gs.CellarSelectorAllowlists {
+		k.SetCellarSelectorAllowlist(ctx, allowlist)
+	}
+
+	for _, id := range gs.PausedCellarIds {
+		ctx.KVStore(k.storeKey).Set(types.GetPausedCellarKey(common.HexToAddress(id)), []byte{})
+	}
+
+	for _, commitment := range gs.CorkCommitments {
+		k.SetCorkCommitment(ctx, commitment)
+	}
+
+	for _, participation := range gs.CorkParticipations {
+		k.SetCorkParticipation(ctx, participation)
+	}
+
+	for _, participation := range gs.ValidatorParticipations {
+		k.SetValidatorParticipation(ctx, participation)
+	}
+
+	for _, missed := range gs.ValidatorMissedCorks {
+		valAddr, err := sdk.ValAddressFromBech32(missed.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, index := range missed.MissedIndexes {
+			k.SetValidatorMissedCork(ctx, valAddr, index)
+		}
+	}
+
+	for _, cork := range gs.GovernanceScheduledCorks {
+		k.SetGovernanceScheduledCork(ctx, cork.BlockHeight, *cork.Cork)
+	}
+
+	for _, cellarABI := range gs.CellarAbis {
+		k.SetCellarABI(ctx, cellarABI)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -37,10 +101,22 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	return types.GenesisState{
-		Params:            k.GetParamSet(ctx),
-		CellarIds:         ids,
-		InvalidationNonce: k.GetLatestInvalidationNonce(ctx),
-		ScheduledCorks:    k.GetScheduledCorks(ctx),
-		CorkResults:       k.GetCorkResults(ctx),
+		Params:                   k.GetParamSet(ctx),
+		CellarIds:                ids,
+		InvalidationNonce:        k.GetLatestInvalidationNonce(ctx),
+		ScheduledCorks:           k.GetScheduledCorks(ctx),
+		CorkResults:              k.GetCorkResults(ctx),
+		CellarVoteThresholds:     k.GetCellarVoteThresholds(ctx),
+		ValidatorCorkCounts:      k.GetValidatorCorkCounts(ctx),
+		CorkContractCalls:        k.GetCorkContractCalls(ctx),
+		CellarSelectorAllowlists: k.GetCellarSelectorAllowlists(ctx),
+		PausedCellarIds:          k.GetPausedCellarIDs(ctx),
+		CorkCommitments:          k.GetCorkCommitments(ctx),
+		CorkParticipations:       k.GetCorkParticipations(ctx),
+		ValidatorParticipations:  k.GetValidatorParticipations(ctx),
+		ValidatorMissedCorks:     k.GetValidatorMissedCorks(ctx),
+		GovernanceScheduledCorks: k.GetGovernanceScheduledCorks(ctx),
+		CellarAbis:               k.GetCellarABIs(ctx),
+		ManagedCellars:           k.GetCellars(ctx),
 	}
 }
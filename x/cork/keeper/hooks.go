@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
 )
 
 type Hooks struct {
@@ -16,7 +21,35 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// AfterContractCallExecutedEvent marks the cork results included in the executed contract call as executed
 func (h Hooks) AfterContractCallExecutedEvent(ctx sdk.Context, event gravitytypes.ContractCallExecutedEvent) {
+	contractCall, found := h.k.GetCorkContractCall(ctx, event.InvalidationNonce)
+	if !found || !bytes.Equal(contractCall.InvalidationScope, event.InvalidationScope) {
+		return
+	}
+
+	for _, corkID := range contractCall.CorkIds {
+		corkResult, found := h.k.GetCorkResult(ctx, corkID)
+		if !found {
+			continue
+		}
+
+		corkResult.Executed = true
+		corkResult.EthereumHeight = event.EthereumHeight
+		h.k.SetCorkResult(ctx, corkID, corkResult)
+	}
+
+	h.k.DeleteCorkContractCall(ctx, event.InvalidationNonce)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCorkExecuted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTargetContract, contractCall.TargetContractAddress),
+			sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(event.InvalidationNonce)),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(event.EthereumHeight)),
+		),
+	)
 }
 
 func (h Hooks) AfterERC20DeployedEvent(ctx sdk.Context, event gravitytypes.ERC20DeployedEvent) {}
@@ -27,3 +60,30 @@ func (h Hooks) AfterSignerSetExecutedEvent(ctx sdk.Context, event gravitytypes.S
 func (h Hooks) AfterBatchExecutedEvent(ctx sdk.Context, event gravitytypes.BatchExecutedEvent) {}
 
 func (h Hooks) AfterSendToCosmosEvent(ctx sdk.Context, event gravitytypes.SendToCosmosEvent) {}
+
+type GovHooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks returns the wrapper struct implementing the gov hooks
+func (k Keeper) GovHooks() GovHooks {
+	return GovHooks{k}
+}
+
+func (h GovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+
+func (h GovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+}
+
+func (h GovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {}
+
+func (h GovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {}
+
+// AfterProposalVotingPeriodEnded records the proposal's ID on the cellars it added. Proposal handlers aren't given
+// the ID of the proposal they execute, so the cellars are marked as pending and assigned the ID here, which gov calls
+// right after executing the proposal.
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.setCellarProposalID(ctx, proposalID)
+}
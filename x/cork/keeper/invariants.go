@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+// RegisterInvariants registers all cork invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "validator-cork-counts", ValidatorCorkCountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cork module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ValidatorCorkCountsInvariant(k)(ctx)
+	}
+}
+
+// ValidatorCorkCountsInvariant checks that each validator's cork count equals the number of corks it has scheduled
+// plus the number of its commitments that have yet to be revealed
+func ValidatorCorkCountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]uint64)
+		k.IterateScheduledCorks(ctx, func(val sdk.ValAddress, _ uint64, _ []byte, _ common.Address, _ types.Cork) (stop bool) {
+			expected[val.String()]++
+			return false
+		})
+		k.IterateCorkCommitments(ctx, func(commitment types.CorkCommitment) (stop bool) {
+			if !commitment.Revealed() {
+				expected[commitment.Validator]++
+			}
+			return false
+		})
+
+		actual := make(map[string]uint64)
+		k.IterateValidatorCorkCounts(ctx, func(val sdk.ValAddress, count uint64) (stop bool) {
+			actual[val.String()] = count
+			return false
+		})
+
+		validators := make([]string, 0, len(expected)+len(actual))
+		for val := range expected {
+			validators = append(validators, val)
+		}
+		for val := range actual {
+			if _, ok := expected[val]; !ok {
+				validators = append(validators, val)
+			}
+		}
+		sort.Strings(validators)
+
+		var msg string
+		broken := false
+		for _, val := range validators {
+			if expected[val] != actual[val] {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has a cork count of %d, expected %d\n", val, actual[val], expected[val])
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator cork counts",
+			fmt.Sprintf("validator cork counts not matching scheduled corks and unrevealed commitments:\n%s", msg)), broken
+	}
+}
@@ -3,32 +3,41 @@ package keeper
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-const corkVoteThresholdStr = "0.67"
-
 // Keeper of the oracle store
 type Keeper struct {
-	storeKey      storetypes.StoreKey
-	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
-	stakingKeeper types.StakingKeeper
-	gravityKeeper types.GravityKeeper
-	pubsubKeeper  types.PubsubKeeper
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+	paramSpace     paramtypes.Subspace
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	gravityKeeper  types.GravityKeeper
+	pubsubKeeper   types.PubsubKeeper
+
+	// contractCallEncoders is shared by copies of the keeper so that encoders registered after the keeper
+	// has been passed to other modules are still used
+	contractCallEncoders map[string]types.ContractCallEncoder
 }
 
 // NewKeeper creates a new x/cork Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
-	stakingKeeper types.StakingKeeper, gravityKeeper types.GravityKeeper, pubsubKeeper types.PubsubKeeper,
+	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper, gravityKeeper types.GravityKeeper,
+	pubsubKeeper types.PubsubKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -36,12 +45,14 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		stakingKeeper: stakingKeeper,
-		gravityKeeper: gravityKeeper,
-		pubsubKeeper:  pubsubKeeper,
+		storeKey:             key,
+		cdc:                  cdc,
+		paramSpace:           paramSpace,
+		stakingKeeper:        stakingKeeper,
+		slashingKeeper:       slashingKeeper,
+		gravityKeeper:        gravityKeeper,
+		pubsubKeeper:         pubsubKeeper,
+		contractCallEncoders: make(map[string]types.ContractCallEncoder),
 	}
 }
 
@@ -56,8 +67,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) SetScheduledCork(ctx sdk.Context, blockHeight uint64, val sdk.ValAddress, cork types.Cork) []byte {
 	id := cork.IDHash(blockHeight)
+	contract := common.HexToAddress(cork.TargetContractAddress)
 	bz := k.cdc.MustMarshal(&cork)
-	ctx.KVStore(k.storeKey).Set(types.GetScheduledCorkKey(blockHeight, id, val, common.HexToAddress(cork.TargetContractAddress)), bz)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledCorkKey(blockHeight, id, val, contract), bz)
+	store.Set(types.GetScheduledCorkIDIndexKey(blockHeight, id, val, contract), []byte{})
 	return id
 }
 
@@ -79,7 +93,9 @@ func (k Keeper) GetScheduledCork(ctx sdk.Context, blockHeight uint64, id []byte,
 // DeleteScheduledCork deletes the scheduled cork for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) DeleteScheduledCork(ctx sdk.Context, blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) {
-	ctx.KVStore(k.storeKey).Delete(types.GetScheduledCorkKey(blockHeight, id, val, contract))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledCorkKey(blockHeight, id, val, contract))
+	store.Delete(types.GetScheduledCorkIDIndexKey(blockHeight, id, val, contract))
 }
 
 // IterateScheduledCorks iterates over all scheduled corks in the store
@@ -98,12 +114,7 @@ func (k Keeper) IterateScheduledCorksByPrefix(ctx sdk.Context, prefix []byte, cb
 
 	for ; iter.Valid(); iter.Next() {
 		var cork types.Cork
-		keyPair := bytes.NewBuffer(iter.Key())
-		keyPair.Next(1) // trim prefix byte
-		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
-		id := keyPair.Next(32)
-		val := sdk.ValAddress(keyPair.Next(20))
-		contract := common.BytesToAddress(keyPair.Bytes())
+		blockHeight, id, val, contract := parseScheduledCorkKey(iter.Key()[1:]) // trim prefix byte
 
 		k.cdc.MustUnmarshal(iter.Value(), &cork)
 		if cb(val, blockHeight, id, contract, cork) {
@@ -143,17 +154,35 @@ func (k Keeper) GetScheduledCorksByBlockHeight(ctx sdk.Context, height uint64) [
 	return scheduledCorks
 }
 
+// IterateScheduledCorksByID iterates over all scheduled corks with the given ID using the ID index
+func (k Keeper) IterateScheduledCorksByID(ctx sdk.Context, id []byte, cb func(val sdk.ValAddress, blockHeight uint64, id []byte, cel common.Address, cork types.Cork) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetScheduledCorkIDIndexPrefix(id))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		blockHeight, val, contract := parseScheduledCorkIDIndexKey(iter.Key()[len(types.GetScheduledCorkIDIndexPrefix(id)):])
+
+		cork, found := k.GetScheduledCork(ctx, blockHeight, id, val, contract)
+		if !found {
+			continue
+		}
+
+		if cb(val, blockHeight, id, contract, cork) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetScheduledCorksByID(ctx sdk.Context, queriedID []byte) []*types.ScheduledCork {
 	var scheduledCorks []*types.ScheduledCork
-	k.IterateScheduledCorks(ctx, func(val sdk.ValAddress, blockHeight uint64, id []byte, _ common.Address, cork types.Cork) (stop bool) {
-		if bytes.Equal(id, queriedID) {
-			scheduledCorks = append(scheduledCorks, &types.ScheduledCork{
-				Validator:   val.String(),
-				Cork:        &cork,
-				BlockHeight: blockHeight,
-				Id:          id,
-			})
-		}
+	k.IterateScheduledCorksByID(ctx, queriedID, func(val sdk.ValAddress, blockHeight uint64, id []byte, _ common.Address, cork types.Cork) (stop bool) {
+		scheduledCorks = append(scheduledCorks, &types.ScheduledCork{
+			Validator:   val.String(),
+			Cork:        &cork,
+			BlockHeight: blockHeight,
+			Id:          id,
+		})
 
 		return false
 	})
@@ -161,6 +190,34 @@ func (k Keeper) GetScheduledCorksByID(ctx sdk.Context, queriedID []byte) []*type
 	return scheduledCorks
 }
 
+// parseScheduledCorkKey parses a scheduled cork key with its prefix byte removed
+func parseScheduledCorkKey(key []byte) (blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) {
+	keyPair := bytes.NewBuffer(key)
+	blockHeight = sdk.BigEndianToUint64(keyPair.Next(8))
+	id = keyPair.Next(32)
+	val, contract = parseValidatorAndContract(keyPair.Bytes())
+	return
+}
+
+// parseScheduledCorkIDIndexKey parses a scheduled cork ID index key with its prefix byte and ID removed
+func parseScheduledCorkIDIndexKey(key []byte) (blockHeight uint64, val sdk.ValAddress, contract common.Address) {
+	keyPair := bytes.NewBuffer(key)
+	blockHeight = sdk.BigEndianToUint64(keyPair.Next(8))
+	val, contract = parseValidatorAndContract(keyPair.Bytes())
+	return
+}
+
+// parseValidatorAndContract splits the trailing <val_address><address> portion of a scheduled cork key,
+// the contract address is always the last 20 bytes
+func parseValidatorAndContract(bz []byte) (sdk.ValAddress, common.Address) {
+	if len(bz) < common.AddressLength {
+		return sdk.ValAddress(bz), common.Address{}
+	}
+
+	split := len(bz) - common.AddressLength
+	return sdk.ValAddress(bz[:split]), common.BytesToAddress(bz[split:])
+}
+
 ///////////////////////////
 // ScheduledBlockHeights //
 ///////////////////////////
@@ -222,8 +279,10 @@ func (k Keeper) IncrementInvalidationNonce(ctx sdk.Context) uint64 {
 //////////////////
 
 func (k Keeper) SetCorkResult(ctx sdk.Context, id []byte, corkResult types.CorkResult) {
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&corkResult)
-	ctx.KVStore(k.storeKey).Set(types.GetCorkResultKey(id), bz)
+	store.Set(types.GetCorkResultKey(id), bz)
+	store.Set(types.GetCorkResultHeightIndexKey(corkResult.BlockHeight, id), []byte{})
 }
 
 func (k Keeper) GetCorkResult(ctx sdk.Context, id []byte) (types.CorkResult, bool) {
@@ -239,7 +298,32 @@ func (k Keeper) GetCorkResult(ctx sdk.Context, id []byte) (types.CorkResult, boo
 }
 
 func (k Keeper) DeleteCorkResult(ctx sdk.Context, id []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.GetCorkResultKey(id))
+	corkResult, found := k.GetCorkResult(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCorkResultKey(id))
+	store.Delete(types.GetCorkResultHeightIndexKey(corkResult.BlockHeight, id))
+}
+
+// IterateCorkResultIDsUpToHeight iterates over the IDs of cork results from block heights at or below maxHeight, in
+// ascending block height order
+func (k Keeper) IterateCorkResultIDsUpToHeight(ctx sdk.Context, maxHeight uint64, cb func(id []byte, blockHeight uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCorkResultHeightIndexPrefix())
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(maxHeight+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		keyPair := bytes.NewBuffer(iter.Key())
+		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
+		id := keyPair.Next(32)
+
+		if cb(id, blockHeight) {
+			break
+		}
+	}
 }
 
 // IterateCorksResult iterates over all cork results in the store
@@ -272,49 +356,166 @@ func (k Keeper) GetCorkResults(ctx sdk.Context) []*types.CorkResult {
 	return corkResults
 }
 
+/////////////////////////
+// Cork Contract Calls //
+/////////////////////////
+
+func (k Keeper) SetCorkContractCall(ctx sdk.Context, contractCall types.CorkContractCall) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&contractCall)
+	store.Set(types.GetCorkContractCallKey(contractCall.InvalidationNonce), bz)
+	store.Set(types.GetCorkContractCallHeightIndexKey(contractCall.BlockHeight, contractCall.InvalidationNonce), []byte{})
+}
+
+func (k Keeper) GetCorkContractCall(ctx sdk.Context, invalidationNonce uint64) (types.CorkContractCall, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCorkContractCallKey(invalidationNonce))
+	if len(bz) == 0 {
+		return types.CorkContractCall{}, false
+	}
+
+	var contractCall types.CorkContractCall
+	k.cdc.MustUnmarshal(bz, &contractCall)
+	return contractCall, true
+}
+
+func (k Keeper) DeleteCorkContractCall(ctx sdk.Context, invalidationNonce uint64) {
+	contractCall, found := k.GetCorkContractCall(ctx, invalidationNonce)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCorkContractCallKey(invalidationNonce))
+	store.Delete(types.GetCorkContractCallHeightIndexKey(contractCall.BlockHeight, invalidationNonce))
+}
+
+// IterateCorkContractCallNoncesUpToHeight iterates over the invalidation nonces of contract calls submitted at block
+// heights at or below maxHeight, in ascending block height order
+func (k Keeper) IterateCorkContractCallNoncesUpToHeight(ctx sdk.Context, maxHeight uint64, cb func(invalidationNonce uint64, blockHeight uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCorkContractCallHeightIndexPrefix())
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(maxHeight+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		keyPair := bytes.NewBuffer(iter.Key())
+		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
+		invalidationNonce := sdk.BigEndianToUint64(keyPair.Next(8))
+
+		if cb(invalidationNonce, blockHeight) {
+			break
+		}
+	}
+}
+
+// IterateCorkContractCalls iterates over all contract calls that have been submitted but not yet executed
+func (k Keeper) IterateCorkContractCalls(ctx sdk.Context, cb func(contractCall types.CorkContractCall) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCorkContractCallKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractCall types.CorkContractCall
+		k.cdc.MustUnmarshal(iter.Value(), &contractCall)
+		if cb(contractCall) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetCorkContractCalls(ctx sdk.Context) []types.CorkContractCall {
+	contractCalls := []types.CorkContractCall{}
+	k.IterateCorkContractCalls(ctx, func(contractCall types.CorkContractCall) (stop bool) {
+		contractCalls = append(contractCalls, contractCall)
+		return false
+	})
+
+	return contractCalls
+}
+
 ///////////
 // Votes //
 ///////////
 
-func (k Keeper) GetApprovedScheduledCorks(ctx sdk.Context) (approvedCorks []types.Cork) {
-	currentBlockHeight := uint64(ctx.BlockHeight())
-	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
-	corks := []types.Cork{}
-	powers := []uint64{}
-	k.IterateScheduledCorksByBlockHeight(ctx, currentBlockHeight, func(val sdk.ValAddress, _ uint64, id []byte, addr common.Address, cork types.Cork) (stop bool) {
-		validator := k.stakingKeeper.Validator(ctx, val)
-		validatorPower := uint64(validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx)))
-		found := false
-		for i, c := range corks {
-			if c.Equals(cork) {
-				powers[i] += validatorPower
+// corkTally is the consensus power of the validators that scheduled a cork for a block height
+type corkTally struct {
+	cork   types.Cork
+	power  uint64
+	voters []sdk.ValAddress
+}
 
-				found = true
-				break
+// tallyScheduledCorks sums the current consensus power of the validators behind each distinct cork scheduled for a
+// block height. Votes for commit-reveal cellars only count if they were revealed against a matching commitment.
+func (k Keeper) tallyScheduledCorks(ctx sdk.Context, params types.Params, blockHeight uint64) []corkTally {
+	tallies := []corkTally{}
+	k.IterateScheduledCorksByBlockHeight(ctx, blockHeight, func(val sdk.ValAddress, _ uint64, id []byte, addr common.Address, cork types.Cork) (stop bool) {
+		if params.RequiresCommitReveal(addr) {
+			commitment, found := k.GetCorkCommitment(ctx, blockHeight, val, addr)
+			if !found || !bytes.Equal(commitment.RevealedCorkId, id) {
+				return false
 			}
 		}
 
-		if !found {
-			corks = append(corks, cork)
-			powers = append(powers, validatorPower)
+		validator := k.stakingKeeper.Validator(ctx, val)
+		validatorPower := uint64(validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx)))
+		for i := range tallies {
+			if tallies[i].cork.Equals(cork) {
+				tallies[i].power += validatorPower
+				tallies[i].voters = append(tallies[i].voters, val)
+				return false
+			}
 		}
 
+		tallies = append(tallies, corkTally{
+			cork:   cork,
+			power:  validatorPower,
+			voters: []sdk.ValAddress{val},
+		})
+		return false
+	})
+
+	return tallies
+}
+
+// tallyResult returns the share of the total power behind a tallied cork, the vote threshold of its cellar, and
+// whether the share exceeds the threshold. Nothing is approved without any bonded power.
+func (k Keeper) tallyResult(ctx sdk.Context, tally corkTally, totalPower sdk.Int) (approvalPercentage sdk.Dec, threshold sdk.Dec, approved bool) {
+	threshold = k.GetEffectiveVoteThreshold(ctx, common.HexToAddress(tally.cork.TargetContractAddress))
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec(), threshold, false
+	}
+
+	approvalPercentage = sdk.NewDecFromInt(sdk.NewIntFromUint64(tally.power)).Quo(sdk.NewDecFromInt(totalPower))
+
+	return approvalPercentage, threshold, approvalPercentage.GT(threshold)
+}
+
+func (k Keeper) GetApprovedScheduledCorks(ctx sdk.Context) (approvedCorks []types.Cork) {
+	currentBlockHeight := uint64(ctx.BlockHeight())
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	params := k.GetParamSet(ctx)
+	tallies := k.tallyScheduledCorks(ctx, params, currentBlockHeight)
+
+	k.IterateScheduledCorksByBlockHeight(ctx, currentBlockHeight, func(val sdk.ValAddress, _ uint64, id []byte, addr common.Address, _ types.Cork) (stop bool) {
 		k.DeleteScheduledCork(ctx, currentBlockHeight, id, val, addr)
 		k.DecrementValidatorCorkCount(ctx, val)
-
 		return false
 	})
 
-	threshold := sdk.MustNewDecFromStr(corkVoteThresholdStr)
-	for i, power := range powers {
-		cork := corks[i]
-		approvalPercentage := sdk.NewDecFromInt(sdk.NewIntFromUint64(power)).Quo(sdk.NewDecFromInt(totalPower))
-		quorumReached := approvalPercentage.GT(threshold)
+	k.deleteCorkCommitmentsByBlockHeight(ctx, currentBlockHeight)
+
+	if len(tallies) > 0 {
+		k.recordParticipation(ctx, params, tallies)
+	}
+
+	for _, tally := range tallies {
+		cork := tally.cork
+		approvalPercentage, threshold, quorumReached := k.tallyResult(ctx, tally, totalPower)
 		corkResult := types.CorkResult{
 			Cork:               &cork,
 			BlockHeight:        currentBlockHeight,
 			Approved:           quorumReached,
 			ApprovalPercentage: approvalPercentage.Mul(sdk.NewDec(100)).String(),
+			VoteThreshold:      threshold.String(),
 		}
 		corkID := cork.IDHash(currentBlockHeight)
 
@@ -328,69 +529,982 @@ func (k Keeper) GetApprovedScheduledCorks(ctx sdk.Context) (approvedCorks []type
 	return approvedCorks
 }
 
-/////////////
-// Cellars //
-/////////////
+// GetCorkTallies previews the tally of the corks scheduled for a block height against the given total power,
+// without consuming the scheduled corks
+func (k Keeper) GetCorkTallies(ctx sdk.Context, blockHeight uint64, totalPower sdk.Int) []types.CorkTally {
+	tallies := []types.CorkTally{}
+	for _, tally := range k.tallyScheduledCorks(ctx, k.GetParamSet(ctx), blockHeight) {
+		approvalPercentage, threshold, approved := k.tallyResult(ctx, tally, totalPower)
+
+		voters := make([]string, len(tally.voters))
+		for i, val := range tally.voters {
+			voters[i] = val.String()
+		}
+
+		tallies = append(tallies, types.CorkTally{
+			Cork:               tally.cork,
+			Id:                 tally.cork.IDHash(blockHeight),
+			BlockHeight:        blockHeight,
+			Power:              tally.power,
+			ApprovalPercentage: approvalPercentage.Mul(sdk.NewDec(100)).String(),
+			VoteThreshold:      threshold.String(),
+			Approved:           approved,
+			Voters:             voters,
+		})
+	}
 
-func (k Keeper) SetCellarIDs(ctx sdk.Context, c types.CellarIDSet) {
-	bz := k.cdc.MustMarshal(&c)
-	ctx.KVStore(k.storeKey).Set(types.MakeCellarIDsKey(), bz)
+	return tallies
 }
 
-func (k Keeper) GetCellarIDs(ctx sdk.Context) (cellars []common.Address) {
+////////////////////////////
+// Cellar Vote Thresholds //
+////////////////////////////
+
+func (k Keeper) SetCellarVoteThreshold(ctx sdk.Context, cellar common.Address, threshold sdk.Dec) {
+	bz, err := threshold.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetCellarVoteThresholdKey(cellar), bz)
+}
+
+// GetCellarVoteThreshold returns the governance set vote threshold override for a cellar, if one exists
+func (k Keeper) GetCellarVoteThreshold(ctx sdk.Context, cellar common.Address) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarVoteThresholdKey(cellar))
+	if len(bz) == 0 {
+		return sdk.Dec{}, false
+	}
+
+	var threshold sdk.Dec
+	if err := threshold.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return threshold, true
+}
+
+func (k Keeper) DeleteCellarVoteThreshold(ctx sdk.Context, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCellarVoteThresholdKey(cellar))
+}
+
+// IterateCellarVoteThresholds iterates over all cellar vote threshold overrides in the store
+func (k Keeper) IterateCellarVoteThresholds(ctx sdk.Context, cb func(cellar common.Address, threshold sdk.Dec) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.MakeCellarIDsKey())
+	iter := sdk.KVStorePrefixIterator(store, types.GetCellarVoteThresholdKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		cellar := common.BytesToAddress(iter.Key()[1:])
 
-	var cids types.CellarIDSet
-	k.cdc.MustUnmarshal(bz, &cids)
+		var threshold sdk.Dec
+		if err := threshold.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
 
-	for _, cid := range cids.Ids {
-		cellars = append(cellars, common.HexToAddress(cid))
+		if cb(cellar, threshold) {
+			break
+		}
 	}
+}
 
-	return cellars
+func (k Keeper) GetCellarVoteThresholds(ctx sdk.Context) []types.CellarVoteThreshold {
+	thresholds := []types.CellarVoteThreshold{}
+	k.IterateCellarVoteThresholds(ctx, func(cellar common.Address, threshold sdk.Dec) (stop bool) {
+		thresholds = append(thresholds, types.CellarVoteThreshold{
+			CellarId:      cellar.String(),
+			VoteThreshold: threshold,
+		})
+		return false
+	})
+
+	return thresholds
 }
 
-func (k Keeper) HasCellarID(ctx sdk.Context, address common.Address) (found bool) {
-	found = false
-	for _, id := range k.GetCellarIDs(ctx) {
-		if id == address {
-			found = true
+// GetEffectiveVoteThreshold returns the cellar's vote threshold override if one has been set,
+// otherwise the module's VoteThreshold parameter
+func (k Keeper) GetEffectiveVoteThreshold(ctx sdk.Context, cellar common.Address) sdk.Dec {
+	if threshold, found := k.GetCellarVoteThreshold(ctx, cellar); found {
+		return threshold
+	}
+
+	return k.GetParamSet(ctx).VoteThreshold
+}
+
+///////////////////////////////
+// Cellar Selector Allowlists //
+///////////////////////////////
+
+func (k Keeper) SetCellarSelectorAllowlist(ctx sdk.Context, allowlist types.CellarSelectorAllowlist) {
+	bz := k.cdc.MustMarshal(&allowlist)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarSelectorAllowlistKey(common.HexToAddress(allowlist.CellarId)), bz)
+}
+
+// GetCellarSelectorAllowlist returns the governance set function selector allowlist for a cellar, if one exists
+func (k Keeper) GetCellarSelectorAllowlist(ctx sdk.Context, cellar common.Address) (types.CellarSelectorAllowlist, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarSelectorAllowlistKey(cellar))
+	if len(bz) == 0 {
+		return types.CellarSelectorAllowlist{}, false
+	}
+
+	var allowlist types.CellarSelectorAllowlist
+	k.cdc.MustUnmarshal(bz, &allowlist)
+	return allowlist, true
+}
+
+func (k Keeper) DeleteCellarSelectorAllowlist(ctx sdk.Context, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCellarSelectorAllowlistKey(cellar))
+}
+
+// IterateCellarSelectorAllowlists iterates over all cellar function selector allowlists in the store
+func (k Keeper) IterateCellarSelectorAllowlists(ctx sdk.Context, cb func(allowlist types.CellarSelectorAllowlist) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCellarSelectorAllowlistKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var allowlist types.CellarSelectorAllowlist
+		k.cdc.MustUnmarshal(iter.Value(), &allowlist)
+
+		if cb(allowlist) {
 			break
 		}
 	}
+}
+
+func (k Keeper) GetCellarSelectorAllowlists(ctx sdk.Context) []types.CellarSelectorAllowlist {
+	allowlists := []types.CellarSelectorAllowlist{}
+	k.IterateCellarSelectorAllowlists(ctx, func(allowlist types.CellarSelectorAllowlist) (stop bool) {
+		allowlists = append(allowlists, allowlist)
+		return false
+	})
 
-	return found
+	return allowlists
 }
 
-///////////////////////////
-// Validator Cork counts //
-///////////////////////////
+// ValidateCorkSelector returns an error if the cork's target cellar has a function selector allowlist that
+// does not include the function the cork calls. Cellars without an allowlist accept any function.
+func (k Keeper) ValidateCorkSelector(ctx sdk.Context, cork types.Cork) error {
+	allowlist, found := k.GetCellarSelectorAllowlist(ctx, common.HexToAddress(cork.TargetContractAddress))
+	if !found {
+		return nil
+	}
 
-func (k Keeper) GetValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) (count uint64) {
+	selector, err := cork.Selector()
+	if err != nil {
+		return err
+	}
+
+	if !allowlist.Allows(selector) {
+		return errorsmod.Wrapf(types.ErrSelectorNotAllowed, "selector %s on cellar %s", selector, cork.TargetContractAddress)
+	}
+
+	return nil
+}
+
+////////////////////
+// Paused Cellars //
+////////////////////
+
+// SetCellarPauseState pauses or resumes a cellar and emits an event recording the authority that changed its state
+func (k Keeper) SetCellarPauseState(ctx sdk.Context, cellar common.Address, paused bool, authority string) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.GetPausedCellarKey(cellar), []byte{})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.GetPausedCellarKey(cellar))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCellarPause,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCellarID, cellar.String()),
+			sdk.NewAttribute(types.AttributeKeyPaused, fmt.Sprintf("%t", paused)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		),
+	)
+}
+
+func (k Keeper) IsCellarPaused(ctx sdk.Context, cellar common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetPausedCellarKey(cellar))
+}
+
+// IteratePausedCellars iterates over all paused cellars
+func (k Keeper) IteratePausedCellars(ctx sdk.Context, cb func(cellar common.Address) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetValidatorCorkCountKey(val))
+	iter := sdk.KVStorePrefixIterator(store, types.GetPausedCellarKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToAddress(iter.Key()[1:])) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPausedCellarIDs(ctx sdk.Context) []string {
+	ids := []string{}
+	k.IteratePausedCellars(ctx, func(cellar common.Address) (stop bool) {
+		ids = append(ids, cellar.String())
+		return false
+	})
+
+	return ids
+}
+
+///////////////////
+// Participation //
+///////////////////
+
+func (k Keeper) SetCorkParticipation(ctx sdk.Context, participation types.CorkParticipation) {
+	bz := k.cdc.MustMarshal(&participation)
+	ctx.KVStore(k.storeKey).Set(types.GetCorkParticipationKey(participation.CorkId), bz)
+}
+
+func (k Keeper) GetCorkParticipation(ctx sdk.Context, id []byte) (types.CorkParticipation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCorkParticipationKey(id))
 	if len(bz) == 0 {
-		return 0
+		return types.CorkParticipation{}, false
 	}
 
-	return binary.BigEndian.Uint64(bz)
+	var participation types.CorkParticipation
+	k.cdc.MustUnmarshal(bz, &participation)
+	return participation, true
 }
 
-func (k Keeper) SetValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress, count uint64) {
-	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, count)
-	ctx.KVStore(k.storeKey).Set(types.GetValidatorCorkCountKey(val), bz)
+func (k Keeper) DeleteCorkParticipation(ctx sdk.Context, id []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCorkParticipationKey(id))
 }
 
-func (k Keeper) IncrementValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) {
-	count := k.GetValidatorCorkCount(ctx, val)
-	k.SetValidatorCorkCount(ctx, val, count+1)
+// IterateCorkParticipations iterates over the voting records of all tallied corks
+func (k Keeper) IterateCorkParticipations(ctx sdk.Context, cb func(participation types.CorkParticipation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCorkParticipationKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var participation types.CorkParticipation
+		k.cdc.MustUnmarshal(iter.Value(), &participation)
+		if cb(participation) {
+			break
+		}
+	}
 }
 
-func (k Keeper) DecrementValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) {
-	count := k.GetValidatorCorkCount(ctx, val)
-	if count > 0 {
-		k.SetValidatorCorkCount(ctx, val, count-1)
+func (k Keeper) GetCorkParticipations(ctx sdk.Context) []types.CorkParticipation {
+	participations := []types.CorkParticipation{}
+	k.IterateCorkParticipations(ctx, func(participation types.CorkParticipation) (stop bool) {
+		participations = append(participations, participation)
+		return false
+	})
+
+	return participations
+}
+
+func (k Keeper) SetValidatorParticipation(ctx sdk.Context, participation types.ValidatorParticipation) {
+	val, err := sdk.ValAddressFromBech32(participation.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	bz := k.cdc.MustMarshal(&participation)
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorParticipationKey(val), bz)
+}
+
+func (k Keeper) GetValidatorParticipation(ctx sdk.Context, val sdk.ValAddress) (types.ValidatorParticipation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorParticipationKey(val))
+	if len(bz) == 0 {
+		return types.ValidatorParticipation{}, false
 	}
+
+	var participation types.ValidatorParticipation
+	k.cdc.MustUnmarshal(bz, &participation)
+	return participation, true
+}
+
+// IterateValidatorParticipations iterates over the participation counters of all tracked validators
+func (k Keeper) IterateValidatorParticipations(ctx sdk.Context, cb func(participation types.ValidatorParticipation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorParticipationKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var participation types.ValidatorParticipation
+		k.cdc.MustUnmarshal(iter.Value(), &participation)
+		if cb(participation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetValidatorParticipations(ctx sdk.Context) []types.ValidatorParticipation {
+	participations := []types.ValidatorParticipation{}
+	k.IterateValidatorParticipations(ctx, func(participation types.ValidatorParticipation) (stop bool) {
+		participations = append(participations, participation)
+		return false
+	})
+
+	return participations
+}
+
+func (k Keeper) SetValidatorMissedCork(ctx sdk.Context, val sdk.ValAddress, index uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorMissedCorkKey(val, index), []byte{})
+}
+
+func (k Keeper) HasValidatorMissedCork(ctx sdk.Context, val sdk.ValAddress, index uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetValidatorMissedCorkKey(val, index))
+}
+
+func (k Keeper) DeleteValidatorMissedCork(ctx sdk.Context, val sdk.ValAddress, index uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetValidatorMissedCorkKey(val, index))
+}
+
+// GetValidatorMissedCorkIndexes returns the positions in the participation window of the tally rounds a validator missed
+func (k Keeper) GetValidatorMissedCorkIndexes(ctx sdk.Context, val sdk.ValAddress) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorMissedCorkKeyPrefix(val)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var indexes []uint64
+	for ; iter.Valid(); iter.Next() {
+		indexes = append(indexes, sdk.BigEndianToUint64(iter.Key()[len(prefix):]))
+	}
+
+	return indexes
+}
+
+func (k Keeper) GetValidatorMissedCorks(ctx sdk.Context) []types.ValidatorMissedCorks {
+	missedCorks := []types.ValidatorMissedCorks{}
+	k.IterateValidatorParticipations(ctx, func(participation types.ValidatorParticipation) (stop bool) {
+		val, err := sdk.ValAddressFromBech32(participation.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		if indexes := k.GetValidatorMissedCorkIndexes(ctx, val); len(indexes) > 0 {
+			missedCorks = append(missedCorks, types.ValidatorMissedCorks{
+				Validator:     participation.Validator,
+				MissedIndexes: indexes,
+			})
+		}
+		return false
+	})
+
+	return missedCorks
+}
+
+// resetValidatorParticipation clears a validator's participation window
+func (k Keeper) resetValidatorParticipation(ctx sdk.Context, val sdk.ValAddress, window uint64) types.ValidatorParticipation {
+	for _, index := range k.GetValidatorMissedCorkIndexes(ctx, val) {
+		k.DeleteValidatorMissedCork(ctx, val, index)
+	}
+
+	return types.ValidatorParticipation{
+		Validator: val.String(),
+		Window:    window,
+	}
+}
+
+// recordParticipation records which bonded validators voted for each tallied cork and updates their participation
+// counters. Each cellar with a tallied cork counts as one tally round, in which a validator participated if it voted
+// for any of the cellar's corks.
+func (k Keeper) recordParticipation(ctx sdk.Context, params types.Params, tallies []corkTally) {
+	blockHeight := uint64(ctx.BlockHeight())
+
+	var bonded []stakingtypes.ValidatorI
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		bonded = append(bonded, validator)
+		return false
+	})
+
+	var cellars []common.Address
+	cellarVoters := make(map[common.Address]map[string]bool)
+	for _, tally := range tallies {
+		cork := tally.cork
+		cellar := common.HexToAddress(cork.TargetContractAddress)
+		if _, found := cellarVoters[cellar]; !found {
+			cellars = append(cellars, cellar)
+			cellarVoters[cellar] = make(map[string]bool)
+		}
+
+		participation := types.CorkParticipation{
+			CorkId:                cork.IDHash(blockHeight),
+			BlockHeight:           blockHeight,
+			TargetContractAddress: cork.TargetContractAddress,
+			Voters:                []string{},
+			NonVoters:             []string{},
+		}
+
+		corkVoters := make(map[string]bool, len(tally.voters))
+		for _, val := range tally.voters {
+			corkVoters[val.String()] = true
+			cellarVoters[cellar][val.String()] = true
+			participation.Voters = append(participation.Voters, val.String())
+		}
+
+		for _, validator := range bonded {
+			if operator := validator.GetOperator().String(); !corkVoters[operator] {
+				participation.NonVoters = append(participation.NonVoters, operator)
+			}
+		}
+
+		k.SetCorkParticipation(ctx, participation)
+	}
+
+	if params.ParticipationWindow == 0 {
+		return
+	}
+
+	for _, cellar := range cellars {
+		for _, validator := range bonded {
+			k.updateValidatorParticipation(ctx, params, validator, cellarVoters[cellar][validator.GetOperator().String()])
+		}
+	}
+}
+
+// updateValidatorParticipation advances a validator's participation window by one tally round, reporting the
+// validator once its participation rate over a full window falls below the minimum and jailing it if enabled
+func (k Keeper) updateValidatorParticipation(ctx sdk.Context, params types.Params, validator stakingtypes.ValidatorI, participated bool) {
+	val := validator.GetOperator()
+	participation, found := k.GetValidatorParticipation(ctx, val)
+	if !found || participation.Window != params.ParticipationWindow {
+		participation = k.resetValidatorParticipation(ctx, val, params.ParticipationWindow)
+	}
+
+	index := participation.IndexOffset % participation.Window
+	participation.IndexOffset++
+
+	previouslyMissed := k.HasValidatorMissedCork(ctx, val, index)
+	switch {
+	case !previouslyMissed && !participated:
+		k.SetValidatorMissedCork(ctx, val, index)
+		participation.MissedCounter++
+	case previouslyMissed && participated:
+		k.DeleteValidatorMissedCork(ctx, val, index)
+		participation.MissedCounter--
+	}
+
+	// rates are only judged over a full window
+	if participation.IndexOffset < participation.Window {
+		k.SetValidatorParticipation(ctx, participation)
+		return
+	}
+
+	rate := participation.ParticipationRate()
+	belowThreshold := rate.LT(params.MinParticipationRate)
+	if belowThreshold && !participation.BelowThreshold {
+		k.Logger(ctx).Info("validator cork participation fell below the minimum",
+			"validator", val.String(),
+			"participation rate", rate.String())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLowParticipation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+				sdk.NewAttribute(types.AttributeKeyParticipationRate, rate.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedCorks, fmt.Sprintf("%d", participation.MissedCounter)),
+				sdk.NewAttribute(types.AttributeKeyWindow, fmt.Sprintf("%d", participation.Window)),
+			),
+		)
+	}
+	participation.BelowThreshold = belowThreshold
+
+	if belowThreshold && params.ParticipationJailingEnabled && !validator.IsJailed() {
+		if err := k.jailForLowParticipation(ctx, params, validator, rate); err != nil {
+			k.Logger(ctx).Error("failed to jail validator for low cork participation", "validator", val.String(), "error", err)
+		} else {
+			participation = k.resetValidatorParticipation(ctx, val, params.ParticipationWindow)
+		}
+	}
+
+	k.SetValidatorParticipation(ctx, participation)
+}
+
+// jailForLowParticipation slashes and jails a validator whose participation rate is below the minimum through the
+// slashing module, so the validator can't unjail until the slashing module's downtime jail duration has elapsed
+func (k Keeper) jailForLowParticipation(ctx sdk.Context, params types.Params, validator stakingtypes.ValidatorI, rate sdk.Dec) error {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return errorsmod.Wrapf(types.ErrSigningInfoNotFound, "consensus address %s", consAddr)
+	}
+
+	if params.ParticipationSlashFraction.IsPositive() {
+		power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+		k.slashingKeeper.Slash(ctx, consAddr, params.ParticipationSlashFraction, power, ctx.BlockHeight())
+	}
+	k.slashingKeeper.Jail(ctx, consAddr)
+	jailedUntil := ctx.BlockHeader().Time.Add(k.slashingKeeper.DowntimeJailDuration(ctx))
+	k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipationJail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+			sdk.NewAttribute(types.AttributeKeyParticipationRate, rate.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, params.ParticipationSlashFraction.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()),
+		),
+	)
+
+	return nil
+}
+
+//////////////////////
+// Cork Commitments //
+//////////////////////
+
+// SetCorkCommitment stores a validator's commitment to its vote on a cellar at a block height
+func (k Keeper) SetCorkCommitment(ctx sdk.Context, commitment types.CorkCommitment) {
+	val, err := sdk.ValAddressFromBech32(commitment.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	bz := k.cdc.MustMarshal(&commitment)
+	ctx.KVStore(k.storeKey).Set(types.GetCorkCommitmentKey(commitment.BlockHeight, val, common.HexToAddress(commitment.CellarId)), bz)
+}
+
+// GetCorkCommitment gets a validator's commitment to its vote on a cellar at a block height
+// CONTRACT: must provide the validator address here not the delegate address
+func (k Keeper) GetCorkCommitment(ctx sdk.Context, blockHeight uint64, val sdk.ValAddress, cellar common.Address) (types.CorkCommitment, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCorkCommitmentKey(blockHeight, val, cellar))
+	if len(bz) == 0 {
+		return types.CorkCommitment{}, false
+	}
+
+	var commitment types.CorkCommitment
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return commitment, true
+}
+
+func (k Keeper) DeleteCorkCommitment(ctx sdk.Context, blockHeight uint64, val sdk.ValAddress, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCorkCommitmentKey(blockHeight, val, cellar))
+}
+
+// IterateCorkCommitments iterates over all cork commitments in the store
+func (k Keeper) IterateCorkCommitments(ctx sdk.Context, cb func(commitment types.CorkCommitment) (stop bool)) {
+	k.IterateCorkCommitmentsByPrefix(ctx, types.GetCorkCommitmentKeyPrefix(), cb)
+}
+
+// IterateCorkCommitmentsByBlockHeight iterates over the cork commitments made for a block height
+func (k Keeper) IterateCorkCommitmentsByBlockHeight(ctx sdk.Context, blockHeight uint64, cb func(commitment types.CorkCommitment) (stop bool)) {
+	k.IterateCorkCommitmentsByPrefix(ctx, types.GetCorkCommitmentKeyByBlockHeightPrefix(blockHeight), cb)
+}
+
+func (k Keeper) IterateCorkCommitmentsByPrefix(ctx sdk.Context, prefix []byte, cb func(commitment types.CorkCommitment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var commitment types.CorkCommitment
+		k.cdc.MustUnmarshal(iter.Value(), &commitment)
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetCorkCommitments(ctx sdk.Context) []types.CorkCommitment {
+	commitments := []types.CorkCommitment{}
+	k.IterateCorkCommitments(ctx, func(commitment types.CorkCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+
+	return commitments
+}
+
+// deleteCorkCommitmentsByBlockHeight removes the commitments made for a block height once its votes have been tallied,
+// releasing the quota held by commitments that were never revealed. Revealed commitments are accounted for by the
+// scheduled corks they produced.
+func (k Keeper) deleteCorkCommitmentsByBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	var commitments []types.CorkCommitment
+	k.IterateCorkCommitmentsByBlockHeight(ctx, blockHeight, func(commitment types.CorkCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+
+	for _, commitment := range commitments {
+		val, err := sdk.ValAddressFromBech32(commitment.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		k.DeleteCorkCommitment(ctx, blockHeight, val, common.HexToAddress(commitment.CellarId))
+		if !commitment.Revealed() {
+			k.DecrementValidatorCorkCount(ctx, val)
+		}
+	}
+}
+
+////////////////////////////////
+// Governance Scheduled Corks //
+////////////////////////////////
+
+// RegisterContractCallEncoder registers the encoder used to derive the contract call of a scheduled cork proposal
+// whose ScheduleRequest JSON contains a call for the given cellar type, such as "cellar_v1"
+func (k Keeper) RegisterContractCallEncoder(cellarType string, encoder types.ContractCallEncoder) {
+	if _, found := k.contractCallEncoders[cellarType]; found {
+		panic(fmt.Sprintf("contract call encoder for cellar type %s already registered", cellarType))
+	}
+
+	k.contractCallEncoders[cellarType] = encoder
+}
+
+// EncodeContractCall ABI encodes the cellar call in the JSON form of a Steward ScheduleRequest using the
+// encoder registered for its cellar type
+func (k Keeper) EncodeContractCall(protoJSON string) ([]byte, error) {
+	cellarType, callJSON, err := types.ParseContractCallProtoJSON(protoJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, found := k.contractCallEncoders[cellarType]
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrContractCallEncoderNotFound, "cellar type: %s", cellarType)
+	}
+
+	encoded, err := encoder(callJSON)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidJSON, "failed to encode %s call: %s", cellarType, err)
+	}
+
+	return encoded, nil
+}
+
+// SetGovernanceScheduledCork stores a cork scheduled by governance to be executed at a block height
+func (k Keeper) SetGovernanceScheduledCork(ctx sdk.Context, blockHeight uint64, cork types.Cork) []byte {
+	id := cork.IDHash(blockHeight)
+	bz := k.cdc.MustMarshal(&types.GovernanceScheduledCork{
+		Cork:        &cork,
+		BlockHeight: blockHeight,
+		Id:          id,
+	})
+	ctx.KVStore(k.storeKey).Set(types.GetGovernanceScheduledCorkKey(blockHeight, id), bz)
+	return id
+}
+
+func (k Keeper) GetGovernanceScheduledCork(ctx sdk.Context, blockHeight uint64, id []byte) (types.GovernanceScheduledCork, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGovernanceScheduledCorkKey(blockHeight, id))
+	if len(bz) == 0 {
+		return types.GovernanceScheduledCork{}, false
+	}
+
+	var cork types.GovernanceScheduledCork
+	k.cdc.MustUnmarshal(bz, &cork)
+	return cork, true
+}
+
+func (k Keeper) DeleteGovernanceScheduledCork(ctx sdk.Context, blockHeight uint64, id []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.GetGovernanceScheduledCorkKey(blockHeight, id))
+}
+
+// IterateGovernanceScheduledCorks iterates over all governance scheduled corks that have yet to be executed
+func (k Keeper) IterateGovernanceScheduledCorks(ctx sdk.Context, cb func(cork types.GovernanceScheduledCork) (stop bool)) {
+	k.IterateGovernanceScheduledCorksByPrefix(ctx, types.GetGovernanceScheduledCorkKeyPrefix(), cb)
+}
+
+// IterateGovernanceScheduledCorksByBlockHeight iterates over the governance scheduled corks for a block height
+func (k Keeper) IterateGovernanceScheduledCorksByBlockHeight(ctx sdk.Context, blockHeight uint64, cb func(cork types.GovernanceScheduledCork) (stop bool)) {
+	k.IterateGovernanceScheduledCorksByPrefix(ctx, types.GetGovernanceScheduledCorkKeyByBlockHeightPrefix(blockHeight), cb)
+}
+
+func (k Keeper) IterateGovernanceScheduledCorksByPrefix(ctx sdk.Context, prefix []byte, cb func(cork types.GovernanceScheduledCork) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cork types.GovernanceScheduledCork
+		k.cdc.MustUnmarshal(iter.Value(), &cork)
+		if cb(cork) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetGovernanceScheduledCorks(ctx sdk.Context) []types.GovernanceScheduledCork {
+	corks := []types.GovernanceScheduledCork{}
+	k.IterateGovernanceScheduledCorks(ctx, func(cork types.GovernanceScheduledCork) (stop bool) {
+		corks = append(corks, cork)
+		return false
+	})
+
+	return corks
+}
+
+// deleteGovernanceScheduledCorksForCellar removes the governance scheduled corks targeting a cellar that have yet to
+// be executed
+func (k Keeper) deleteGovernanceScheduledCorksForCellar(ctx sdk.Context, cellar common.Address) {
+	var scheduled []types.GovernanceScheduledCork
+	k.IterateGovernanceScheduledCorks(ctx, func(cork types.GovernanceScheduledCork) (stop bool) {
+		if common.HexToAddress(cork.Cork.TargetContractAddress) == cellar {
+			scheduled = append(scheduled, cork)
+		}
+		return false
+	})
+
+	for _, s := range scheduled {
+		k.DeleteGovernanceScheduledCork(ctx, s.BlockHeight, s.Id)
+	}
+}
+
+// takeGovernanceScheduledCorks removes the governance scheduled corks for the current block height and records an
+// approved result for each. Corks that validators already approved at this height are not returned again.
+func (k Keeper) takeGovernanceScheduledCorks(ctx sdk.Context) (corks []types.Cork) {
+	currentBlockHeight := uint64(ctx.BlockHeight())
+
+	var scheduled []types.GovernanceScheduledCork
+	k.IterateGovernanceScheduledCorksByBlockHeight(ctx, currentBlockHeight, func(cork types.GovernanceScheduledCork) (stop bool) {
+		scheduled = append(scheduled, cork)
+		return false
+	})
+
+	for _, s := range scheduled {
+		k.DeleteGovernanceScheduledCork(ctx, currentBlockHeight, s.Id)
+
+		if corkResult, found := k.GetCorkResult(ctx, s.Id); found && corkResult.Approved {
+			continue
+		}
+
+		k.SetCorkResult(ctx, s.Id, types.CorkResult{
+			Cork:        s.Cork,
+			BlockHeight: currentBlockHeight,
+			Approved:    true,
+			Governance:  true,
+		})
+		corks = append(corks, *s.Cork)
+	}
+
+	return corks
+}
+
+/////////////////
+// Cellar ABIs //
+/////////////////
+
+func (k Keeper) SetCellarABI(ctx sdk.Context, cellarABI types.CellarABI) {
+	bz := k.cdc.MustMarshal(&cellarABI)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarABIKey(common.HexToAddress(cellarABI.CellarId)), bz)
+}
+
+// GetCellarABI returns the governance registered ABI for a cellar, if one exists
+func (k Keeper) GetCellarABI(ctx sdk.Context, cellar common.Address) (types.CellarABI, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarABIKey(cellar))
+	if len(bz) == 0 {
+		return types.CellarABI{}, false
+	}
+
+	var cellarABI types.CellarABI
+	k.cdc.MustUnmarshal(bz, &cellarABI)
+	return cellarABI, true
+}
+
+func (k Keeper) DeleteCellarABI(ctx sdk.Context, cellar common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCellarABIKey(cellar))
+}
+
+// IterateCellarABIs iterates over all cellar ABIs in the store
+func (k Keeper) IterateCellarABIs(ctx sdk.Context, cb func(cellarABI types.CellarABI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCellarABIKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cellarABI types.CellarABI
+		k.cdc.MustUnmarshal(iter.Value(), &cellarABI)
+		if cb(cellarABI) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetCellarABIs(ctx sdk.Context) []types.CellarABI {
+	abis := []types.CellarABI{}
+	k.IterateCellarABIs(ctx, func(cellarABI types.CellarABI) (stop bool) {
+		abis = append(abis, cellarABI)
+		return false
+	})
+
+	return abis
+}
+
+// DecodeCork decodes the contract call of a cork using its target cellar's registered ABI. Corks whose cellar has
+// no registered ABI, or whose call the ABI doesn't describe, are not decoded.
+func (k Keeper) DecodeCork(ctx sdk.Context, id []byte, cork types.Cork) (types.DecodedContractCall, bool) {
+	cellarABI, found := k.GetCellarABI(ctx, common.HexToAddress(cork.TargetContractAddress))
+	if !found {
+		return types.DecodedContractCall{}, false
+	}
+
+	decoded, err := cellarABI.DecodeContractCall(id, cork.EncodedContractCall)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to decode cork", "id", hex.EncodeToString(id), "cellar", cork.TargetContractAddress, "error", err)
+		return types.DecodedContractCall{}, false
+	}
+
+	return decoded, true
+}
+
+/////////////
+// Cellars //
+/////////////
+
+// SetCellar adds a managed cellar, or replaces the record of an existing one
+func (k Keeper) SetCellar(ctx sdk.Context, cellar types.ManagedCellar) {
+	address := common.HexToAddress(cellar.CellarId)
+	cellar.CellarId = address.String()
+	bz := k.cdc.MustMarshal(&cellar)
+	ctx.KVStore(k.storeKey).Set(types.GetCellarKey(address), bz)
+}
+
+// GetCellar returns the record of a managed cellar
+func (k Keeper) GetCellar(ctx sdk.Context, address common.Address) (types.ManagedCellar, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCellarKey(address))
+	if len(bz) == 0 {
+		return types.ManagedCellar{}, false
+	}
+
+	var cellar types.ManagedCellar
+	k.cdc.MustUnmarshal(bz, &cellar)
+
+	return cellar, true
+}
+
+// DeleteCellar removes a managed cellar
+func (k Keeper) DeleteCellar(ctx sdk.Context, address common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCellarKey(address))
+	store.Delete(types.GetPendingCellarProposalKey(address))
+}
+
+// IterateCellars iterates over the managed cellars in address order
+func (k Keeper) IterateCellars(ctx sdk.Context, cb func(cellar types.ManagedCellar) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetCellarKeyPrefix())
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cellar types.ManagedCellar
+		k.cdc.MustUnmarshal(iter.Value(), &cellar)
+		if cb(cellar) {
+			break
+		}
+	}
+}
+
+// GetCellars returns the records of all managed cellars
+func (k Keeper) GetCellars(ctx sdk.Context) (cellars []types.ManagedCellar) {
+	k.IterateCellars(ctx, func(cellar types.ManagedCellar) (stop bool) {
+		cellars = append(cellars, cellar)
+		return false
+	})
+
+	return cellars
+}
+
+func (k Keeper) GetCellarIDs(ctx sdk.Context) (cellars []common.Address) {
+	k.IterateCellars(ctx, func(cellar types.ManagedCellar) (stop bool) {
+		cellars = append(cellars, common.HexToAddress(cellar.CellarId))
+		return false
+	})
+
+	return cellars
+}
+
+func (k Keeper) HasCellarID(ctx sdk.Context, address common.Address) (found bool) {
+	return ctx.KVStore(k.storeKey).Has(types.GetCellarKey(address))
+}
+
+// setPendingCellarProposal marks a cellar as added by the governance proposal currently being executed, the
+// proposal's ID is recorded once gov calls AfterProposalVotingPeriodEnded
+func (k Keeper) setPendingCellarProposal(ctx sdk.Context, address common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingCellarProposalKey(address), []byte{})
+}
+
+// setCellarProposalID records the proposal ID on every cellar pending one
+func (k Keeper) setCellarProposalID(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPendingCellarProposalKeyPrefix())
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		address := common.BytesToAddress(key[1:])
+		if cellar, found := k.GetCellar(ctx, address); found {
+			cellar.ProposalId = proposalID
+			k.SetCellar(ctx, cellar)
+		}
+	}
+}
+
+///////////////////////////
+// Validator Cork counts //
+///////////////////////////
+
+func (k Keeper) GetValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) (count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorCorkCountKey(val))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorCorkCountKey(val), bz)
+}
+
+func (k Keeper) IncrementValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) {
+	count := k.GetValidatorCorkCount(ctx, val)
+	k.SetValidatorCorkCount(ctx, val, count+1)
+}
+
+func (k Keeper) DecrementValidatorCorkCount(ctx sdk.Context, val sdk.ValAddress) {
+	count := k.GetValidatorCorkCount(ctx, val)
+	if count > 0 {
+		k.SetValidatorCorkCount(ctx, val, count-1)
+	}
+}
+
+// IterateValidatorCorkCounts iterates over all validator cork counts in the store
+func (k Keeper) IterateValidatorCorkCounts(ctx sdk.Context, cb func(val sdk.ValAddress, count uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.ValidatorCorkCountKey})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		val := sdk.ValAddress(iter.Key()[1:])
+		if cb(val, binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetValidatorCorkCounts(ctx sdk.Context) []types.ValidatorCorkCount {
+	counts := []types.ValidatorCorkCount{}
+	k.IterateValidatorCorkCounts(ctx, func(val sdk.ValAddress, count uint64) (stop bool) {
+		counts = append(counts, types.ValidatorCorkCount{
+			Validator: val.String(),
+			Count:     count,
+		})
+		return false
+	})
+
+	return counts
 }
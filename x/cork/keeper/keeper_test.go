@@ -27,12 +27,13 @@ var (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx           sdk.Context
-	corkKeeper    Keeper
-	gravityKeeper *corktestutil.MockGravityKeeper
-	pubsubKeeper  *corktestutil.MockPubsubKeeper
-	stakingKeeper *corktestutil.MockStakingKeeper
-	validator     *corktestutil.MockValidatorI
+	ctx            sdk.Context
+	corkKeeper     Keeper
+	gravityKeeper  *corktestutil.MockGravityKeeper
+	pubsubKeeper   *corktestutil.MockPubsubKeeper
+	stakingKeeper  *corktestutil.MockStakingKeeper
+	slashingKeeper *corktestutil.MockSlashingKeeper
+	validator      *corktestutil.MockValidatorI
 
 	queryClient types.QueryClient
 
@@ -53,6 +54,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.gravityKeeper = corktestutil.NewMockGravityKeeper(ctrl)
 	suite.pubsubKeeper = corktestutil.NewMockPubsubKeeper(ctrl)
 	suite.stakingKeeper = corktestutil.NewMockStakingKeeper(ctrl)
+	suite.slashingKeeper = corktestutil.NewMockSlashingKeeper(ctrl)
 	suite.validator = corktestutil.NewMockValidatorI(ctrl)
 	suite.ctx = ctx
 
@@ -72,6 +74,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		key,
 		subSpace,
 		suite.stakingKeeper,
+		suite.slashingKeeper,
 		suite.gravityKeeper,
 		suite.pubsubKeeper,
 	)
@@ -94,18 +97,44 @@ func (suite *KeeperTestSuite) TestSetGetCellarIDsHappyPath() {
 	ctx, corkKeeper := suite.ctx, suite.corkKeeper
 	require := suite.Require()
 
-	cellarIDSet := types.CellarIDSet{
-		Ids: []string{sampleCellarHex},
+	cellar := types.ManagedCellar{
+		CellarId:        sampleCellarHex,
+		AddedHeight:     10,
+		PublisherDomain: "example.com",
 	}
-	expected := []common.Address{}
-	for _, id := range cellarIDSet.Ids {
-		expected = append(expected, common.HexToAddress(id))
-	}
-	corkKeeper.SetCellarIDs(ctx, cellarIDSet)
-	actual := corkKeeper.GetCellarIDs(ctx)
+	corkKeeper.SetCellar(ctx, cellar)
 
-	require.Equal(expected, actual)
+	require.Equal([]common.Address{sampleCellarAddr}, corkKeeper.GetCellarIDs(ctx))
 	require.True(corkKeeper.HasCellarID(ctx, sampleCellarAddr))
+
+	actual, found := corkKeeper.GetCellar(ctx, sampleCellarAddr)
+	require.True(found)
+	require.Equal(sampleCellarAddr.String(), actual.CellarId)
+	require.Equal(uint64(10), actual.AddedHeight)
+	require.Equal("example.com", actual.PublisherDomain)
+
+	corkKeeper.DeleteCellar(ctx, sampleCellarAddr)
+	require.False(corkKeeper.HasCellarID(ctx, sampleCellarAddr))
+	require.Empty(corkKeeper.GetCellarIDs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCellarProposalIDSetByGovHook() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex, AddedHeight: 5})
+	corkKeeper.setPendingCellarProposal(ctx, sampleCellarAddr)
+
+	corkKeeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, 42)
+
+	cellar, found := corkKeeper.GetCellar(ctx, sampleCellarAddr)
+	require.True(found)
+	require.Equal(uint64(42), cellar.ProposalId)
+
+	// a later proposal must not overwrite the recorded ID
+	corkKeeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, 43)
+	cellar, _ = corkKeeper.GetCellar(ctx, sampleCellarAddr)
+	require.Equal(uint64(42), cellar.ProposalId)
 }
 
 func (suite *KeeperTestSuite) TestSetGetDeleteScheduledCork() {
@@ -164,6 +193,7 @@ func (suite *KeeperTestSuite) TestGetWinningVotes() {
 	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
 	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(100))
 	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
 
 	winningScheduledVotes := corkKeeper.GetApprovedScheduledCorks(ctx)
 	results := corkKeeper.GetCorkResults(ctx)
@@ -176,6 +206,101 @@ func (suite *KeeperTestSuite) TestGetWinningVotes() {
 	require.Empty(corkKeeper.GetScheduledCorksByBlockHeight(ctx, testHeight))
 }
 
+func (suite *KeeperTestSuite) TestGetWinningVotesWithoutBondedPower() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+	testHeight := uint64(ctx.BlockHeight())
+	corkKeeper.SetParams(ctx, types.DefaultParams())
+	cork := types.Cork{
+		EncodedContractCall:   []byte("testcall"),
+		TargetContractAddress: sampleCellarHex,
+	}
+	corkKeeper.SetScheduledCork(ctx, testHeight, sdk.ValAddress("12345678901234567890"), cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.ZeroInt())
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(0))
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
+
+	// nothing is approved, rather than dividing by zero
+	require.Empty(corkKeeper.GetApprovedScheduledCorks(ctx))
+	results := corkKeeper.GetCorkResults(ctx)
+	require.Len(results, 1)
+	require.False(results[0].Approved)
+	require.Equal("0.000000000000000000", results[0].ApprovalPercentage)
+}
+
+func (suite *KeeperTestSuite) TestGetWinningVotesCellarVoteThreshold() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+	testHeight := uint64(ctx.BlockHeight())
+	params := types.DefaultParams()
+	params.VoteThreshold = sdk.ZeroDec()
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellarVoteThreshold(ctx, sampleCellarAddr, sdk.MustNewDecFromStr("0.9"))
+	cork := types.Cork{
+		EncodedContractCall:   []byte("testcall"),
+		TargetContractAddress: sampleCellarHex,
+	}
+	_, bytes, err := bech32.DecodeAndConvert("somm1fcl08ymkl70dhyg3vmx4hjsqvxym7dawnp0zfp")
+	require.NoError(err)
+	corkKeeper.SetScheduledCork(ctx, testHeight, bytes, cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100))
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(80))
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any())
+
+	// 80% of power voted, which passes the module threshold but not the cellar override
+	winningScheduledVotes := corkKeeper.GetApprovedScheduledCorks(ctx)
+	results := corkKeeper.GetCorkResults(ctx)
+	require.Empty(winningScheduledVotes)
+	require.False(results[0].Approved)
+	require.Equal("80.000000000000000000", results[0].ApprovalPercentage)
+	require.Equal("0.900000000000000000", results[0].VoteThreshold)
+}
+
+func (suite *KeeperTestSuite) TestCellarVoteThresholds() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	corkKeeper.SetParams(ctx, params)
+
+	_, found := corkKeeper.GetCellarVoteThreshold(ctx, sampleCellarAddr)
+	require.False(found)
+	require.Equal(params.VoteThreshold, corkKeeper.GetEffectiveVoteThreshold(ctx, sampleCellarAddr))
+
+	expected := sdk.MustNewDecFromStr("0.5")
+	corkKeeper.SetCellarVoteThreshold(ctx, sampleCellarAddr, expected)
+	actual, found := corkKeeper.GetCellarVoteThreshold(ctx, sampleCellarAddr)
+	require.True(found)
+	require.Equal(expected, actual)
+	require.Equal(expected, corkKeeper.GetEffectiveVoteThreshold(ctx, sampleCellarAddr))
+	require.Equal([]types.CellarVoteThreshold{{CellarId: sampleCellarAddr.String(), VoteThreshold: expected}}, corkKeeper.GetCellarVoteThresholds(ctx))
+
+	corkKeeper.DeleteCellarVoteThreshold(ctx, sampleCellarAddr)
+	require.Empty(corkKeeper.GetCellarVoteThresholds(ctx))
+	require.Equal(params.VoteThreshold, corkKeeper.GetEffectiveVoteThreshold(ctx, sampleCellarAddr))
+}
+
+func (suite *KeeperTestSuite) TestGenesisValidatorCorkCounts() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	gs := types.DefaultGenesisState()
+	gs.ValidatorCorkCounts = []types.ValidatorCorkCount{{Validator: sampleValAddr.String(), Count: 3}}
+	require.NoError(gs.Validate())
+
+	InitGenesis(ctx, corkKeeper, gs)
+	require.Equal(uint64(3), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	exported := ExportGenesis(ctx, corkKeeper)
+	require.Equal(gs.ValidatorCorkCounts, exported.ValidatorCorkCounts)
+}
+
 func (suite *KeeperTestSuite) TestInvalidationNonce() {
 	ctx, corkKeeper := suite.ctx, suite.corkKeeper
 	require := suite.Require()
@@ -229,3 +354,52 @@ func (suite *KeeperTestSuite) TestParamSet() {
 	corkKeeper.SetParams(ctx, params)
 	require.Equal(params, corkKeeper.GetParamSet(ctx))
 }
+
+func (suite *KeeperTestSuite) TestValidatorCorkCountsInvariant() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	invariant := ValidatorCorkCountsInvariant(corkKeeper)
+	_, broken := invariant(ctx)
+	require.False(broken)
+
+	testHeight := uint64(123)
+	cork := types.Cork{
+		EncodedContractCall:   []byte("testcall"),
+		TargetContractAddress: sampleCellarHex,
+	}
+	corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+	_, broken = invariant(ctx)
+	require.True(broken)
+
+	corkKeeper.IncrementValidatorCorkCount(ctx, sampleValAddr)
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	// an unrevealed commitment holds a slot in the validator's quota
+	commitment := types.CorkCommitment{
+		Validator:   sampleValAddr.String(),
+		BlockHeight: testHeight + 1,
+		CellarId:    sampleCellarHex,
+		Commitment:  []byte("commitment"),
+	}
+	corkKeeper.SetCorkCommitment(ctx, commitment)
+	msg, broken := invariant(ctx)
+	require.True(broken)
+	require.Contains(msg, "has a cork count of 1, expected 2")
+
+	corkKeeper.IncrementValidatorCorkCount(ctx, sampleValAddr)
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	// a revealed commitment is accounted for by its scheduled cork
+	commitment.RevealedCorkId = cork.IDHash(testHeight + 1)
+	corkKeeper.SetCorkCommitment(ctx, commitment)
+	corkKeeper.SetScheduledCork(ctx, testHeight+1, sampleValAddr, cork)
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	corkKeeper.DecrementValidatorCorkCount(ctx, sampleValAddr)
+	_, broken = invariant(ctx)
+	require.True(broken)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/peggyjv/sommelier/v7/x/cork/migrations/v1"
+	v2 "github.com/peggyjv/sommelier/v7/x/cork/migrations/v2"
+	v3 "github.com/peggyjv/sommelier/v7/x/cork/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -23,3 +25,17 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v2.MigrateParamStore(ctx, m.keeper.paramSpace); err != nil {
+		return err
+	}
+
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -29,12 +30,36 @@ func (k Keeper) ScheduleCork(c context.Context, msg *types.MsgScheduleCorkReques
 		return nil, types.ErrUnmanagedCellarAddress
 	}
 
+	if k.IsCellarPaused(ctx, common.HexToAddress(msg.Cork.TargetContractAddress)) {
+		return nil, errorsmod.Wrapf(types.ErrCellarPaused, "%s", msg.Cork.TargetContractAddress)
+	}
+
+	if err := k.ValidateCorkSelector(ctx, *msg.Cork); err != nil {
+		return nil, err
+	}
+
+	if k.GetParamSet(ctx).RequiresCommitReveal(common.HexToAddress(msg.Cork.TargetContractAddress)) {
+		return nil, errorsmod.Wrapf(types.ErrCommitRevealRequired, "%s", msg.Cork.TargetContractAddress)
+	}
+
 	if msg.BlockHeight <= uint64(ctx.BlockHeight()) {
 		return nil, types.ErrSchedulingInThePast
 	}
 
+	// resubmitting an identical cork overwrites the existing entry, so it doesn't count against the quota
+	targetAddress := common.HexToAddress(msg.Cork.TargetContractAddress)
+	_, exists := k.GetScheduledCork(ctx, msg.BlockHeight, msg.Cork.IDHash(msg.BlockHeight), validatorAddr, targetAddress)
+	if !exists {
+		maxCorks := k.GetParamSet(ctx).MaxCorksPerValidator
+		if k.GetValidatorCorkCount(ctx, validatorAddr) >= maxCorks {
+			return nil, errorsmod.Wrapf(types.ErrValidatorCorkCapacityReached, "validator %s has reached the limit of %d scheduled corks", validatorAddr, maxCorks)
+		}
+	}
+
 	corkID := k.SetScheduledCork(ctx, msg.BlockHeight, validatorAddr, *msg.Cork)
-	k.IncrementValidatorCorkCount(ctx, validatorAddr)
+	if !exists {
+		k.IncrementValidatorCorkCount(ctx, validatorAddr)
+	}
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -54,3 +79,240 @@ func (k Keeper) ScheduleCork(c context.Context, msg *types.MsgScheduleCorkReques
 
 	return &types.MsgScheduleCorkResponse{Id: hex.EncodeToString(corkID)}, nil
 }
+
+// CancelScheduledCork implements types.MsgServer
+func (k Keeper) CancelScheduledCork(c context.Context, msg *types.MsgCancelScheduledCorkRequest) (*types.MsgCancelScheduledCorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer := msg.MustGetSigner()
+	validatorAddr := k.gravityKeeper.GetOrchestratorValidatorAddress(ctx, signer)
+	if validatorAddr == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not a delegate", signer.String())
+	}
+
+	id, err := msg.GetIDBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled []common.Address
+	k.IterateScheduledCorksByPrefix(ctx, types.GetScheduledCorkKeyByValidatorPrefix(msg.BlockHeight, id, validatorAddr), func(_ sdk.ValAddress, _ uint64, _ []byte, contract common.Address, _ types.Cork) (stop bool) {
+		cancelled = append(cancelled, contract)
+		return false
+	})
+
+	if len(cancelled) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrScheduledCorkNotFound, "id: %s, block height: %d, validator: %s", msg.Id, msg.BlockHeight, validatorAddr)
+	}
+
+	for _, contract := range cancelled {
+		k.DeleteScheduledCork(ctx, msg.BlockHeight, id, validatorAddr, contract)
+		k.DecrementValidatorCorkCount(ctx, validatorAddr)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeCancelScheduledCork,
+				sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCorkID, msg.Id),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", msg.BlockHeight)),
+			),
+		},
+	)
+
+	return &types.MsgCancelScheduledCorkResponse{}, nil
+}
+
+// SetCellarPaused implements types.MsgServer
+func (k Keeper) SetCellarPaused(c context.Context, msg *types.MsgSetCellarPausedRequest) (*types.MsgSetCellarPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer := msg.MustGetSigner()
+	guardian := k.GetParamSet(ctx).PauseGuardian
+	if guardian == "" || guardian != signer.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the pause guardian", signer.String())
+	}
+
+	cellarAddress := common.HexToAddress(msg.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", msg.CellarId)
+	}
+
+	k.SetCellarPauseState(ctx, cellarAddress, msg.Paused, signer.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgSetCellarPausedResponse{}, nil
+}
+
+// CommitCork implements types.MsgServer
+func (k Keeper) CommitCork(c context.Context, msg *types.MsgCommitCorkRequest) (*types.MsgCommitCorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer := msg.MustGetSigner()
+	validatorAddr := k.gravityKeeper.GetOrchestratorValidatorAddress(ctx, signer)
+	if validatorAddr == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not a delegate", signer.String())
+	}
+
+	cellarAddress := common.HexToAddress(msg.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return nil, types.ErrUnmanagedCellarAddress
+	}
+
+	if k.IsCellarPaused(ctx, cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrCellarPaused, "%s", msg.CellarId)
+	}
+
+	params := k.GetParamSet(ctx)
+	if !params.RequiresCommitReveal(cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrCommitRevealNotRequired, "%s", msg.CellarId)
+	}
+
+	currentHeight := uint64(ctx.BlockHeight())
+	if msg.BlockHeight <= currentHeight {
+		return nil, types.ErrSchedulingInThePast
+	}
+
+	// commitments can't be made once reveals for the block height may have started
+	if currentHeight+params.RevealWindowBlocks >= msg.BlockHeight {
+		return nil, errorsmod.Wrapf(types.ErrCommitPeriodClosed, "block height %d, reveal window opens at %d", msg.BlockHeight, msg.BlockHeight-params.RevealWindowBlocks)
+	}
+
+	commitment, err := msg.GetCommitmentBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	// recommitting overwrites the existing commitment, so it doesn't count against the quota
+	_, exists := k.GetCorkCommitment(ctx, msg.BlockHeight, validatorAddr, cellarAddress)
+	if !exists {
+		if k.GetValidatorCorkCount(ctx, validatorAddr) >= params.MaxCorksPerValidator {
+			return nil, errorsmod.Wrapf(types.ErrValidatorCorkCapacityReached, "validator %s has reached the limit of %d scheduled corks", validatorAddr, params.MaxCorksPerValidator)
+		}
+
+		k.IncrementValidatorCorkCount(ctx, validatorAddr)
+	}
+
+	k.SetCorkCommitment(ctx, types.CorkCommitment{
+		Validator:   validatorAddr.String(),
+		BlockHeight: msg.BlockHeight,
+		CellarId:    cellarAddress.String(),
+		Commitment:  commitment,
+	})
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeCorkCommit,
+				sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCellarID, cellarAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyCommitment, msg.Commitment),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", msg.BlockHeight)),
+			),
+		},
+	)
+
+	return &types.MsgCommitCorkResponse{}, nil
+}
+
+// RevealCork implements types.MsgServer
+func (k Keeper) RevealCork(c context.Context, msg *types.MsgRevealCorkRequest) (*types.MsgRevealCorkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signer := msg.MustGetSigner()
+	validatorAddr := k.gravityKeeper.GetOrchestratorValidatorAddress(ctx, signer)
+	if validatorAddr == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not a delegate", signer.String())
+	}
+
+	cellarAddress := common.HexToAddress(msg.Cork.TargetContractAddress)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return nil, types.ErrUnmanagedCellarAddress
+	}
+
+	if k.IsCellarPaused(ctx, cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrCellarPaused, "%s", msg.Cork.TargetContractAddress)
+	}
+
+	if err := k.ValidateCorkSelector(ctx, *msg.Cork); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParamSet(ctx)
+	if !params.RequiresCommitReveal(cellarAddress) {
+		return nil, errorsmod.Wrapf(types.ErrCommitRevealNotRequired, "%s", msg.Cork.TargetContractAddress)
+	}
+
+	currentHeight := uint64(ctx.BlockHeight())
+	if msg.BlockHeight <= currentHeight {
+		return nil, types.ErrSchedulingInThePast
+	}
+
+	if currentHeight+params.RevealWindowBlocks < msg.BlockHeight {
+		return nil, errorsmod.Wrapf(types.ErrOutsideRevealWindow, "block height %d, reveal window opens at %d", msg.BlockHeight, msg.BlockHeight-params.RevealWindowBlocks)
+	}
+
+	commitment, found := k.GetCorkCommitment(ctx, msg.BlockHeight, validatorAddr, cellarAddress)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentNotFound, "block height: %d, validator: %s, cellar: %s", msg.BlockHeight, validatorAddr, cellarAddress)
+	}
+
+	if commitment.Revealed() {
+		return nil, errorsmod.Wrapf(types.ErrCommitmentRevealed, "block height: %d, validator: %s, cellar: %s", msg.BlockHeight, validatorAddr, cellarAddress)
+	}
+
+	salt, err := msg.GetSaltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(msg.Cork.CommitmentHash(msg.BlockHeight, salt, validatorAddr), commitment.Commitment) {
+		return nil, types.ErrCommitmentMismatch
+	}
+
+	// the commitment already holds a slot in the validator's quota, which the revealed cork takes over
+	_, exists := k.GetScheduledCork(ctx, msg.BlockHeight, msg.Cork.IDHash(msg.BlockHeight), validatorAddr, cellarAddress)
+	if exists {
+		k.DecrementValidatorCorkCount(ctx, validatorAddr)
+	}
+
+	corkID := k.SetScheduledCork(ctx, msg.BlockHeight, validatorAddr, *msg.Cork)
+	commitment.RevealedCorkId = corkID
+	k.SetCorkCommitment(ctx, commitment)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeCorkReveal,
+				sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCork, msg.Cork.String()),
+				sdk.NewAttribute(types.AttributeKeyCorkID, hex.EncodeToString(corkID)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", msg.BlockHeight)),
+			),
+		},
+	)
+
+	return &types.MsgRevealCorkResponse{Id: hex.EncodeToString(corkID)}, nil
+}
@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+var (
+	sampleOrchAddr = sdk.AccAddress("orchestrator________")
+	sampleValAddr  = sdk.ValAddress("validator___________")
+)
+
+func (suite *KeeperTestSuite) TestScheduleCorkValidatorCapacity() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.MaxCorksPerValidator = 2
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	newMsg := func(call string, height uint64) *types.MsgScheduleCorkRequest {
+		return &types.MsgScheduleCorkRequest{
+			Cork: &types.Cork{
+				EncodedContractCall:   []byte(call),
+				TargetContractAddress: sampleCellarHex,
+			},
+			BlockHeight: height,
+			Signer:      sampleOrchAddr.String(),
+		}
+	}
+
+	_, err := corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call1", 10))
+	require.NoError(err)
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call2", 10))
+	require.NoError(err)
+	require.Equal(uint64(2), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	// resubmitting an already scheduled cork doesn't consume quota
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call2", 10))
+	require.NoError(err)
+	require.Equal(uint64(2), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call3", 10))
+	require.ErrorIs(err, types.ErrValidatorCorkCapacityReached)
+	require.Len(corkKeeper.GetScheduledCorks(ctx), 2)
+
+	countResult, err := corkKeeper.QueryValidatorCorkCount(sdk.WrapSDKContext(ctx), &types.QueryValidatorCorkCountRequest{Validator: sampleValAddr.String()})
+	require.NoError(err)
+	require.Equal(uint64(2), countResult.Count)
+	require.Zero(countResult.Remaining)
+
+	params.MaxCorksPerValidator = 5
+	corkKeeper.SetParams(ctx, params)
+	countResult, err = corkKeeper.QueryValidatorCorkCount(sdk.WrapSDKContext(ctx), &types.QueryValidatorCorkCountRequest{Validator: sampleValAddr.String()})
+	require.NoError(err)
+	require.Equal(uint64(3), countResult.Remaining)
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledCork() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	corkKeeper.SetParams(ctx, types.DefaultParams())
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	scheduleMsg := &types.MsgScheduleCorkRequest{
+		Cork: &types.Cork{
+			EncodedContractCall:   []byte("testcall"),
+			TargetContractAddress: sampleCellarHex,
+		},
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	}
+	scheduleResult, err := corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	// wrong height
+	_, err = corkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledCorkRequest{
+		Id:          scheduleResult.Id,
+		BlockHeight: 11,
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrScheduledCorkNotFound)
+
+	_, err = corkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledCorkRequest{
+		Id:          scheduleResult.Id,
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.NoError(err)
+	require.Empty(corkKeeper.GetScheduledCorks(ctx))
+	require.Zero(corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	events := ctx.EventManager().Events()
+	require.Equal(types.EventTypeCancelScheduledCork, events[len(events)-1].Type)
+
+	// already cancelled
+	_, err = corkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledCorkRequest{
+		Id:          scheduleResult.Id,
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrScheduledCorkNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledCorkNotDelegate() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(nil)
+
+	_, err := corkKeeper.CancelScheduledCork(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledCorkRequest{
+		Id:          hex.EncodeToString(make([]byte, 32)),
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestScheduleCorkSelectorAllowlist() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	corkKeeper.SetParams(ctx, types.DefaultParams())
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	newMsg := func(call string) *types.MsgScheduleCorkRequest {
+		return &types.MsgScheduleCorkRequest{
+			Cork: &types.Cork{
+				EncodedContractCall:   []byte(call),
+				TargetContractAddress: sampleCellarHex,
+			},
+			BlockHeight: 10,
+			Signer:      sampleOrchAddr.String(),
+		}
+	}
+
+	// any function may be called until an allowlist is set
+	_, err := corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap1"))
+	require.NoError(err)
+
+	// "call" is 0x63616c6c
+	proposal := types.NewSetCellarSelectorAllowlistProposal("title", "desc", sampleCellarHex, []string{"0x63616C6C"})
+	require.NoError(HandleSetCellarSelectorAllowlistProposal(ctx, corkKeeper, *proposal))
+
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("call1"))
+	require.NoError(err)
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap2"))
+	require.ErrorIs(err, types.ErrSelectorNotAllowed)
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("ca"))
+	require.ErrorIs(err, types.ErrInvalidSelector)
+
+	result, err := corkKeeper.QueryCellarSelectorAllowlist(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal([]string{"0x63616c6c"}, result.Selectors)
+
+	allResult, err := corkKeeper.QueryCellarSelectorAllowlists(sdk.WrapSDKContext(ctx), &types.QueryCellarSelectorAllowlistsRequest{})
+	require.NoError(err)
+	require.Len(allResult.Allowlists, 1)
+	require.Equal(sampleCellarAddr.String(), allResult.Allowlists[0].CellarId)
+
+	// an empty selector list removes the allowlist
+	proposal = types.NewSetCellarSelectorAllowlistProposal("title", "desc", sampleCellarHex, nil)
+	require.NoError(HandleSetCellarSelectorAllowlistProposal(ctx, corkKeeper, *proposal))
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), newMsg("swap2"))
+	require.NoError(err)
+	require.Empty(corkKeeper.GetCellarSelectorAllowlists(ctx))
+
+	// allowlists can only be set on managed cellars
+	proposal = types.NewSetCellarSelectorAllowlistProposal("title", "desc", "0x0000000000000000000000000000000000000001", []string{"0x63616c6c"})
+	require.ErrorIs(HandleSetCellarSelectorAllowlistProposal(ctx, corkKeeper, *proposal), types.ErrUnmanagedCellarAddress)
+}
+
+func (suite *KeeperTestSuite) TestSetCellarPaused() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	guardian := sdk.AccAddress("guardian____________")
+	params := types.DefaultParams()
+	params.PauseGuardian = guardian.String()
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(ctx, sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+
+	scheduleMsg := &types.MsgScheduleCorkRequest{
+		Cork: &types.Cork{
+			EncodedContractCall:   []byte("call1"),
+			TargetContractAddress: sampleCellarHex,
+		},
+		BlockHeight: 10,
+		Signer:      sampleOrchAddr.String(),
+	}
+
+	// only the pause guardian may pause a cellar
+	_, err := corkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), &types.MsgSetCellarPausedRequest{
+		CellarId: sampleCellarHex,
+		Paused:   true,
+		Signer:   sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = corkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), &types.MsgSetCellarPausedRequest{
+		CellarId: "0x0000000000000000000000000000000000000001",
+		Paused:   true,
+		Signer:   guardian.String(),
+	})
+	require.ErrorIs(err, types.ErrUnmanagedCellarAddress)
+
+	_, err = corkKeeper.SetCellarPaused(sdk.WrapSDKContext(ctx), &types.MsgSetCellarPausedRequest{
+		CellarId: sampleCellarHex,
+		Paused:   true,
+		Signer:   guardian.String(),
+	})
+	require.NoError(err)
+	require.True(corkKeeper.IsCellarPaused(ctx, sampleCellarAddr))
+
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.ErrorIs(err, types.ErrCellarPaused)
+
+	stateResult, err := corkKeeper.QueryCellarPauseState(sdk.WrapSDKContext(ctx), &types.QueryCellarPauseStateRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.True(stateResult.Paused)
+
+	pausedResult, err := corkKeeper.QueryPausedCellars(sdk.WrapSDKContext(ctx), &types.QueryPausedCellarsRequest{})
+	require.NoError(err)
+	require.Equal([]string{sampleCellarAddr.String()}, pausedResult.CellarIds)
+
+	// governance can resume a cellar without the guardian
+	proposal := types.NewSetCellarPausedProposal("title", "desc", sampleCellarHex, false)
+	require.NoError(HandleSetCellarPausedProposal(ctx, corkKeeper, *proposal))
+	require.False(corkKeeper.IsCellarPaused(ctx, sampleCellarAddr))
+	require.Empty(corkKeeper.GetPausedCellarIDs(ctx))
+
+	_, err = corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCommitRevealCork() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.CommitRevealCellarIds = []string{sampleCellarHex}
+	params.RevealWindowBlocks = 5
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+
+	otherOrchAddr := sdk.AccAddress("otherorchestrator___")
+	otherValAddr := sdk.ValAddress("othervalidator______")
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(gomock.Any(), sampleOrchAddr).Return(sampleValAddr).AnyTimes()
+	suite.gravityKeeper.EXPECT().GetOrchestratorValidatorAddress(gomock.Any(), otherOrchAddr).Return(otherValAddr).AnyTimes()
+
+	targetHeight := uint64(20)
+	cork := types.Cork{EncodedContractCall: []byte("call1"), TargetContractAddress: sampleCellarHex}
+	salt := []byte("some secret salt value")
+	commitment := cork.CommitmentHash(targetHeight, salt, sampleValAddr)
+
+	// plaintext votes are rejected for commit-reveal cellars
+	_, err := corkKeeper.ScheduleCork(sdk.WrapSDKContext(ctx), &types.MsgScheduleCorkRequest{
+		Cork:        &cork,
+		BlockHeight: targetHeight,
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrCommitRevealRequired)
+
+	_, err = corkKeeper.CommitCork(sdk.WrapSDKContext(ctx), &types.MsgCommitCorkRequest{
+		CellarId:    sampleCellarHex,
+		BlockHeight: targetHeight,
+		Commitment:  hex.EncodeToString(commitment),
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.NoError(err)
+
+	// a commitment that is never revealed
+	_, err = corkKeeper.CommitCork(sdk.WrapSDKContext(ctx), &types.MsgCommitCorkRequest{
+		CellarId:    sampleCellarHex,
+		BlockHeight: targetHeight,
+		Commitment:  hex.EncodeToString(cork.CommitmentHash(targetHeight, salt, otherValAddr)),
+		Signer:      otherOrchAddr.String(),
+	})
+	require.NoError(err)
+	require.Equal(uint64(1), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+	require.Equal(uint64(1), corkKeeper.GetValidatorCorkCount(ctx, otherValAddr))
+
+	revealMsg := &types.MsgRevealCorkRequest{
+		Cork:        &cork,
+		BlockHeight: targetHeight,
+		Salt:        hex.EncodeToString(salt),
+		Signer:      sampleOrchAddr.String(),
+	}
+	_, err = corkKeeper.RevealCork(sdk.WrapSDKContext(ctx), revealMsg)
+	require.ErrorIs(err, types.ErrOutsideRevealWindow)
+
+	revealCtx := ctx.WithBlockHeight(15)
+	_, err = corkKeeper.CommitCork(sdk.WrapSDKContext(revealCtx), &types.MsgCommitCorkRequest{
+		CellarId:    sampleCellarHex,
+		BlockHeight: targetHeight,
+		Commitment:  hex.EncodeToString(commitment),
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrCommitPeriodClosed)
+
+	_, err = corkKeeper.RevealCork(sdk.WrapSDKContext(revealCtx), &types.MsgRevealCorkRequest{
+		Cork:        &cork,
+		BlockHeight: targetHeight,
+		Salt:        hex.EncodeToString([]byte("the wrong secret salt")),
+		Signer:      sampleOrchAddr.String(),
+	})
+	require.ErrorIs(err, types.ErrCommitmentMismatch)
+
+	res, err := corkKeeper.RevealCork(sdk.WrapSDKContext(revealCtx), revealMsg)
+	require.NoError(err)
+	require.Equal(hex.EncodeToString(cork.IDHash(targetHeight)), res.Id)
+	require.Equal(uint64(1), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+
+	_, err = corkKeeper.RevealCork(sdk.WrapSDKContext(revealCtx), revealMsg)
+	require.ErrorIs(err, types.ErrCommitmentRevealed)
+
+	commitments, err := corkKeeper.QueryCorkCommitments(sdk.WrapSDKContext(ctx), &types.QueryCorkCommitmentsRequest{BlockHeight: targetHeight})
+	require.NoError(err)
+	require.Len(commitments.Commitments, 2)
+
+	// a plaintext vote that bypassed the commit-reveal process isn't counted
+	corkKeeper.SetScheduledCork(ctx, targetHeight, otherValAddr, cork)
+
+	tallyCtx := ctx.WithBlockHeight(int64(targetHeight))
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(140))
+	suite.stakingKeeper.EXPECT().Validator(gomock.Any(), sampleValAddr).Return(suite.validator)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(100))
+	suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.OneInt())
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any())
+
+	approved := corkKeeper.GetApprovedScheduledCorks(tallyCtx)
+	require.Len(approved, 1)
+	require.True(approved[0].Equals(cork))
+
+	require.Empty(corkKeeper.GetCorkCommitments(ctx))
+	require.Equal(uint64(0), corkKeeper.GetValidatorCorkCount(ctx, sampleValAddr))
+	require.Equal(uint64(0), corkKeeper.GetValidatorCorkCount(ctx, otherValAddr))
+}
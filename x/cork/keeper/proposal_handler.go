@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
-	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 	pubsubtypes "github.com/peggyjv/sommelier/v7/x/pubsub/types"
@@ -22,69 +24,190 @@ func HandleAddManagedCellarsProposal(ctx sdk.Context, k Keeper, p types.AddManag
 		return fmt.Errorf("not an approved publisher: %s", p.PublisherDomain)
 	}
 
-	cellarAddresses := k.GetCellarIDs(ctx)
+	metadata := make(map[common.Address]types.CellarMetadata, len(p.CellarMetadata))
+	for _, entry := range p.CellarMetadata {
+		metadata[common.HexToAddress(entry.CellarId)] = entry.Metadata
+	}
 
+	// cellars that are already managed keep their record, their metadata is updated with a SetCellarMetadataProposal
 	for _, proposedCellarID := range p.CellarIds.Ids {
 		proposedCellarAddress := common.HexToAddress(proposedCellarID)
-		found := false
-		for _, id := range cellarAddresses {
-			if id == proposedCellarAddress {
-				found = true
-			}
-		}
-		if !found {
-			cellarAddresses = append(cellarAddresses, proposedCellarAddress)
-			subscriptionID := NewEthereumSubscriptionID(proposedCellarAddress)
-			defaultSubscription := pubsubtypes.DefaultSubscription{
-				SubscriptionId:  subscriptionID,
-				PublisherDomain: p.PublisherDomain,
-			}
-			k.pubsubKeeper.SetDefaultSubscription(ctx, defaultSubscription)
+		if k.HasCellarID(ctx, proposedCellarAddress) {
+			continue
 		}
-	}
 
-	idStrings := make([]string, len(cellarAddresses))
-	for i, cid := range cellarAddresses {
-		idStrings[i] = cid.String()
+		k.SetCellar(ctx, types.ManagedCellar{
+			CellarId:        proposedCellarAddress.String(),
+			AddedHeight:     uint64(ctx.BlockHeight()),
+			PublisherDomain: p.PublisherDomain,
+			Metadata:        metadata[proposedCellarAddress],
+		})
+		k.setPendingCellarProposal(ctx, proposedCellarAddress)
+
+		subscriptionID := NewEthereumSubscriptionID(proposedCellarAddress)
+		defaultSubscription := pubsubtypes.DefaultSubscription{
+			SubscriptionId:  subscriptionID,
+			PublisherDomain: p.PublisherDomain,
+		}
+		k.pubsubKeeper.SetDefaultSubscription(ctx, defaultSubscription)
 	}
 
-	sort.Strings(idStrings)
-	k.SetCellarIDs(ctx, types.CellarIDSet{Ids: idStrings})
-
 	return nil
 }
 
 // HandleRemoveManagedCellarsProposal is a handler for executing a passed community cellar removal proposal
 func HandleRemoveManagedCellarsProposal(ctx sdk.Context, k Keeper, p types.RemoveManagedCellarIDsProposal) error {
-	var outputCellarIDs types.CellarIDSet
-
-	for _, existingID := range k.GetCellarIDs(ctx) {
-		found := false
-		for _, inputID := range p.CellarIds.Ids {
-			if existingID == common.HexToAddress(inputID) {
-				found = true
-			}
-		}
-
-		if !found {
-			outputCellarIDs.Ids = append(outputCellarIDs.Ids, existingID.String())
-		}
-	}
-	k.SetCellarIDs(ctx, outputCellarIDs)
-
 	for _, cellarToDelete := range p.CellarIds.Ids {
-		subscriptionID := NewEthereumSubscriptionID(common.HexToAddress(cellarToDelete))
+		cellarAddress := common.HexToAddress(cellarToDelete)
+		k.DeleteCellar(ctx, cellarAddress)
+		subscriptionID := NewEthereumSubscriptionID(cellarAddress)
 		k.pubsubKeeper.DeleteDefaultSubscription(ctx, subscriptionID)
+		k.DeleteCellarVoteThreshold(ctx, cellarAddress)
+		k.DeleteCellarSelectorAllowlist(ctx, cellarAddress)
+		k.DeleteCellarABI(ctx, cellarAddress)
+		k.deleteGovernanceScheduledCorksForCellar(ctx, cellarAddress)
+		if k.IsCellarPaused(ctx, cellarAddress) {
+			k.SetCellarPauseState(ctx, cellarAddress, false, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+		}
 	}
 
 	return nil
 }
 
 // HandleScheduledCorkProposal is a handler for executing a passed scheduled cork proposal
+// by scheduling a governance cork that is executed at the proposal's block height without a validator vote. The
+// contract call is encoded from the proposal's ScheduleRequest JSON unless raw calldata is supplied.
 func HandleScheduledCorkProposal(ctx sdk.Context, k Keeper, p types.ScheduledCorkProposal) error {
 	if !k.HasCellarID(ctx, common.HexToAddress(p.TargetContractAddress)) {
 		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.TargetContractAddress)
 	}
 
+	// proposals that can't be executed on chain remain a signal for Steward to act on off chain
+	if p.BlockHeight <= uint64(ctx.BlockHeight()) {
+		k.Logger(ctx).Info("scheduled cork proposal block height has passed, treating it as a signal",
+			"block height", p.BlockHeight,
+			"target contract address", p.TargetContractAddress)
+		return nil
+	}
+
+	encodedContractCall := p.EncodedContractCall
+	if len(encodedContractCall) == 0 {
+		var err error
+		encodedContractCall, err = k.EncodeContractCall(p.ContractCallProtoJson)
+		if err != nil {
+			return err
+		}
+	}
+
+	cork := types.Cork{
+		EncodedContractCall:   encodedContractCall,
+		TargetContractAddress: p.TargetContractAddress,
+	}
+	if err := cork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	corkID := k.SetGovernanceScheduledCork(ctx, p.BlockHeight, cork)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGovernanceCork,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCorkID, hex.EncodeToString(corkID)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", p.BlockHeight)),
+			sdk.NewAttribute(types.AttributeKeyTargetContract, p.TargetContractAddress),
+		),
+	)
+
+	return nil
+}
+
+// HandleSetCellarVoteThresholdProposal is a handler for executing a passed cellar vote threshold proposal
+func HandleSetCellarVoteThresholdProposal(ctx sdk.Context, k Keeper, p types.SetCellarVoteThresholdProposal) error {
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	k.SetCellarVoteThreshold(ctx, cellarAddress, p.VoteThreshold)
+
+	return nil
+}
+
+// HandleSetCellarSelectorAllowlistProposal is a handler for executing a passed cellar selector allowlist proposal
+func HandleSetCellarSelectorAllowlistProposal(ctx sdk.Context, k Keeper, p types.SetCellarSelectorAllowlistProposal) error {
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if len(p.Selectors) == 0 {
+		k.DeleteCellarSelectorAllowlist(ctx, cellarAddress)
+		return nil
+	}
+
+	selectors, err := types.NormalizeSelectors(p.Selectors)
+	if err != nil {
+		return err
+	}
+
+	k.SetCellarSelectorAllowlist(ctx, types.CellarSelectorAllowlist{
+		CellarId:  cellarAddress.String(),
+		Selectors: selectors,
+	})
+
+	return nil
+}
+
+// HandleSetCellarPausedProposal is a handler for executing a passed cellar pause proposal
+func HandleSetCellarPausedProposal(ctx sdk.Context, k Keeper, p types.SetCellarPausedProposal) error {
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	k.SetCellarPauseState(ctx, cellarAddress, p.Paused, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	return nil
+}
+
+// HandleSetCellarABIProposal is a handler for executing a passed cellar ABI proposal
+func HandleSetCellarABIProposal(ctx sdk.Context, k Keeper, p types.SetCellarABIProposal) error {
+	cellarAddress := common.HexToAddress(p.CellarId)
+	if !k.HasCellarID(ctx, cellarAddress) {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if p.Abi == "" {
+		k.DeleteCellarABI(ctx, cellarAddress)
+		return nil
+	}
+
+	if _, err := types.ParseABI(p.Abi); err != nil {
+		return err
+	}
+
+	k.SetCellarABI(ctx, types.CellarABI{
+		CellarId: cellarAddress.String(),
+		Abi:      p.Abi,
+	})
+
+	return nil
+}
+
+// HandleSetCellarMetadataProposal is a handler for executing a passed cellar metadata proposal
+func HandleSetCellarMetadataProposal(ctx sdk.Context, k Keeper, p types.SetCellarMetadataProposal) error {
+	cellar, found := k.GetCellar(ctx, common.HexToAddress(p.CellarId))
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnmanagedCellarAddress, "id: %s", p.CellarId)
+	}
+
+	if err := p.Metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cellar.Metadata = p.Metadata
+	k.SetCellar(ctx, cellar)
+
 	return nil
 }
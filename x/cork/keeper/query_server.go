@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,10 +36,31 @@ func (k Keeper) QueryCellarIDs(c context.Context, req *types.QueryCellarIDsReque
 	ctx := sdk.UnwrapSDKContext(c)
 
 	response := &types.QueryCellarIDsResponse{}
-	for _, id := range k.GetCellarIDs(ctx) {
-		response.CellarIds = append(response.CellarIds, id.String())
+	// callers that don't paginate receive every managed cellar, as before pagination was added
+	if isEmptyPageRequest(req.Pagination) {
+		for _, id := range k.GetCellarIDs(ctx) {
+			response.CellarIds = append(response.CellarIds, id.String())
+		}
+		response.Pagination.Total = uint64(len(response.CellarIds))
+
+		return response, nil
 	}
 
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCellarKeyPrefix())
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, value []byte) error {
+		var cellar types.ManagedCellar
+		if err := k.cdc.Unmarshal(value, &cellar); err != nil {
+			return err
+		}
+
+		response.CellarIds = append(response.CellarIds, cellar.CellarId)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return response, nil
 }
 
@@ -45,19 +69,68 @@ func (k Keeper) QueryScheduledCorks(c context.Context, req *types.QueryScheduled
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	var cellar common.Address
+	if req.TargetContractAddress != "" {
+		if !common.IsHexAddress(req.TargetContractAddress) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target contract address: %s", req.TargetContractAddress)
+		}
+		cellar = common.HexToAddress(req.TargetContractAddress)
+	}
+
+	var validator sdk.ValAddress
+	if req.Validator != "" {
+		var err error
+		if validator, err = sdk.ValAddressFromBech32(req.Validator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s: %s", req.Validator, err)
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	response := types.QueryScheduledCorksResponse{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledCorkKeyPrefix())
+	pageRes, err := query.FilteredPaginate(
+		store,
+		&req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			blockHeight, id, val, contract := parseScheduledCorkKey(key)
+			if req.MinBlockHeight != 0 && blockHeight < req.MinBlockHeight {
+				return false, nil
+			}
+			if req.MaxBlockHeight != 0 && blockHeight > req.MaxBlockHeight {
+				return false, nil
+			}
+			if req.TargetContractAddress != "" && contract != cellar {
+				return false, nil
+			}
+			if req.Validator != "" && !val.Equals(validator) {
+				return false, nil
+			}
 
-	k.IterateScheduledCorks(ctx, func(val sdk.ValAddress, blockHeight uint64, id []byte, cel common.Address, cork types.Cork) (stop bool) {
-		response.Corks = append(response.Corks, &types.ScheduledCork{
-			Cork:        &cork,
-			BlockHeight: blockHeight,
-			Validator:   val.String(),
-			Id:          id,
-		})
-		return false
-	})
+			if accumulate {
+				var cork types.Cork
+				if err := k.cdc.Unmarshal(value, &cork); err != nil {
+					return false, err
+				}
+
+				response.Corks = append(response.Corks, &types.ScheduledCork{
+					Cork:        &cork,
+					BlockHeight: blockHeight,
+					Validator:   val.String(),
+					Id:          id,
+				})
+				if decoded, found := k.decodeCork(ctx, req.DecodeCalls, id, cork); found {
+					response.DecodedCalls = append(response.DecodedCalls, decoded)
+				}
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -80,7 +153,32 @@ func (k Keeper) QueryScheduledCorksByBlockHeight(c context.Context, req *types.Q
 	ctx := sdk.UnwrapSDKContext(c)
 
 	response := types.QueryScheduledCorksByBlockHeightResponse{}
-	response.Corks = k.GetScheduledCorksByBlockHeight(ctx, req.BlockHeight)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledCorkKeyByBlockHeightPrefix(req.BlockHeight))
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, value []byte) error {
+		var cork types.Cork
+		if err := k.cdc.Unmarshal(value, &cork); err != nil {
+			return err
+		}
+
+		keyPair := bytes.NewBuffer(key)
+		id := keyPair.Next(32)
+		val, _ := parseValidatorAndContract(keyPair.Bytes())
+		response.Corks = append(response.Corks, &types.ScheduledCork{
+			Cork:        &cork,
+			BlockHeight: req.BlockHeight,
+			Validator:   val.String(),
+			Id:          id,
+		})
+		if decoded, found := k.decodeCork(ctx, req.DecodeCalls, id, cork); found {
+			response.DecodedCalls = append(response.DecodedCalls, decoded)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -96,7 +194,30 @@ func (k Keeper) QueryScheduledCorksByID(c context.Context, req *types.QuerySched
 	}
 
 	response := types.QueryScheduledCorksByIDResponse{}
-	response.Corks = k.GetScheduledCorksByID(ctx, id)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScheduledCorkIDIndexPrefix(id))
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, _ []byte) error {
+		blockHeight, val, contract := parseScheduledCorkIDIndexKey(key)
+		cork, found := k.GetScheduledCork(ctx, blockHeight, id, val, contract)
+		if !found {
+			return nil
+		}
+
+		response.Corks = append(response.Corks, &types.ScheduledCork{
+			Cork:        &cork,
+			BlockHeight: blockHeight,
+			Validator:   val.String(),
+			Id:          id,
+		})
+		if decoded, found := k.decodeCork(ctx, req.DecodeCalls, id, cork); found {
+			response.DecodedCalls = append(response.DecodedCalls, decoded)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
 
@@ -118,6 +239,9 @@ func (k Keeper) QueryCorkResult(c context.Context, req *types.QueryCorkResultReq
 		return &types.QueryCorkResultResponse{}, status.Errorf(codes.NotFound, "No cork result found for id: %s", req.GetId())
 	}
 	response.CorkResult = &result
+	if decoded, found := k.decodeCork(ctx, req.DecodeCall, id, *result.Cork); found {
+		response.DecodedCall = &decoded
+	}
 
 	return &response, nil
 }
@@ -127,9 +251,412 @@ func (k Keeper) QueryCorkResults(c context.Context, req *types.QueryCorkResultsR
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	var cellar common.Address
+	if req.TargetContractAddress != "" {
+		if !common.IsHexAddress(req.TargetContractAddress) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target contract address: %s", req.TargetContractAddress)
+		}
+		cellar = common.HexToAddress(req.TargetContractAddress)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	response := types.QueryCorkResultsResponse{}
-	response.CorkResults = k.GetCorkResults(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCorkResultPrefix())
+	pageRes, err := query.FilteredPaginate(
+		store,
+		&req.Pagination,
+		func(id []byte, value []byte, accumulate bool) (bool, error) {
+			var corkResult types.CorkResult
+			if err := k.cdc.Unmarshal(value, &corkResult); err != nil {
+				return false, err
+			}
+
+			if req.MinBlockHeight != 0 && corkResult.BlockHeight < req.MinBlockHeight {
+				return false, nil
+			}
+			if req.MaxBlockHeight != 0 && corkResult.BlockHeight > req.MaxBlockHeight {
+				return false, nil
+			}
+			if req.TargetContractAddress != "" && common.HexToAddress(corkResult.Cork.TargetContractAddress) != cellar {
+				return false, nil
+			}
+			if req.Approval == types.ApprovalFilter_APPROVAL_FILTER_APPROVED && !corkResult.Approved {
+				return false, nil
+			}
+			if req.Approval == types.ApprovalFilter_APPROVAL_FILTER_REJECTED && corkResult.Approved {
+				return false, nil
+			}
+
+			if accumulate {
+				response.CorkResults = append(response.CorkResults, &corkResult)
+				if decoded, found := k.decodeCork(ctx, req.DecodeCalls, id, *corkResult.Cork); found {
+					response.DecodedCalls = append(response.DecodedCalls, decoded)
+				}
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
 	return &response, nil
 }
+
+func (k Keeper) QueryCellarVoteThreshold(c context.Context, req *types.QueryCellarVoteThresholdRequest) (*types.QueryCellarVoteThresholdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	cellar := common.HexToAddress(req.CellarId)
+
+	if threshold, found := k.GetCellarVoteThreshold(ctx, cellar); found {
+		return &types.QueryCellarVoteThresholdResponse{
+			VoteThreshold: threshold,
+			IsOverride:    true,
+		}, nil
+	}
+
+	return &types.QueryCellarVoteThresholdResponse{
+		VoteThreshold: k.GetParamSet(ctx).VoteThreshold,
+	}, nil
+}
+
+func (k Keeper) QueryCellarSelectorAllowlist(c context.Context, req *types.QueryCellarSelectorAllowlistRequest) (*types.QueryCellarSelectorAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowlist, _ := k.GetCellarSelectorAllowlist(ctx, common.HexToAddress(req.CellarId))
+
+	return &types.QueryCellarSelectorAllowlistResponse{Selectors: allowlist.Selectors}, nil
+}
+
+func (k Keeper) QueryCellarSelectorAllowlists(c context.Context, req *types.QueryCellarSelectorAllowlistsRequest) (*types.QueryCellarSelectorAllowlistsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryCellarSelectorAllowlistsResponse{
+		Allowlists: k.GetCellarSelectorAllowlists(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (k Keeper) QueryCellarPauseState(c context.Context, req *types.QueryCellarPauseStateRequest) (*types.QueryCellarPauseStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCellarPauseStateResponse{
+		Paused: k.IsCellarPaused(ctx, common.HexToAddress(req.CellarId)),
+	}, nil
+}
+
+func (k Keeper) QueryPausedCellars(c context.Context, req *types.QueryPausedCellarsRequest) (*types.QueryPausedCellarsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryPausedCellarsResponse{
+		CellarIds: k.GetPausedCellarIDs(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (k Keeper) QueryCorkParticipation(c context.Context, req *types.QueryCorkParticipationRequest) (*types.QueryCorkParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	id, err := hex.DecodeString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to decode %s from hexadecimal to bytes", req.Id)
+	}
+
+	participation, found := k.GetCorkParticipation(sdk.UnwrapSDKContext(c), id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No cork participation found for id: %s", req.Id)
+	}
+
+	return &types.QueryCorkParticipationResponse{Participation: participation}, nil
+}
+
+func (k Keeper) QueryValidatorParticipation(c context.Context, req *types.QueryValidatorParticipationRequest) (*types.QueryValidatorParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s: %s", req.Validator, err)
+	}
+
+	participation, found := k.GetValidatorParticipation(sdk.UnwrapSDKContext(c), valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No participation found for validator: %s", req.Validator)
+	}
+
+	return &types.QueryValidatorParticipationResponse{
+		Participation: types.ValidatorParticipationRate{
+			Participation:     participation,
+			ParticipationRate: participation.ParticipationRate(),
+		},
+	}, nil
+}
+
+func (k Keeper) QueryValidatorParticipations(c context.Context, req *types.QueryValidatorParticipationsRequest) (*types.QueryValidatorParticipationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	response := types.QueryValidatorParticipationsResponse{Participations: []types.ValidatorParticipationRate{}}
+	k.IterateValidatorParticipations(sdk.UnwrapSDKContext(c), func(participation types.ValidatorParticipation) (stop bool) {
+		response.Participations = append(response.Participations, types.ValidatorParticipationRate{
+			Participation:     participation,
+			ParticipationRate: participation.ParticipationRate(),
+		})
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCorkCommitments(c context.Context, req *types.QueryCorkCommitmentsRequest) (*types.QueryCorkCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	commitments := []types.CorkCommitment{}
+	collect := func(commitment types.CorkCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	}
+
+	if req.BlockHeight != 0 {
+		k.IterateCorkCommitmentsByBlockHeight(ctx, req.BlockHeight, collect)
+	} else {
+		k.IterateCorkCommitments(ctx, collect)
+	}
+
+	return &types.QueryCorkCommitmentsResponse{Commitments: commitments}, nil
+}
+
+func (k Keeper) QueryValidatorCorkCount(c context.Context, req *types.QueryValidatorCorkCountRequest) (*types.QueryValidatorCorkCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s: %s", req.Validator, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	count := k.GetValidatorCorkCount(ctx, valAddr)
+	maxCorks := k.GetParamSet(ctx).MaxCorksPerValidator
+
+	response := types.QueryValidatorCorkCountResponse{Count: count}
+	if count < maxCorks {
+		response.Remaining = maxCorks - count
+	}
+
+	return &response, nil
+}
+
+func (k Keeper) QueryCorkExecutionStatus(c context.Context, req *types.QueryCorkExecutionStatusRequest) (*types.QueryCorkExecutionStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	cellar := common.HexToAddress(req.CellarId)
+	lastObservedHeight := k.gravityKeeper.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
+
+	response := types.QueryCorkExecutionStatusResponse{}
+	k.IterateCorkContractCalls(ctx, func(contractCall types.CorkContractCall) (stop bool) {
+		timedOut := contractCall.EthereumTimeoutHeight != 0 && lastObservedHeight > contractCall.EthereumTimeoutHeight
+		for _, corkID := range contractCall.CorkIds {
+			corkResult, found := k.GetCorkResult(ctx, corkID)
+			if !found || common.HexToAddress(corkResult.Cork.TargetContractAddress) != cellar {
+				continue
+			}
+
+			result := corkResult
+			if timedOut {
+				response.TimedOut = append(response.TimedOut, &result)
+			} else {
+				response.Pending = append(response.Pending, &result)
+			}
+		}
+
+		return false
+	})
+
+	k.IterateCorkResults(ctx, func(_ []byte, _ uint64, _ bool, _ string, corkResult types.CorkResult) (stop bool) {
+		if corkResult.Executed && common.HexToAddress(corkResult.Cork.TargetContractAddress) == cellar {
+			result := corkResult
+			response.Executed = append(response.Executed, &result)
+		}
+
+		return false
+	})
+
+	return &response, nil
+}
+
+func (k Keeper) QueryGovernanceScheduledCorks(c context.Context, req *types.QueryGovernanceScheduledCorksRequest) (*types.QueryGovernanceScheduledCorksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryGovernanceScheduledCorksResponse{
+		Corks: k.GetGovernanceScheduledCorks(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (k Keeper) QueryCellarABI(c context.Context, req *types.QueryCellarABIRequest) (*types.QueryCellarABIResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	cellarABI, _ := k.GetCellarABI(sdk.UnwrapSDKContext(c), common.HexToAddress(req.CellarId))
+
+	return &types.QueryCellarABIResponse{Abi: cellarABI.Abi}, nil
+}
+
+func (k Keeper) QueryCellarABIs(c context.Context, req *types.QueryCellarABIsRequest) (*types.QueryCellarABIsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryCellarABIsResponse{
+		Abis: k.GetCellarABIs(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (k Keeper) QueryManagedCellar(c context.Context, req *types.QueryManagedCellarRequest) (*types.QueryManagedCellarResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.CellarId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cellar ID: %s", req.CellarId)
+	}
+
+	cellar, found := k.GetCellar(sdk.UnwrapSDKContext(c), common.HexToAddress(req.CellarId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cellar %s is not managed", req.CellarId)
+	}
+
+	return &types.QueryManagedCellarResponse{Cellar: cellar}, nil
+}
+
+func (k Keeper) QueryManagedCellars(c context.Context, req *types.QueryManagedCellarsRequest) (*types.QueryManagedCellarsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	response := &types.QueryManagedCellarsResponse{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCellarKeyPrefix())
+	pageRes, err := query.Paginate(store, &req.Pagination, func(key []byte, value []byte) error {
+		var cellar types.ManagedCellar
+		if err := k.cdc.Unmarshal(value, &cellar); err != nil {
+			return err
+		}
+
+		response.Cellars = append(response.Cellars, cellar)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = *pageRes
+	return response, nil
+}
+
+func (k Keeper) QueryCorkTally(c context.Context, req *types.QueryCorkTallyRequest) (*types.QueryCorkTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if (req.BlockHeight == 0) == (req.Id == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of block height and cork ID must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return nil, status.Error(codes.FailedPrecondition, "no bonded validator power")
+	}
+
+	response := types.QueryCorkTallyResponse{
+		Tallies:    []types.CorkTally{},
+		TotalPower: totalPower.Uint64(),
+	}
+
+	if req.BlockHeight != 0 {
+		response.Tallies = k.GetCorkTallies(ctx, req.BlockHeight, totalPower)
+		return &response, nil
+	}
+
+	id, err := hex.DecodeString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to decode %s from hexadecimal to bytes", req.Id)
+	}
+
+	// a cork ID commits to its block height, so every scheduled copy of the cork shares one height
+	scheduled := k.GetScheduledCorksByID(ctx, id)
+	if len(scheduled) == 0 {
+		return nil, status.Errorf(codes.NotFound, "No scheduled cork found for id: %s", req.Id)
+	}
+
+	for _, tally := range k.GetCorkTallies(ctx, scheduled[0].BlockHeight, totalPower) {
+		if bytes.Equal(tally.Id, id) {
+			response.Tallies = append(response.Tallies, tally)
+		}
+	}
+
+	return &response, nil
+}
+
+// decodeCork decodes a queried cork's contract call when the request asks for it
+func (k Keeper) decodeCork(ctx sdk.Context, decode bool, id []byte, cork types.Cork) (types.DecodedContractCall, bool) {
+	if !decode {
+		return types.DecodedContractCall{}, false
+	}
+
+	return k.DecodeCork(ctx, id, cork)
+}
+
+// isEmptyPageRequest returns true if the request carries no pagination options
+func isEmptyPageRequest(pageReq query.PageRequest) bool {
+	return pageReq.Key == nil && pageReq.Offset == 0 && pageReq.Limit == 0 && !pageReq.CountTotal && !pageReq.Reverse
+}
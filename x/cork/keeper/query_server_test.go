@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"encoding/hex"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
 	"github.com/peggyjv/sommelier/v7/x/cork/types"
+	pubsubtypes "github.com/peggyjv/sommelier/v7/x/pubsub/types"
 )
 
 func (suite *KeeperTestSuite) TestQueriesHappyPath() {
@@ -37,7 +42,8 @@ func (suite *KeeperTestSuite) TestQueriesHappyPath() {
 	}
 	corkKeeper.SetCorkResult(ctx, id, corkResult)
 
-	corkKeeper.SetCellarIDs(ctx, types.CellarIDSet{Ids: []string{"0x0000000000000000000000000000000000000000", "0x1111111111111111111111111111111111111111"}})
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: "0x0000000000000000000000000000000000000000"})
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: "0x1111111111111111111111111111111111111111"})
 
 	paramsResult, err := corkKeeper.QueryParams(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
 	require.Nil(err)
@@ -66,6 +72,17 @@ func (suite *KeeperTestSuite) TestQueriesHappyPath() {
 	corkResultsResult, err := corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{})
 	require.Nil(err)
 	require.Equal(&corkResult, corkResultsResult.CorkResults[0])
+
+	voteThresholdResult, err := corkKeeper.QueryCellarVoteThreshold(sdk.WrapSDKContext(ctx), &types.QueryCellarVoteThresholdRequest{CellarId: sampleCellarHex})
+	require.Nil(err)
+	require.Equal(params.VoteThreshold, voteThresholdResult.VoteThreshold)
+	require.False(voteThresholdResult.IsOverride)
+
+	corkKeeper.SetCellarVoteThreshold(ctx, sampleCellarAddr, sdk.MustNewDecFromStr("0.9"))
+	voteThresholdResult, err = corkKeeper.QueryCellarVoteThreshold(sdk.WrapSDKContext(ctx), &types.QueryCellarVoteThresholdRequest{CellarId: sampleCellarHex})
+	require.Nil(err)
+	require.Equal(sdk.MustNewDecFromStr("0.9"), voteThresholdResult.VoteThreshold)
+	require.True(voteThresholdResult.IsOverride)
 }
 
 func (suite *KeeperTestSuite) TestQueriesUnhappyPath() {
@@ -99,4 +116,318 @@ func (suite *KeeperTestSuite) TestQueriesUnhappyPath() {
 	corkResultsResult, err := corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), nil)
 	require.Nil(corkResultsResult)
 	require.NotNil(err)
+
+	voteThresholdResult, err := corkKeeper.QueryCellarVoteThreshold(sdk.WrapSDKContext(ctx), nil)
+	require.Nil(voteThresholdResult)
+	require.NotNil(err)
+
+	voteThresholdResult, err = corkKeeper.QueryCellarVoteThreshold(sdk.WrapSDKContext(ctx), &types.QueryCellarVoteThresholdRequest{CellarId: "0x01"})
+	require.Nil(voteThresholdResult)
+	require.NotNil(err)
+}
+
+func (suite *KeeperTestSuite) TestQueriesPaginationAndFilters() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	otherCellarHex := "0x1111111111111111111111111111111111111111"
+	otherValAddr := sdk.ValAddress("othervalidator______")
+	schedule := []struct {
+		height uint64
+		val    sdk.ValAddress
+		target string
+		call   string
+	}{
+		{10, sampleValAddr, sampleCellarHex, "call1"},
+		{10, otherValAddr, sampleCellarHex, "call1"},
+		{11, sampleValAddr, otherCellarHex, "call2"},
+		{12, otherValAddr, sampleCellarHex, "call3"},
+	}
+	for _, s := range schedule {
+		corkKeeper.SetScheduledCork(ctx, s.height, s.val, types.Cork{EncodedContractCall: []byte(s.call), TargetContractAddress: s.target})
+	}
+
+	scheduledCorksResult, err := corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		Pagination: query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 3)
+	require.Equal(uint64(4), scheduledCorksResult.Pagination.Total)
+	require.NotEmpty(scheduledCorksResult.Pagination.NextKey)
+
+	scheduledCorksResult, err = corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		Pagination: query.PageRequest{Key: scheduledCorksResult.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 1)
+
+	scheduledCorksResult, err = corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		TargetContractAddress: sampleCellarHex,
+		Validator:             otherValAddr.String(),
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 2)
+
+	scheduledCorksResult, err = corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{
+		MinBlockHeight: 11,
+		MaxBlockHeight: 11,
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 1)
+	require.Equal(otherCellarHex, scheduledCorksResult.Corks[0].Cork.TargetContractAddress)
+
+	_, err = corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{Validator: "bad"})
+	require.Error(err)
+
+	call1 := types.Cork{EncodedContractCall: []byte("call1"), TargetContractAddress: sampleCellarHex}
+	id := call1.IDHash(10)
+	scheduledCorksByIDResult, err := corkKeeper.QueryScheduledCorksByID(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksByIDRequest{
+		Id:         hex.EncodeToString(id),
+		Pagination: query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(scheduledCorksByIDResult.Corks, 1)
+	require.Equal(uint64(2), scheduledCorksByIDResult.Pagination.Total)
+
+	scheduledCorksByHeightResult, err := corkKeeper.QueryScheduledCorksByBlockHeight(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksByBlockHeightRequest{BlockHeight: 10})
+	require.NoError(err)
+	require.Len(scheduledCorksByHeightResult.Corks, 2)
+
+	// the ID index is cleaned up when scheduled corks are deleted
+	corkKeeper.DeleteScheduledCork(ctx, 10, id, sampleValAddr, sampleCellarAddr)
+	require.Len(corkKeeper.GetScheduledCorksByID(ctx, id), 1)
+
+	for i, approved := range []bool{true, false, true} {
+		cork := types.Cork{EncodedContractCall: []byte("resultcall"), TargetContractAddress: sampleCellarHex}
+		height := uint64(20 + i)
+		corkKeeper.SetCorkResult(ctx, cork.IDHash(height), types.CorkResult{
+			Cork:               &cork,
+			BlockHeight:        height,
+			Approved:           approved,
+			ApprovalPercentage: "50.00",
+		})
+	}
+
+	corkResultsResult, err := corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{
+		Approval: types.ApprovalFilter_APPROVAL_FILTER_APPROVED,
+	})
+	require.NoError(err)
+	require.Len(corkResultsResult.CorkResults, 2)
+
+	corkResultsResult, err = corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{
+		Approval:       types.ApprovalFilter_APPROVAL_FILTER_REJECTED,
+		MinBlockHeight: 21,
+	})
+	require.NoError(err)
+	require.Len(corkResultsResult.CorkResults, 1)
+	require.Equal(uint64(21), corkResultsResult.CorkResults[0].BlockHeight)
+
+	corkResultsResult, err = corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{
+		TargetContractAddress: otherCellarHex,
+	})
+	require.NoError(err)
+	require.Empty(corkResultsResult.CorkResults)
+}
+
+func (suite *KeeperTestSuite) TestQueriesDecodeCalls() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	cellarABI := `[{"type":"function","name":"setRebalanceDeviation","inputs":[{"name":"newDeviation","type":"uint256"}],"outputs":[]}]`
+	contractABI, err := types.ParseABI(cellarABI)
+	require.NoError(err)
+	call, err := contractABI.Pack("setRebalanceDeviation", big.NewInt(5))
+	require.NoError(err)
+
+	corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: sampleCellarHex})
+	proposal := types.NewSetCellarABIProposal("title", "desc", sampleCellarHex, cellarABI)
+	require.NoError(HandleSetCellarABIProposal(ctx, corkKeeper, *proposal))
+
+	abiResult, err := corkKeeper.QueryCellarABI(sdk.WrapSDKContext(ctx), &types.QueryCellarABIRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(cellarABI, abiResult.Abi)
+
+	abisResult, err := corkKeeper.QueryCellarABIs(sdk.WrapSDKContext(ctx), &types.QueryCellarABIsRequest{})
+	require.NoError(err)
+	require.Len(abisResult.Abis, 1)
+
+	testHeight := uint64(ctx.BlockHeight())
+	cork := types.Cork{EncodedContractCall: call, TargetContractAddress: sampleCellarHex}
+	undecodable := types.Cork{EncodedContractCall: []byte("testcall"), TargetContractAddress: sampleCellarHex}
+	id := corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+	corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, undecodable)
+	corkKeeper.SetCorkResult(ctx, id, types.CorkResult{Cork: &cork, BlockHeight: testHeight, Approved: true, ApprovalPercentage: "100.00"})
+
+	expected := types.DecodedContractCall{
+		CorkId:    id,
+		Method:    "setRebalanceDeviation",
+		Signature: "setRebalanceDeviation(uint256)",
+		Arguments: `{"newDeviation":"5"}`,
+	}
+
+	scheduledCorksResult, err := corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 2)
+	require.Empty(scheduledCorksResult.DecodedCalls)
+
+	scheduledCorksResult, err = corkKeeper.QueryScheduledCorks(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksRequest{DecodeCalls: true})
+	require.NoError(err)
+	require.Len(scheduledCorksResult.Corks, 2)
+	require.Equal([]types.DecodedContractCall{expected}, scheduledCorksResult.DecodedCalls)
+
+	scheduledCorksByHeightResult, err := corkKeeper.QueryScheduledCorksByBlockHeight(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksByBlockHeightRequest{BlockHeight: testHeight, DecodeCalls: true})
+	require.NoError(err)
+	require.Equal([]types.DecodedContractCall{expected}, scheduledCorksByHeightResult.DecodedCalls)
+
+	scheduledCorksByIDResult, err := corkKeeper.QueryScheduledCorksByID(sdk.WrapSDKContext(ctx), &types.QueryScheduledCorksByIDRequest{Id: hex.EncodeToString(id), DecodeCalls: true})
+	require.NoError(err)
+	require.Equal([]types.DecodedContractCall{expected}, scheduledCorksByIDResult.DecodedCalls)
+
+	corkResultResult, err := corkKeeper.QueryCorkResult(sdk.WrapSDKContext(ctx), &types.QueryCorkResultRequest{Id: hex.EncodeToString(id), DecodeCall: true})
+	require.NoError(err)
+	require.Equal(&expected, corkResultResult.DecodedCall)
+
+	corkResultsResult, err := corkKeeper.QueryCorkResults(sdk.WrapSDKContext(ctx), &types.QueryCorkResultsRequest{DecodeCalls: true})
+	require.NoError(err)
+	require.Equal([]types.DecodedContractCall{expected}, corkResultsResult.DecodedCalls)
+
+	// an empty ABI removes the cellar's ABI, after which its corks are no longer decoded
+	proposal = types.NewSetCellarABIProposal("title", "desc", sampleCellarHex, "")
+	require.NoError(HandleSetCellarABIProposal(ctx, corkKeeper, *proposal))
+
+	abiResult, err = corkKeeper.QueryCellarABI(sdk.WrapSDKContext(ctx), &types.QueryCellarABIRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Empty(abiResult.Abi)
+
+	corkResultResult, err = corkKeeper.QueryCorkResult(sdk.WrapSDKContext(ctx), &types.QueryCorkResultRequest{Id: hex.EncodeToString(id), DecodeCall: true})
+	require.NoError(err)
+	require.Nil(corkResultResult.DecodedCall)
+
+	proposal = types.NewSetCellarABIProposal("title", "desc", "0x0000000000000000000000000000000000000001", cellarABI)
+	require.ErrorIs(HandleSetCellarABIProposal(ctx, corkKeeper, *proposal), types.ErrUnmanagedCellarAddress)
+}
+
+func (suite *KeeperTestSuite) TestQueryCorkTally() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	corkKeeper.SetParams(ctx, params)
+	corkKeeper.SetCellarVoteThreshold(ctx, sampleCellarAddr, sdk.MustNewDecFromStr("0.5"))
+
+	testHeight := uint64(ctx.BlockHeight() + 10)
+	cork := types.Cork{EncodedContractCall: []byte("testcall"), TargetContractAddress: sampleCellarHex}
+	otherVal := sdk.ValAddress("other_validator_addr")
+	id := corkKeeper.SetScheduledCork(ctx, testHeight, sampleValAddr, cork)
+	corkKeeper.SetScheduledCork(ctx, testHeight, otherVal, cork)
+
+	suite.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100)).Times(2)
+	suite.stakingKeeper.EXPECT().Validator(ctx, gomock.Any()).Return(suite.validator).Times(4)
+	suite.validator.EXPECT().GetConsensusPower(gomock.Any()).Return(int64(30)).Times(4)
+	suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.OneInt()).Times(4)
+
+	expected := types.CorkTally{
+		Cork:               cork,
+		Id:                 id,
+		BlockHeight:        testHeight,
+		Power:              60,
+		ApprovalPercentage: "60.000000000000000000",
+		VoteThreshold:      "0.500000000000000000",
+		Approved:           true,
+	}
+	voters := []string{sampleValAddr.String(), otherVal.String()}
+
+	tallyResult, err := corkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{BlockHeight: testHeight})
+	require.NoError(err)
+	require.Equal(uint64(100), tallyResult.TotalPower)
+	require.Len(tallyResult.Tallies, 1)
+	require.ElementsMatch(voters, tallyResult.Tallies[0].Voters)
+	tallyResult.Tallies[0].Voters = nil
+	require.Equal(expected, tallyResult.Tallies[0])
+
+	tallyResult, err = corkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{Id: hex.EncodeToString(id)})
+	require.NoError(err)
+	require.Len(tallyResult.Tallies, 1)
+	require.Equal(id, tallyResult.Tallies[0].Id)
+
+	// previewing the tally doesn't consume the scheduled corks
+	require.Len(corkKeeper.GetScheduledCorksByBlockHeight(ctx, testHeight), 2)
+
+	_, err = corkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{})
+	require.Error(err)
+
+	_, err = corkKeeper.QueryCorkTally(sdk.WrapSDKContext(ctx), &types.QueryCorkTallyRequest{BlockHeight: testHeight, Id: hex.EncodeToString(id)})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryManagedCellarMetadata() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	ctx = ctx.WithBlockHeight(7)
+	metadata := types.CellarMetadata{
+		Name:            "Dollary-doos LP",
+		Version:         "v2.5",
+		AssetDenom:      "usdc",
+		StrategySpecUrl: "https://example.com/strategies/dollary-doos-lp",
+	}
+
+	suite.pubsubKeeper.EXPECT().GetPublisher(ctx, "example.com").Return(pubsubtypes.Publisher{}, true)
+	suite.pubsubKeeper.EXPECT().SetDefaultSubscription(ctx, gomock.Any()).Times(2)
+	addProposal := types.NewAddManagedCellarIDsProposal(
+		"title",
+		"desc",
+		&types.CellarIDSet{Ids: []string{sampleCellarHex, "0x0000000000000000000000000000000000000001"}},
+		"example.com",
+		[]types.CellarMetadataEntry{{CellarId: sampleCellarHex, Metadata: metadata}},
+	)
+	require.NoError(HandleAddManagedCellarsProposal(ctx, corkKeeper, *addProposal))
+
+	cellarResult, err := corkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(sampleCellarAddr.String(), cellarResult.Cellar.CellarId)
+	require.Equal(uint64(7), cellarResult.Cellar.AddedHeight)
+	require.Equal("example.com", cellarResult.Cellar.PublisherDomain)
+	require.Equal(metadata, cellarResult.Cellar.Metadata)
+
+	cellarsResult, err := corkKeeper.QueryManagedCellars(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarsRequest{})
+	require.NoError(err)
+	require.Len(cellarsResult.Cellars, 2)
+	require.Equal(types.CellarMetadata{}, cellarsResult.Cellars[0].Metadata)
+
+	updated := types.CellarMetadata{Name: "Dollary-doos LP v3", Version: "v3"}
+	setProposal := types.NewSetCellarMetadataProposal("title", "desc", sampleCellarHex, updated)
+	require.NoError(HandleSetCellarMetadataProposal(ctx, corkKeeper, *setProposal))
+
+	cellarResult, err = corkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{CellarId: sampleCellarHex})
+	require.NoError(err)
+	require.Equal(updated, cellarResult.Cellar.Metadata)
+	require.Equal(uint64(7), cellarResult.Cellar.AddedHeight)
+
+	setProposal = types.NewSetCellarMetadataProposal("title", "desc", "0x0000000000000000000000000000000000000002", updated)
+	require.ErrorIs(HandleSetCellarMetadataProposal(ctx, corkKeeper, *setProposal), types.ErrUnmanagedCellarAddress)
+
+	_, err = corkKeeper.QueryManagedCellar(sdk.WrapSDKContext(ctx), &types.QueryManagedCellarRequest{CellarId: "0x0000000000000000000000000000000000000002"})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryCellarIDsWithoutPagination() {
+	ctx, corkKeeper := suite.ctx, suite.corkKeeper
+	require := suite.Require()
+
+	cellarCount := int(query.DefaultLimit) + 50
+	for i := 1; i <= cellarCount; i++ {
+		corkKeeper.SetCellar(ctx, types.ManagedCellar{CellarId: common.BigToAddress(big.NewInt(int64(i))).Hex()})
+	}
+
+	// legacy callers that don't paginate receive every cellar
+	result, err := corkKeeper.QueryCellarIDs(sdk.WrapSDKContext(ctx), &types.QueryCellarIDsRequest{})
+	require.NoError(err)
+	require.Len(result.CellarIds, cellarCount)
+	require.Equal(uint64(cellarCount), result.Pagination.Total)
+
+	result, err = corkKeeper.QueryCellarIDs(sdk.WrapSDKContext(ctx), &types.QueryCellarIDsRequest{Pagination: query.PageRequest{Limit: 10}})
+	require.NoError(err)
+	require.Len(result.CellarIds, 10)
+	require.NotNil(result.Pagination.NextKey)
 }
@@ -25,10 +25,11 @@ import (
 )
 
 type mocksForCork struct {
-	mockStakingKeeper *mock.MockStakingKeeper
-	mockGravityKeeper *mock.MockGravityKeeper
-	mockPubsubKeeper  *mock.MockPubsubKeeper
-	mockValidator     *mock.MockValidatorI
+	mockStakingKeeper  *mock.MockStakingKeeper
+	mockSlashingKeeper *mock.MockSlashingKeeper
+	mockGravityKeeper  *mock.MockGravityKeeper
+	mockPubsubKeeper   *mock.MockPubsubKeeper
+	mockValidator      *mock.MockValidatorI
 }
 
 func setupCorkKeeper(t *testing.T) (
@@ -59,6 +60,7 @@ func setupCorkKeeper(t *testing.T) (
 
 	ctrl := gomock.NewController(t)
 	mockStakingKeeper := mock.NewMockStakingKeeper(ctrl)
+	mockSlashingKeeper := mock.NewMockSlashingKeeper(ctrl)
 	mockGravityKeeper := mock.NewMockGravityKeeper(ctrl)
 	mockPubsubKeeper := mock.NewMockPubsubKeeper(ctrl)
 
@@ -67,6 +69,7 @@ func setupCorkKeeper(t *testing.T) (
 		storeKey,
 		subSpace,
 		mockStakingKeeper,
+		mockSlashingKeeper,
 		mockGravityKeeper,
 		mockPubsubKeeper,
 	)
@@ -74,10 +77,11 @@ func setupCorkKeeper(t *testing.T) (
 	ctx := sdk.NewContext(commitMultiStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	return k, ctx, mocksForCork{
-		mockStakingKeeper: mockStakingKeeper,
-		mockGravityKeeper: mockGravityKeeper,
-		mockPubsubKeeper:  mockPubsubKeeper,
-		mockValidator:     mock.NewMockValidatorI(ctrl),
+		mockStakingKeeper:  mockStakingKeeper,
+		mockSlashingKeeper: mockSlashingKeeper,
+		mockGravityKeeper:  mockGravityKeeper,
+		mockPubsubKeeper:   mockPubsubKeeper,
+		mockValidator:      mock.NewMockValidatorI(ctrl),
 	}, ctrl
 }
 
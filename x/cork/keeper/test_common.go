@@ -192,7 +192,7 @@ var (
 
 	// TestingcorkParams is a set of gravity params for testing
 	TestingcorkParams = types.Params{
-		VoteThreshold: sdk.MustNewDecFromStr(corkVoteThresholdStr),
+		VoteThreshold: types.DefaultParams().VoteThreshold,
 	}
 )
 
@@ -390,6 +390,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		keycork,
 		getSubspace(paramsKeeper, types.DefaultParamspace),
 		stakingKeeper,
+		slashingKeeper,
 		gravityKeeper,
 		pubsubKeeper,
 	)
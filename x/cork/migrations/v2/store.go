@@ -0,0 +1,116 @@
+package v2
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+// MigrateStore builds the scheduled cork ID index and the cork result block height index for corks scheduled and
+// results recorded before consensus version 3
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Cork v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetScheduledCorkKeyPrefix())
+	defer iter.Close()
+
+	var indexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keyPair := bytes.NewBuffer(iter.Key())
+		keyPair.Next(1) // trim prefix byte
+		blockHeight := sdk.BigEndianToUint64(keyPair.Next(8))
+		id := keyPair.Next(32)
+		rest := keyPair.Bytes()
+		val := sdk.ValAddress(rest[:len(rest)-common.AddressLength])
+		contract := common.BytesToAddress(rest[len(rest)-common.AddressLength:])
+
+		indexKeys = append(indexKeys, types.GetScheduledCorkIDIndexKey(blockHeight, id, val, contract))
+	}
+
+	resultIter := sdk.KVStorePrefixIterator(store, types.GetCorkResultPrefix())
+	defer resultIter.Close()
+
+	for ; resultIter.Valid(); resultIter.Next() {
+		var corkResult types.CorkResult
+		if err := cdc.Unmarshal(resultIter.Value(), &corkResult); err != nil {
+			return err
+		}
+
+		id := resultIter.Key()[1:]
+		indexKeys = append(indexKeys, types.GetCorkResultHeightIndexKey(corkResult.BlockHeight, id))
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+
+	ctx.Logger().Info("Cork v2 to v3: Store migration complete")
+
+	return nil
+}
+
+// MigrateParamStore initializes params introduced in cork consensus version 3 to their defaults
+func MigrateParamStore(ctx sdk.Context, subspace paramstypes.Subspace) error {
+	ctx.Logger().Info("Cork v2 to v3: Beginning params migration")
+
+	defaults := types.DefaultParams()
+
+	// Don't want to overwrite values if they were set in an upgrade handler
+	if !subspace.Has(ctx, types.KeyBatchTargetAddress) {
+		subspace.Set(ctx, types.KeyBatchTargetAddress, defaults.BatchTargetAddress)
+	}
+
+	if !subspace.Has(ctx, types.KeyMaxBatchSize) {
+		subspace.Set(ctx, types.KeyMaxBatchSize, defaults.MaxBatchSize)
+	}
+
+	if !subspace.Has(ctx, types.KeyCorkResultRetentionBlocks) {
+		subspace.Set(ctx, types.KeyCorkResultRetentionBlocks, defaults.CorkResultRetentionBlocks)
+	}
+
+	if !subspace.Has(ctx, types.KeyArchivePrunedCorkResults) {
+		subspace.Set(ctx, types.KeyArchivePrunedCorkResults, defaults.ArchivePrunedCorkResults)
+	}
+
+	if !subspace.Has(ctx, types.KeyPauseGuardian) {
+		subspace.Set(ctx, types.KeyPauseGuardian, defaults.PauseGuardian)
+	}
+
+	if !subspace.Has(ctx, types.KeyCommitRevealEnabled) {
+		subspace.Set(ctx, types.KeyCommitRevealEnabled, defaults.CommitRevealEnabled)
+	}
+
+	if !subspace.Has(ctx, types.KeyCommitRevealCellarIDs) {
+		subspace.Set(ctx, types.KeyCommitRevealCellarIDs, defaults.CommitRevealCellarIds)
+	}
+
+	if !subspace.Has(ctx, types.KeyRevealWindowBlocks) {
+		subspace.Set(ctx, types.KeyRevealWindowBlocks, defaults.RevealWindowBlocks)
+	}
+
+	if !subspace.Has(ctx, types.KeyParticipationWindow) {
+		subspace.Set(ctx, types.KeyParticipationWindow, defaults.ParticipationWindow)
+	}
+
+	if !subspace.Has(ctx, types.KeyMinParticipationRate) {
+		subspace.Set(ctx, types.KeyMinParticipationRate, defaults.MinParticipationRate)
+	}
+
+	if !subspace.Has(ctx, types.KeyParticipationJailingEnabled) {
+		subspace.Set(ctx, types.KeyParticipationJailingEnabled, defaults.ParticipationJailingEnabled)
+	}
+
+	if !subspace.Has(ctx, types.KeyParticipationSlashFraction) {
+		subspace.Set(ctx, types.KeyParticipationSlashFraction, defaults.ParticipationSlashFraction)
+	}
+
+	ctx.Logger().Info("Cork v2 to v3: Params migration complete")
+
+	return nil
+}
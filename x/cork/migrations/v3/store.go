@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+// MigrateStore moves the managed cellar ID set into per-cellar records introduced in consensus version 4
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Cork v3 to v4: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	legacyKey := []byte{types.CellarIDsKey}
+
+	bz := store.Get(legacyKey)
+	if bz == nil {
+		ctx.Logger().Info("Cork v3 to v4: No cellar IDs to migrate")
+		return nil
+	}
+
+	var cellarIDs types.CellarIDSet
+	if err := cdc.Unmarshal(bz, &cellarIDs); err != nil {
+		return err
+	}
+
+	for _, id := range cellarIDs.Ids {
+		address := common.HexToAddress(id)
+		cellar := types.ManagedCellar{CellarId: address.String()}
+		store.Set(types.GetCellarKey(address), cdc.MustMarshal(&cellar))
+	}
+
+	store.Delete(legacyKey)
+
+	ctx.Logger().Info("Cork v3 to v4: Store migration complete")
+
+	return nil
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -186,6 +187,93 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// DowntimeJailDuration mocks base method.
+func (m *MockSlashingKeeper) DowntimeJailDuration(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DowntimeJailDuration", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DowntimeJailDuration indicates an expected call of DowntimeJailDuration.
+func (mr *MockSlashingKeeperMockRecorder) DowntimeJailDuration(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DowntimeJailDuration), ctx)
+}
+
+// HasValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) HasValidatorSigningInfo(ctx types.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasValidatorSigningInfo indicates an expected call of HasValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) HasValidatorSigningInfo(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).HasValidatorSigningInfo), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx types.Context, consAddr types.ConsAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Jail", ctx, consAddr)
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx types.Context, consAddr types.ConsAddress, jailTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// Slash mocks base method.
+func (m *MockSlashingKeeper) Slash(ctx types.Context, consAddr types.ConsAddress, fraction types.Dec, power, distributionHeight int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Slash", ctx, consAddr, fraction, power, distributionHeight)
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockSlashingKeeperMockRecorder) Slash(ctx, consAddr, fraction, power, distributionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), ctx, consAddr, fraction, power, distributionHeight)
+}
+
 // MockGravityKeeper is a mock of GravityKeeper interface.
 type MockGravityKeeper struct {
 	ctrl     *gomock.Controller
@@ -237,6 +325,20 @@ func (mr *MockGravityKeeperMockRecorder) GetEthereumOrchestratorAddress(ctx, eth
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthereumOrchestratorAddress", reflect.TypeOf((*MockGravityKeeper)(nil).GetEthereumOrchestratorAddress), ctx, ethAddr)
 }
 
+// GetLastObservedEthereumBlockHeight mocks base method.
+func (m *MockGravityKeeper) GetLastObservedEthereumBlockHeight(ctx types.Context) types1.LatestEthereumBlockHeight {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastObservedEthereumBlockHeight", ctx)
+	ret0, _ := ret[0].(types1.LatestEthereumBlockHeight)
+	return ret0
+}
+
+// GetLastObservedEthereumBlockHeight indicates an expected call of GetLastObservedEthereumBlockHeight.
+func (mr *MockGravityKeeperMockRecorder) GetLastObservedEthereumBlockHeight(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastObservedEthereumBlockHeight", reflect.TypeOf((*MockGravityKeeper)(nil).GetLastObservedEthereumBlockHeight), ctx)
+}
+
 // GetOrchestratorValidatorAddress mocks base method.
 func (m *MockGravityKeeper) GetOrchestratorValidatorAddress(ctx types.Context, orchAddr types.AccAddress) types.ValAddress {
 	m.ctrl.T.Helper()
@@ -0,0 +1,94 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+// Simulation parameter constants
+const (
+	VoteThreshold             = "vote_threshold"
+	MaxCorksPerValidator      = "max_corks_per_validator"
+	CorkResultRetentionBlocks = "cork_result_retention_blocks"
+	CellarIDs                 = "cellar_ids"
+)
+
+// GenVoteThreshold randomized VoteThreshold
+func GenVoteThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 100)), 2)
+}
+
+// GenMaxCorksPerValidator randomized MaxCorksPerValidator
+func GenMaxCorksPerValidator(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenCorkResultRetentionBlocks randomized CorkResultRetentionBlocks
+func GenCorkResultRetentionBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200))
+}
+
+// GenCellarIDs randomized set of managed cellar addresses
+func GenCellarIDs(r *rand.Rand) []string {
+	ids := make([]string, simtypes.RandIntBetween(r, 1, 5))
+	for i := range ids {
+		ids[i] = RandomEVMAddress(r).Hex()
+	}
+
+	return ids
+}
+
+// RandomEVMAddress returns a random EVM address
+func RandomEVMAddress(r *rand.Rand) common.Address {
+	bz := make([]byte, common.AddressLength)
+	r.Read(bz)
+
+	return common.BytesToAddress(bz)
+}
+
+// RandomizedGenState generates a random GenesisState for cork
+func RandomizedGenState(simState *module.SimulationState) {
+	var voteThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VoteThreshold, &voteThreshold, simState.Rand,
+		func(r *rand.Rand) { voteThreshold = GenVoteThreshold(r) },
+	)
+
+	var maxCorksPerValidator uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCorksPerValidator, &maxCorksPerValidator, simState.Rand,
+		func(r *rand.Rand) { maxCorksPerValidator = GenMaxCorksPerValidator(r) },
+	)
+
+	var corkResultRetentionBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CorkResultRetentionBlocks, &corkResultRetentionBlocks, simState.Rand,
+		func(r *rand.Rand) { corkResultRetentionBlocks = GenCorkResultRetentionBlocks(r) },
+	)
+
+	var cellarIDs []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CellarIDs, &cellarIDs, simState.Rand,
+		func(r *rand.Rand) { cellarIDs = GenCellarIDs(r) },
+	)
+
+	corkGenesis := types.DefaultGenesisState()
+	corkGenesis.Params.VoteThreshold = voteThreshold
+	corkGenesis.Params.MaxCorksPerValidator = maxCorksPerValidator
+	corkGenesis.Params.CorkResultRetentionBlocks = corkResultRetentionBlocks
+	corkGenesis.CellarIds = types.CellarIDSet{Ids: cellarIDs}
+
+	bz, err := json.MarshalIndent(&corkGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated cork parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&corkGenesis)
+}
@@ -0,0 +1,212 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+	"github.com/peggyjv/sommelier/v7/x/cork/keeper"
+	"github.com/peggyjv/sommelier/v7/x/cork/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgScheduleCork = "op_weight_msg_schedule_cork" //nolint:gosec
+
+	DefaultWeightMsgScheduleCork = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, gk types.GravityKeeper, sk types.StakingKeeper) simulation.WeightedOperations {
+	var weightMsgScheduleCork int
+	appParams.GetOrGenerate(cdc, OpWeightMsgScheduleCork, &weightMsgScheduleCork, nil,
+		func(_ *rand.Rand) {
+			weightMsgScheduleCork = DefaultWeightMsgScheduleCork
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgScheduleCork,
+			SimulateMsgScheduleCork(ak, bk, k, gk, sk),
+		),
+	}
+}
+
+// SimulateMsgScheduleCork generates a MsgScheduleCorkRequest from a random validator for a random managed cellar,
+// and schedules the same cork vote from the other bonded validators in the next block so that the cork can be
+// approved. Validators without delegate keys register them first.
+func SimulateMsgScheduleCork(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, gk types.GravityKeeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validators := sk.GetBondedValidatorsByPower(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "no bonded validators"), nil, nil
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "validator account not found"), nil, nil
+		}
+
+		if gk.GetOrchestratorValidatorAddress(ctx, simAccount.Address) == nil {
+			return DeliverMsgDelegateKeys(r, app, ctx, ak, bk, simAccount)
+		}
+
+		cellars := k.GetCellarIDs(ctx)
+		if len(cellars) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "no managed cellars"), nil, nil
+		}
+
+		cork := types.Cork{
+			EncodedContractCall:   RandomContractCall(r),
+			TargetContractAddress: cellars[r.Intn(len(cellars))].Hex(),
+		}
+		// leave room for the other validators' votes in the next block
+		blockHeight := uint64(ctx.BlockHeight()) + uint64(simtypes.RandIntBetween(r, 2, 10))
+
+		opMsg, _, err := simulateMsgScheduleCorkVote(ak, bk, k, gk, validator.GetOperator(), cork, blockHeight)(r, app, ctx, accs, chainID)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		var futureOps []simtypes.FutureOperation
+		for _, otherValidator := range validators {
+			if otherValidator.GetOperator().Equals(validator.GetOperator()) || r.Intn(4) == 0 {
+				continue
+			}
+
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          simulateMsgScheduleCorkVote(ak, bk, k, gk, otherValidator.GetOperator(), cork, blockHeight),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+func simulateMsgScheduleCorkVote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, gk types.GravityKeeper,
+	validator sdk.ValAddress, cork types.Cork, blockHeight uint64) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "validator account not found"), nil, nil
+		}
+
+		if !validator.Equals(gk.GetOrchestratorValidatorAddress(ctx, simAccount.Address)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "validator has no delegate keys"), nil, nil
+		}
+
+		if blockHeight <= uint64(ctx.BlockHeight()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "block height has passed"), nil, nil
+		}
+
+		cellar := common.HexToAddress(cork.TargetContractAddress)
+		if !k.HasCellarID(ctx, cellar) || k.IsCellarPaused(ctx, cellar) || k.GetParamSet(ctx).RequiresCommitReveal(cellar) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "cellar does not accept scheduled corks"), nil, nil
+		}
+
+		if err := k.ValidateCorkSelector(ctx, cork); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "selector not allowed"), nil, nil
+		}
+
+		if _, exists := k.GetScheduledCork(ctx, blockHeight, cork.IDHash(blockHeight), validator, cellar); !exists &&
+			k.GetValidatorCorkCount(ctx, validator) >= k.GetParamSet(ctx).MaxCorksPerValidator {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "validator cork capacity reached"), nil, nil
+		}
+
+		msg, err := types.NewMsgScheduleCorkRequest(cork.EncodedContractCall, cellar, blockHeight, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgScheduleCorkRequest, "unable to build msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// DeliverMsgDelegateKeys delivers a gravity MsgDelegateKeys that makes the validator operator's own account its
+// orchestrator, with an ethereum key derived from the same private key. Validators need delegate keys before they
+// can schedule corks.
+func DeliverMsgDelegateKeys(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(&gravitytypes.MsgDelegateKeys{})
+
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(gravitytypes.ModuleName, msgType, "account not found"), nil, nil
+	}
+
+	ethPrivKey, err := crypto.ToECDSA(simAccount.PrivKey.Bytes())
+	if err != nil {
+		return simtypes.NoOpMsg(gravitytypes.ModuleName, msgType, "unable to derive ethereum key"), nil, err
+	}
+
+	validator := sdk.ValAddress(simAccount.Address)
+	signMsg := gravitytypes.DelegateKeysSignMsg{
+		ValidatorAddress: validator.String(),
+		Nonce:            account.GetSequence(),
+	}
+	signMsgBz, err := signMsg.Marshal()
+	if err != nil {
+		return simtypes.NoOpMsg(gravitytypes.ModuleName, msgType, "unable to marshal delegate keys sign msg"), nil, err
+	}
+	signature, err := gravitytypes.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), ethPrivKey)
+	if err != nil {
+		return simtypes.NoOpMsg(gravitytypes.ModuleName, msgType, "unable to sign delegate keys"), nil, err
+	}
+
+	msg := gravitytypes.NewMsgDelegateKeys(validator, simAccount.Address, crypto.PubkeyToAddress(ethPrivKey.PublicKey).Hex(), signature)
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      gravitytypes.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// RandomContractCall returns a random contract call made up of a function selector and up to four ABI words
+func RandomContractCall(r *rand.Rand) []byte {
+	bz := make([]byte, 4+32*r.Intn(5))
+	r.Read(bz)
+
+	return bz
+}
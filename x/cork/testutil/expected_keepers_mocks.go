@@ -6,17 +6,93 @@ package mock_types
 
 import (
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
-	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
-	types1 "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
-	types2 "github.com/peggyjv/sommelier/v7/x/pubsub/types"
+	types2 "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
+	types3 "github.com/peggyjv/sommelier/v7/x/pubsub/types"
 	bytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
+// MockAccountKeeper is a mock of AccountKeeper interface.
+type MockAccountKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountKeeperMockRecorder
+}
+
+// MockAccountKeeperMockRecorder is the mock recorder for MockAccountKeeper.
+type MockAccountKeeperMockRecorder struct {
+	mock *MockAccountKeeper
+}
+
+// NewMockAccountKeeper creates a new mock instance.
+func NewMockAccountKeeper(ctrl *gomock.Controller) *MockAccountKeeper {
+	mock := &MockAccountKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAccount mocks base method.
+func (m *MockAccountKeeper) GetAccount(ctx types.Context, addr types.AccAddress) types0.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
+	ret0, _ := ret[0].(types0.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(ctx types.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -41,10 +117,10 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 }
 
 // GetBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx types.Context) []types0.Validator {
+func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx types.Context) []types1.Validator {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
-	ret0, _ := ret[0].([]types0.Validator)
+	ret0, _ := ret[0].([]types1.Validator)
 	return ret0
 }
 
@@ -83,7 +159,7 @@ func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator int
 }
 
 // IterateBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 types.Context, arg1 func(int64, types0.ValidatorI) bool) {
+func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 types.Context, arg1 func(int64, types1.ValidatorI) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateBondedValidatorsByPower", arg0, arg1)
 }
@@ -95,7 +171,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(arg0, ar
 }
 
 // IterateLastValidators mocks base method.
-func (m *MockStakingKeeper) IterateLastValidators(arg0 types.Context, arg1 func(int64, types0.ValidatorI) bool) {
+func (m *MockStakingKeeper) IterateLastValidators(arg0 types.Context, arg1 func(int64, types1.ValidatorI) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateLastValidators", arg0, arg1)
 }
@@ -107,7 +183,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateLastValidators(arg0, arg1 interf
 }
 
 // IterateValidators mocks base method.
-func (m *MockStakingKeeper) IterateValidators(arg0 types.Context, arg1 func(int64, types0.ValidatorI) bool) {
+func (m *MockStakingKeeper) IterateValidators(arg0 types.Context, arg1 func(int64, types1.ValidatorI) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateValidators", arg0, arg1)
 }
@@ -159,10 +235,10 @@ func (mr *MockStakingKeeperMockRecorder) Slash(arg0, arg1, arg2, arg3, arg4 inte
 }
 
 // Validator mocks base method.
-func (m *MockStakingKeeper) Validator(arg0 types.Context, arg1 types.ValAddress) types0.ValidatorI {
+func (m *MockStakingKeeper) Validator(arg0 types.Context, arg1 types.ValAddress) types1.ValidatorI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
-	ret0, _ := ret[0].(types0.ValidatorI)
+	ret0, _ := ret[0].(types1.ValidatorI)
 	return ret0
 }
 
@@ -173,10 +249,10 @@ func (mr *MockStakingKeeperMockRecorder) Validator(arg0, arg1 interface{}) *gomo
 }
 
 // ValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) ValidatorByConsAddr(arg0 types.Context, arg1 types.ConsAddress) types0.ValidatorI {
+func (m *MockStakingKeeper) ValidatorByConsAddr(arg0 types.Context, arg1 types.ConsAddress) types1.ValidatorI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorByConsAddr", arg0, arg1)
-	ret0, _ := ret[0].(types0.ValidatorI)
+	ret0, _ := ret[0].(types1.ValidatorI)
 	return ret0
 }
 
@@ -186,6 +262,93 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// DowntimeJailDuration mocks base method.
+func (m *MockSlashingKeeper) DowntimeJailDuration(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DowntimeJailDuration", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DowntimeJailDuration indicates an expected call of DowntimeJailDuration.
+func (mr *MockSlashingKeeperMockRecorder) DowntimeJailDuration(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DowntimeJailDuration), ctx)
+}
+
+// HasValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) HasValidatorSigningInfo(ctx types.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasValidatorSigningInfo indicates an expected call of HasValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) HasValidatorSigningInfo(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).HasValidatorSigningInfo), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx types.Context, consAddr types.ConsAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Jail", ctx, consAddr)
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx types.Context, consAddr types.ConsAddress, jailTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// Slash mocks base method.
+func (m *MockSlashingKeeper) Slash(ctx types.Context, consAddr types.ConsAddress, fraction types.Dec, power, distributionHeight int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Slash", ctx, consAddr, fraction, power, distributionHeight)
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockSlashingKeeperMockRecorder) Slash(ctx, consAddr, fraction, power, distributionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), ctx, consAddr, fraction, power, distributionHeight)
+}
+
 // MockGravityKeeper is a mock of GravityKeeper interface.
 type MockGravityKeeper struct {
 	ctrl     *gomock.Controller
@@ -210,10 +373,10 @@ func (m *MockGravityKeeper) EXPECT() *MockGravityKeeperMockRecorder {
 }
 
 // CreateContractCallTx mocks base method.
-func (m *MockGravityKeeper) CreateContractCallTx(ctx types.Context, invalidationNonce uint64, invalidationScope bytes.HexBytes, address common.Address, payload []byte, tokens, fees []types1.ERC20Token) *types1.ContractCallTx {
+func (m *MockGravityKeeper) CreateContractCallTx(ctx types.Context, invalidationNonce uint64, invalidationScope bytes.HexBytes, address common.Address, payload []byte, tokens, fees []types2.ERC20Token) *types2.ContractCallTx {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateContractCallTx", ctx, invalidationNonce, invalidationScope, address, payload, tokens, fees)
-	ret0, _ := ret[0].(*types1.ContractCallTx)
+	ret0, _ := ret[0].(*types2.ContractCallTx)
 	return ret0
 }
 
@@ -237,6 +400,20 @@ func (mr *MockGravityKeeperMockRecorder) GetEthereumOrchestratorAddress(ctx, eth
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthereumOrchestratorAddress", reflect.TypeOf((*MockGravityKeeper)(nil).GetEthereumOrchestratorAddress), ctx, ethAddr)
 }
 
+// GetLastObservedEthereumBlockHeight mocks base method.
+func (m *MockGravityKeeper) GetLastObservedEthereumBlockHeight(ctx types.Context) types2.LatestEthereumBlockHeight {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastObservedEthereumBlockHeight", ctx)
+	ret0, _ := ret[0].(types2.LatestEthereumBlockHeight)
+	return ret0
+}
+
+// GetLastObservedEthereumBlockHeight indicates an expected call of GetLastObservedEthereumBlockHeight.
+func (mr *MockGravityKeeperMockRecorder) GetLastObservedEthereumBlockHeight(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastObservedEthereumBlockHeight", reflect.TypeOf((*MockGravityKeeper)(nil).GetLastObservedEthereumBlockHeight), ctx)
+}
+
 // GetOrchestratorValidatorAddress mocks base method.
 func (m *MockGravityKeeper) GetOrchestratorValidatorAddress(ctx types.Context, orchAddr types.AccAddress) types.ValAddress {
 	m.ctrl.T.Helper()
@@ -278,7 +455,7 @@ func (mr *MockGravityKeeperMockRecorder) SetOrchestratorValidatorAddress(ctx, va
 }
 
 // SetOutgoingTx mocks base method.
-func (m *MockGravityKeeper) SetOutgoingTx(ctx types.Context, outgoing types1.OutgoingTx) {
+func (m *MockGravityKeeper) SetOutgoingTx(ctx types.Context, outgoing types2.OutgoingTx) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetOutgoingTx", ctx, outgoing)
 }
@@ -325,10 +502,10 @@ func (mr *MockPubsubKeeperMockRecorder) DeleteDefaultSubscription(ctx, subscript
 }
 
 // GetPublisher mocks base method.
-func (m *MockPubsubKeeper) GetPublisher(ctx types.Context, publisherDomain string) (types2.Publisher, bool) {
+func (m *MockPubsubKeeper) GetPublisher(ctx types.Context, publisherDomain string) (types3.Publisher, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPublisher", ctx, publisherDomain)
-	ret0, _ := ret[0].(types2.Publisher)
+	ret0, _ := ret[0].(types3.Publisher)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -340,7 +517,7 @@ func (mr *MockPubsubKeeperMockRecorder) GetPublisher(ctx, publisherDomain interf
 }
 
 // SetDefaultSubscription mocks base method.
-func (m *MockPubsubKeeper) SetDefaultSubscription(ctx types.Context, defaultSubscription types2.DefaultSubscription) {
+func (m *MockPubsubKeeper) SetDefaultSubscription(ctx types.Context, defaultSubscription types3.DefaultSubscription) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetDefaultSubscription", ctx, defaultSubscription)
 }
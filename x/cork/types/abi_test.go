@@ -0,0 +1,67 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testCellarABI = `[
+	{"type":"function","name":"setRebalanceDeviation","inputs":[{"name":"newDeviation","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"addPosition","inputs":[{"name":"index","type":"uint32"},{"name":"adaptor","type":"address"},{"name":"","type":"bytes"},{"name":"inDebt","type":"bool"}],"outputs":[]}
+]`
+
+func TestParseABI(t *testing.T) {
+	_, err := ParseABI(testCellarABI)
+	require.NoError(t, err)
+
+	_, err = ParseABI("not an abi")
+	require.ErrorIs(t, err, ErrInvalidABI)
+
+	_, err = ParseABI(`[{"type":"event","name":"Paused","inputs":[],"anonymous":false}]`)
+	require.ErrorIs(t, err, ErrInvalidABI)
+}
+
+func TestDecodeContractCall(t *testing.T) {
+	cellarABI := CellarABI{
+		CellarId: "0x0000000000000000000000000000000000000001",
+		Abi:      testCellarABI,
+	}
+	require.NoError(t, cellarABI.ValidateBasic())
+
+	contractABI, err := ParseABI(testCellarABI)
+	require.NoError(t, err)
+
+	corkID := []byte("id")
+	adaptor := common.HexToAddress("0x123801a7D398351b8bE11C439e05C5B3259aeC9B")
+	call, err := contractABI.Pack("addPosition", uint32(7), adaptor, []byte{0xde, 0xad}, true)
+	require.NoError(t, err)
+
+	decoded, err := cellarABI.DecodeContractCall(corkID, call)
+	require.NoError(t, err)
+	require.Equal(t, DecodedContractCall{
+		CorkId:    corkID,
+		Method:    "addPosition",
+		Signature: "addPosition(uint32,address,bytes,bool)",
+		Arguments: `{"adaptor":"` + adaptor.Hex() + `","arg2":"0xdead","inDebt":true,"index":7}`,
+	}, decoded)
+
+	deviation, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	call, err = contractABI.Pack("setRebalanceDeviation", deviation)
+	require.NoError(t, err)
+
+	decoded, err = cellarABI.DecodeContractCall(corkID, call)
+	require.NoError(t, err)
+	require.Equal(t, `{"newDeviation":"1000000000000000000000"}`, decoded.Arguments)
+
+	_, err = cellarABI.DecodeContractCall(corkID, []byte{0x01, 0x02})
+	require.ErrorIs(t, err, ErrInvalidSelector)
+
+	_, err = cellarABI.DecodeContractCall(corkID, []byte{0x01, 0x02, 0x03, 0x04})
+	require.ErrorIs(t, err, ErrInvalidSelector)
+
+	_, err = cellarABI.DecodeContractCall(corkID, call[:20])
+	require.ErrorIs(t, err, ErrInvalidABI)
+}
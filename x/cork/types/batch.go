@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	bytesArr, _ = abi.NewType("bytes[]", "", nil)
+
+	// MulticallSelector is the function selector of multicall(bytes[])
+	MulticallSelector = crypto.Keccak256([]byte("multicall(bytes[])"))[:4]
+)
+
+// EncodeMulticall ABI encodes a multicall(bytes[]) call that executes each of the provided contract calls
+func EncodeMulticall(calls [][]byte) ([]byte, error) {
+	args, err := abi.Arguments{{Type: bytesArr}}.Pack(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, MulticallSelector...), args...), nil
+}
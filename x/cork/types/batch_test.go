@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeMulticall(t *testing.T) {
+	calls := [][]byte{[]byte("call1"), []byte("call2")}
+
+	payload, err := EncodeMulticall(calls)
+	require.NoError(t, err)
+	require.Equal(t, MulticallSelector, payload[:4])
+
+	decoded, err := abi.Arguments{{Type: bytesArr}}.Unpack(payload[4:])
+	require.NoError(t, err)
+	require.Equal(t, calls, decoded[0])
+}
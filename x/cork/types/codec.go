@@ -13,6 +13,10 @@ import (
 // provided LegacyAmino codec. These types are used for Amino JSON serialization
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgScheduleCorkRequest{}, "cork/MsgScheduleCorkRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledCorkRequest{}, "cork/MsgCancelScheduledCorkRequest", nil)
+	cdc.RegisterConcrete(&MsgSetCellarPausedRequest{}, "cork/MsgSetCellarPausedRequest", nil)
+	cdc.RegisterConcrete(&MsgCommitCorkRequest{}, "cork/MsgCommitCorkRequest", nil)
+	cdc.RegisterConcrete(&MsgRevealCorkRequest{}, "cork/MsgRevealCorkRequest", nil)
 }
 
 var (
@@ -36,12 +40,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgScheduleCorkRequest{},
+		&MsgCancelScheduledCorkRequest{},
+		&MsgSetCellarPausedRequest{},
+		&MsgCommitCorkRequest{},
+		&MsgRevealCorkRequest{},
 	)
 
 	registry.RegisterImplementations((*govtypesv1beta1.Content)(nil),
 		&AddManagedCellarIDsProposal{},
 		&RemoveManagedCellarIDsProposal{},
 		&ScheduledCorkProposal{},
+		&SetCellarVoteThresholdProposal{},
+		&SetCellarSelectorAllowlistProposal{},
+		&SetCellarPausedProposal{},
+		&SetCellarABIProposal{},
+		&SetCellarMetadataProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
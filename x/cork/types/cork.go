@@ -2,7 +2,10 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +15,12 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
+// FunctionSelectorLength is the number of leading calldata bytes that identify the contract function being called
+const FunctionSelectorLength = 4
+
+// MaxCellarMetadataFieldLength is the maximum length of each cellar metadata field
+const MaxCellarMetadataFieldLength = 256
+
 func (c *Cork) InvalidationScope() tmbytes.HexBytes {
 	addr := common.HexToAddress(c.TargetContractAddress)
 	return crypto.Keccak256Hash(
@@ -33,6 +42,15 @@ func (c *Cork) IDHash(blockHeight uint64) []byte {
 		)).Bytes()
 }
 
+// CommitmentHash returns the hash a validator commits to before revealing its vote for the cork at a block height
+func (c *Cork) CommitmentHash(blockHeight uint64, salt []byte, val sdk.ValAddress) []byte {
+	return crypto.Keccak256Hash(
+		bytes.Join(
+			[][]byte{c.IDHash(blockHeight), salt, val.Bytes()},
+			[]byte{},
+		)).Bytes()
+}
+
 func (c *Cork) Equals(other Cork) bool {
 	firstAddr := common.HexToAddress(c.TargetContractAddress)
 	secondAddr := common.HexToAddress(other.TargetContractAddress)
@@ -73,13 +91,33 @@ func (s *ScheduledCork) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
-	if len(s.Id) != 64 {
+	if len(s.Id) != 32 {
 		return fmt.Errorf("invalid ID length, must be a keccak256 hash")
 	}
 
 	return nil
 }
 
+func (g *GovernanceScheduledCork) ValidateBasic() error {
+	if g.Cork == nil {
+		return ErrEmptyContractCall
+	}
+
+	if err := g.Cork.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if g.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if !bytes.Equal(g.Id, g.Cork.IDHash(g.BlockHeight)) {
+		return errorsmod.Wrap(ErrInvalidCorkID, "ID does not match the cork and block height")
+	}
+
+	return nil
+}
+
 func (c *CorkResult) ValidateBasic() error {
 	if err := c.Cork.ValidateBasic(); err != nil {
 		return err
@@ -89,10 +127,21 @@ func (c *CorkResult) ValidateBasic() error {
 		return fmt.Errorf("block height must be non-zero")
 	}
 
+	// governance scheduled corks are never voted on
+	if c.Governance {
+		return nil
+	}
+
 	if _, err := sdk.NewDecFromStr(c.ApprovalPercentage); err != nil {
 		return fmt.Errorf("approval percentage must be a valid Dec")
 	}
 
+	if c.VoteThreshold != "" {
+		if _, err := sdk.NewDecFromStr(c.VoteThreshold); err != nil {
+			return fmt.Errorf("vote threshold must be a valid Dec")
+		}
+	}
+
 	return nil
 }
 
@@ -105,3 +154,241 @@ func (c *CellarIDSet) ValidateBasic() error {
 
 	return nil
 }
+
+func (c *ManagedCellar) ValidateBasic() error {
+	if !common.IsHexAddress(c.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", c.CellarId)
+	}
+
+	return c.Metadata.ValidateBasic()
+}
+
+// ValidateBasic checks the metadata fields that are set, all of them are optional
+func (m *CellarMetadata) ValidateBasic() error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"name", m.Name},
+		{"version", m.Version},
+		{"asset denom", m.AssetDenom},
+		{"strategy spec url", m.StrategySpecUrl},
+	}
+	for _, field := range fields {
+		if len(field.value) > MaxCellarMetadataFieldLength {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "%s longer than %d characters", field.name, MaxCellarMetadataFieldLength)
+		}
+	}
+
+	if m.AssetDenom != "" {
+		if err := sdk.ValidateDenom(m.AssetDenom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "asset denom: %s", err)
+		}
+	}
+
+	if m.StrategySpecUrl != "" {
+		u, err := url.ParseRequestURI(m.StrategySpecUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "strategy spec url must be an http(s) URL: %s", m.StrategySpecUrl)
+		}
+	}
+
+	return nil
+}
+
+func (e *CellarMetadataEntry) ValidateBasic() error {
+	if !common.IsHexAddress(e.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", e.CellarId)
+	}
+
+	return e.Metadata.ValidateBasic()
+}
+
+func (c *CellarVoteThreshold) ValidateBasic() error {
+	if !common.IsHexAddress(c.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", c.CellarId)
+	}
+
+	return validateVoteThreshold(c.VoteThreshold)
+}
+
+func (c *ValidatorCorkCount) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(c.Validator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+func (c *CorkContractCall) ValidateBasic() error {
+	if c.InvalidationNonce == 0 {
+		return fmt.Errorf("invalidation nonce must be non-zero")
+	}
+
+	if !common.IsHexAddress(c.TargetContractAddress) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", c.TargetContractAddress)
+	}
+
+	for _, id := range c.CorkIds {
+		if len(id) != 32 {
+			return fmt.Errorf("invalid cork ID length, must be a keccak256 hash")
+		}
+	}
+
+	return nil
+}
+
+func (c *CorkCommitment) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(c.Validator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if c.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if !common.IsHexAddress(c.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", c.CellarId)
+	}
+
+	if len(c.Commitment) != 32 {
+		return fmt.Errorf("invalid commitment length, must be a keccak256 hash")
+	}
+
+	if len(c.RevealedCorkId) != 0 && len(c.RevealedCorkId) != 32 {
+		return fmt.Errorf("invalid revealed cork ID length, must be a keccak256 hash")
+	}
+
+	return nil
+}
+
+// Revealed returns true once a cork matching the commitment has been revealed
+func (c *CorkCommitment) Revealed() bool {
+	return len(c.RevealedCorkId) != 0
+}
+
+func (c *CorkParticipation) ValidateBasic() error {
+	if len(c.CorkId) != 32 {
+		return fmt.Errorf("invalid cork ID length, must be a keccak256 hash")
+	}
+
+	if c.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if !common.IsHexAddress(c.TargetContractAddress) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", c.TargetContractAddress)
+	}
+
+	for _, val := range append(append([]string{}, c.Voters...), c.NonVoters...) {
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (p *ValidatorParticipation) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(p.Validator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if p.MissedCounter > p.ObservedRounds() {
+		return fmt.Errorf("missed counter %d exceeds the %d observed tally rounds", p.MissedCounter, p.ObservedRounds())
+	}
+
+	return nil
+}
+
+// ObservedRounds returns the number of tally rounds in the validator's current participation window
+func (p *ValidatorParticipation) ObservedRounds() uint64 {
+	if p.IndexOffset < p.Window {
+		return p.IndexOffset
+	}
+
+	return p.Window
+}
+
+// ParticipationRate returns the fraction of observed tally rounds the validator voted in, a validator that has not
+// been observed yet has a rate of one
+func (p *ValidatorParticipation) ParticipationRate() sdk.Dec {
+	observed := p.ObservedRounds()
+	if observed == 0 {
+		return sdk.OneDec()
+	}
+
+	return sdk.NewDec(int64(observed - p.MissedCounter)).QuoInt64(int64(observed))
+}
+
+func (m *ValidatorMissedCorks) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// Selector returns the 0x prefixed, hex encoded function selector of the cork's contract call
+func (c *Cork) Selector() (string, error) {
+	if len(c.EncodedContractCall) < FunctionSelectorLength {
+		return "", errorsmod.Wrap(ErrInvalidSelector, "contract call is shorter than a function selector")
+	}
+
+	return "0x" + hex.EncodeToString(c.EncodedContractCall[:FunctionSelectorLength]), nil
+}
+
+// NormalizeSelector validates a hex encoded function selector and returns it 0x prefixed and lower case
+func NormalizeSelector(selector string) (string, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(selector), "0x"))
+	if err != nil || len(bz) != FunctionSelectorLength {
+		return "", errorsmod.Wrapf(ErrInvalidSelector, "%s", selector)
+	}
+
+	return "0x" + hex.EncodeToString(bz), nil
+}
+
+// NormalizeSelectors normalizes each selector in the list, rejecting duplicates
+func NormalizeSelectors(selectors []string) ([]string, error) {
+	normalized := make([]string, 0, len(selectors))
+	seen := make(map[string]bool, len(selectors))
+	for _, selector := range selectors {
+		s, err := NormalizeSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[s] {
+			return nil, errorsmod.Wrapf(ErrInvalidSelector, "duplicate selector %s", s)
+		}
+
+		seen[s] = true
+		normalized = append(normalized, s)
+	}
+
+	return normalized, nil
+}
+
+func (a *CellarSelectorAllowlist) ValidateBasic() error {
+	if !common.IsHexAddress(a.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", a.CellarId)
+	}
+
+	if len(a.Selectors) == 0 {
+		return errorsmod.Wrap(ErrInvalidSelector, "allowlist must contain at least one selector")
+	}
+
+	_, err := NormalizeSelectors(a.Selectors)
+	return err
+}
+
+// Allows returns true if the selector is in the allowlist
+func (a *CellarSelectorAllowlist) Allows(selector string) bool {
+	for _, s := range a.Selectors {
+		if strings.EqualFold(s, selector) {
+			return true
+		}
+	}
+
+	return false
+}
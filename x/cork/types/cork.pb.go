@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -144,18 +146,91 @@ func (m *ScheduledCork) GetId() []byte {
 	return nil
 }
 
+// GovernanceScheduledCork is a cork scheduled by a passed governance proposal, executed at its block height
+// without a validator vote
+type GovernanceScheduledCork struct {
+	Cork        *Cork  `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Id          []byte `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GovernanceScheduledCork) Reset()         { *m = GovernanceScheduledCork{} }
+func (m *GovernanceScheduledCork) String() string { return proto.CompactTextString(m) }
+func (*GovernanceScheduledCork) ProtoMessage()    {}
+func (*GovernanceScheduledCork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{2}
+}
+func (m *GovernanceScheduledCork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceScheduledCork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceScheduledCork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceScheduledCork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceScheduledCork.Merge(m, src)
+}
+func (m *GovernanceScheduledCork) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceScheduledCork) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceScheduledCork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceScheduledCork proto.InternalMessageInfo
+
+func (m *GovernanceScheduledCork) GetCork() *Cork {
+	if m != nil {
+		return m.Cork
+	}
+	return nil
+}
+
+func (m *GovernanceScheduledCork) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GovernanceScheduledCork) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
 type CorkResult struct {
 	Cork               *Cork  `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork,omitempty"`
 	BlockHeight        uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Approved           bool   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
 	ApprovalPercentage string `protobuf:"bytes,4,opt,name=approval_percentage,json=approvalPercentage,proto3" json:"approval_percentage,omitempty"`
+	// vote threshold that applied to this cork when it was tallied
+	VoteThreshold string `protobuf:"bytes,5,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// invalidation nonce of the contract call this cork was submitted in when it targeted the batch target contract,
+	// zero if it was not batched
+	BatchNonce uint64 `protobuf:"varint,6,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	// true once the gravity bridge has observed the contract call being executed on Ethereum
+	Executed bool `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
+	// Ethereum block height the contract call was executed at
+	EthereumHeight uint64 `protobuf:"varint,8,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// true if the cork was scheduled by a governance proposal rather than approved by a validator vote
+	Governance bool `protobuf:"varint,9,opt,name=governance,proto3" json:"governance,omitempty"`
 }
 
 func (m *CorkResult) Reset()         { *m = CorkResult{} }
 func (m *CorkResult) String() string { return proto.CompactTextString(m) }
 func (*CorkResult) ProtoMessage()    {}
 func (*CorkResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1219f78116b242b2, []int{2}
+	return fileDescriptor_1219f78116b242b2, []int{3}
 }
 func (m *CorkResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,6 +287,41 @@ func (m *CorkResult) GetApprovalPercentage() string {
 	return ""
 }
 
+func (m *CorkResult) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
+	}
+	return ""
+}
+
+func (m *CorkResult) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *CorkResult) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *CorkResult) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *CorkResult) GetGovernance() bool {
+	if m != nil {
+		return m.Governance
+	}
+	return false
+}
+
 type CellarIDSet struct {
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -220,7 +330,7 @@ func (m *CellarIDSet) Reset()         { *m = CellarIDSet{} }
 func (m *CellarIDSet) String() string { return proto.CompactTextString(m) }
 func (*CellarIDSet) ProtoMessage()    {}
 func (*CellarIDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1219f78116b242b2, []int{3}
+	return fileDescriptor_1219f78116b242b2, []int{4}
 }
 func (m *CellarIDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,320 +366,4275 @@ func (m *CellarIDSet) GetIds() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Cork)(nil), "cork.v2.Cork")
-	proto.RegisterType((*ScheduledCork)(nil), "cork.v2.ScheduledCork")
-	proto.RegisterType((*CorkResult)(nil), "cork.v2.CorkResult")
-	proto.RegisterType((*CellarIDSet)(nil), "cork.v2.CellarIDSet")
+// ManagedCellar is a cellar managed by the cork module along with a record of how it was added
+type ManagedCellar struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// block height at which the cellar was added
+	AddedHeight uint64 `protobuf:"varint,2,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+	// domain of the publisher whose default subscription was set when the cellar was added
+	PublisherDomain string `protobuf:"bytes,3,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	// ID of the governance proposal that added the cellar, zero if it was not added by a proposal
+	ProposalId uint64         `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Metadata   CellarMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func init() { proto.RegisterFile("cork/v2/cork.proto", fileDescriptor_1219f78116b242b2) }
-
-var fileDescriptor_1219f78116b242b2 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x8a, 0xdb, 0x30,
-	0x10, 0x87, 0xa3, 0xc4, 0xb4, 0xb1, 0x92, 0x94, 0xa2, 0x10, 0x6a, 0x4a, 0x71, 0x13, 0x9f, 0x72,
-	0x28, 0x16, 0xb8, 0xd0, 0x9e, 0x5b, 0x97, 0xd2, 0xde, 0x8a, 0x73, 0xeb, 0xc5, 0x28, 0xd2, 0x60,
-	0x7b, 0xad, 0x44, 0x46, 0x56, 0xcc, 0xe6, 0xbc, 0x2f, 0xb0, 0x6f, 0xb0, 0xaf, 0xb3, 0xc7, 0x1c,
-	0xf7, 0xb8, 0x24, 0x2f, 0xb2, 0x58, 0xf9, 0xf7, 0x02, 0x7b, 0xf2, 0xf8, 0xf7, 0x31, 0x33, 0x9f,
-	0x60, 0x30, 0xe1, 0x4a, 0x97, 0xb4, 0x89, 0x68, 0xfb, 0x0d, 0x2b, 0xad, 0x8c, 0x22, 0x6f, 0x6d,
-	0xdd, 0x44, 0x81, 0xc6, 0x4e, 0xac, 0x74, 0x49, 0x22, 0x3c, 0x81, 0x35, 0x57, 0x02, 0x44, 0xca,
-	0xd5, 0xda, 0x68, 0xc6, 0x4d, 0xca, 0x99, 0x94, 0x1e, 0x9a, 0xa2, 0xf9, 0x30, 0x19, 0x9f, 0x60,
-	0x7c, 0x62, 0x31, 0x93, 0x92, 0x7c, 0xc3, 0x1f, 0x0c, 0xd3, 0x19, 0x98, 0x6b, 0x0b, 0x13, 0x42,
-	0x43, 0x5d, 0x7b, 0xdd, 0x29, 0x9a, 0xbb, 0xc9, 0xe4, 0x88, 0xcf, 0x4d, 0x3f, 0x8e, 0x30, 0xb8,
-	0x43, 0x78, 0xb4, 0xe0, 0x39, 0x88, 0x8d, 0x6c, 0x27, 0xea, 0x92, 0xcc, 0xb0, 0xd3, 0x0a, 0xd9,
-	0x65, 0x83, 0x68, 0x14, 0x9e, 0xec, 0xc2, 0x16, 0x26, 0x16, 0x91, 0x19, 0x1e, 0x2e, 0xa5, 0xe2,
-	0x65, 0x9a, 0x43, 0x91, 0xe5, 0xc6, 0x6e, 0x70, 0x92, 0x81, 0xcd, 0xfe, 0xd8, 0x88, 0x7c, 0xc2,
-	0x6e, 0xc3, 0x64, 0x21, 0x98, 0x51, 0xda, 0xeb, 0x59, 0x83, 0x6b, 0x40, 0xde, 0xe1, 0x6e, 0x21,
-	0x3c, 0xc7, 0x3e, 0xa7, 0x5b, 0x88, 0xe0, 0x01, 0x61, 0x6c, 0xe7, 0x43, 0xbd, 0x91, 0xe6, 0x95,
-	0x14, 0x3e, 0xe2, 0x3e, 0xab, 0x2a, 0xad, 0x1a, 0x10, 0xd6, 0xa0, 0x9f, 0x5c, 0xfe, 0x09, 0xc5,
-	0xe3, 0x63, 0xcd, 0x64, 0x5a, 0x81, 0xe6, 0xb0, 0x36, 0x2c, 0x03, 0x6b, 0xe4, 0x26, 0xe4, 0x8c,
-	0xfe, 0x5d, 0x48, 0xf0, 0x19, 0x0f, 0x62, 0x90, 0x92, 0xe9, 0xbf, 0xbf, 0x16, 0x60, 0xc8, 0x7b,
-	0xdc, 0x2b, 0x44, 0xed, 0xa1, 0x69, 0x6f, 0xee, 0x26, 0x6d, 0xf9, 0xf3, 0xf7, 0xe3, 0xde, 0x47,
-	0xbb, 0xbd, 0x8f, 0x9e, 0xf7, 0x3e, 0xba, 0x3f, 0xf8, 0x9d, 0xdd, 0xc1, 0xef, 0x3c, 0x1d, 0xfc,
-	0xce, 0xff, 0x2f, 0x59, 0x61, 0xf2, 0xcd, 0x32, 0xe4, 0x6a, 0x45, 0x2b, 0xc8, 0xb2, 0xed, 0x4d,
-	0x43, 0x6b, 0xb5, 0x5a, 0x81, 0x2c, 0x40, 0xd3, 0xe6, 0x3b, 0xbd, 0xb5, 0xd7, 0x40, 0xcd, 0xb6,
-	0x82, 0x7a, 0xf9, 0xc6, 0x1e, 0xc5, 0xd7, 0x97, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc0, 0x22,
-	0xce, 0x2a, 0x02, 0x00, 0x00,
+func (m *ManagedCellar) Reset()         { *m = ManagedCellar{} }
+func (m *ManagedCellar) String() string { return proto.CompactTextString(m) }
+func (*ManagedCellar) ProtoMessage()    {}
+func (*ManagedCellar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{5}
 }
-
-func (m *Cork) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagedCellar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedCellar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedCellar.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Cork) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ManagedCellar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedCellar.Merge(m, src)
+}
+func (m *ManagedCellar) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedCellar) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedCellar.DiscardUnknown(m)
 }
 
-func (m *Cork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintCork(dAtA, i, uint64(len(m.TargetContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EncodedContractCall) > 0 {
-		i -= len(m.EncodedContractCall)
-		copy(dAtA[i:], m.EncodedContractCall)
-		i = encodeVarintCork(dAtA, i, uint64(len(m.EncodedContractCall)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ManagedCellar proto.InternalMessageInfo
+
+func (m *ManagedCellar) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ScheduledCork) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagedCellar) GetAddedHeight() uint64 {
+	if m != nil {
+		return m.AddedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ScheduledCork) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ManagedCellar) GetPublisherDomain() string {
+	if m != nil {
+		return m.PublisherDomain
+	}
+	return ""
 }
 
-func (m *ScheduledCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintCork(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Cork != nil {
-		{
-			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCork(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ManagedCellar) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CorkResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagedCellar) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return CellarMetadata{}
 }
 
-func (m *CorkResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CellarMetadata is the optional, governance provided description of a managed cellar
+type CellarMetadata struct {
+	// human readable name of the cellar
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cellar contract version or type
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// denomination of the asset the cellar accepts
+	AssetDenom string `protobuf:"bytes,3,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// link to the specification of the cellar's strategy
+	StrategySpecUrl string `protobuf:"bytes,4,opt,name=strategy_spec_url,json=strategySpecUrl,proto3" json:"strategy_spec_url,omitempty"`
 }
 
-func (m *CorkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ApprovalPercentage) > 0 {
-		i -= len(m.ApprovalPercentage)
-		copy(dAtA[i:], m.ApprovalPercentage)
-		i = encodeVarintCork(dAtA, i, uint64(len(m.ApprovalPercentage)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Approved {
-		i--
-		if m.Approved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *CellarMetadata) Reset()         { *m = CellarMetadata{} }
+func (m *CellarMetadata) String() string { return proto.CompactTextString(m) }
+func (*CellarMetadata) ProtoMessage()    {}
+func (*CellarMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{6}
+}
+func (m *CellarMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.Cork != nil {
-		{
-			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCork(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CellarMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarMetadata.Merge(m, src)
+}
+func (m *CellarMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarMetadata proto.InternalMessageInfo
+
+func (m *CellarMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CellarIDSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CellarMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CellarIDSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CellarMetadata) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
 }
 
-func (m *CellarIDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintCork(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *CellarMetadata) GetStrategySpecUrl() string {
+	if m != nil {
+		return m.StrategySpecUrl
+	}
+	return ""
+}
+
+// CellarMetadataEntry is the metadata proposed for a single cellar
+type CellarMetadataEntry struct {
+	CellarId string         `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata CellarMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *CellarMetadataEntry) Reset()         { *m = CellarMetadataEntry{} }
+func (m *CellarMetadataEntry) String() string { return proto.CompactTextString(m) }
+func (*CellarMetadataEntry) ProtoMessage()    {}
+func (*CellarMetadataEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{7}
+}
+func (m *CellarMetadataEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarMetadataEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarMetadataEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellarMetadataEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarMetadataEntry.Merge(m, src)
+}
+func (m *CellarMetadataEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarMetadataEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarMetadataEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarMetadataEntry proto.InternalMessageInfo
+
+func (m *CellarMetadataEntry) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *CellarMetadataEntry) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return CellarMetadata{}
+}
+
+// CellarVoteThreshold overrides the module vote threshold for a single cellar
+type CellarVoteThreshold struct {
+	CellarId      string                                 `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
+}
+
+func (m *CellarVoteThreshold) Reset()         { *m = CellarVoteThreshold{} }
+func (m *CellarVoteThreshold) String() string { return proto.CompactTextString(m) }
+func (*CellarVoteThreshold) ProtoMessage()    {}
+func (*CellarVoteThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{8}
+}
+func (m *CellarVoteThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarVoteThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarVoteThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellarVoteThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarVoteThreshold.Merge(m, src)
+}
+func (m *CellarVoteThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarVoteThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarVoteThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarVoteThreshold proto.InternalMessageInfo
+
+func (m *CellarVoteThreshold) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+// CellarSelectorAllowlist restricts the function selectors validators may call on a cellar
+type CellarSelectorAllowlist struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// 0x prefixed, hex encoded 4 byte function selectors
+	Selectors []string `protobuf:"bytes,2,rep,name=selectors,proto3" json:"selectors,omitempty"`
+}
+
+func (m *CellarSelectorAllowlist) Reset()         { *m = CellarSelectorAllowlist{} }
+func (m *CellarSelectorAllowlist) String() string { return proto.CompactTextString(m) }
+func (*CellarSelectorAllowlist) ProtoMessage()    {}
+func (*CellarSelectorAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{9}
+}
+func (m *CellarSelectorAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarSelectorAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarSelectorAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellarSelectorAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarSelectorAllowlist.Merge(m, src)
+}
+func (m *CellarSelectorAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarSelectorAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarSelectorAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarSelectorAllowlist proto.InternalMessageInfo
+
+func (m *CellarSelectorAllowlist) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *CellarSelectorAllowlist) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
+// ValidatorCorkCount is the number of corks a validator currently has scheduled
+type ValidatorCorkCount struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ValidatorCorkCount) Reset()         { *m = ValidatorCorkCount{} }
+func (m *ValidatorCorkCount) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCorkCount) ProtoMessage()    {}
+func (*ValidatorCorkCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{10}
+}
+func (m *ValidatorCorkCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCorkCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCorkCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCorkCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCorkCount.Merge(m, src)
+}
+func (m *ValidatorCorkCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCorkCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCorkCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCorkCount proto.InternalMessageInfo
+
+func (m *ValidatorCorkCount) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorCorkCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// CorkCommitment is a validator's hashed commitment to the cork it will vote for on a cellar at a block height
+type CorkCommitment struct {
+	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CellarId    string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// keccak256 hash of the cork ID, salt and validator address
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// ID of the revealed cork, empty until the commitment has been revealed
+	RevealedCorkId []byte `protobuf:"bytes,5,opt,name=revealed_cork_id,json=revealedCorkId,proto3" json:"revealed_cork_id,omitempty"`
+}
+
+func (m *CorkCommitment) Reset()         { *m = CorkCommitment{} }
+func (m *CorkCommitment) String() string { return proto.CompactTextString(m) }
+func (*CorkCommitment) ProtoMessage()    {}
+func (*CorkCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{11}
+}
+func (m *CorkCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorkCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorkCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorkCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorkCommitment.Merge(m, src)
+}
+func (m *CorkCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorkCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorkCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorkCommitment proto.InternalMessageInfo
+
+func (m *CorkCommitment) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *CorkCommitment) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CorkCommitment) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *CorkCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *CorkCommitment) GetRevealedCorkId() []byte {
+	if m != nil {
+		return m.RevealedCorkId
+	}
+	return nil
+}
+
+// CorkParticipation records which bonded validators voted for a tallied cork
+type CorkParticipation struct {
+	CorkId                []byte   `protobuf:"bytes,1,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	BlockHeight           uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TargetContractAddress string   `protobuf:"bytes,3,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	Voters                []string `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters,omitempty"`
+	NonVoters             []string `protobuf:"bytes,5,rep,name=non_voters,json=nonVoters,proto3" json:"non_voters,omitempty"`
+}
+
+func (m *CorkParticipation) Reset()         { *m = CorkParticipation{} }
+func (m *CorkParticipation) String() string { return proto.CompactTextString(m) }
+func (*CorkParticipation) ProtoMessage()    {}
+func (*CorkParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{12}
+}
+func (m *CorkParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorkParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorkParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorkParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorkParticipation.Merge(m, src)
+}
+func (m *CorkParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorkParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorkParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorkParticipation proto.InternalMessageInfo
+
+func (m *CorkParticipation) GetCorkId() []byte {
+	if m != nil {
+		return m.CorkId
+	}
+	return nil
+}
+
+func (m *CorkParticipation) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CorkParticipation) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *CorkParticipation) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *CorkParticipation) GetNonVoters() []string {
+	if m != nil {
+		return m.NonVoters
+	}
+	return nil
+}
+
+// ValidatorParticipation tracks a validator's cork vote participation over the participation window. A validator
+// participates in a tally round when it votes for any cork targeting the tallied cellar at the tallied height.
+type ValidatorParticipation struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// number of tally rounds observed since tracking started, the round's position in the window is index_offset % window
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// number of tally rounds in the window the validator did not vote in
+	MissedCounter uint64 `protobuf:"varint,3,opt,name=missed_counter,json=missedCounter,proto3" json:"missed_counter,omitempty"`
+	// participation window the counters were recorded over
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	// true while the validator's participation rate is below the minimum participation rate
+	BelowThreshold bool `protobuf:"varint,5,opt,name=below_threshold,json=belowThreshold,proto3" json:"below_threshold,omitempty"`
+}
+
+func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{} }
+func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipation) ProtoMessage()    {}
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{13}
+}
+func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipation.Merge(m, src)
+}
+func (m *ValidatorParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipation proto.InternalMessageInfo
+
+func (m *ValidatorParticipation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorParticipation) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetMissedCounter() uint64 {
+	if m != nil {
+		return m.MissedCounter
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetBelowThreshold() bool {
+	if m != nil {
+		return m.BelowThreshold
+	}
+	return false
+}
+
+// ValidatorMissedCorks lists the positions in the participation window of the tally rounds a validator missed
+type ValidatorMissedCorks struct {
+	Validator     string   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	MissedIndexes []uint64 `protobuf:"varint,2,rep,packed,name=missed_indexes,json=missedIndexes,proto3" json:"missed_indexes,omitempty"`
+}
+
+func (m *ValidatorMissedCorks) Reset()         { *m = ValidatorMissedCorks{} }
+func (m *ValidatorMissedCorks) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedCorks) ProtoMessage()    {}
+func (*ValidatorMissedCorks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{14}
+}
+func (m *ValidatorMissedCorks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedCorks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedCorks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedCorks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedCorks.Merge(m, src)
+}
+func (m *ValidatorMissedCorks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedCorks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedCorks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedCorks proto.InternalMessageInfo
+
+func (m *ValidatorMissedCorks) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorMissedCorks) GetMissedIndexes() []uint64 {
+	if m != nil {
+		return m.MissedIndexes
+	}
+	return nil
+}
+
+// CorkContractCall records a contract call submitted to the gravity bridge for one or more approved corks
+type CorkContractCall struct {
+	InvalidationNonce     uint64 `protobuf:"varint,1,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	InvalidationScope     []byte `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	TargetContractAddress string `protobuf:"bytes,3,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// block height the corks were approved at
+	BlockHeight uint64   `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CorkIds     [][]byte `protobuf:"bytes,5,rep,name=cork_ids,json=corkIds,proto3" json:"cork_ids,omitempty"`
+	// Ethereum block height after which the contract call can no longer be executed
+	EthereumTimeoutHeight uint64 `protobuf:"varint,6,opt,name=ethereum_timeout_height,json=ethereumTimeoutHeight,proto3" json:"ethereum_timeout_height,omitempty"`
+}
+
+func (m *CorkContractCall) Reset()         { *m = CorkContractCall{} }
+func (m *CorkContractCall) String() string { return proto.CompactTextString(m) }
+func (*CorkContractCall) ProtoMessage()    {}
+func (*CorkContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{15}
+}
+func (m *CorkContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorkContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorkContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorkContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorkContractCall.Merge(m, src)
+}
+func (m *CorkContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorkContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorkContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorkContractCall proto.InternalMessageInfo
+
+func (m *CorkContractCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
+
+func (m *CorkContractCall) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
+	}
+	return nil
+}
+
+func (m *CorkContractCall) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *CorkContractCall) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CorkContractCall) GetCorkIds() [][]byte {
+	if m != nil {
+		return m.CorkIds
+	}
+	return nil
+}
+
+func (m *CorkContractCall) GetEthereumTimeoutHeight() uint64 {
+	if m != nil {
+		return m.EthereumTimeoutHeight
+	}
+	return 0
+}
+
+// CellarABI is the governance registered contract ABI used to decode the contract calls of corks targeting a cellar
+type CellarABI struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// JSON encoded contract ABI
+	Abi string `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *CellarABI) Reset()         { *m = CellarABI{} }
+func (m *CellarABI) String() string { return proto.CompactTextString(m) }
+func (*CellarABI) ProtoMessage()    {}
+func (*CellarABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{16}
+}
+func (m *CellarABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellarABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellarABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellarABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellarABI.Merge(m, src)
+}
+func (m *CellarABI) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellarABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellarABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellarABI proto.InternalMessageInfo
+
+func (m *CellarABI) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
+}
+
+func (m *CellarABI) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+// DecodedContractCall is a cork's contract call decoded with its cellar's registered ABI
+type DecodedContractCall struct {
+	// ID of the cork whose contract call was decoded
+	CorkId []byte `protobuf:"bytes,1,opt,name=cork_id,json=corkId,proto3" json:"cork_id,omitempty"`
+	// name of the called function
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// canonical signature of the called function, such as transfer(address,uint256)
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// JSON object of the call's arguments keyed by argument name
+	Arguments string `protobuf:"bytes,4,opt,name=arguments,proto3" json:"arguments,omitempty"`
+}
+
+func (m *DecodedContractCall) Reset()         { *m = DecodedContractCall{} }
+func (m *DecodedContractCall) String() string { return proto.CompactTextString(m) }
+func (*DecodedContractCall) ProtoMessage()    {}
+func (*DecodedContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1219f78116b242b2, []int{17}
+}
+func (m *DecodedContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedContractCall.Merge(m, src)
+}
+func (m *DecodedContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedContractCall proto.InternalMessageInfo
+
+func (m *DecodedContractCall) GetCorkId() []byte {
+	if m != nil {
+		return m.CorkId
+	}
+	return nil
+}
+
+func (m *DecodedContractCall) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DecodedContractCall) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *DecodedContractCall) GetArguments() string {
+	if m != nil {
+		return m.Arguments
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Cork)(nil), "cork.v2.Cork")
+	proto.RegisterType((*ScheduledCork)(nil), "cork.v2.ScheduledCork")
+	proto.RegisterType((*GovernanceScheduledCork)(nil), "cork.v2.GovernanceScheduledCork")
+	proto.RegisterType((*CorkResult)(nil), "cork.v2.CorkResult")
+	proto.RegisterType((*CellarIDSet)(nil), "cork.v2.CellarIDSet")
+	proto.RegisterType((*ManagedCellar)(nil), "cork.v2.ManagedCellar")
+	proto.RegisterType((*CellarMetadata)(nil), "cork.v2.CellarMetadata")
+	proto.RegisterType((*CellarMetadataEntry)(nil), "cork.v2.CellarMetadataEntry")
+	proto.RegisterType((*CellarVoteThreshold)(nil), "cork.v2.CellarVoteThreshold")
+	proto.RegisterType((*CellarSelectorAllowlist)(nil), "cork.v2.CellarSelectorAllowlist")
+	proto.RegisterType((*ValidatorCorkCount)(nil), "cork.v2.ValidatorCorkCount")
+	proto.RegisterType((*CorkCommitment)(nil), "cork.v2.CorkCommitment")
+	proto.RegisterType((*CorkParticipation)(nil), "cork.v2.CorkParticipation")
+	proto.RegisterType((*ValidatorParticipation)(nil), "cork.v2.ValidatorParticipation")
+	proto.RegisterType((*ValidatorMissedCorks)(nil), "cork.v2.ValidatorMissedCorks")
+	proto.RegisterType((*CorkContractCall)(nil), "cork.v2.CorkContractCall")
+	proto.RegisterType((*CellarABI)(nil), "cork.v2.CellarABI")
+	proto.RegisterType((*DecodedContractCall)(nil), "cork.v2.DecodedContractCall")
+}
+
+func init() { proto.RegisterFile("cork/v2/cork.proto", fileDescriptor_1219f78116b242b2) }
+
+var fileDescriptor_1219f78116b242b2 = []byte{
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x1c, 0x45,
+	0x10, 0xf6, 0x78, 0xd7, 0xf6, 0x6e, 0xf9, 0x27, 0x4e, 0x3b, 0x89, 0x97, 0x10, 0xd6, 0xc9, 0x48,
+	0x80, 0x41, 0xc4, 0x2b, 0x19, 0x09, 0x04, 0xb7, 0xd8, 0x06, 0xe2, 0x43, 0x20, 0x1a, 0x27, 0x39,
+	0xc0, 0x61, 0xd4, 0xdb, 0x53, 0x99, 0x1d, 0x76, 0xa6, 0x7b, 0xd4, 0xdd, 0xbb, 0x8e, 0xaf, 0x70,
+	0x42, 0x5c, 0x78, 0x02, 0x9e, 0x83, 0x13, 0x12, 0xb7, 0x1c, 0x38, 0xe4, 0x84, 0x10, 0x87, 0x08,
+	0x25, 0x2f, 0x82, 0xfa, 0x67, 0x66, 0xbd, 0x1b, 0xb1, 0x09, 0x08, 0x4e, 0xd3, 0xfd, 0x55, 0x4f,
+	0xd5, 0x57, 0xd5, 0xdf, 0x54, 0x0d, 0x10, 0x26, 0xe4, 0xb0, 0x37, 0xde, 0xef, 0x99, 0xe7, 0x5e,
+	0x29, 0x85, 0x16, 0x64, 0xc5, 0xae, 0xc7, 0xfb, 0x57, 0x2f, 0xa5, 0x22, 0x15, 0x16, 0xeb, 0x99,
+	0x95, 0x33, 0x87, 0x12, 0x9a, 0x87, 0x42, 0x0e, 0xc9, 0x3e, 0x5c, 0x46, 0xce, 0x44, 0x82, 0x49,
+	0xcc, 0x04, 0xd7, 0x92, 0x32, 0x1d, 0x33, 0x9a, 0xe7, 0x9d, 0xe0, 0x7a, 0xb0, 0xbb, 0x16, 0x6d,
+	0x79, 0xe3, 0xa1, 0xb7, 0x1d, 0xd2, 0x3c, 0x27, 0x1f, 0xc0, 0xb6, 0xa6, 0x32, 0x45, 0x3d, 0x79,
+	0x85, 0x26, 0x89, 0x44, 0xa5, 0x3a, 0x8b, 0xd7, 0x83, 0xdd, 0x76, 0x74, 0xd9, 0x99, 0xab, 0x97,
+	0x6e, 0x39, 0x63, 0xf8, 0x6d, 0x00, 0xeb, 0x27, 0x6c, 0x80, 0xc9, 0x28, 0x37, 0x1e, 0xe5, 0x90,
+	0xdc, 0x80, 0xa6, 0xa1, 0x69, 0x83, 0xad, 0xee, 0xaf, 0xef, 0x79, 0xce, 0x7b, 0xc6, 0x18, 0x59,
+	0x13, 0xb9, 0x01, 0x6b, 0xfd, 0x5c, 0xb0, 0x61, 0x3c, 0xc0, 0x2c, 0x1d, 0x68, 0x1b, 0xa1, 0x19,
+	0xad, 0x5a, 0xec, 0xb6, 0x85, 0xc8, 0x35, 0x68, 0x8f, 0x69, 0x9e, 0x25, 0x54, 0x0b, 0xd9, 0x69,
+	0x58, 0x06, 0x13, 0x80, 0x6c, 0xc0, 0x62, 0x96, 0x74, 0x9a, 0x36, 0x9d, 0xc5, 0x2c, 0x09, 0x05,
+	0x6c, 0x7f, 0x26, 0xc6, 0x28, 0x39, 0xe5, 0x0c, 0xff, 0x0f, 0x3a, 0x2e, 0x60, 0xa3, 0x0e, 0xf8,
+	0xeb, 0x22, 0x80, 0xf5, 0x80, 0x6a, 0x94, 0xeb, 0xff, 0x28, 0xc8, 0x55, 0x68, 0xd1, 0xb2, 0x94,
+	0x62, 0x8c, 0x2e, 0x54, 0x2b, 0xaa, 0xf7, 0xa4, 0x07, 0x5b, 0x6e, 0x4d, 0xf3, 0xb8, 0x44, 0xc9,
+	0x90, 0x6b, 0x9a, 0xa2, 0x2d, 0x41, 0x3b, 0x22, 0x95, 0xe9, 0x6e, 0x6d, 0x21, 0x6f, 0xc2, 0xc6,
+	0x58, 0x68, 0x8c, 0xf5, 0x40, 0xa2, 0x1a, 0x88, 0x3c, 0xe9, 0x2c, 0xd9, 0xb3, 0xeb, 0x06, 0xbd,
+	0x57, 0x81, 0x64, 0x07, 0x56, 0xfb, 0x54, 0xb3, 0x41, 0xcc, 0x05, 0x67, 0xd8, 0x59, 0xb6, 0xac,
+	0xc0, 0x42, 0x9f, 0x1b, 0xc4, 0x90, 0xc2, 0x47, 0xc8, 0x46, 0x1a, 0x93, 0xce, 0x8a, 0x23, 0x55,
+	0xed, 0xc9, 0xdb, 0x70, 0x01, 0xf5, 0x00, 0x25, 0x8e, 0x8a, 0x2a, 0xad, 0x96, 0x75, 0xb0, 0x51,
+	0xc1, 0x3e, 0xb3, 0x2e, 0x40, 0x5a, 0xdf, 0x4f, 0xa7, 0x6d, 0xdd, 0x9c, 0x43, 0xc2, 0x1d, 0x58,
+	0x3d, 0xc4, 0x3c, 0xa7, 0xf2, 0xf8, 0xe8, 0x04, 0x35, 0xd9, 0x84, 0x46, 0x96, 0xa8, 0x4e, 0x70,
+	0xbd, 0xb1, 0xdb, 0x8e, 0xcc, 0x32, 0xfc, 0x2d, 0x80, 0xf5, 0x3b, 0x94, 0xd3, 0x14, 0x13, 0x77,
+	0x90, 0xbc, 0x0e, 0x6d, 0x66, 0x57, 0x71, 0x96, 0xd8, 0xba, 0xb7, 0xa3, 0x96, 0x03, 0x8e, 0x13,
+	0x53, 0x6c, 0x9a, 0x18, 0xfd, 0x4f, 0x17, 0xdb, 0x62, 0x9e, 0xd2, 0x3b, 0xb0, 0x59, 0x8e, 0xfa,
+	0x79, 0xa6, 0x06, 0x28, 0xe3, 0x44, 0x14, 0x34, 0xe3, 0x5e, 0x67, 0x17, 0x6a, 0xfc, 0xc8, 0xc2,
+	0xa6, 0x46, 0xa5, 0x14, 0xa5, 0x50, 0x34, 0x8f, 0xbd, 0xec, 0x9a, 0x11, 0x54, 0xd0, 0x71, 0x42,
+	0x3e, 0x82, 0x56, 0x81, 0x9a, 0x26, 0x54, 0x53, 0x5b, 0xe5, 0xd5, 0xfd, 0xed, 0x89, 0x04, 0x2c,
+	0xa7, 0x3b, 0xde, 0x7c, 0xd0, 0x7c, 0xfc, 0x74, 0x67, 0x21, 0xaa, 0x8f, 0x87, 0xdf, 0x07, 0xb0,
+	0x31, 0x7d, 0x84, 0x10, 0x68, 0x72, 0x5a, 0xa0, 0x4f, 0xca, 0xae, 0x49, 0x07, 0x56, 0xc6, 0x28,
+	0x55, 0x26, 0xb8, 0xff, 0x1c, 0xab, 0xad, 0x21, 0x47, 0x95, 0x42, 0x1d, 0x27, 0xc8, 0x45, 0xe1,
+	0x53, 0x00, 0x0b, 0x1d, 0x19, 0x84, 0xbc, 0x0b, 0x17, 0x95, 0x96, 0x54, 0x63, 0x7a, 0x16, 0xab,
+	0x12, 0x59, 0x3c, 0x92, 0xb9, 0xd7, 0xcd, 0x85, 0xca, 0x70, 0x52, 0x22, 0xbb, 0x2f, 0xf3, 0xb0,
+	0x80, 0xad, 0x69, 0x32, 0x9f, 0x70, 0x2d, 0xcf, 0xe6, 0xd7, 0xfa, 0x7c, 0xf2, 0x8b, 0xff, 0x2c,
+	0xf9, 0xef, 0x82, 0x2a, 0xde, 0x83, 0x29, 0x51, 0xce, 0x8d, 0x77, 0xff, 0x05, 0x61, 0xdb, 0x8a,
+	0x1c, 0xec, 0x19, 0xe7, 0x7f, 0x3c, 0xdd, 0x79, 0x2b, 0xcd, 0xf4, 0x60, 0xd4, 0xdf, 0x63, 0xa2,
+	0xe8, 0x31, 0xa1, 0x0a, 0xa1, 0xfc, 0xe3, 0xa6, 0x4a, 0x86, 0x3d, 0x7d, 0x56, 0xa2, 0xda, 0x3b,
+	0x42, 0x36, 0xf3, 0x21, 0x84, 0xf7, 0x60, 0xdb, 0x51, 0x39, 0xc1, 0x1c, 0x99, 0x16, 0xf2, 0x56,
+	0x9e, 0x8b, 0xd3, 0x3c, 0x53, 0x7a, 0x3e, 0x9d, 0x6b, 0xd0, 0x56, 0xfe, 0x0d, 0xd3, 0x2a, 0x8d,
+	0x62, 0x27, 0x40, 0x78, 0x1b, 0xc8, 0x83, 0xaa, 0x6b, 0x99, 0x66, 0x70, 0x28, 0x46, 0x7c, 0xa6,
+	0xb9, 0x05, 0xb3, 0xcd, 0xed, 0x12, 0x2c, 0x31, 0x73, 0xcc, 0xab, 0xd6, 0x6d, 0xc2, 0x9f, 0x8c,
+	0x50, 0xac, 0x87, 0xa2, 0xc8, 0x74, 0x81, 0x2f, 0x75, 0xf3, 0x0a, 0x0d, 0x67, 0x2a, 0xb1, 0xc6,
+	0x4c, 0x62, 0x5d, 0x00, 0x56, 0xc7, 0xf2, 0xbd, 0xf6, 0x1c, 0x42, 0x76, 0x61, 0x53, 0xe2, 0x18,
+	0x69, 0x6e, 0xc7, 0x8c, 0x1c, 0x1a, 0x1f, 0x4b, 0xf6, 0xd4, 0x46, 0x85, 0x1b, 0xbe, 0xc7, 0x49,
+	0xf8, 0x73, 0x00, 0x17, 0xcd, 0xf2, 0x2e, 0x95, 0x3a, 0x63, 0x59, 0x49, 0xb5, 0x11, 0xee, 0x36,
+	0xac, 0x54, 0xaf, 0xb9, 0xb9, 0xb4, 0xcc, 0xec, 0xf1, 0x57, 0x21, 0x3e, 0x67, 0x5a, 0x35, 0xe6,
+	0x4c, 0x2b, 0x72, 0x05, 0x96, 0xcd, 0xad, 0x4b, 0xd5, 0x69, 0xda, 0x9b, 0xf2, 0x3b, 0xf2, 0x06,
+	0x00, 0x17, 0x3c, 0xf6, 0xb6, 0x25, 0x77, 0x8b, 0x5c, 0xf0, 0x07, 0x16, 0x08, 0x7f, 0x09, 0xe0,
+	0x4a, 0x7d, 0x8d, 0xd3, 0x59, 0xbc, 0xf4, 0x0e, 0x32, 0x9e, 0xe0, 0xa3, 0x58, 0x3c, 0x7c, 0xa8,
+	0xb0, 0x4e, 0xc5, 0x62, 0x5f, 0x58, 0xc8, 0xf4, 0xe9, 0x22, 0x53, 0xca, 0x16, 0x71, 0xc4, 0x35,
+	0xba, 0x69, 0xd7, 0x8c, 0xd6, 0x1d, 0x7a, 0xe8, 0x40, 0xc3, 0xfc, 0x34, 0xe3, 0x89, 0x38, 0xf5,
+	0xed, 0xc7, 0xef, 0x4c, 0x0b, 0xee, 0x63, 0x2e, 0x4e, 0x67, 0xfa, 0x7c, 0x2b, 0xda, 0xb0, 0xf0,
+	0x44, 0xdf, 0x5f, 0xc1, 0xa5, 0x3a, 0x85, 0x3b, 0xde, 0xb5, 0x1c, 0xaa, 0x97, 0x24, 0x30, 0x61,
+	0x67, 0x39, 0xa3, 0x93, 0x78, 0xcd, 0xee, 0xd8, 0x81, 0xe1, 0x8f, 0x8b, 0xb0, 0xe9, 0xc4, 0x79,
+	0xee, 0x97, 0xe2, 0x26, 0x90, 0x8c, 0x7b, 0x57, 0x99, 0xe0, 0x7e, 0xc2, 0x04, 0x96, 0xfe, 0xc5,
+	0xf3, 0x16, 0x37, 0x68, 0x66, 0x8f, 0x2b, 0x26, 0x4a, 0xb4, 0x15, 0x5b, 0x9b, 0x3e, 0x7e, 0x62,
+	0x0c, 0xff, 0x5a, 0x02, 0xb3, 0xea, 0x6a, 0xbe, 0xa8, 0xae, 0xd7, 0xa0, 0xe5, 0x95, 0xe9, 0xb4,
+	0xb0, 0x16, 0xad, 0x38, 0x69, 0x2a, 0x13, 0xb5, 0x9e, 0x78, 0x3a, 0x2b, 0x50, 0x8c, 0x74, 0xe5,
+	0xc8, 0x8d, 0xce, 0xcb, 0x95, 0xf9, 0x9e, 0xb3, 0x3a, 0x97, 0xe1, 0xc7, 0xd0, 0x76, 0xdd, 0xe5,
+	0xd6, 0xc1, 0xf1, 0xfc, 0x7e, 0xb2, 0x09, 0x0d, 0xda, 0xcf, 0x7c, 0x97, 0x37, 0xcb, 0xf0, 0x9b,
+	0x00, 0xb6, 0x8e, 0xf0, 0xc5, 0x5f, 0xb6, 0xbf, 0xfd, 0x80, 0xae, 0xc0, 0x72, 0x81, 0x7a, 0x20,
+	0x7c, 0x67, 0x8c, 0xfc, 0xce, 0xb6, 0xaa, 0x2c, 0xe5, 0x54, 0x8f, 0x24, 0x56, 0xff, 0x54, 0x35,
+	0x60, 0xac, 0x54, 0xa6, 0x23, 0xf3, 0x6d, 0x2b, 0x3f, 0x1f, 0x26, 0xc0, 0xc1, 0xa7, 0x8f, 0x9f,
+	0x75, 0x83, 0x27, 0xcf, 0xba, 0xc1, 0x9f, 0xcf, 0xba, 0xc1, 0x0f, 0xcf, 0xbb, 0x0b, 0x4f, 0x9e,
+	0x77, 0x17, 0x7e, 0x7f, 0xde, 0x5d, 0xf8, 0xf2, 0xbd, 0x73, 0xfd, 0xb6, 0xc4, 0x34, 0x3d, 0xfb,
+	0x7a, 0xdc, 0x53, 0xa2, 0x28, 0x30, 0xcf, 0x50, 0xf6, 0xc6, 0x1f, 0xf6, 0x1e, 0xd9, 0x5f, 0x58,
+	0xd7, 0x79, 0xfb, 0xcb, 0xf6, 0x57, 0xf5, 0xfd, 0xbf, 0x06, 0x00, 0x86, 0x62, 0x0b, 0xc1, 0xdf,
+	0x0a, 0x00, 0x00,
+}
+
+func (m *Cork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceScheduledCork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceScheduledCork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceScheduledCork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Governance {
+		i--
+		if m.Governance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VoteThreshold) > 0 {
+		i -= len(m.VoteThreshold)
+		copy(dAtA[i:], m.VoteThreshold)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.VoteThreshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApprovalPercentage) > 0 {
+		i -= len(m.ApprovalPercentage)
+		copy(dAtA[i:], m.ApprovalPercentage)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.ApprovalPercentage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarIDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarIDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarIDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintCork(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedCellar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedCellar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedCellar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ProposalId != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.PublisherDomain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AddedHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StrategySpecUrl) > 0 {
+		i -= len(m.StrategySpecUrl)
+		copy(dAtA[i:], m.StrategySpecUrl)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.StrategySpecUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarMetadataEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarMetadataEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarMetadataEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarVoteThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarVoteThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarVoteThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCork(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarSelectorAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarSelectorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarSelectorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintCork(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCorkCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCorkCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCorkCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorkCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorkCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorkCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevealedCorkId) > 0 {
+		i -= len(m.RevealedCorkId)
+		copy(dAtA[i:], m.RevealedCorkId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.RevealedCorkId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorkParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorkParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorkParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonVoters) > 0 {
+		for iNdEx := len(m.NonVoters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NonVoters[iNdEx])
+			copy(dAtA[i:], m.NonVoters[iNdEx])
+			i = encodeVarintCork(dAtA, i, uint64(len(m.NonVoters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintCork(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BelowThreshold {
+		i--
+		if m.BelowThreshold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Window != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCounter != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.MissedCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedCorks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedCorks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedCorks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedIndexes) > 0 {
+		dAtA7 := make([]byte, len(m.MissedIndexes)*10)
+		var j6 int
+		for _, num := range m.MissedIndexes {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintCork(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorkContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorkContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorkContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumTimeoutHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.EthereumTimeoutHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CorkIds) > 0 {
+		for iNdEx := len(m.CorkIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorkIds[iNdEx])
+			copy(dAtA[i:], m.CorkIds[iNdEx])
+			i = encodeVarintCork(dAtA, i, uint64(len(m.CorkIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintCork(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellarABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellarABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellarABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		i -= len(m.Arguments)
+		copy(dAtA[i:], m.Arguments)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Arguments)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CorkId) > 0 {
+		i -= len(m.CorkId)
+		copy(dAtA[i:], m.CorkId)
+		i = encodeVarintCork(dAtA, i, uint64(len(m.CorkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCork(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCork(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Cork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceScheduledCork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *CorkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	if m.Approved {
+		n += 2
+	}
+	l = len(m.ApprovalPercentage)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.VoteThreshold)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovCork(uint64(m.BatchNonce))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovCork(uint64(m.EthereumHeight))
+	}
+	if m.Governance {
+		n += 2
+	}
+	return n
+}
+
+func (m *CellarIDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovCork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ManagedCellar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovCork(uint64(m.AddedHeight))
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovCork(uint64(m.ProposalId))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovCork(uint64(l))
+	return n
+}
+
+func (m *CellarMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.StrategySpecUrl)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *CellarMetadataEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovCork(uint64(l))
+	return n
+}
+
+func (m *CellarVoteThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovCork(uint64(l))
+	return n
+}
+
+func (m *CellarSelectorAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovCork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCorkCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCork(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *CorkCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.RevealedCorkId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *CorkParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovCork(uint64(l))
+		}
+	}
+	if len(m.NonVoters) > 0 {
+		for _, s := range m.NonVoters {
+			l = len(s)
+			n += 1 + l + sovCork(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovCork(uint64(m.IndexOffset))
+	}
+	if m.MissedCounter != 0 {
+		n += 1 + sovCork(uint64(m.MissedCounter))
+	}
+	if m.Window != 0 {
+		n += 1 + sovCork(uint64(m.Window))
+	}
+	if m.BelowThreshold {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorMissedCorks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if len(m.MissedIndexes) > 0 {
+		l = 0
+		for _, e := range m.MissedIndexes {
+			l += sovCork(uint64(e))
+		}
+		n += 1 + sovCork(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CorkContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovCork(uint64(m.InvalidationNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCork(uint64(m.BlockHeight))
+	}
+	if len(m.CorkIds) > 0 {
+		for _, b := range m.CorkIds {
+			l = len(b)
+			n += 1 + l + sovCork(uint64(l))
+		}
+	}
+	if m.EthereumTimeoutHeight != 0 {
+		n += 1 + sovCork(uint64(m.EthereumTimeoutHeight))
+	}
+	return n
+}
+
+func (m *CellarABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodedContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CorkId)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	l = len(m.Arguments)
+	if l > 0 {
+		n += 1 + l + sovCork(uint64(l))
+	}
+	return n
+}
+
+func sovCork(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCork(x uint64) (n int) {
+	return sovCork(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Cork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedContractCall == nil {
+				m.EncodedContractCall = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &Cork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceScheduledCork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceScheduledCork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceScheduledCork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &Cork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CorkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CorkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CorkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &Cork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Governance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarIDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarIDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedCellar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedCellar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedCellar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategySpecUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategySpecUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarMetadataEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarMetadataEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarMetadataEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarVoteThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarVoteThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarVoteThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellarSelectorAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellarSelectorAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellarSelectorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCorkCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCorkCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCorkCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CorkCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CorkCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CorkCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedCorkId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevealedCorkId = append(m.RevealedCorkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevealedCorkId == nil {
+				m.RevealedCorkId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CorkParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CorkParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CorkParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = append(m.CorkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CorkId == nil {
+				m.CorkId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVoters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonVoters = append(m.NonVoters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintCork(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCork(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Cork) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EncodedContractCall)
-	if l > 0 {
-		n += 1 + l + sovCork(uint64(l))
-	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovCork(uint64(l))
-	}
-	return n
-}
-
-func (m *ScheduledCork) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Cork != nil {
-		l = m.Cork.Size()
-		n += 1 + l + sovCork(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovCork(uint64(m.BlockHeight))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovCork(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCork(uint64(l))
-	}
-	return n
-}
-
-func (m *CorkResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Cork != nil {
-		l = m.Cork.Size()
-		n += 1 + l + sovCork(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovCork(uint64(m.BlockHeight))
-	}
-	if m.Approved {
-		n += 2
-	}
-	l = len(m.ApprovalPercentage)
-	if l > 0 {
-		n += 1 + l + sovCork(uint64(l))
-	}
-	return n
-}
 
-func (m *CellarIDSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovCork(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovCork(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCork(x uint64) (n int) {
-	return sovCork(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Cork) Unmarshal(dAtA []byte) error {
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,17 +4657,17 @@ func (m *Cork) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cork: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cork: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCork
@@ -612,29 +4677,154 @@ func (m *Cork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCork
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncodedContractCall == nil {
-				m.EncodedContractCall = []byte{}
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCounter", wireType)
+			}
+			m.MissedCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BelowThreshold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BelowThreshold = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedCorks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedCorks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedCorks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -662,8 +4852,84 @@ func (m *Cork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCork
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedIndexes = append(m.MissedIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCork
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCork
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCork
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedIndexes) == 0 {
+					m.MissedIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCork
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedIndexes = append(m.MissedIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedIndexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCork(dAtA[iNdEx:])
@@ -685,7 +4951,7 @@ func (m *Cork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
+func (m *CorkContractCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -708,17 +4974,17 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledCork: wiretype end group for non-group")
+			return fmt.Errorf("proto: CorkContractCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledCork: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CorkContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			var msglen int
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCork
@@ -728,33 +4994,16 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCork
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCork
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cork == nil {
-				m.Cork = &Cork{}
-			}
-			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			m.BlockHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCork
@@ -764,14 +5013,29 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -799,11 +5063,30 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -830,11 +5113,28 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
+			m.CorkIds = append(m.CorkIds, make([]byte, postIndex-iNdEx))
+			copy(m.CorkIds[len(m.CorkIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTimeoutHeight", wireType)
+			}
+			m.EthereumTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCork(dAtA[iNdEx:])
@@ -856,7 +5156,7 @@ func (m *ScheduledCork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CorkResult) Unmarshal(dAtA []byte) error {
+func (m *CellarABI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,17 +5179,17 @@ func (m *CorkResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CorkResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellarABI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CorkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellarABI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCork
@@ -899,70 +5199,27 @@ func (m *CorkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCork
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCork
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cork == nil {
-				m.Cork = &Cork{}
-			}
-			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCork
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCork
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Approved = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -990,7 +5247,7 @@ func (m *CorkResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1013,7 +5270,7 @@ func (m *CorkResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
+func (m *DecodedContractCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,15 +5293,49 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CellarIDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedContractCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CellarIDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkId = append(m.CorkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CorkId == nil {
+				m.CorkId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1072,7 +5363,71 @@ func (m *CellarIDSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
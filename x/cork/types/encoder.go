@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ContractCallEncoder ABI encodes the JSON form of a Steward cellar call, the value of the cellar type field of a
+// ScheduleRequest, into the contract call it describes
+type ContractCallEncoder func(callJSON json.RawMessage) ([]byte, error)
+
+// scheduleRequestFields are the ScheduleRequest fields that don't describe the cellar call
+var scheduleRequestFields = map[string]bool{
+	"cellar_id":    true,
+	"block_height": true,
+	"chain_id":     true,
+	"deadline":     true,
+}
+
+// ParseContractCallProtoJSON splits the JSON form of a Steward ScheduleRequest into the name of its cellar type
+// field, such as "cellar_v1", and the JSON of the cellar call it contains
+func ParseContractCallProtoJSON(protoJSON string) (string, json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(protoJSON), &fields); err != nil {
+		return "", nil, errorsmod.Wrap(ErrInvalidJSON, err.Error())
+	}
+
+	var cellarTypes []string
+	for name := range fields {
+		if !scheduleRequestFields[name] {
+			cellarTypes = append(cellarTypes, name)
+		}
+	}
+	sort.Strings(cellarTypes)
+
+	switch len(cellarTypes) {
+	case 0:
+		return "", nil, errorsmod.Wrap(ErrInvalidJSON, "schedule request has no cellar call")
+	case 1:
+		return cellarTypes[0], fields[cellarTypes[0]], nil
+	default:
+		return "", nil, errorsmod.Wrapf(ErrInvalidJSON, "schedule request has more than one cellar call: %s", strings.Join(cellarTypes, ", "))
+	}
+}
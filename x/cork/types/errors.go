@@ -12,4 +12,21 @@ var (
 	ErrSchedulingInThePast          = errorsmod.Register(ModuleName, 5, "cork is trying to be scheduled for a block that has already passed")
 	ErrInvalidJSON                  = errorsmod.Register(ModuleName, 6, "invalid json")
 	ErrValidatorCorkCapacityReached = errorsmod.Register(ModuleName, 7, "validator cork capacity reached")
+	ErrInvalidCorkID                = errorsmod.Register(ModuleName, 8, "invalid cork ID")
+	ErrScheduledCorkNotFound        = errorsmod.Register(ModuleName, 9, "scheduled cork not found")
+	ErrInvalidSelector              = errorsmod.Register(ModuleName, 10, "invalid function selector")
+	ErrSelectorNotAllowed           = errorsmod.Register(ModuleName, 11, "function selector is not in the cellar's allowlist")
+	ErrCellarPaused                 = errorsmod.Register(ModuleName, 12, "cellar is paused")
+	ErrCommitRevealRequired         = errorsmod.Register(ModuleName, 13, "votes for this cellar must be committed before they are revealed")
+	ErrCommitRevealNotRequired      = errorsmod.Register(ModuleName, 14, "votes for this cellar are not committed before they are revealed")
+	ErrInvalidCommitment            = errorsmod.Register(ModuleName, 15, "invalid cork commitment")
+	ErrCommitmentNotFound           = errorsmod.Register(ModuleName, 16, "cork commitment not found")
+	ErrCommitmentMismatch           = errorsmod.Register(ModuleName, 17, "revealed cork does not match its commitment")
+	ErrCommitPeriodClosed           = errorsmod.Register(ModuleName, 18, "commit period for this block height has closed")
+	ErrOutsideRevealWindow          = errorsmod.Register(ModuleName, 19, "reveal window for this block height is not open")
+	ErrCommitmentRevealed           = errorsmod.Register(ModuleName, 20, "cork commitment has already been revealed")
+	ErrContractCallEncoderNotFound  = errorsmod.Register(ModuleName, 21, "no contract call encoder registered for cellar type")
+	ErrInvalidABI                   = errorsmod.Register(ModuleName, 22, "invalid contract ABI")
+	ErrInvalidCellarMetadata        = errorsmod.Register(ModuleName, 23, "invalid cellar metadata")
+	ErrSigningInfoNotFound          = errorsmod.Register(ModuleName, 24, "no validator signing info found")
 )
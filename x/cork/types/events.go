@@ -2,8 +2,19 @@ package types
 
 // cork module event types
 const (
-	EventTypeCork         = "cork"
-	EventTypeCommitPeriod = "commit_period"
+	EventTypeCork                = "cork"
+	EventTypeCommitPeriod        = "commit_period"
+	EventTypeCancelScheduledCork = "cancel_scheduled_cork"
+	EventTypeCorkBatch           = "cork_batch"
+	EventTypeCorkExecuted        = "cork_executed"
+	EventTypeCorkResultArchived  = "cork_result_archived"
+	EventTypeCellarPause         = "cellar_pause"
+	EventTypeCorkSkipped         = "cork_skipped"
+	EventTypeCorkCommit          = "cork_commit"
+	EventTypeCorkReveal          = "cork_reveal"
+	EventTypeLowParticipation    = "low_cork_participation"
+	EventTypeParticipationJail   = "cork_participation_jail"
+	EventTypeGovernanceCork      = "governance_cork"
 
 	AttributeKeySigner            = "signer"
 	AttributeKeyValidator         = "validator"
@@ -12,6 +23,24 @@ const (
 	AttributeKeyCommitPeriodStart = "commit_period_start"
 	AttributeKeyCommitPeriodEnd   = "commit_period_end"
 	AttributeKeyBlockHeight       = "block_height"
+	AttributeKeyCorkID            = "cork_id"
+	AttributeKeyBatchNonce        = "batch_nonce"
+	AttributeKeyBatchSize         = "batch_size"
+	AttributeKeyInvalidationNonce = "invalidation_nonce"
+	AttributeKeyEthereumHeight    = "ethereum_height"
+	AttributeKeyTargetContract    = "target_contract_address"
+	AttributeKeyApproved          = "approved"
+	AttributeKeyApprovalPercent   = "approval_percentage"
+	AttributeKeyExecuted          = "executed"
+	AttributeKeyCellarID          = "cellar_id"
+	AttributeKeyPaused            = "paused"
+	AttributeKeyAuthority         = "authority"
+	AttributeKeyCommitment        = "commitment"
+	AttributeKeyParticipationRate = "participation_rate"
+	AttributeKeyMissedCorks       = "missed_corks"
+	AttributeKeyWindow            = "window"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyJailedUntil       = "jailed_until"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,9 +3,12 @@ package types
 //go:generate mockgen --source=x/cork/types/expected_keepers.go --destination=x/cork/testutil/expected_keepers_mocks.go --package=mock_types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/v4/x/gravity/types"
@@ -13,6 +16,16 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
+// AccountKeeper defines the expected account keeper methods
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the expected bank keeper methods
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
 // StakingKeeper defines the expected staking keeper methods
 type StakingKeeper interface {
 	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
@@ -28,6 +41,15 @@ type StakingKeeper interface {
 	PowerReduction(ctx sdk.Context) math.Int
 }
 
+// SlashingKeeper defines the expected slashing keeper methods
+type SlashingKeeper interface {
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64)
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	DowntimeJailDuration(ctx sdk.Context) time.Duration
+}
+
 // GravityKeeper defines the expected gravity keeper methods
 type GravityKeeper interface {
 	SetOutgoingTx(ctx sdk.Context, outgoing gravitytypes.OutgoingTx)
@@ -43,6 +65,7 @@ type GravityKeeper interface {
 	GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) common.Address
 	GetEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address) sdk.AccAddress
 	SetOrchestratorValidatorAddress(ctx sdk.Context, val sdk.ValAddress, orchAddr sdk.AccAddress)
+	GetLastObservedEthereumBlockHeight(ctx sdk.Context) gravitytypes.LatestEthereumBlockHeight
 }
 
 type PubsubKeeper interface {
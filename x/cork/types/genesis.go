@@ -1,15 +1,32 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const DefaultParamspace = ModuleName
 
 // DefaultGenesisState get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:            DefaultParams(),
-		CellarIds:         CellarIDSet{},
-		InvalidationNonce: 0,
-		ScheduledCorks:    []*ScheduledCork{},
-		CorkResults:       []*CorkResult{},
+		Params:                   DefaultParams(),
+		CellarIds:                CellarIDSet{},
+		InvalidationNonce:        0,
+		ScheduledCorks:           []*ScheduledCork{},
+		CorkResults:              []*CorkResult{},
+		CellarVoteThresholds:     []CellarVoteThreshold{},
+		ValidatorCorkCounts:      []ValidatorCorkCount{},
+		CorkContractCalls:        []CorkContractCall{},
+		CellarSelectorAllowlists: []CellarSelectorAllowlist{},
+		PausedCellarIds:          []string{},
+		CorkCommitments:          []CorkCommitment{},
+		CorkParticipations:       []CorkParticipation{},
+		ValidatorParticipations:  []ValidatorParticipation{},
+		ValidatorMissedCorks:     []ValidatorMissedCorks{},
+		GovernanceScheduledCorks: []GovernanceScheduledCork{},
+		CellarAbis:               []CellarABI{},
+		ManagedCellars:           []ManagedCellar{},
 	}
 }
 
@@ -23,6 +40,21 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	cellarIDs := make(map[common.Address]bool, len(gs.CellarIds.Ids))
+	for _, id := range gs.CellarIds.Ids {
+		cellarIDs[common.HexToAddress(id)] = true
+	}
+
+	for _, cellar := range gs.ManagedCellars {
+		if err := cellar.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !cellarIDs[common.HexToAddress(cellar.CellarId)] {
+			return errorsmod.Wrapf(ErrUnmanagedCellarAddress, "record for cellar %s not in cellar IDs", cellar.CellarId)
+		}
+	}
+
 	for _, scheduledCork := range gs.ScheduledCorks {
 		if err := scheduledCork.ValidateBasic(); err != nil {
 			return err
@@ -35,5 +67,71 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, threshold := range gs.CellarVoteThresholds {
+		if err := threshold.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, corkCount := range gs.ValidatorCorkCounts {
+		if err := corkCount.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, contractCall := range gs.CorkContractCalls {
+		if err := contractCall.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, allowlist := range gs.CellarSelectorAllowlists {
+		if err := allowlist.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range gs.PausedCellarIds {
+		if !common.IsHexAddress(id) {
+			return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", id)
+		}
+	}
+
+	for _, commitment := range gs.CorkCommitments {
+		if err := commitment.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, participation := range gs.CorkParticipations {
+		if err := participation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, participation := range gs.ValidatorParticipations {
+		if err := participation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, missed := range gs.ValidatorMissedCorks {
+		if err := missed.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, cork := range gs.GovernanceScheduledCorks {
+		if err := cork.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, cellarABI := range gs.CellarAbis {
+		if err := cellarABI.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -26,11 +26,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - all cork state that must be provided at genesis
 type GenesisState struct {
-	Params            Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	CellarIds         CellarIDSet      `protobuf:"bytes,2,opt,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids"`
-	InvalidationNonce uint64           `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	ScheduledCorks    []*ScheduledCork `protobuf:"bytes,4,rep,name=scheduled_corks,json=scheduledCorks,proto3" json:"scheduled_corks,omitempty"`
-	CorkResults       []*CorkResult    `protobuf:"bytes,5,rep,name=cork_results,json=corkResults,proto3" json:"cork_results,omitempty"`
+	Params                   Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	CellarIds                CellarIDSet               `protobuf:"bytes,2,opt,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids"`
+	InvalidationNonce        uint64                    `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	ScheduledCorks           []*ScheduledCork          `protobuf:"bytes,4,rep,name=scheduled_corks,json=scheduledCorks,proto3" json:"scheduled_corks,omitempty"`
+	CorkResults              []*CorkResult             `protobuf:"bytes,5,rep,name=cork_results,json=corkResults,proto3" json:"cork_results,omitempty"`
+	CellarVoteThresholds     []CellarVoteThreshold     `protobuf:"bytes,6,rep,name=cellar_vote_thresholds,json=cellarVoteThresholds,proto3" json:"cellar_vote_thresholds"`
+	ValidatorCorkCounts      []ValidatorCorkCount      `protobuf:"bytes,7,rep,name=validator_cork_counts,json=validatorCorkCounts,proto3" json:"validator_cork_counts"`
+	CorkContractCalls        []CorkContractCall        `protobuf:"bytes,8,rep,name=cork_contract_calls,json=corkContractCalls,proto3" json:"cork_contract_calls"`
+	CellarSelectorAllowlists []CellarSelectorAllowlist `protobuf:"bytes,9,rep,name=cellar_selector_allowlists,json=cellarSelectorAllowlists,proto3" json:"cellar_selector_allowlists"`
+	PausedCellarIds          []string                  `protobuf:"bytes,10,rep,name=paused_cellar_ids,json=pausedCellarIds,proto3" json:"paused_cellar_ids,omitempty"`
+	CorkCommitments          []CorkCommitment          `protobuf:"bytes,11,rep,name=cork_commitments,json=corkCommitments,proto3" json:"cork_commitments"`
+	CorkParticipations       []CorkParticipation       `protobuf:"bytes,12,rep,name=cork_participations,json=corkParticipations,proto3" json:"cork_participations"`
+	ValidatorParticipations  []ValidatorParticipation  `protobuf:"bytes,13,rep,name=validator_participations,json=validatorParticipations,proto3" json:"validator_participations"`
+	ValidatorMissedCorks     []ValidatorMissedCorks    `protobuf:"bytes,14,rep,name=validator_missed_corks,json=validatorMissedCorks,proto3" json:"validator_missed_corks"`
+	GovernanceScheduledCorks []GovernanceScheduledCork `protobuf:"bytes,15,rep,name=governance_scheduled_corks,json=governanceScheduledCorks,proto3" json:"governance_scheduled_corks"`
+	CellarAbis               []CellarABI               `protobuf:"bytes,16,rep,name=cellar_abis,json=cellarAbis,proto3" json:"cellar_abis"`
+	// records of the managed cellars listed in cellar_ids, cellars without a record are added with an empty one
+	ManagedCellars []ManagedCellar `protobuf:"bytes,17,rep,name=managed_cellars,json=managedCellars,proto3" json:"managed_cellars"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -101,12 +114,127 @@ func (m *GenesisState) GetCorkResults() []*CorkResult {
 	return nil
 }
 
+func (m *GenesisState) GetCellarVoteThresholds() []CellarVoteThreshold {
+	if m != nil {
+		return m.CellarVoteThresholds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorCorkCounts() []ValidatorCorkCount {
+	if m != nil {
+		return m.ValidatorCorkCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorkContractCalls() []CorkContractCall {
+	if m != nil {
+		return m.CorkContractCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCellarSelectorAllowlists() []CellarSelectorAllowlist {
+	if m != nil {
+		return m.CellarSelectorAllowlists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedCellarIds() []string {
+	if m != nil {
+		return m.PausedCellarIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorkCommitments() []CorkCommitment {
+	if m != nil {
+		return m.CorkCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorkParticipations() []CorkParticipation {
+	if m != nil {
+		return m.CorkParticipations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorParticipations() []ValidatorParticipation {
+	if m != nil {
+		return m.ValidatorParticipations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorMissedCorks() []ValidatorMissedCorks {
+	if m != nil {
+		return m.ValidatorMissedCorks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceScheduledCorks() []GovernanceScheduledCork {
+	if m != nil {
+		return m.GovernanceScheduledCorks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCellarAbis() []CellarABI {
+	if m != nil {
+		return m.CellarAbis
+	}
+	return nil
+}
+
+func (m *GenesisState) GetManagedCellars() []ManagedCellar {
+	if m != nil {
+		return m.ManagedCellars
+	}
+	return nil
+}
+
 // Params cork parameters
 type Params struct {
-	// Deprecated
-	// VoteThreshold defines the percentage of bonded stake required to vote for a scheduled cork to be approved
+	// VoteThreshold defines the percentage of bonded stake required to vote for a scheduled cork to be approved,
+	// unless a cellar specific threshold has been set by governance
 	VoteThreshold        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold" yaml:"vote_threshold"`
 	MaxCorksPerValidator uint64                                 `protobuf:"varint,2,opt,name=max_corks_per_validator,json=maxCorksPerValidator,proto3" json:"max_corks_per_validator,omitempty"`
+	// BatchTargetAddress is the multicall contract whose approved corks are aggregated into a single
+	// multicall(bytes[]) contract call each block. Batching is disabled when empty.
+	BatchTargetAddress string `protobuf:"bytes,3,opt,name=batch_target_address,json=batchTargetAddress,proto3" json:"batch_target_address,omitempty" yaml:"batch_target_address"`
+	// MaxBatchSize is the maximum number of corks aggregated into a single batched contract call
+	MaxBatchSize uint64 `protobuf:"varint,4,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty" yaml:"max_batch_size"`
+	// CorkResultRetentionBlocks is the number of blocks a cork result is kept in state before it is pruned.
+	// Pruning is disabled when zero.
+	CorkResultRetentionBlocks uint64 `protobuf:"varint,5,opt,name=cork_result_retention_blocks,json=corkResultRetentionBlocks,proto3" json:"cork_result_retention_blocks,omitempty" yaml:"cork_result_retention_blocks"`
+	// ArchivePrunedCorkResults enables emitting an event containing each cork result before it is pruned
+	ArchivePrunedCorkResults bool `protobuf:"varint,6,opt,name=archive_pruned_cork_results,json=archivePrunedCorkResults,proto3" json:"archive_pruned_cork_results,omitempty" yaml:"archive_pruned_cork_results"`
+	// PauseGuardian is an account appointed by governance that may pause and resume cellars without a proposal.
+	// There is no guardian when empty.
+	PauseGuardian string `protobuf:"bytes,7,opt,name=pause_guardian,json=pauseGuardian,proto3" json:"pause_guardian,omitempty" yaml:"pause_guardian"`
+	// CommitRevealEnabled requires validators to commit to their cork votes before revealing them for every cellar
+	CommitRevealEnabled bool `protobuf:"varint,8,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty" yaml:"commit_reveal_enabled"`
+	// CommitRevealCellarIds requires commit-reveal voting for individual cellars when it isn't enabled globally
+	CommitRevealCellarIds []string `protobuf:"bytes,9,rep,name=commit_reveal_cellar_ids,json=commitRevealCellarIds,proto3" json:"commit_reveal_cellar_ids,omitempty" yaml:"commit_reveal_cellar_ids"`
+	// RevealWindowBlocks is the number of blocks before a cork's scheduled height during which commitments are
+	// revealed. Commitments must be submitted before the window opens.
+	RevealWindowBlocks uint64 `protobuf:"varint,10,opt,name=reveal_window_blocks,json=revealWindowBlocks,proto3" json:"reveal_window_blocks,omitempty" yaml:"reveal_window_blocks"`
+	// ParticipationWindow is the number of tally rounds validators' cork vote participation is tracked over.
+	// Participation tracking is disabled when zero.
+	ParticipationWindow uint64 `protobuf:"varint,11,opt,name=participation_window,json=participationWindow,proto3" json:"participation_window,omitempty" yaml:"participation_window"`
+	// MinParticipationRate is the fraction of tally rounds in a full window a validator must vote in to avoid being
+	// reported, and jailed if participation jailing is enabled
+	MinParticipationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=min_participation_rate,json=minParticipationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_participation_rate" yaml:"min_participation_rate"`
+	// ParticipationJailingEnabled jails validators whose participation rate falls below the minimum. Jailed validators
+	// can unjail once the slashing module's downtime jail duration has elapsed
+	ParticipationJailingEnabled bool `protobuf:"varint,13,opt,name=participation_jailing_enabled,json=participationJailingEnabled,proto3" json:"participation_jailing_enabled,omitempty" yaml:"participation_jailing_enabled"`
+	// ParticipationSlashFraction is the fraction of stake slashed when a validator is jailed for low participation
+	ParticipationSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=participation_slash_fraction,json=participationSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation_slash_fraction" yaml:"participation_slash_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -149,6 +277,76 @@ func (m *Params) GetMaxCorksPerValidator() uint64 {
 	return 0
 }
 
+func (m *Params) GetBatchTargetAddress() string {
+	if m != nil {
+		return m.BatchTargetAddress
+	}
+	return ""
+}
+
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetCorkResultRetentionBlocks() uint64 {
+	if m != nil {
+		return m.CorkResultRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetArchivePrunedCorkResults() bool {
+	if m != nil {
+		return m.ArchivePrunedCorkResults
+	}
+	return false
+}
+
+func (m *Params) GetPauseGuardian() string {
+	if m != nil {
+		return m.PauseGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
+func (m *Params) GetCommitRevealCellarIds() []string {
+	if m != nil {
+		return m.CommitRevealCellarIds
+	}
+	return nil
+}
+
+func (m *Params) GetRevealWindowBlocks() uint64 {
+	if m != nil {
+		return m.RevealWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationWindow() uint64 {
+	if m != nil {
+		return m.ParticipationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationJailingEnabled() bool {
+	if m != nil {
+		return m.ParticipationJailingEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cork.v2.GenesisState")
 	proto.RegisterType((*Params)(nil), "cork.v2.Params")
@@ -157,35 +355,80 @@ func init() {
 func init() { proto.RegisterFile("cork/v2/genesis.proto", fileDescriptor_41a8de26c4f93490) }
 
 var fileDescriptor_41a8de26c4f93490 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x36, 0x04, 0x75, 0x53, 0x5a, 0xb1, 0xa4, 0x60, 0xf5, 0xe0, 0x44, 0x39, 0xa0,
-	0x1c, 0x88, 0x2d, 0x05, 0x01, 0x82, 0x0b, 0x52, 0x5a, 0x51, 0xf5, 0x82, 0x2a, 0x07, 0x71, 0xe0,
-	0x62, 0x6d, 0xed, 0x91, 0x63, 0xb2, 0xeb, 0xb5, 0x76, 0x36, 0x56, 0xf2, 0x16, 0x3c, 0x02, 0x6f,
-	0xc0, 0x6b, 0xf4, 0xd8, 0x23, 0xe2, 0x10, 0xa1, 0xe4, 0x0d, 0x78, 0x02, 0xb4, 0x6b, 0x27, 0x84,
-	0xd3, 0xce, 0xfe, 0xdf, 0xfc, 0xb3, 0x33, 0xa3, 0x25, 0x67, 0xb1, 0x54, 0xb3, 0xa0, 0x1c, 0x05,
-	0x29, 0xe4, 0x80, 0x19, 0xfa, 0x85, 0x92, 0x5a, 0xd2, 0x87, 0x46, 0xf6, 0xcb, 0xd1, 0x39, 0xdd,
-	0x72, 0x2b, 0x58, 0x78, 0xde, 0x49, 0x65, 0x2a, 0x6d, 0x18, 0x98, 0xa8, 0x52, 0xfb, 0xdf, 0x0f,
-	0xc8, 0xf1, 0x55, 0x55, 0x64, 0xa2, 0x99, 0x06, 0x3a, 0x24, 0xad, 0x82, 0x29, 0x26, 0xd0, 0x75,
-	0x7a, 0xce, 0xa0, 0x3d, 0x3a, 0xf5, 0xeb, 0xa2, 0xfe, 0x8d, 0x95, 0xc7, 0xcd, 0xbb, 0x55, 0xb7,
-	0x11, 0xd6, 0x49, 0xf4, 0x2d, 0x21, 0x31, 0x70, 0xce, 0x54, 0x94, 0x25, 0xe8, 0x1e, 0x58, 0x4b,
-	0x67, 0x67, 0xb9, 0xb0, 0xe8, 0xfa, 0x72, 0x02, 0xba, 0xf6, 0x1d, 0x55, 0xd9, 0xd7, 0x09, 0xd2,
-	0x21, 0xa1, 0x59, 0x5e, 0x32, 0x9e, 0x25, 0x4c, 0x67, 0x32, 0x8f, 0x72, 0x99, 0xc7, 0xe0, 0x1e,
-	0xf6, 0x9c, 0x41, 0x33, 0x7c, 0xbc, 0x4f, 0x3e, 0x1a, 0x40, 0xdf, 0x93, 0x53, 0x8c, 0xa7, 0x90,
-	0xcc, 0x39, 0x24, 0x91, 0x79, 0x00, 0xdd, 0x66, 0xef, 0x70, 0xd0, 0x1e, 0x3d, 0xdd, 0x3d, 0x37,
-	0xd9, 0xf2, 0x0b, 0xa9, 0x66, 0xe1, 0x09, 0xee, 0x5f, 0x91, 0xbe, 0x26, 0xc7, 0x26, 0x31, 0x52,
-	0x80, 0x73, 0xae, 0xd1, 0x7d, 0x60, 0xdd, 0x4f, 0xfe, 0x35, 0x6b, 0x4c, 0x96, 0x85, 0xed, 0x78,
-	0x17, 0x63, 0xff, 0x87, 0x43, 0x5a, 0xd5, 0xec, 0x34, 0x27, 0x27, 0xa5, 0xd4, 0x10, 0xe9, 0xa9,
-	0x02, 0x9c, 0x4a, 0x9e, 0xd8, 0x25, 0x1d, 0x8d, 0xaf, 0xcc, 0x6c, 0xbf, 0x56, 0xdd, 0xe7, 0x69,
-	0xa6, 0xa7, 0xf3, 0x5b, 0x3f, 0x96, 0x22, 0x88, 0x25, 0x0a, 0x89, 0xf5, 0x31, 0xc4, 0x64, 0x16,
-	0xe8, 0x65, 0x01, 0xe8, 0x5f, 0x42, 0xfc, 0x67, 0xd5, 0x3d, 0x5b, 0x32, 0xc1, 0xdf, 0xf5, 0xff,
-	0xaf, 0xd6, 0x0f, 0x1f, 0x19, 0xe1, 0xd3, 0xf6, 0x4e, 0x5f, 0x91, 0x67, 0x82, 0x2d, 0xaa, 0x69,
-	0xa3, 0x02, 0x54, 0x54, 0x2f, 0x45, 0x2a, 0xbb, 0xea, 0x66, 0xd8, 0x11, 0x6c, 0x61, 0xa7, 0xbb,
-	0x01, 0xf5, 0x79, 0xcb, 0xc6, 0x1f, 0xee, 0xd6, 0x9e, 0x73, 0xbf, 0xf6, 0x9c, 0xdf, 0x6b, 0xcf,
-	0xf9, 0xb6, 0xf1, 0x1a, 0xf7, 0x1b, 0xaf, 0xf1, 0x73, 0xe3, 0x35, 0xbe, 0xbc, 0xd8, 0x6b, 0xb0,
-	0x80, 0x34, 0x5d, 0x7e, 0x2d, 0x03, 0x94, 0x42, 0x00, 0xcf, 0x40, 0x05, 0xe5, 0x9b, 0x60, 0x61,
-	0xbf, 0x4c, 0xd5, 0xea, 0x6d, 0xcb, 0xfe, 0x91, 0x97, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0x7a, 0x15, 0x00, 0x6f, 0x02, 0x00, 0x00,
+	// 1161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x26, 0x85, 0x02, 0x99, 0x40, 0xb2, 0x0c, 0x01, 0xcc, 0xaf, 0x38, 0x32, 0xd5, 0x36, 0xaa,
+	0x4a, 0x22, 0x51, 0xb5, 0x55, 0x7b, 0xd9, 0x92, 0xec, 0x2e, 0xa5, 0xd2, 0x76, 0x61, 0xc2, 0x6e,
+	0x7f, 0xa8, 0x92, 0x3b, 0xb1, 0xa7, 0xce, 0x2c, 0xfe, 0x11, 0x79, 0x1c, 0x03, 0x7b, 0xef, 0xbd,
+	0xb7, 0xfe, 0x3d, 0xbd, 0xed, 0x71, 0x8f, 0x55, 0x0f, 0x51, 0x05, 0xff, 0x41, 0x0e, 0x3d, 0x57,
+	0x33, 0x1e, 0x3b, 0xb6, 0xb1, 0x56, 0xda, 0x53, 0xec, 0xf7, 0x7d, 0xf3, 0xcd, 0x7b, 0xf3, 0xde,
+	0x7c, 0x31, 0xd8, 0x30, 0x3c, 0xff, 0xb2, 0x13, 0x1e, 0x75, 0x2c, 0xe2, 0x12, 0x46, 0x59, 0x7b,
+	0xe4, 0x7b, 0x81, 0x07, 0x97, 0x78, 0xb8, 0x1d, 0x1e, 0xed, 0xc0, 0x18, 0x17, 0x01, 0x01, 0xee,
+	0xd4, 0x2d, 0xcf, 0xf2, 0xc4, 0x63, 0x87, 0x3f, 0x45, 0x51, 0xed, 0xbf, 0x32, 0x58, 0x39, 0x89,
+	0x44, 0xfa, 0x01, 0x0e, 0x08, 0x3c, 0x04, 0x8b, 0x23, 0xec, 0x63, 0x87, 0x29, 0xa5, 0x66, 0xa9,
+	0x55, 0x39, 0xaa, 0xb5, 0xa5, 0x68, 0xfb, 0x4c, 0x84, 0xbb, 0x0b, 0x6f, 0x26, 0xea, 0x1c, 0x92,
+	0x24, 0xf8, 0x15, 0x00, 0x06, 0xb1, 0x6d, 0xec, 0xeb, 0xd4, 0x64, 0xca, 0x07, 0x62, 0x49, 0x3d,
+	0x59, 0xd2, 0x13, 0xd0, 0xe9, 0xe3, 0x3e, 0x09, 0xe4, 0xba, 0x72, 0xc4, 0x3e, 0x35, 0x19, 0x3c,
+	0x04, 0x90, 0xba, 0x21, 0xb6, 0xa9, 0x89, 0x03, 0xea, 0xb9, 0xba, 0xeb, 0xb9, 0x06, 0x51, 0xe6,
+	0x9b, 0xa5, 0xd6, 0x02, 0x5a, 0x4b, 0x23, 0xdf, 0x73, 0x00, 0x3e, 0x02, 0x35, 0x66, 0x0c, 0x89,
+	0x39, 0xb6, 0x89, 0xa9, 0xf3, 0x0d, 0x98, 0xb2, 0xd0, 0x9c, 0x6f, 0x55, 0x8e, 0x36, 0x93, 0xed,
+	0xfa, 0x31, 0xde, 0xf3, 0xfc, 0x4b, 0x54, 0x65, 0xe9, 0x57, 0x06, 0xbf, 0x00, 0x2b, 0x9c, 0xa8,
+	0xfb, 0x84, 0x8d, 0xed, 0x80, 0x29, 0x1f, 0x8a, 0xd5, 0xeb, 0xb3, 0x64, 0xf9, 0x22, 0x81, 0xa1,
+	0x8a, 0x91, 0x3c, 0x33, 0xf8, 0x23, 0xd8, 0x94, 0x25, 0x86, 0x5e, 0x40, 0xf4, 0x60, 0xe8, 0x13,
+	0x36, 0xf4, 0x6c, 0x93, 0x29, 0x8b, 0x42, 0x61, 0x2f, 0x57, 0xee, 0x4b, 0x2f, 0x20, 0x17, 0x31,
+	0x49, 0x96, 0x5d, 0x37, 0xee, 0x43, 0x0c, 0xbe, 0x00, 0x1b, 0xb2, 0x4a, 0xcf, 0x17, 0x25, 0xe9,
+	0x86, 0x37, 0x76, 0x03, 0xa6, 0x2c, 0x09, 0xe1, 0xdd, 0x44, 0xf8, 0x65, 0xcc, 0xe2, 0x39, 0xf6,
+	0x38, 0x47, 0xea, 0xae, 0x87, 0xf7, 0x10, 0x06, 0x9f, 0x83, 0x75, 0x29, 0xe6, 0x06, 0x3e, 0x36,
+	0x02, 0xdd, 0xc0, 0xb6, 0xcd, 0x94, 0x65, 0x21, 0xba, 0x9d, 0xa9, 0xb7, 0x27, 0x29, 0x3d, 0x6c,
+	0xdb, 0x52, 0x72, 0xcd, 0xc8, 0xc5, 0x19, 0x34, 0xc1, 0x8e, 0x3c, 0x01, 0x46, 0x6c, 0x62, 0xf0,
+	0x6c, 0xb1, 0x6d, 0x7b, 0x57, 0x36, 0x65, 0x01, 0x53, 0xca, 0x42, 0xb7, 0x99, 0x3b, 0x85, 0xbe,
+	0x64, 0x1e, 0xc7, 0x44, 0x29, 0xaf, 0x18, 0xc5, 0x30, 0x83, 0x9f, 0x80, 0xb5, 0x11, 0x1e, 0x33,
+	0xde, 0xdd, 0xd9, 0x44, 0x81, 0xe6, 0x7c, 0xab, 0x8c, 0x6a, 0x11, 0xd0, 0x4b, 0x66, 0xe7, 0x5b,
+	0xf0, 0x40, 0x96, 0xe8, 0x38, 0x34, 0x70, 0x08, 0x3f, 0xb4, 0x8a, 0xc8, 0x63, 0x2b, 0x57, 0x5f,
+	0x8c, 0xcb, 0xed, 0x6b, 0x46, 0x26, 0xca, 0xe0, 0xb9, 0x3c, 0xac, 0x11, 0xf6, 0x03, 0x6a, 0xd0,
+	0x91, 0x98, 0x38, 0xa6, 0xac, 0x08, 0xb1, 0x9d, 0x8c, 0xd8, 0x59, 0x9a, 0x22, 0xf5, 0xa0, 0x91,
+	0x07, 0x18, 0xfc, 0x15, 0x28, 0xb3, 0xb6, 0xe6, 0x74, 0x57, 0x85, 0xae, 0x7a, 0xbf, 0xb3, 0x45,
+	0xe2, 0x5b, 0x61, 0x21, 0xca, 0xe0, 0x4f, 0x60, 0x73, 0xb6, 0x83, 0x43, 0x19, 0x4b, 0xae, 0x44,
+	0x55, 0xe8, 0xef, 0xdf, 0xd7, 0x7f, 0x26, 0x58, 0xe2, 0x26, 0xc4, 0x33, 0x19, 0x16, 0x60, 0xbc,
+	0xd7, 0x96, 0x17, 0x12, 0xdf, 0xc5, 0xae, 0x41, 0xf4, 0xfc, 0x8d, 0xab, 0xe5, 0x7a, 0x7d, 0x92,
+	0x50, 0x33, 0x77, 0x2f, 0xee, 0xb5, 0x55, 0x0c, 0x73, 0xdb, 0xa8, 0xc8, 0x26, 0xe3, 0x01, 0x65,
+	0xca, 0x03, 0x21, 0x0b, 0x73, 0x23, 0x74, 0xdc, 0x3d, 0x95, 0x42, 0xd2, 0x63, 0x8e, 0x07, 0x94,
+	0xc1, 0x27, 0xa0, 0xe6, 0x60, 0x17, 0x5b, 0xc9, 0x9c, 0x30, 0x65, 0x2d, 0xe7, 0x03, 0xcf, 0x22,
+	0x3c, 0x52, 0x91, 0x12, 0x55, 0x27, 0x1d, 0x64, 0xda, 0x5f, 0x00, 0x2c, 0x46, 0x8e, 0x06, 0x5d,
+	0x50, 0xcd, 0xde, 0x6c, 0x61, 0x7d, 0xe5, 0xee, 0x09, 0x5f, 0xf8, 0xcf, 0x44, 0x7d, 0x68, 0xd1,
+	0x60, 0x38, 0x1e, 0xb4, 0x0d, 0xcf, 0xe9, 0x18, 0x1e, 0x73, 0x3c, 0x26, 0x7f, 0x0e, 0x99, 0x79,
+	0xd9, 0x09, 0x6e, 0x46, 0x84, 0xb5, 0x1f, 0x13, 0x63, 0x3a, 0x51, 0x37, 0x6e, 0xb0, 0x63, 0x7f,
+	0xad, 0x65, 0xd5, 0x34, 0xb4, 0x1a, 0xa6, 0xef, 0x3d, 0xfc, 0x1c, 0x6c, 0x39, 0xf8, 0x3a, 0x3a,
+	0x51, 0x7d, 0x44, 0x7c, 0x3d, 0x69, 0x84, 0x30, 0xd0, 0x05, 0x54, 0x77, 0xf0, 0xb5, 0x38, 0xa7,
+	0x33, 0xe2, 0x27, 0x0d, 0x84, 0xe7, 0xa0, 0x3e, 0xc0, 0x81, 0x31, 0xd4, 0x03, 0xec, 0x5b, 0x24,
+	0xd0, 0xb1, 0x69, 0xfa, 0x84, 0x31, 0xe1, 0x98, 0xe5, 0xae, 0x3a, 0x9d, 0xa8, 0xbb, 0xd1, 0xf6,
+	0x45, 0x2c, 0x0d, 0x41, 0x11, 0xbe, 0x10, 0xd1, 0xe3, 0x28, 0x08, 0x1f, 0x81, 0x2a, 0xcf, 0x24,
+	0x5a, 0xc0, 0xe8, 0x6b, 0xa2, 0x2c, 0xf0, 0x04, 0xba, 0xdb, 0xb3, 0x5a, 0xb2, 0xb8, 0x86, 0x56,
+	0x1c, 0x7c, 0xdd, 0xe5, 0xef, 0x7d, 0xfa, 0x9a, 0xc0, 0x21, 0xd8, 0x4b, 0x79, 0xaa, 0xee, 0x93,
+	0x80, 0xb8, 0xc2, 0xcc, 0x07, 0xb6, 0x67, 0x5c, 0x72, 0x8f, 0xe5, 0x72, 0x1f, 0x4f, 0x27, 0xea,
+	0x41, 0x24, 0xf7, 0x2e, 0xb6, 0x86, 0xb6, 0x67, 0xbe, 0x8b, 0x62, 0xb0, 0x2b, 0x30, 0x48, 0xc0,
+	0x2e, 0xf6, 0x8d, 0x21, 0x0d, 0x89, 0x3e, 0xf2, 0xc7, 0xae, 0x9c, 0xc8, 0xc4, 0xcc, 0x17, 0x9b,
+	0xa5, 0xd6, 0x72, 0xf7, 0xe1, 0x74, 0xa2, 0x6a, 0xd1, 0x46, 0xef, 0x20, 0x6b, 0x48, 0x91, 0xe8,
+	0x99, 0x00, 0x7b, 0x29, 0xb3, 0xff, 0x06, 0x54, 0x85, 0xd7, 0xe8, 0xd6, 0x18, 0xfb, 0x26, 0xc5,
+	0xae, 0xb2, 0x24, 0x8e, 0x37, 0x75, 0x22, 0x59, 0x5c, 0x43, 0xab, 0x22, 0x70, 0x22, 0xdf, 0xe1,
+	0x05, 0xff, 0x77, 0xe6, 0xfe, 0xa2, 0xfb, 0x24, 0x24, 0xd8, 0xd6, 0x89, 0x8b, 0x07, 0x36, 0x31,
+	0x95, 0x65, 0x91, 0x62, 0x73, 0x3a, 0x51, 0xf7, 0xe2, 0xb3, 0x28, 0xa0, 0x69, 0x68, 0x3d, 0x8a,
+	0x23, 0x11, 0x7e, 0x12, 0x45, 0xe1, 0x2f, 0x40, 0xc9, 0xd2, 0x53, 0x1e, 0xc9, 0x0d, 0xb8, 0xdc,
+	0x3d, 0x98, 0x4e, 0x54, 0xb5, 0x48, 0x78, 0xc6, 0xd4, 0xd0, 0x46, 0x5a, 0x7b, 0x66, 0xa7, 0xe7,
+	0xa0, 0x2e, 0xc9, 0x57, 0xd4, 0x35, 0xbd, 0xab, 0xb8, 0x7d, 0x40, 0xb4, 0x2f, 0x35, 0x5a, 0x45,
+	0x2c, 0x0d, 0xc1, 0x28, 0xfc, 0x83, 0x88, 0xca, 0x7e, 0x21, 0x50, 0xcf, 0x58, 0x9f, 0x5c, 0xa3,
+	0x54, 0xf2, 0x92, 0x45, 0x2c, 0x0d, 0xad, 0x67, 0xc2, 0x91, 0x32, 0xfc, 0xbd, 0x04, 0x36, 0x1d,
+	0xea, 0x66, 0x3d, 0x55, 0xf7, 0x71, 0x40, 0x94, 0x15, 0xd1, 0xa5, 0xe7, 0xef, 0x7d, 0x63, 0xf7,
+	0xe5, 0x94, 0x17, 0xaa, 0x6a, 0xa8, 0xee, 0x50, 0x37, 0xe3, 0xbd, 0x88, 0x7f, 0x23, 0xd9, 0x60,
+	0x3f, 0x4b, 0x7e, 0x85, 0xa9, 0x4d, 0x5d, 0x2b, 0x69, 0xf5, 0xaa, 0x68, 0x75, 0x6b, 0x3a, 0x51,
+	0x3f, 0x2a, 0x2a, 0x32, 0x47, 0xd7, 0xd0, 0x6e, 0x06, 0xff, 0x2e, 0x82, 0xe3, 0xd6, 0xff, 0x59,
+	0x02, 0x7b, 0xd9, 0xf5, 0xcc, 0xc6, 0x6c, 0xa8, 0xff, 0xc6, 0xff, 0xa0, 0xa9, 0xe7, 0x2a, 0x55,
+	0x51, 0xfb, 0x8b, 0xf7, 0xae, 0xfd, 0xa0, 0x28, 0xb7, 0xac, 0xb6, 0x86, 0x76, 0x32, 0x70, 0x9f,
+	0xa3, 0x4f, 0x25, 0xd8, 0x7d, 0xfa, 0xe6, 0xb6, 0x51, 0x7a, 0x7b, 0xdb, 0x28, 0xfd, 0x7b, 0xdb,
+	0x28, 0xfd, 0x71, 0xd7, 0x98, 0x7b, 0x7b, 0xd7, 0x98, 0xfb, 0xfb, 0xae, 0x31, 0xf7, 0xf3, 0xa7,
+	0xa9, 0x24, 0x46, 0xc4, 0xb2, 0x6e, 0x5e, 0x85, 0x1d, 0xe6, 0x39, 0x0e, 0xb1, 0x29, 0xf1, 0x3b,
+	0xe1, 0x97, 0x9d, 0x6b, 0xf1, 0x69, 0x1a, 0xa5, 0x33, 0x58, 0x14, 0xdf, 0xa2, 0x9f, 0xfd, 0x3f,
+	0x00, 0xda, 0xb2, 0x71, 0x79, 0xd7, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -208,6 +451,173 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManagedCellars) > 0 {
+		for iNdEx := len(m.ManagedCellars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedCellars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.CellarAbis) > 0 {
+		for iNdEx := len(m.CellarAbis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarAbis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GovernanceScheduledCorks) > 0 {
+		for iNdEx := len(m.GovernanceScheduledCorks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceScheduledCorks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ValidatorMissedCorks) > 0 {
+		for iNdEx := len(m.ValidatorMissedCorks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorMissedCorks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorParticipations) > 0 {
+		for iNdEx := len(m.ValidatorParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CorkParticipations) > 0 {
+		for iNdEx := len(m.CorkParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CorkCommitments) > 0 {
+		for iNdEx := len(m.CorkCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PausedCellarIds) > 0 {
+		for iNdEx := len(m.PausedCellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedCellarIds[iNdEx])
+			copy(dAtA[i:], m.PausedCellarIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedCellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CellarSelectorAllowlists) > 0 {
+		for iNdEx := len(m.CellarSelectorAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarSelectorAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CorkContractCalls) > 0 {
+		for iNdEx := len(m.CorkContractCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkContractCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValidatorCorkCounts) > 0 {
+		for iNdEx := len(m.ValidatorCorkCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCorkCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CellarVoteThresholds) > 0 {
+		for iNdEx := len(m.CellarVoteThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarVoteThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.CorkResults) > 0 {
 		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -284,42 +694,135 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MaxCorksPerValidator != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCorksPerValidator))
+	{
+		size := m.ParticipationSlashFraction.Size()
+		i -= size
+		if _, err := m.ParticipationSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.ParticipationJailingEnabled {
 		i--
-		dAtA[i] = 0x10
+		if m.ParticipationJailingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
 	{
-		size := m.VoteThreshold.Size()
+		size := m.MinParticipationRate.Size()
 		i -= size
-		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinParticipationRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	dAtA[i] = 0x62
+	if m.ParticipationWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ParticipationWindow))
+		i--
+		dAtA[i] = 0x58
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RevealWindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RevealWindowBlocks))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
+	if len(m.CommitRevealCellarIds) > 0 {
+		for iNdEx := len(m.CommitRevealCellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommitRevealCellarIds[iNdEx])
+			copy(dAtA[i:], m.CommitRevealCellarIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CommitRevealCellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PauseGuardian) > 0 {
+		i -= len(m.PauseGuardian)
+		copy(dAtA[i:], m.PauseGuardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PauseGuardian)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ArchivePrunedCorkResults {
+		i--
+		if m.ArchivePrunedCorkResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CorkResultRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CorkResultRetentionBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BatchTargetAddress) > 0 {
+		i -= len(m.BatchTargetAddress)
+		copy(dAtA[i:], m.BatchTargetAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BatchTargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxCorksPerValidator != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCorksPerValidator))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.CellarIds.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -338,6 +841,78 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CellarVoteThresholds) > 0 {
+		for _, e := range m.CellarVoteThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCorkCounts) > 0 {
+		for _, e := range m.ValidatorCorkCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorkContractCalls) > 0 {
+		for _, e := range m.CorkContractCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CellarSelectorAllowlists) > 0 {
+		for _, e := range m.CellarSelectorAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedCellarIds) > 0 {
+		for _, s := range m.PausedCellarIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorkCommitments) > 0 {
+		for _, e := range m.CorkCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorkParticipations) > 0 {
+		for _, e := range m.CorkParticipations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorParticipations) > 0 {
+		for _, e := range m.ValidatorParticipations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorMissedCorks) > 0 {
+		for _, e := range m.ValidatorMissedCorks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceScheduledCorks) > 0 {
+		for _, e := range m.GovernanceScheduledCorks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CellarAbis) > 0 {
+		for _, e := range m.CellarAbis {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ManagedCellars) > 0 {
+		for _, e := range m.ManagedCellars {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -352,16 +927,664 @@ func (m *Params) Size() (n int) {
 	if m.MaxCorksPerValidator != 0 {
 		n += 1 + sovGenesis(uint64(m.MaxCorksPerValidator))
 	}
-	return n
-}
+	l = len(m.BatchTargetAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBatchSize))
+	}
+	if m.CorkResultRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.CorkResultRetentionBlocks))
+	}
+	if m.ArchivePrunedCorkResults {
+		n += 2
+	}
+	l = len(m.PauseGuardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CommitRevealEnabled {
+		n += 2
+	}
+	if len(m.CommitRevealCellarIds) > 0 {
+		for _, s := range m.CommitRevealCellarIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RevealWindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.RevealWindowBlocks))
+	}
+	if m.ParticipationWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.ParticipationWindow))
+	}
+	l = m.MinParticipationRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ParticipationJailingEnabled {
+		n += 2
+	}
+	l = m.ParticipationSlashFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCorks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCorks = append(m.ScheduledCorks, &ScheduledCork{})
+			if err := m.ScheduledCorks[len(m.ScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkResults = append(m.CorkResults, &CorkResult{})
+			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarVoteThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarVoteThresholds = append(m.CellarVoteThresholds, CellarVoteThreshold{})
+			if err := m.CellarVoteThresholds[len(m.CellarVoteThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCorkCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCorkCounts = append(m.ValidatorCorkCounts, ValidatorCorkCount{})
+			if err := m.ValidatorCorkCounts[len(m.ValidatorCorkCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkContractCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkContractCalls = append(m.CorkContractCalls, CorkContractCall{})
+			if err := m.CorkContractCalls[len(m.CorkContractCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarSelectorAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarSelectorAllowlists = append(m.CellarSelectorAllowlists, CellarSelectorAllowlist{})
+			if err := m.CellarSelectorAllowlists[len(m.CellarSelectorAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedCellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedCellarIds = append(m.PausedCellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkCommitments = append(m.CorkCommitments, CorkCommitment{})
+			if err := m.CorkCommitments[len(m.CorkCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkParticipations = append(m.CorkParticipations, CorkParticipation{})
+			if err := m.CorkParticipations[len(m.CorkParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorParticipations = append(m.ValidatorParticipations, ValidatorParticipation{})
+			if err := m.ValidatorParticipations[len(m.ValidatorParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMissedCorks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorMissedCorks = append(m.ValidatorMissedCorks, ValidatorMissedCorks{})
+			if err := m.ValidatorMissedCorks[len(m.ValidatorMissedCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceScheduledCorks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceScheduledCorks = append(m.GovernanceScheduledCorks, GovernanceScheduledCork{})
+			if err := m.GovernanceScheduledCorks[len(m.GovernanceScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarAbis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarAbis = append(m.CellarAbis, CellarABI{})
+			if err := m.CellarAbis[len(m.CellarAbis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedCellars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedCellars = append(m.ManagedCellars, ManagedCellar{})
+			if err := m.ManagedCellars[len(m.ManagedCellars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -384,17 +1607,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -404,30 +1627,50 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCorksPerValidator", wireType)
+			}
+			m.MaxCorksPerValidator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCorksPerValidator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTargetAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -437,30 +1680,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BatchTargetAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			m.InvalidationNonce = 0
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -470,16 +1712,55 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResultRetentionBlocks", wireType)
+			}
+			m.CorkResultRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorkResultRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivePrunedCorkResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArchivePrunedCorkResults = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCorks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGuardian", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -489,31 +1770,49 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledCorks = append(m.ScheduledCorks, &ScheduledCork{})
-			if err := m.ScheduledCorks[len(m.ScheduledCorks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PauseGuardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealEnabled = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealCellarIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -523,79 +1822,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorkResults = append(m.CorkResults, &CorkResult{})
-			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitRevealCellarIds = append(m.CommitRevealCellarIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindowBlocks", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.RevealWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindow", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ParticipationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipationRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -623,15 +1908,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCorksPerValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationJailingEnabled", wireType)
 			}
-			m.MaxCorksPerValidator = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -641,11 +1926,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCorksPerValidator |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ParticipationJailingEnabled = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,7 +35,7 @@ const (
 	// LatestInvalidationNonceKey - <prefix> -> uint64(latestNonce)
 	LatestInvalidationNonceKey
 
-	// CellarIDsKey - <prefix> -> []string
+	// CellarIDsKey - <prefix> -> CellarIDSet -- replaced by CellarKeyPrefix as of cork v4, left to preserve ID values
 	CellarIDsKey
 
 	// ScheduledCorkKeyPrefix - <prefix><block_height><val_address><address> -> <cork>
@@ -48,11 +49,52 @@ const (
 
 	// ValidatorCorkCountKey - <prefix><val_address> -> uint64(count)
 	ValidatorCorkCountKey
-)
 
-func MakeCellarIDsKey() []byte {
-	return []byte{CellarIDsKey}
-}
+	// CellarVoteThresholdKeyPrefix - <prefix><cellar_address> -> sdk.Dec
+	CellarVoteThresholdKeyPrefix
+
+	// CorkContractCallKeyPrefix - <prefix><invalidation_nonce> -> CorkContractCall
+	CorkContractCallKeyPrefix
+
+	// ScheduledCorkIDIndexPrefix - <prefix><id><block_height><val_address><address> -> []byte{}
+	ScheduledCorkIDIndexPrefix
+
+	// CellarSelectorAllowlistKeyPrefix - <prefix><cellar_address> -> CellarSelectorAllowlist
+	CellarSelectorAllowlistKeyPrefix
+
+	// PausedCellarKeyPrefix - <prefix><cellar_address> -> []byte{}
+	PausedCellarKeyPrefix
+
+	// CorkCommitmentKeyPrefix - <prefix><block_height><val_address><cellar_address> -> CorkCommitment
+	CorkCommitmentKeyPrefix
+
+	// CorkParticipationKeyPrefix - <prefix><id> -> CorkParticipation
+	CorkParticipationKeyPrefix
+
+	// ValidatorParticipationKeyPrefix - <prefix><val_address> -> ValidatorParticipation
+	ValidatorParticipationKeyPrefix
+
+	// ValidatorMissedCorkKeyPrefix - <prefix><len(val_address)><val_address><index> -> []byte{}
+	ValidatorMissedCorkKeyPrefix
+
+	// GovernanceScheduledCorkKeyPrefix - <prefix><block_height><id> -> GovernanceScheduledCork
+	GovernanceScheduledCorkKeyPrefix
+
+	// CellarABIKeyPrefix - <prefix><cellar_address> -> CellarABI
+	CellarABIKeyPrefix
+
+	// CellarKeyPrefix - <prefix><cellar_address> -> ManagedCellar
+	CellarKeyPrefix
+
+	// PendingCellarProposalKeyPrefix - <prefix><cellar_address> -> []byte{}
+	PendingCellarProposalKeyPrefix
+
+	// CorkResultHeightIndexPrefix - <prefix><block_height><id> -> []byte{}
+	CorkResultHeightIndexPrefix
+
+	// CorkContractCallHeightIndexPrefix - <prefix><block_height><invalidation_nonce> -> []byte{}
+	CorkContractCallHeightIndexPrefix
+)
 
 func GetScheduledCorkKeyPrefix() []byte {
 	return []byte{ScheduledCorkKeyPrefix}
@@ -67,6 +109,20 @@ func GetScheduledCorkKey(blockHeight uint64, id []byte, val sdk.ValAddress, cont
 	return bytes.Join([][]byte{GetScheduledCorkKeyPrefix(), blockHeightBytes, id, val.Bytes(), contract.Bytes()}, []byte{})
 }
 
+func GetScheduledCorkKeyByValidatorPrefix(blockHeight uint64, id []byte, val sdk.ValAddress) []byte {
+	blockHeightBytes := sdk.Uint64ToBigEndian(blockHeight)
+	return bytes.Join([][]byte{GetScheduledCorkKeyPrefix(), blockHeightBytes, id, val.Bytes()}, []byte{})
+}
+
+func GetScheduledCorkIDIndexPrefix(id []byte) []byte {
+	return append([]byte{ScheduledCorkIDIndexPrefix}, id...)
+}
+
+func GetScheduledCorkIDIndexKey(blockHeight uint64, id []byte, val sdk.ValAddress, contract common.Address) []byte {
+	blockHeightBytes := sdk.Uint64ToBigEndian(blockHeight)
+	return bytes.Join([][]byte{GetScheduledCorkIDIndexPrefix(id), blockHeightBytes, val.Bytes(), contract.Bytes()}, []byte{})
+}
+
 func GetCorkResultPrefix() []byte {
 	return []byte{CorkResultPrefix}
 }
@@ -75,6 +131,128 @@ func GetCorkResultKey(id []byte) []byte {
 	return append(GetCorkResultPrefix(), id...)
 }
 
+func GetCorkResultHeightIndexPrefix() []byte {
+	return []byte{CorkResultHeightIndexPrefix}
+}
+
+func GetCorkResultHeightIndexKey(blockHeight uint64, id []byte) []byte {
+	return bytes.Join([][]byte{GetCorkResultHeightIndexPrefix(), sdk.Uint64ToBigEndian(blockHeight), id}, []byte{})
+}
+
 func GetValidatorCorkCountKey(val sdk.ValAddress) []byte {
 	return append([]byte{ValidatorCorkCountKey}, val.Bytes()...)
 }
+
+func GetCellarVoteThresholdKeyPrefix() []byte {
+	return []byte{CellarVoteThresholdKeyPrefix}
+}
+
+func GetCellarVoteThresholdKey(cellar common.Address) []byte {
+	return append(GetCellarVoteThresholdKeyPrefix(), cellar.Bytes()...)
+}
+
+func GetCorkContractCallKeyPrefix() []byte {
+	return []byte{CorkContractCallKeyPrefix}
+}
+
+func GetCorkContractCallKey(invalidationNonce uint64) []byte {
+	return append(GetCorkContractCallKeyPrefix(), sdk.Uint64ToBigEndian(invalidationNonce)...)
+}
+
+func GetCorkContractCallHeightIndexPrefix() []byte {
+	return []byte{CorkContractCallHeightIndexPrefix}
+}
+
+func GetCorkContractCallHeightIndexKey(blockHeight uint64, invalidationNonce uint64) []byte {
+	return bytes.Join([][]byte{GetCorkContractCallHeightIndexPrefix(), sdk.Uint64ToBigEndian(blockHeight), sdk.Uint64ToBigEndian(invalidationNonce)}, []byte{})
+}
+
+func GetCellarSelectorAllowlistKeyPrefix() []byte {
+	return []byte{CellarSelectorAllowlistKeyPrefix}
+}
+
+func GetCellarSelectorAllowlistKey(cellar common.Address) []byte {
+	return append(GetCellarSelectorAllowlistKeyPrefix(), cellar.Bytes()...)
+}
+
+func GetPausedCellarKeyPrefix() []byte {
+	return []byte{PausedCellarKeyPrefix}
+}
+
+func GetPausedCellarKey(cellar common.Address) []byte {
+	return append(GetPausedCellarKeyPrefix(), cellar.Bytes()...)
+}
+
+func GetCorkCommitmentKeyPrefix() []byte {
+	return []byte{CorkCommitmentKeyPrefix}
+}
+
+func GetCorkCommitmentKeyByBlockHeightPrefix(blockHeight uint64) []byte {
+	return append(GetCorkCommitmentKeyPrefix(), sdk.Uint64ToBigEndian(blockHeight)...)
+}
+
+func GetCorkCommitmentKey(blockHeight uint64, val sdk.ValAddress, cellar common.Address) []byte {
+	return bytes.Join([][]byte{GetCorkCommitmentKeyByBlockHeightPrefix(blockHeight), val.Bytes(), cellar.Bytes()}, []byte{})
+}
+
+func GetCorkParticipationKeyPrefix() []byte {
+	return []byte{CorkParticipationKeyPrefix}
+}
+
+func GetCorkParticipationKey(id []byte) []byte {
+	return append(GetCorkParticipationKeyPrefix(), id...)
+}
+
+func GetValidatorParticipationKeyPrefix() []byte {
+	return []byte{ValidatorParticipationKeyPrefix}
+}
+
+func GetValidatorParticipationKey(val sdk.ValAddress) []byte {
+	return append(GetValidatorParticipationKeyPrefix(), val.Bytes()...)
+}
+
+// GetValidatorMissedCorkKeyPrefix length prefixes the validator address so that one validator's missed corks are
+// never iterated over as part of another's
+func GetValidatorMissedCorkKeyPrefix(val sdk.ValAddress) []byte {
+	return append([]byte{ValidatorMissedCorkKeyPrefix}, address.MustLengthPrefix(val.Bytes())...)
+}
+
+func GetValidatorMissedCorkKey(val sdk.ValAddress, index uint64) []byte {
+	return append(GetValidatorMissedCorkKeyPrefix(val), sdk.Uint64ToBigEndian(index)...)
+}
+
+func GetGovernanceScheduledCorkKeyPrefix() []byte {
+	return []byte{GovernanceScheduledCorkKeyPrefix}
+}
+
+func GetGovernanceScheduledCorkKeyByBlockHeightPrefix(blockHeight uint64) []byte {
+	return append(GetGovernanceScheduledCorkKeyPrefix(), sdk.Uint64ToBigEndian(blockHeight)...)
+}
+
+func GetGovernanceScheduledCorkKey(blockHeight uint64, id []byte) []byte {
+	return append(GetGovernanceScheduledCorkKeyByBlockHeightPrefix(blockHeight), id...)
+}
+
+func GetCellarABIKeyPrefix() []byte {
+	return []byte{CellarABIKeyPrefix}
+}
+
+func GetCellarABIKey(cellar common.Address) []byte {
+	return append(GetCellarABIKeyPrefix(), cellar.Bytes()...)
+}
+
+func GetCellarKeyPrefix() []byte {
+	return []byte{CellarKeyPrefix}
+}
+
+func GetCellarKey(cellar common.Address) []byte {
+	return append(GetCellarKeyPrefix(), cellar.Bytes()...)
+}
+
+func GetPendingCellarProposalKeyPrefix() []byte {
+	return []byte{PendingCellarProposalKeyPrefix}
+}
+
+func GetPendingCellarProposalKey(cellar common.Address) []byte {
+	return append(GetPendingCellarProposalKeyPrefix(), cellar.Bytes()...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -11,11 +12,23 @@ import (
 
 var (
 	_ sdk.Msg = &MsgScheduleCorkRequest{}
+	_ sdk.Msg = &MsgCancelScheduledCorkRequest{}
+	_ sdk.Msg = &MsgSetCellarPausedRequest{}
+	_ sdk.Msg = &MsgCommitCorkRequest{}
+	_ sdk.Msg = &MsgRevealCorkRequest{}
 )
 
 const (
-	TypeMsgSubmitCorkRequest   = "cork_submit"
-	TypeMsgScheduleCorkRequest = "cork_schedule"
+	TypeMsgSubmitCorkRequest          = "cork_submit"
+	TypeMsgScheduleCorkRequest        = "cork_schedule"
+	TypeMsgCancelScheduledCorkRequest = "cork_cancel_scheduled"
+	TypeMsgSetCellarPausedRequest     = "cork_set_cellar_paused"
+	TypeMsgCommitCorkRequest          = "cork_commit"
+	TypeMsgRevealCorkRequest          = "cork_reveal"
+
+	// MinCommitmentSaltLength is the minimum number of salt bytes, so that commitments to predictable corks can't be
+	// brute forced before they are revealed
+	MinCommitmentSaltLength = 16
 )
 
 ////////////////////////////
@@ -71,3 +84,274 @@ func (m *MsgScheduleCorkRequest) MustGetSigner() sdk.AccAddress {
 	}
 	return addr
 }
+
+///////////////////////////////////
+// MsgCancelScheduledCorkRequest //
+///////////////////////////////////
+
+// NewMsgCancelScheduledCorkRequest return a new MsgCancelScheduledCorkRequest
+func NewMsgCancelScheduledCorkRequest(id []byte, blockHeight uint64, signer sdk.AccAddress) (*MsgCancelScheduledCorkRequest, error) {
+	return &MsgCancelScheduledCorkRequest{
+		Id:          hex.EncodeToString(id),
+		BlockHeight: blockHeight,
+		Signer:      signer.String(),
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgCancelScheduledCorkRequest) Route() string { return ModuleName }
+
+// Type implements sdk.Msg
+func (m *MsgCancelScheduledCorkRequest) Type() string { return TypeMsgCancelScheduledCorkRequest }
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCancelScheduledCorkRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if m.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if _, err := m.GetIDBytes(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCancelScheduledCorkRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCancelScheduledCorkRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.MustGetSigner()}
+}
+
+// MustGetSigner returns the signer address
+func (m *MsgCancelScheduledCorkRequest) MustGetSigner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetIDBytes decodes the hex encoded cork ID
+func (m *MsgCancelScheduledCorkRequest) GetIDBytes() ([]byte, error) {
+	id, err := hex.DecodeString(m.Id)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCorkID, "%s", m.Id)
+	}
+
+	if len(id) != 32 {
+		return nil, errorsmod.Wrapf(ErrInvalidCorkID, "%s is not a keccak256 hash", m.Id)
+	}
+
+	return id, nil
+}
+
+///////////////////////////////
+// MsgSetCellarPausedRequest //
+///////////////////////////////
+
+// NewMsgSetCellarPausedRequest return a new MsgSetCellarPausedRequest
+func NewMsgSetCellarPausedRequest(cellar common.Address, paused bool, signer sdk.AccAddress) (*MsgSetCellarPausedRequest, error) {
+	return &MsgSetCellarPausedRequest{
+		CellarId: cellar.String(),
+		Paused:   paused,
+		Signer:   signer.String(),
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgSetCellarPausedRequest) Route() string { return ModuleName }
+
+// Type implements sdk.Msg
+func (m *MsgSetCellarPausedRequest) Type() string { return TypeMsgSetCellarPausedRequest }
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetCellarPausedRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetCellarPausedRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetCellarPausedRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.MustGetSigner()}
+}
+
+// MustGetSigner returns the signer address
+func (m *MsgSetCellarPausedRequest) MustGetSigner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+//////////////////////////
+// MsgCommitCorkRequest //
+//////////////////////////
+
+// NewMsgCommitCorkRequest return a new MsgCommitCorkRequest
+func NewMsgCommitCorkRequest(cellar common.Address, blockHeight uint64, commitment []byte, signer sdk.AccAddress) (*MsgCommitCorkRequest, error) {
+	return &MsgCommitCorkRequest{
+		CellarId:    cellar.String(),
+		BlockHeight: blockHeight,
+		Commitment:  hex.EncodeToString(commitment),
+		Signer:      signer.String(),
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgCommitCorkRequest) Route() string { return ModuleName }
+
+// Type implements sdk.Msg
+func (m *MsgCommitCorkRequest) Type() string { return TypeMsgCommitCorkRequest }
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCommitCorkRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	if m.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if _, err := m.GetCommitmentBytes(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCommitCorkRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCommitCorkRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.MustGetSigner()}
+}
+
+// MustGetSigner returns the signer address
+func (m *MsgCommitCorkRequest) MustGetSigner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetCommitmentBytes decodes the hex encoded commitment
+func (m *MsgCommitCorkRequest) GetCommitmentBytes() ([]byte, error) {
+	commitment, err := hex.DecodeString(m.Commitment)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCommitment, "%s", m.Commitment)
+	}
+
+	if len(commitment) != 32 {
+		return nil, errorsmod.Wrapf(ErrInvalidCommitment, "%s is not a keccak256 hash", m.Commitment)
+	}
+
+	return commitment, nil
+}
+
+//////////////////////////
+// MsgRevealCorkRequest //
+//////////////////////////
+
+// NewMsgRevealCorkRequest return a new MsgRevealCorkRequest
+func NewMsgRevealCorkRequest(body []byte, address common.Address, blockHeight uint64, salt []byte, signer sdk.AccAddress) (*MsgRevealCorkRequest, error) {
+	return &MsgRevealCorkRequest{
+		Cork: &Cork{
+			EncodedContractCall:   body,
+			TargetContractAddress: address.String(),
+		},
+		BlockHeight: blockHeight,
+		Salt:        hex.EncodeToString(salt),
+		Signer:      signer.String(),
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevealCorkRequest) Route() string { return ModuleName }
+
+// Type implements sdk.Msg
+func (m *MsgRevealCorkRequest) Type() string { return TypeMsgRevealCorkRequest }
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevealCorkRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if m.BlockHeight == 0 {
+		return fmt.Errorf("block height must be non-zero")
+	}
+
+	if m.Cork == nil {
+		return ErrEmptyContractCall
+	}
+
+	if _, err := m.GetSaltBytes(); err != nil {
+		return err
+	}
+
+	return m.Cork.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevealCorkRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevealCorkRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.MustGetSigner()}
+}
+
+// MustGetSigner returns the signer address
+func (m *MsgRevealCorkRequest) MustGetSigner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetSaltBytes decodes the hex encoded salt
+func (m *MsgRevealCorkRequest) GetSaltBytes() ([]byte, error) {
+	salt, err := hex.DecodeString(m.Salt)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCommitment, "invalid salt %s", m.Salt)
+	}
+
+	if len(salt) < MinCommitmentSaltLength {
+		return nil, errorsmod.Wrapf(ErrInvalidCommitment, "salt must be at least %d bytes", MinCommitmentSaltLength)
+	}
+
+	return salt, nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelScheduledCorkRequestValidation(t *testing.T) {
+	signer := sdk.AccAddress("orchestrator________").String()
+	validID := hex.EncodeToString(make([]byte, 32))
+
+	testCases := []struct {
+		name    string
+		msg     MsgCancelScheduledCorkRequest
+		expPass bool
+		err     error
+	}{
+		{
+			name:    "Happy path",
+			msg:     MsgCancelScheduledCorkRequest{Id: validID, BlockHeight: 1, Signer: signer},
+			expPass: true,
+			err:     nil,
+		},
+		{
+			name:    "Zero block height",
+			msg:     MsgCancelScheduledCorkRequest{Id: validID, BlockHeight: 0, Signer: signer},
+			expPass: false,
+			err:     fmt.Errorf("block height must be non-zero"),
+		},
+		{
+			name:    "Non-hex ID",
+			msg:     MsgCancelScheduledCorkRequest{Id: "zz", BlockHeight: 1, Signer: signer},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidCorkID, "zz"),
+		},
+		{
+			name:    "Short ID",
+			msg:     MsgCancelScheduledCorkRequest{Id: strings.Repeat("0", 62), BlockHeight: 1, Signer: signer},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidCorkID, "%s is not a keccak256 hash", strings.Repeat("0", 62)),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Equal(t, tc.err.Error(), err.Error(), tc.name)
+		}
+	}
+}
@@ -6,13 +6,26 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Parameter keys
 var (
-	KeyVotePeriod           = []byte("voteperiod")
-	KeyVoteThreshold        = []byte("votethreshold")
-	KeyMaxCorksPerValidator = []byte("maxcorkspervalidator")
+	KeyVotePeriod                  = []byte("voteperiod")
+	KeyVoteThreshold               = []byte("votethreshold")
+	KeyMaxCorksPerValidator        = []byte("maxcorkspervalidator")
+	KeyBatchTargetAddress          = []byte("batchtargetaddress")
+	KeyMaxBatchSize                = []byte("maxbatchsize")
+	KeyCorkResultRetentionBlocks   = []byte("corkresultretentionblocks")
+	KeyArchivePrunedCorkResults    = []byte("archiveprunedcorkresults")
+	KeyPauseGuardian               = []byte("pauseguardian")
+	KeyCommitRevealEnabled         = []byte("commitrevealenabled")
+	KeyCommitRevealCellarIDs       = []byte("commitrevealcellarids")
+	KeyRevealWindowBlocks          = []byte("revealwindowblocks")
+	KeyParticipationWindow         = []byte("participationwindow")
+	KeyMinParticipationRate        = []byte("minparticipationrate")
+	KeyParticipationJailingEnabled = []byte("participationjailingenabled")
+	KeyParticipationSlashFraction  = []byte("participationslashfraction")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -25,9 +38,21 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default oracle parameters
 func DefaultParams() Params {
 	return Params{
-		// Deprecated
 		VoteThreshold:        sdk.NewDecWithPrec(67, 2), // 67%
 		MaxCorksPerValidator: 1000,
+		BatchTargetAddress:   "",
+		MaxBatchSize:         10,
+		// roughly one week of blocks
+		CorkResultRetentionBlocks: 100800,
+		ArchivePrunedCorkResults:  true,
+		PauseGuardian:             "",
+		CommitRevealEnabled:       false,
+		RevealWindowBlocks:        10,
+		ParticipationWindow:       100,
+		MinParticipationRate:      sdk.NewDecWithPrec(5, 1), // 50%
+		// jailing for low participation is opt in
+		ParticipationJailingEnabled: false,
+		ParticipationSlashFraction:  sdk.ZeroDec(),
 	}
 }
 
@@ -36,6 +61,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyVoteThreshold, &p.VoteThreshold, validateVoteThreshold),
 		paramtypes.NewParamSetPair(KeyMaxCorksPerValidator, &p.MaxCorksPerValidator, validateMaxCorksPerValidator),
+		paramtypes.NewParamSetPair(KeyBatchTargetAddress, &p.BatchTargetAddress, validateBatchTargetAddress),
+		paramtypes.NewParamSetPair(KeyMaxBatchSize, &p.MaxBatchSize, validateMaxBatchSize),
+		paramtypes.NewParamSetPair(KeyCorkResultRetentionBlocks, &p.CorkResultRetentionBlocks, validateCorkResultRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyArchivePrunedCorkResults, &p.ArchivePrunedCorkResults, validateArchivePrunedCorkResults),
+		paramtypes.NewParamSetPair(KeyPauseGuardian, &p.PauseGuardian, validatePauseGuardian),
+		paramtypes.NewParamSetPair(KeyCommitRevealEnabled, &p.CommitRevealEnabled, validateCommitRevealEnabled),
+		paramtypes.NewParamSetPair(KeyCommitRevealCellarIDs, &p.CommitRevealCellarIds, validateCommitRevealCellarIDs),
+		paramtypes.NewParamSetPair(KeyRevealWindowBlocks, &p.RevealWindowBlocks, validateRevealWindowBlocks),
+		paramtypes.NewParamSetPair(KeyParticipationWindow, &p.ParticipationWindow, validateParticipationWindow),
+		paramtypes.NewParamSetPair(KeyMinParticipationRate, &p.MinParticipationRate, validateMinParticipationRate),
+		paramtypes.NewParamSetPair(KeyParticipationJailingEnabled, &p.ParticipationJailingEnabled, validateParticipationJailingEnabled),
+		paramtypes.NewParamSetPair(KeyParticipationSlashFraction, &p.ParticipationSlashFraction, validateParticipationSlashFraction),
 	}
 }
 
@@ -47,6 +84,42 @@ func (p *Params) ValidateBasic() error {
 	if err := validateMaxCorksPerValidator(p.MaxCorksPerValidator); err != nil {
 		return err
 	}
+	if err := validateBatchTargetAddress(p.BatchTargetAddress); err != nil {
+		return err
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return err
+	}
+	if err := validateCorkResultRetentionBlocks(p.CorkResultRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateArchivePrunedCorkResults(p.ArchivePrunedCorkResults); err != nil {
+		return err
+	}
+	if err := validatePauseGuardian(p.PauseGuardian); err != nil {
+		return err
+	}
+	if err := validateCommitRevealEnabled(p.CommitRevealEnabled); err != nil {
+		return err
+	}
+	if err := validateCommitRevealCellarIDs(p.CommitRevealCellarIds); err != nil {
+		return err
+	}
+	if err := validateRevealWindowBlocks(p.RevealWindowBlocks); err != nil {
+		return err
+	}
+	if err := validateParticipationWindow(p.ParticipationWindow); err != nil {
+		return err
+	}
+	if err := validateMinParticipationRate(p.MinParticipationRate); err != nil {
+		return err
+	}
+	if err := validateParticipationJailingEnabled(p.ParticipationJailingEnabled); err != nil {
+		return err
+	}
+	if err := validateParticipationSlashFraction(p.ParticipationSlashFraction); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -79,3 +152,172 @@ func validateMaxCorksPerValidator(i interface{}) error {
 
 	return nil
 }
+
+func validateBatchTargetAddress(i interface{}) error {
+	batchTargetAddress, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty disables batching
+	if batchTargetAddress != "" && !common.IsHexAddress(batchTargetAddress) {
+		return fmt.Errorf("invalid batch target address: %s", batchTargetAddress)
+	}
+
+	return nil
+}
+
+func validateMaxBatchSize(i interface{}) error {
+	maxBatchSize, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxBatchSize == 0 {
+		return errors.New("max batch size cannot be 0")
+	}
+
+	return nil
+}
+
+func validateCorkResultRetentionBlocks(i interface{}) error {
+	// zero disables pruning
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateArchivePrunedCorkResults(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePauseGuardian(i interface{}) error {
+	pauseGuardian, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty means there is no guardian
+	if pauseGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(pauseGuardian); err != nil {
+			return fmt.Errorf("invalid pause guardian address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateCommitRevealEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCommitRevealCellarIDs(i interface{}) error {
+	cellarIDs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(cellarIDs))
+	for _, cellarID := range cellarIDs {
+		if !common.IsHexAddress(cellarID) {
+			return fmt.Errorf("invalid commit-reveal cellar ID: %s", cellarID)
+		}
+
+		cellar := common.HexToAddress(cellarID)
+		if seen[cellar] {
+			return fmt.Errorf("duplicate commit-reveal cellar ID: %s", cellarID)
+		}
+		seen[cellar] = true
+	}
+
+	return nil
+}
+
+func validateRevealWindowBlocks(i interface{}) error {
+	revealWindowBlocks, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if revealWindowBlocks == 0 {
+		return errors.New("reveal window blocks cannot be 0")
+	}
+
+	return nil
+}
+
+func validateParticipationWindow(i interface{}) error {
+	// zero disables participation tracking
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinParticipationRate(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if rate.IsNil() {
+		return errors.New("min participation rate cannot be nil")
+	}
+
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("min participation rate must be within the 0 - 1 range, got: %s", rate)
+	}
+
+	return nil
+}
+
+func validateParticipationJailingEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateParticipationSlashFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction.IsNil() {
+		return errors.New("participation slash fraction cannot be nil")
+	}
+
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("participation slash fraction must be within the 0 - 1 range, got: %s", fraction)
+	}
+
+	return nil
+}
+
+// RequiresCommitReveal returns true if votes for corks targeting the cellar must be committed before they are revealed
+func (p Params) RequiresCommitReveal(cellar common.Address) bool {
+	if p.CommitRevealEnabled {
+		return true
+	}
+
+	for _, cellarID := range p.CommitRevealCellarIds {
+		if common.HexToAddress(cellarID) == cellar {
+			return true
+		}
+	}
+
+	return false
+}
@@ -30,6 +30,69 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid batch target address",
+			params: Params{
+				VoteThreshold:        sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator: 1000,
+				BatchTargetAddress:   "0x01",
+				MaxBatchSize:         10,
+			},
+			expPass: false,
+		},
+		{
+			name: "zero max batch size",
+			params: Params{
+				VoteThreshold:        sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator: 1000,
+				BatchTargetAddress:   "0xc0ffee254729296a45a3885639AC7E10F9d54979",
+				MaxBatchSize:         0,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pause guardian",
+			params: Params{
+				VoteThreshold:        sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator: 1000,
+				MaxBatchSize:         10,
+				PauseGuardian:        "notanaddress",
+			},
+			expPass: false,
+		},
+		{
+			name: "zero reveal window",
+			params: Params{
+				VoteThreshold:        sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator: 1000,
+				MaxBatchSize:         10,
+				RevealWindowBlocks:   0,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid commit-reveal cellar ID",
+			params: Params{
+				VoteThreshold:         sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator:  1000,
+				MaxBatchSize:          10,
+				CommitRevealCellarIds: []string{"0x01"},
+				RevealWindowBlocks:    10,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid participation slash fraction",
+			params: Params{
+				VoteThreshold:              sdk.NewDecWithPrec(67, 2),
+				MaxCorksPerValidator:       1000,
+				MaxBatchSize:               10,
+				RevealWindowBlocks:         10,
+				MinParticipationRate:       sdk.NewDecWithPrec(5, 1),
+				ParticipationSlashFraction: sdk.NewDec(2),
+			},
+			expPass: false,
+		},
 		{
 			name: "nil vote threshold",
 			params: Params{
@@ -5,20 +5,31 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 	pubsubtypes "github.com/peggyjv/sommelier/v7/x/pubsub/types"
 )
 
 const (
-	ProposalTypeAddManagedCellarIDs    = "AddManagedCellarIDs"
-	ProposalTypeRemoveManagedCellarIDs = "RemoveManagedCellarIDs"
-	ProposalTypeScheduledCork          = "ScheduledCork"
+	ProposalTypeAddManagedCellarIDs        = "AddManagedCellarIDs"
+	ProposalTypeRemoveManagedCellarIDs     = "RemoveManagedCellarIDs"
+	ProposalTypeScheduledCork              = "ScheduledCork"
+	ProposalTypeSetCellarVoteThreshold     = "SetCellarVoteThreshold"
+	ProposalTypeSetCellarSelectorAllowlist = "SetCellarSelectorAllowlist"
+	ProposalTypeSetCellarPaused            = "SetCellarPaused"
+	ProposalTypeSetCellarABI               = "SetCellarABI"
+	ProposalTypeSetCellarMetadata          = "SetCellarMetadata"
 )
 
 var _ govtypesv1beta1.Content = &AddManagedCellarIDsProposal{}
 var _ govtypesv1beta1.Content = &RemoveManagedCellarIDsProposal{}
 var _ govtypesv1beta1.Content = &ScheduledCorkProposal{}
+var _ govtypesv1beta1.Content = &SetCellarVoteThresholdProposal{}
+var _ govtypesv1beta1.Content = &SetCellarSelectorAllowlistProposal{}
+var _ govtypesv1beta1.Content = &SetCellarPausedProposal{}
+var _ govtypesv1beta1.Content = &SetCellarABIProposal{}
+var _ govtypesv1beta1.Content = &SetCellarMetadataProposal{}
 
 func init() {
 	// The RegisterProposalTypeCodec function was mysteriously removed by in 0.46.0 even though
@@ -36,14 +47,30 @@ func init() {
 
 	govtypesv1beta1.RegisterProposalType(ProposalTypeScheduledCork)
 	govtypesv1beta1.ModuleCdc.RegisterConcrete(&ScheduledCorkProposal{}, "sommelier/ScheduledCorkProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetCellarVoteThreshold)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetCellarVoteThresholdProposal{}, "sommelier/SetCellarVoteThresholdProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetCellarSelectorAllowlist)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetCellarSelectorAllowlistProposal{}, "sommelier/SetCellarSelectorAllowlistProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetCellarPaused)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetCellarPausedProposal{}, "sommelier/SetCellarPausedProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetCellarABI)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetCellarABIProposal{}, "sommelier/SetCellarABIProposal", nil)
+
+	govtypesv1beta1.RegisterProposalType(ProposalTypeSetCellarMetadata)
+	govtypesv1beta1.ModuleCdc.RegisterConcrete(&SetCellarMetadataProposal{}, "sommelier/SetCellarMetadataProposal", nil)
 }
 
-func NewAddManagedCellarIDsProposal(title string, description string, cellarIds *CellarIDSet, publisherDomain string) *AddManagedCellarIDsProposal {
+func NewAddManagedCellarIDsProposal(title string, description string, cellarIds *CellarIDSet, publisherDomain string, cellarMetadata []CellarMetadataEntry) *AddManagedCellarIDsProposal {
 	return &AddManagedCellarIDsProposal{
 		Title:           title,
 		Description:     description,
 		CellarIds:       cellarIds,
 		PublisherDomain: publisherDomain,
+		CellarMetadata:  cellarMetadata,
 	}
 }
 
@@ -68,6 +95,28 @@ func (m *AddManagedCellarIDsProposal) ValidateBasic() error {
 		return err
 	}
 
+	cellarIDs := make(map[common.Address]bool, len(m.CellarIds.Ids))
+	for _, id := range m.CellarIds.Ids {
+		cellarIDs[common.HexToAddress(id)] = true
+	}
+
+	seen := make(map[common.Address]bool, len(m.CellarMetadata))
+	for _, entry := range m.CellarMetadata {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(entry.CellarId)
+		if !cellarIDs[address] {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "cellar %s is not being added", entry.CellarId)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(ErrInvalidCellarMetadata, "duplicate metadata for cellar %s", entry.CellarId)
+		}
+		seen[address] = true
+	}
+
 	return nil
 }
 
@@ -99,13 +148,14 @@ func (m *RemoveManagedCellarIDsProposal) ValidateBasic() error {
 	return nil
 }
 
-func NewScheduledCorkProposal(title string, description string, blockHeight uint64, targetContractAddress string, contractCallProtoJSON string) *ScheduledCorkProposal {
+func NewScheduledCorkProposal(title string, description string, blockHeight uint64, targetContractAddress string, contractCallProtoJSON string, encodedContractCall []byte) *ScheduledCorkProposal {
 	return &ScheduledCorkProposal{
 		Title:                 title,
 		Description:           description,
 		BlockHeight:           blockHeight,
 		TargetContractAddress: targetContractAddress,
 		ContractCallProtoJson: contractCallProtoJSON,
+		EncodedContractCall:   encodedContractCall,
 	}
 }
 
@@ -126,11 +176,11 @@ func (m *ScheduledCorkProposal) ValidateBasic() error {
 		return fmt.Errorf("block height must be non-zero")
 	}
 
-	if len(m.ContractCallProtoJson) == 0 {
+	if len(m.ContractCallProtoJson) == 0 && len(m.EncodedContractCall) == 0 {
 		return errorsmod.Wrapf(ErrInvalidJSON, "cannot have empty contract call")
 	}
 
-	if !json.Valid([]byte(m.ContractCallProtoJson)) {
+	if len(m.ContractCallProtoJson) != 0 && !json.Valid([]byte(m.ContractCallProtoJson)) {
 		return errorsmod.Wrapf(ErrInvalidJSON, "%s", m.ContractCallProtoJson)
 	}
 
@@ -140,3 +190,155 @@ func (m *ScheduledCorkProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewSetCellarVoteThresholdProposal(title string, description string, cellarID string, voteThreshold sdk.Dec) *SetCellarVoteThresholdProposal {
+	return &SetCellarVoteThresholdProposal{
+		Title:         title,
+		Description:   description,
+		CellarId:      cellarID,
+		VoteThreshold: voteThreshold,
+	}
+}
+
+func (m *SetCellarVoteThresholdProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetCellarVoteThresholdProposal) ProposalType() string {
+	return ProposalTypeSetCellarVoteThreshold
+}
+
+func (m *SetCellarVoteThresholdProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	return validateVoteThreshold(m.VoteThreshold)
+}
+
+func NewSetCellarSelectorAllowlistProposal(title string, description string, cellarID string, selectors []string) *SetCellarSelectorAllowlistProposal {
+	return &SetCellarSelectorAllowlistProposal{
+		Title:       title,
+		Description: description,
+		CellarId:    cellarID,
+		Selectors:   selectors,
+	}
+}
+
+func (m *SetCellarSelectorAllowlistProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetCellarSelectorAllowlistProposal) ProposalType() string {
+	return ProposalTypeSetCellarSelectorAllowlist
+}
+
+func (m *SetCellarSelectorAllowlistProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	_, err := NormalizeSelectors(m.Selectors)
+	return err
+}
+
+func NewSetCellarPausedProposal(title string, description string, cellarID string, paused bool) *SetCellarPausedProposal {
+	return &SetCellarPausedProposal{
+		Title:       title,
+		Description: description,
+		CellarId:    cellarID,
+		Paused:      paused,
+	}
+}
+
+func (m *SetCellarPausedProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetCellarPausedProposal) ProposalType() string {
+	return ProposalTypeSetCellarPaused
+}
+
+func (m *SetCellarPausedProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	return nil
+}
+
+func NewSetCellarABIProposal(title string, description string, cellarID string, abi string) *SetCellarABIProposal {
+	return &SetCellarABIProposal{
+		Title:       title,
+		Description: description,
+		CellarId:    cellarID,
+		Abi:         abi,
+	}
+}
+
+func (m *SetCellarABIProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetCellarABIProposal) ProposalType() string {
+	return ProposalTypeSetCellarABI
+}
+
+// ValidateBasic accepts an empty ABI, which removes the cellar's registered ABI
+func (m *SetCellarABIProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	if m.Abi == "" {
+		return nil
+	}
+
+	_, err := ParseABI(m.Abi)
+	return err
+}
+
+func NewSetCellarMetadataProposal(title string, description string, cellarID string, metadata CellarMetadata) *SetCellarMetadataProposal {
+	return &SetCellarMetadataProposal{
+		Title:       title,
+		Description: description,
+		CellarId:    cellarID,
+		Metadata:    metadata,
+	}
+}
+
+func (m *SetCellarMetadataProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetCellarMetadataProposal) ProposalType() string {
+	return ProposalTypeSetCellarMetadata
+}
+
+func (m *SetCellarMetadataProposal) ValidateBasic() error {
+	if err := govtypesv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.CellarId) {
+		return errorsmod.Wrapf(ErrInvalidEthereumAddress, "%s", m.CellarId)
+	}
+
+	return m.Metadata.ValidateBasic()
+}
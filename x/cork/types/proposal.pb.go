@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,8 @@ type AddManagedCellarIDsProposal struct {
 	Description     string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	CellarIds       *CellarIDSet `protobuf:"bytes,3,opt,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
 	PublisherDomain string       `protobuf:"bytes,4,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	// optional metadata for the added cellars, each entry must name a cellar in cellar_ids
+	CellarMetadata []CellarMetadataEntry `protobuf:"bytes,5,rep,name=cellar_metadata,json=cellarMetadata,proto3" json:"cellar_metadata"`
 }
 
 func (m *AddManagedCellarIDsProposal) Reset()         { *m = AddManagedCellarIDsProposal{} }
@@ -90,13 +94,21 @@ func (m *AddManagedCellarIDsProposal) GetPublisherDomain() string {
 	return ""
 }
 
+func (m *AddManagedCellarIDsProposal) GetCellarMetadata() []CellarMetadataEntry {
+	if m != nil {
+		return m.CellarMetadata
+	}
+	return nil
+}
+
 // AddManagedCellarIDsProposalWithDeposit is a specific definition for CLI commands
 type AddManagedCellarIDsProposalWithDeposit struct {
-	Title           string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CellarIds       []string `protobuf:"bytes,3,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
-	PublisherDomain string   `protobuf:"bytes,4,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
-	Deposit         string   `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Title           string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarIds       []string              `protobuf:"bytes,3,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	PublisherDomain string                `protobuf:"bytes,4,opt,name=publisher_domain,json=publisherDomain,proto3" json:"publisher_domain,omitempty"`
+	Deposit         string                `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	CellarMetadata  []CellarMetadataEntry `protobuf:"bytes,6,rep,name=cellar_metadata,json=cellarMetadata,proto3" json:"cellar_metadata"`
 }
 
 func (m *AddManagedCellarIDsProposalWithDeposit) Reset() {
@@ -169,6 +181,13 @@ func (m *AddManagedCellarIDsProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
+func (m *AddManagedCellarIDsProposalWithDeposit) GetCellarMetadata() []CellarMetadataEntry {
+	if m != nil {
+		return m.CellarMetadata
+	}
+	return nil
+}
+
 type RemoveManagedCellarIDsProposal struct {
 	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -324,7 +343,13 @@ type ScheduledCorkProposal struct {
 	// }
 	//
 	// You can use the Steward CLI to generate the required JSON rather than constructing it by hand https://github.com/peggyjv/steward
+	//
+	// When the proposal passes, the cellar call is ABI encoded by the contract call encoder registered for its
+	// cellar type unless encoded_contract_call is set. The proposal fails to execute if no encoder is registered for the
+	// cellar type. If block_height has already passed, the proposal is only a signal for Steward to act on.
 	ContractCallProtoJson string `protobuf:"bytes,5,opt,name=contract_call_proto_json,json=contractCallProtoJson,proto3" json:"contract_call_proto_json,omitempty"`
+	// ABI encoded contract call executed at block_height, optional when contract_call_proto_json can be encoded
+	EncodedContractCall []byte `protobuf:"bytes,6,opt,name=encoded_contract_call,json=encodedContractCall,proto3" json:"encoded_contract_call,omitempty"`
 }
 
 func (m *ScheduledCorkProposal) Reset()         { *m = ScheduledCorkProposal{} }
@@ -395,6 +420,13 @@ func (m *ScheduledCorkProposal) GetContractCallProtoJson() string {
 	return ""
 }
 
+func (m *ScheduledCorkProposal) GetEncodedContractCall() []byte {
+	if m != nil {
+		return m.EncodedContractCall
+	}
+	return nil
+}
+
 // ScheduledCorkProposalWithDeposit is a specific definition for CLI commands
 type ScheduledCorkProposalWithDeposit struct {
 	Title                 string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -403,6 +435,8 @@ type ScheduledCorkProposalWithDeposit struct {
 	TargetContractAddress string `protobuf:"bytes,4,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
 	ContractCallProtoJson string `protobuf:"bytes,5,opt,name=contract_call_proto_json,json=contractCallProtoJson,proto3" json:"contract_call_proto_json,omitempty"`
 	Deposit               string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// 0x prefixed, hex encoded contract call
+	EncodedContractCall string `protobuf:"bytes,7,opt,name=encoded_contract_call,json=encodedContractCall,proto3" json:"encoded_contract_call,omitempty"`
 }
 
 func (m *ScheduledCorkProposalWithDeposit) Reset()         { *m = ScheduledCorkProposalWithDeposit{} }
@@ -480,569 +514,4293 @@ func (m *ScheduledCorkProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AddManagedCellarIDsProposal)(nil), "cork.v2.AddManagedCellarIDsProposal")
-	proto.RegisterType((*AddManagedCellarIDsProposalWithDeposit)(nil), "cork.v2.AddManagedCellarIDsProposalWithDeposit")
-	proto.RegisterType((*RemoveManagedCellarIDsProposal)(nil), "cork.v2.RemoveManagedCellarIDsProposal")
-	proto.RegisterType((*RemoveManagedCellarIDsProposalWithDeposit)(nil), "cork.v2.RemoveManagedCellarIDsProposalWithDeposit")
-	proto.RegisterType((*ScheduledCorkProposal)(nil), "cork.v2.ScheduledCorkProposal")
-	proto.RegisterType((*ScheduledCorkProposalWithDeposit)(nil), "cork.v2.ScheduledCorkProposalWithDeposit")
+func (m *ScheduledCorkProposalWithDeposit) GetEncodedContractCall() string {
+	if m != nil {
+		return m.EncodedContractCall
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("cork/v2/proposal.proto", fileDescriptor_e01dea5e2496e85f) }
-
-var fileDescriptor_e01dea5e2496e85f = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6a, 0x13, 0x41,
-	0x18, 0xce, 0xb4, 0x69, 0x4b, 0x26, 0x82, 0x32, 0x34, 0xba, 0x28, 0x2e, 0x71, 0x0f, 0x92, 0x82,
-	0xec, 0x42, 0x0a, 0xf6, 0x5c, 0x13, 0xc4, 0x0a, 0x42, 0x49, 0x0f, 0x82, 0x97, 0x65, 0x32, 0xf3,
-	0xb3, 0x3b, 0xcd, 0xec, 0xce, 0x30, 0x33, 0x59, 0xec, 0x1b, 0x78, 0x11, 0x7c, 0x00, 0x5f, 0xc3,
-	0x37, 0xf0, 0xe0, 0xb1, 0x47, 0x8f, 0x92, 0xbc, 0x88, 0x74, 0x76, 0x53, 0x52, 0x91, 0x1e, 0xaa,
-	0x94, 0x1e, 0xff, 0xef, 0xfb, 0xf7, 0xdf, 0xef, 0xfb, 0xfe, 0x99, 0xc1, 0x0f, 0x99, 0x32, 0xb3,
-	0xa4, 0x1a, 0x26, 0xda, 0x28, 0xad, 0x2c, 0x95, 0xb1, 0x36, 0xca, 0x29, 0xb2, 0x73, 0x81, 0xc7,
-	0xd5, 0xf0, 0x31, 0x59, 0x35, 0x78, 0xc0, 0x93, 0xd1, 0x37, 0x84, 0x9f, 0x1c, 0x72, 0xfe, 0x8e,
-	0x96, 0x34, 0x03, 0x3e, 0x02, 0x29, 0xa9, 0x39, 0x1a, 0xdb, 0xe3, 0x66, 0x04, 0xd9, 0xc5, 0x5b,
-	0x4e, 0x38, 0x09, 0x01, 0xea, 0xa3, 0x41, 0x67, 0x52, 0x17, 0xa4, 0x8f, 0xbb, 0x1c, 0x2c, 0x33,
-	0x42, 0x3b, 0xa1, 0xca, 0x60, 0xc3, 0x73, 0xeb, 0x10, 0xd9, 0xc7, 0x98, 0xf9, 0x61, 0xa9, 0xe0,
-	0x36, 0xd8, 0xec, 0xa3, 0x41, 0x77, 0xb8, 0x1b, 0x37, 0x4a, 0xe2, 0xd5, 0x7f, 0x4e, 0xc0, 0x4d,
-	0x3a, 0x75, 0xdf, 0x11, 0xb7, 0x64, 0x0f, 0x3f, 0xd0, 0xf3, 0xa9, 0x14, 0x36, 0x07, 0x93, 0x72,
-	0x55, 0x50, 0x51, 0x06, 0x6d, 0x3f, 0xfb, 0xfe, 0x25, 0x3e, 0xf6, 0x70, 0xf4, 0x1d, 0xe1, 0xe7,
-	0xd7, 0xe8, 0x7e, 0x2f, 0x5c, 0x3e, 0x06, 0xad, 0xac, 0x70, 0x37, 0xb6, 0xf0, 0xf4, 0x0f, 0x0b,
-	0x9b, 0x83, 0xce, 0xcd, 0xc4, 0x92, 0x00, 0xef, 0xf0, 0x5a, 0x4c, 0xb0, 0xe5, 0x3b, 0x56, 0x65,
-	0xf4, 0x19, 0xe1, 0x70, 0x02, 0x85, 0xaa, 0xe0, 0x4e, 0x6c, 0x20, 0xfa, 0x8a, 0xf0, 0xde, 0xf5,
-	0x7a, 0x6e, 0x21, 0xd9, 0xb5, 0xb8, 0xda, 0x57, 0xe3, 0x5a, 0x22, 0xdc, 0x3b, 0x61, 0x39, 0xf0,
-	0xb9, 0x04, 0x3e, 0x52, 0x66, 0xf6, 0xcf, 0x29, 0x3d, 0xc3, 0xf7, 0xa6, 0x52, 0xb1, 0x59, 0x9a,
-	0x83, 0xc8, 0x72, 0xe7, 0x73, 0x6a, 0x4f, 0xba, 0x1e, 0x7b, 0xe3, 0x21, 0xf2, 0x12, 0x3f, 0x72,
-	0xd4, 0x64, 0xe0, 0x52, 0xa6, 0x4a, 0x67, 0x28, 0x73, 0x29, 0xe5, 0xdc, 0x80, 0xb5, 0x8d, 0xbc,
-	0x5e, 0x4d, 0x8f, 0x1a, 0xf6, 0xb0, 0x26, 0xc9, 0x01, 0x0e, 0x2e, 0x3f, 0x60, 0x54, 0xca, 0xd4,
-	0xdf, 0xb8, 0xf4, 0xd4, 0xaa, 0xb2, 0x39, 0x06, 0xbd, 0x15, 0x3f, 0xa2, 0x52, 0x1e, 0x5f, 0xb0,
-	0x6f, 0xad, 0x2a, 0xa3, 0x4f, 0x1b, 0xb8, 0xff, 0x57, 0x97, 0xff, 0x23, 0xfb, 0x3b, 0x68, 0x78,
-	0x7d, 0xe1, 0xdb, 0x57, 0x16, 0xfe, 0xea, 0xf5, 0x8f, 0x45, 0x88, 0xce, 0x17, 0x21, 0xfa, 0xb5,
-	0x08, 0xd1, 0x97, 0x65, 0xd8, 0x3a, 0x5f, 0x86, 0xad, 0x9f, 0xcb, 0xb0, 0xf5, 0xe1, 0x45, 0x26,
-	0x5c, 0x3e, 0x9f, 0xc6, 0x4c, 0x15, 0x89, 0x86, 0x2c, 0x3b, 0x3b, 0xad, 0x12, 0xab, 0x8a, 0x02,
-	0xa4, 0x00, 0x93, 0x54, 0x07, 0xc9, 0x47, 0xff, 0xcc, 0x25, 0xee, 0x4c, 0x83, 0x9d, 0x6e, 0x7b,
-	0x29, 0xfb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xd4, 0xbf, 0x65, 0x24, 0x05, 0x00, 0x00,
+type SetCellarVoteThresholdProposal struct {
+	Title         string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId      string                                 `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
 }
 
-func (m *AddManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SetCellarVoteThresholdProposal) Reset()         { *m = SetCellarVoteThresholdProposal{} }
+func (m *SetCellarVoteThresholdProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCellarVoteThresholdProposal) ProtoMessage()    {}
+func (*SetCellarVoteThresholdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{6}
 }
-
-func (m *AddManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetCellarVoteThresholdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *AddManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublisherDomain) > 0 {
-		i -= len(m.PublisherDomain)
-		copy(dAtA[i:], m.PublisherDomain)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CellarIds != nil {
-		{
-			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
+func (m *SetCellarVoteThresholdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarVoteThresholdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetCellarVoteThresholdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarVoteThresholdProposal.Merge(m, src)
+}
+func (m *SetCellarVoteThresholdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarVoteThresholdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarVoteThresholdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarVoteThresholdProposal proto.InternalMessageInfo
+
+func (m *SetCellarVoteThresholdProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetCellarVoteThresholdProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AddManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetCellarVoteThresholdProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AddManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SetCellarVoteThresholdProposalWithDeposit is a specific definition for CLI commands
+type SetCellarVoteThresholdProposalWithDeposit struct {
+	Title         string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId      string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	VoteThreshold string `protobuf:"bytes,4,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	Deposit       string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (m *AddManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PublisherDomain) > 0 {
-		i -= len(m.PublisherDomain)
-		copy(dAtA[i:], m.PublisherDomain)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *SetCellarVoteThresholdProposalWithDeposit) Reset() {
+	*m = SetCellarVoteThresholdProposalWithDeposit{}
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SetCellarVoteThresholdProposalWithDeposit) ProtoMessage() {}
+func (*SetCellarVoteThresholdProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{7}
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarVoteThresholdProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarVoteThresholdProposalWithDeposit.Merge(m, src)
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarVoteThresholdProposalWithDeposit.DiscardUnknown(m)
 }
 
-func (m *RemoveManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetCellarVoteThresholdProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetCellarVoteThresholdProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CellarIds != nil {
-		{
-			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *SetCellarVoteThresholdProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetCellarVoteThresholdProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SetCellarSelectorAllowlistProposal replaces the function selectors validators may call on a cellar.
+// An empty selector list removes the allowlist, allowing any function to be called.
+type SetCellarSelectorAllowlistProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string   `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Selectors   []string `protobuf:"bytes,4,rep,name=selectors,proto3" json:"selectors,omitempty"`
 }
 
-func (m *RemoveManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *SetCellarSelectorAllowlistProposal) Reset()         { *m = SetCellarSelectorAllowlistProposal{} }
+func (m *SetCellarSelectorAllowlistProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCellarSelectorAllowlistProposal) ProtoMessage()    {}
+func (*SetCellarSelectorAllowlistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{8}
+}
+func (m *SetCellarSelectorAllowlistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarSelectorAllowlistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarSelectorAllowlistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetCellarSelectorAllowlistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarSelectorAllowlistProposal.Merge(m, src)
+}
+func (m *SetCellarSelectorAllowlistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarSelectorAllowlistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarSelectorAllowlistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarSelectorAllowlistProposal proto.InternalMessageInfo
+
+func (m *SetCellarSelectorAllowlistProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetCellarSelectorAllowlistProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ScheduledCorkProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetCellarSelectorAllowlistProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ScheduledCorkProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetCellarSelectorAllowlistProposal) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
 }
 
-func (m *ScheduledCorkProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractCallProtoJson) > 0 {
-		i -= len(m.ContractCallProtoJson)
-		copy(dAtA[i:], m.ContractCallProtoJson)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
-		i--
-		dAtA[i] = 0x2a
+// SetCellarSelectorAllowlistProposalWithDeposit is a specific definition for CLI commands
+type SetCellarSelectorAllowlistProposalWithDeposit struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string   `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Selectors   []string `protobuf:"bytes,4,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	Deposit     string   `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) Reset() {
+	*m = SetCellarSelectorAllowlistProposalWithDeposit{}
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SetCellarSelectorAllowlistProposalWithDeposit) ProtoMessage() {}
+func (*SetCellarSelectorAllowlistProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{9}
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarSelectorAllowlistProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarSelectorAllowlistProposalWithDeposit.Merge(m, src)
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarSelectorAllowlistProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarSelectorAllowlistProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ScheduledCorkProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ScheduledCorkProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SetCellarPausedProposal pauses or resumes a cellar. Scheduled corks for a paused cellar are neither
+// accepted nor executed.
+type SetCellarPausedProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused      bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (m *ScheduledCorkProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x32
+func (m *SetCellarPausedProposal) Reset()         { *m = SetCellarPausedProposal{} }
+func (m *SetCellarPausedProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCellarPausedProposal) ProtoMessage()    {}
+func (*SetCellarPausedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{10}
+}
+func (m *SetCellarPausedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarPausedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarPausedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractCallProtoJson) > 0 {
-		i -= len(m.ContractCallProtoJson)
-		copy(dAtA[i:], m.ContractCallProtoJson)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *SetCellarPausedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarPausedProposal.Merge(m, src)
+}
+func (m *SetCellarPausedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarPausedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarPausedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarPausedProposal proto.InternalMessageInfo
+
+func (m *SetCellarPausedProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.TargetContractAddress) > 0 {
-		i -= len(m.TargetContractAddress)
-		copy(dAtA[i:], m.TargetContractAddress)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *SetCellarPausedProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SetCellarPausedProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SetCellarPausedProposal) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+// SetCellarPausedProposalWithDeposit is a specific definition for CLI commands
+type SetCellarPausedProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused      bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetCellarPausedProposalWithDeposit) Reset()         { *m = SetCellarPausedProposalWithDeposit{} }
+func (m *SetCellarPausedProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetCellarPausedProposalWithDeposit) ProtoMessage()    {}
+func (*SetCellarPausedProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{11}
+}
+func (m *SetCellarPausedProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarPausedProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarPausedProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetCellarPausedProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarPausedProposalWithDeposit.Merge(m, src)
+}
+func (m *SetCellarPausedProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarPausedProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarPausedProposalWithDeposit.DiscardUnknown(m)
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SetCellarPausedProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetCellarPausedProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *AddManagedCellarIDsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SetCellarPausedProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarPausedProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarPausedProposalWithDeposit) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if m.CellarIds != nil {
-		l = m.CellarIds.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	return false
+}
+
+func (m *SetCellarPausedProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.PublisherDomain)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+// SetCellarABIProposal registers the contract ABI used to decode the contract calls of corks targeting a cellar.
+// An empty ABI removes the cellar's registered ABI.
+type SetCellarABIProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// JSON encoded contract ABI
+	Abi string `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *SetCellarABIProposal) Reset()         { *m = SetCellarABIProposal{} }
+func (m *SetCellarABIProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCellarABIProposal) ProtoMessage()    {}
+func (*SetCellarABIProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{12}
+}
+func (m *SetCellarABIProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarABIProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarABIProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SetCellarABIProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarABIProposal.Merge(m, src)
+}
+func (m *SetCellarABIProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarABIProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarABIProposal.DiscardUnknown(m)
 }
 
-func (m *AddManagedCellarIDsProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SetCellarABIProposal proto.InternalMessageInfo
+
+func (m *SetCellarABIProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarABIProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarABIProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
-		}
+	return ""
+}
+
+func (m *SetCellarABIProposal) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	l = len(m.PublisherDomain)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+// SetCellarABIProposalWithDeposit is a specific definition for CLI commands
+type SetCellarABIProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Abi         string `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetCellarABIProposalWithDeposit) Reset()         { *m = SetCellarABIProposalWithDeposit{} }
+func (m *SetCellarABIProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetCellarABIProposalWithDeposit) ProtoMessage()    {}
+func (*SetCellarABIProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{13}
+}
+func (m *SetCellarABIProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarABIProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarABIProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+}
+func (m *SetCellarABIProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarABIProposalWithDeposit.Merge(m, src)
+}
+func (m *SetCellarABIProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarABIProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarABIProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarABIProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetCellarABIProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return n
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetCellarABIProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarABIProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarABIProposalWithDeposit) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	if m.CellarIds != nil {
-		l = m.CellarIds.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarABIProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	return n
+	return ""
 }
 
-func (m *RemoveManagedCellarIDsProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+// SetCellarMetadataProposal replaces the metadata of a managed cellar
+type SetCellarMetadataProposal struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string         `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata    CellarMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *SetCellarMetadataProposal) Reset()         { *m = SetCellarMetadataProposal{} }
+func (m *SetCellarMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCellarMetadataProposal) ProtoMessage()    {}
+func (*SetCellarMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{14}
+}
+func (m *SetCellarMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+}
+func (m *SetCellarMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarMetadataProposal.Merge(m, src)
+}
+func (m *SetCellarMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarMetadataProposal proto.InternalMessageInfo
+
+func (m *SetCellarMetadataProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarMetadataProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
-		}
+	return ""
+}
+
+func (m *SetCellarMetadataProposal) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarMetadataProposal) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return CellarMetadata{}
 }
 
-func (m *ScheduledCorkProposal) Size() (n int) {
-	if m == nil {
-		return 0
+// SetCellarMetadataProposalWithDeposit is a specific definition for CLI commands
+type SetCellarMetadataProposalWithDeposit struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CellarId    string         `protobuf:"bytes,3,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Metadata    CellarMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+	Deposit     string         `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) Reset()         { *m = SetCellarMetadataProposalWithDeposit{} }
+func (m *SetCellarMetadataProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*SetCellarMetadataProposalWithDeposit) ProtoMessage()    {}
+func (*SetCellarMetadataProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01dea5e2496e85f, []int{15}
+}
+func (m *SetCellarMetadataProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCellarMetadataProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCellarMetadataProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+}
+func (m *SetCellarMetadataProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCellarMetadataProposalWithDeposit.Merge(m, src)
+}
+func (m *SetCellarMetadataProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCellarMetadataProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCellarMetadataProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCellarMetadataProposalWithDeposit proto.InternalMessageInfo
+
+func (m *SetCellarMetadataProposalWithDeposit) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovProposal(uint64(m.BlockHeight))
+	return ""
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return ""
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) GetMetadata() CellarMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.ContractCallProtoJson)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return CellarMetadata{}
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
 	}
-	return n
+	return ""
 }
 
-func (m *ScheduledCorkProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*AddManagedCellarIDsProposal)(nil), "cork.v2.AddManagedCellarIDsProposal")
+	proto.RegisterType((*AddManagedCellarIDsProposalWithDeposit)(nil), "cork.v2.AddManagedCellarIDsProposalWithDeposit")
+	proto.RegisterType((*RemoveManagedCellarIDsProposal)(nil), "cork.v2.RemoveManagedCellarIDsProposal")
+	proto.RegisterType((*RemoveManagedCellarIDsProposalWithDeposit)(nil), "cork.v2.RemoveManagedCellarIDsProposalWithDeposit")
+	proto.RegisterType((*ScheduledCorkProposal)(nil), "cork.v2.ScheduledCorkProposal")
+	proto.RegisterType((*ScheduledCorkProposalWithDeposit)(nil), "cork.v2.ScheduledCorkProposalWithDeposit")
+	proto.RegisterType((*SetCellarVoteThresholdProposal)(nil), "cork.v2.SetCellarVoteThresholdProposal")
+	proto.RegisterType((*SetCellarVoteThresholdProposalWithDeposit)(nil), "cork.v2.SetCellarVoteThresholdProposalWithDeposit")
+	proto.RegisterType((*SetCellarSelectorAllowlistProposal)(nil), "cork.v2.SetCellarSelectorAllowlistProposal")
+	proto.RegisterType((*SetCellarSelectorAllowlistProposalWithDeposit)(nil), "cork.v2.SetCellarSelectorAllowlistProposalWithDeposit")
+	proto.RegisterType((*SetCellarPausedProposal)(nil), "cork.v2.SetCellarPausedProposal")
+	proto.RegisterType((*SetCellarPausedProposalWithDeposit)(nil), "cork.v2.SetCellarPausedProposalWithDeposit")
+	proto.RegisterType((*SetCellarABIProposal)(nil), "cork.v2.SetCellarABIProposal")
+	proto.RegisterType((*SetCellarABIProposalWithDeposit)(nil), "cork.v2.SetCellarABIProposalWithDeposit")
+	proto.RegisterType((*SetCellarMetadataProposal)(nil), "cork.v2.SetCellarMetadataProposal")
+	proto.RegisterType((*SetCellarMetadataProposalWithDeposit)(nil), "cork.v2.SetCellarMetadataProposalWithDeposit")
+}
+
+func init() { proto.RegisterFile("cork/v2/proposal.proto", fileDescriptor_e01dea5e2496e85f) }
+
+var fileDescriptor_e01dea5e2496e85f = []byte{
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0x33, 0x49, 0x36, 0xbb, 0x79, 0x29, 0x6d, 0x65, 0x92, 0xae, 0x69, 0x8b, 0x37, 0x58,
+	0x50, 0x65, 0x25, 0x1a, 0x4b, 0xa9, 0x44, 0xc5, 0x31, 0x3f, 0x40, 0x2c, 0xa8, 0xd2, 0xca, 0xe1,
+	0x87, 0xc4, 0xc5, 0x72, 0x3c, 0x23, 0xdb, 0xcd, 0xc4, 0x63, 0x79, 0x26, 0x81, 0x3d, 0x70, 0xe1,
+	0xc0, 0x0d, 0x09, 0x4e, 0xbd, 0x70, 0xe2, 0xda, 0x7f, 0xa1, 0x17, 0x6e, 0x3d, 0x70, 0xd8, 0x23,
+	0xe2, 0xb0, 0x42, 0xbb, 0xff, 0x08, 0xca, 0xd8, 0xb1, 0x92, 0x25, 0x31, 0x52, 0x76, 0x5d, 0xed,
+	0x29, 0x9e, 0xf7, 0xc6, 0xf3, 0xbe, 0xf3, 0x99, 0xaf, 0x67, 0x26, 0x70, 0xcf, 0x61, 0xd1, 0xd8,
+	0x98, 0x75, 0x8c, 0x30, 0x62, 0x21, 0xe3, 0x36, 0x6d, 0x87, 0x11, 0x13, 0x4c, 0xd9, 0x9d, 0xc7,
+	0xdb, 0xb3, 0xce, 0x7d, 0x65, 0xd1, 0x41, 0x06, 0x64, 0xf2, 0x7e, 0xdd, 0x65, 0x2e, 0x93, 0x8f,
+	0xc6, 0xfc, 0x29, 0x8e, 0xea, 0x3f, 0x16, 0xe1, 0x41, 0x17, 0xe3, 0x67, 0x76, 0x60, 0xbb, 0x04,
+	0xf7, 0x09, 0xa5, 0x76, 0x74, 0x34, 0xe0, 0xc7, 0xc9, 0xc0, 0x4a, 0x1d, 0x76, 0x84, 0x2f, 0x28,
+	0x51, 0x51, 0x13, 0xb5, 0xaa, 0x66, 0xdc, 0x50, 0x9a, 0x50, 0xc3, 0x84, 0x3b, 0x91, 0x1f, 0x0a,
+	0x9f, 0x05, 0x6a, 0x51, 0xe6, 0x96, 0x43, 0xca, 0x13, 0x00, 0x47, 0x0e, 0x66, 0xf9, 0x98, 0xab,
+	0xa5, 0x26, 0x6a, 0xd5, 0x3a, 0xf5, 0x76, 0xa2, 0xaf, 0xbd, 0xa8, 0x33, 0x24, 0xc2, 0xac, 0xc6,
+	0xfd, 0x8e, 0x30, 0x57, 0x0e, 0xe1, 0x6e, 0x38, 0x1d, 0x51, 0x9f, 0x7b, 0x24, 0xb2, 0x30, 0x9b,
+	0xd8, 0x7e, 0xa0, 0x96, 0xe5, 0xd8, 0x77, 0xd2, 0xf8, 0x40, 0x86, 0x95, 0x2f, 0xe0, 0x4e, 0x32,
+	0xfe, 0x84, 0x08, 0x1b, 0xdb, 0xc2, 0x56, 0x77, 0x9a, 0xa5, 0x56, 0xad, 0xf3, 0xf0, 0x52, 0x91,
+	0x67, 0x49, 0xfa, 0x93, 0x40, 0x44, 0x27, 0xbd, 0xf2, 0xeb, 0xb3, 0x83, 0x82, 0x79, 0xdb, 0x59,
+	0x49, 0xe9, 0xbf, 0x16, 0xe1, 0x51, 0x06, 0x84, 0x6f, 0x7c, 0xe1, 0x0d, 0x48, 0xc8, 0xb8, 0x2f,
+	0xb6, 0xe6, 0xf1, 0xee, 0x25, 0x1e, 0xa5, 0x56, 0x75, 0xcb, 0x99, 0xab, 0xb0, 0x8b, 0x63, 0x31,
+	0xea, 0x8e, 0xec, 0xb1, 0x68, 0xae, 0x63, 0x52, 0xd9, 0x9a, 0xc9, 0xcf, 0x08, 0x34, 0x93, 0x4c,
+	0xd8, 0x8c, 0xdc, 0x08, 0x6f, 0xe8, 0xbf, 0x21, 0x38, 0xcc, 0xd6, 0xf3, 0x06, 0x96, 0x69, 0x89,
+	0x7d, 0x79, 0x85, 0xbd, 0xfe, 0xa2, 0x08, 0x8d, 0xa1, 0xe3, 0x11, 0x3c, 0xa5, 0x04, 0xf7, 0x59,
+	0x34, 0xbe, 0x32, 0xa5, 0xf7, 0xe0, 0xd6, 0x88, 0x32, 0x67, 0x6c, 0x79, 0xc4, 0x77, 0x3d, 0x21,
+	0x39, 0x95, 0xcd, 0x9a, 0x8c, 0x7d, 0x26, 0x43, 0xca, 0x47, 0xb0, 0x2f, 0xec, 0xc8, 0x25, 0xc2,
+	0x72, 0x58, 0x20, 0x22, 0xdb, 0x11, 0x96, 0x8d, 0x71, 0x44, 0x38, 0x4f, 0xe4, 0x35, 0xe2, 0x74,
+	0x3f, 0xc9, 0x76, 0xe3, 0xa4, 0xf2, 0x14, 0xd4, 0xf4, 0x05, 0xc7, 0xa6, 0xd4, 0x92, 0x7b, 0x81,
+	0xf5, 0x9c, 0xb3, 0x20, 0xf1, 0x54, 0x63, 0x91, 0xef, 0xdb, 0x94, 0x1e, 0xcf, 0xb3, 0x9f, 0x73,
+	0x16, 0x28, 0x1d, 0x68, 0x90, 0xc0, 0x61, 0x98, 0x60, 0x6b, 0x65, 0x00, 0xb5, 0xd2, 0x44, 0xad,
+	0x5b, 0xe6, 0xdb, 0x49, 0xb2, 0xbf, 0xf4, 0xb2, 0xfe, 0xaa, 0x08, 0xcd, 0xb5, 0x64, 0xae, 0x63,
+	0xbd, 0x6e, 0x22, 0xa4, 0x25, 0x93, 0x54, 0x56, 0x3f, 0xd0, 0x8d, 0xf8, 0x76, 0x65, 0xbf, 0xb5,
+	0xf8, 0xfe, 0x44, 0xa0, 0x0d, 0x89, 0x88, 0xdd, 0xfe, 0x35, 0x13, 0xe4, 0x4b, 0x2f, 0x22, 0xdc,
+	0x63, 0x14, 0x5f, 0xd9, 0x61, 0x0f, 0xa0, 0x9a, 0x9a, 0x5d, 0x92, 0xab, 0x9a, 0x7b, 0x0b, 0xaf,
+	0x2b, 0x5f, 0xc1, 0xed, 0x19, 0x13, 0xc4, 0x12, 0x8b, 0x72, 0x31, 0xad, 0x5e, 0x7b, 0xbe, 0x5b,
+	0xfc, 0x7d, 0x76, 0xf0, 0xc8, 0xf5, 0x85, 0x37, 0x1d, 0xb5, 0x1d, 0x36, 0x31, 0x1c, 0xc6, 0x27,
+	0x8c, 0x27, 0x3f, 0x8f, 0x39, 0x1e, 0x1b, 0xe2, 0x24, 0x24, 0xbc, 0x3d, 0x20, 0x8e, 0xf9, 0xd6,
+	0x6c, 0x59, 0xb3, 0xfe, 0x07, 0x82, 0xc3, 0xec, 0xe9, 0x5c, 0x87, 0x2d, 0x32, 0x67, 0xf6, 0xc1,
+	0xfa, 0x99, 0x5d, 0x52, 0xba, 0x79, 0x9f, 0xd5, 0x5f, 0x20, 0xd0, 0xd3, 0x39, 0x0c, 0x09, 0x25,
+	0x8e, 0x60, 0x51, 0x97, 0x52, 0xf6, 0x1d, 0xf5, 0xb9, 0xc8, 0x77, 0x59, 0x1e, 0x42, 0x95, 0x27,
+	0x15, 0xe7, 0xfe, 0x95, 0xfb, 0x53, 0x1a, 0xd0, 0x5f, 0x21, 0x78, 0xfc, 0xff, 0xca, 0x72, 0x27,
+	0x9c, 0x29, 0x32, 0x03, 0xec, 0x4f, 0x08, 0xf6, 0x53, 0xf9, 0xc7, 0xf6, 0x94, 0x93, 0x9c, 0x4d,
+	0x7e, 0x0f, 0x2a, 0xa1, 0x2c, 0x23, 0x2d, 0xb0, 0x67, 0x26, 0x2d, 0xfd, 0xe5, 0xf2, 0x0a, 0xaf,
+	0x0a, 0xc9, 0x1d, 0xde, 0x06, 0x4d, 0x19, 0xd8, 0x7e, 0x80, 0x7a, 0x2a, 0xb6, 0xdb, 0x3b, 0xca,
+	0x17, 0xd9, 0x5d, 0x28, 0xd9, 0x23, 0x3f, 0xf9, 0x64, 0xe6, 0x8f, 0xfa, 0xef, 0x08, 0x0e, 0xd6,
+	0xd5, 0xcf, 0x9d, 0xd4, 0x7f, 0xa4, 0x64, 0x30, 0x7a, 0x89, 0xe0, 0x9d, 0x54, 0xe4, 0xe2, 0x92,
+	0x93, 0x2f, 0xa9, 0x8f, 0x61, 0x2f, 0xbd, 0x87, 0x95, 0xe5, 0x25, 0x67, 0x7f, 0xc3, 0x3d, 0x2c,
+	0xb9, 0x82, 0xa5, 0xdd, 0xf5, 0x53, 0x04, 0xef, 0x6f, 0x54, 0x9b, 0x3b, 0xd7, 0xed, 0x85, 0x6f,
+	0x5e, 0x80, 0xde, 0xa7, 0xaf, 0xcf, 0x35, 0x74, 0x7a, 0xae, 0xa1, 0x7f, 0xce, 0x35, 0xf4, 0xcb,
+	0x85, 0x56, 0x38, 0xbd, 0xd0, 0x0a, 0x7f, 0x5d, 0x68, 0x85, 0x6f, 0x3f, 0x5c, 0x3a, 0x49, 0x42,
+	0xe2, 0xba, 0x27, 0xcf, 0x67, 0x06, 0x67, 0x93, 0x09, 0xa1, 0x3e, 0x89, 0x8c, 0xd9, 0x53, 0xe3,
+	0x7b, 0xf9, 0x37, 0x26, 0x3e, 0x53, 0x46, 0x15, 0x79, 0x0c, 0x3f, 0xf9, 0x77, 0x00, 0x3f, 0x52,
+	0xe8, 0x04, 0x04, 0x0d, 0x00, 0x00,
+}
+
+func (m *AddManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.CellarMetadata) > 0 {
+		for iNdEx := len(m.CellarMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovProposal(uint64(m.BlockHeight))
+	if m.CellarIds != nil {
+		{
+			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TargetContractAddress)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractCallProtoJson)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AddManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarMetadata) > 0 {
+		for iNdEx := len(m.CellarMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellarMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublisherDomain) > 0 {
+		i -= len(m.PublisherDomain)
+		copy(dAtA[i:], m.PublisherDomain)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.PublisherDomain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveManagedCellarIDsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveManagedCellarIDsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveManagedCellarIDsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CellarIds != nil {
+		{
+			size, err := m.CellarIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveManagedCellarIDsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveManagedCellarIDsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveManagedCellarIDsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCorkProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCorkProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCorkProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContractCallProtoJson) > 0 {
+		i -= len(m.ContractCallProtoJson)
+		copy(dAtA[i:], m.ContractCallProtoJson)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCorkProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCorkProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCorkProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncodedContractCall) > 0 {
+		i -= len(m.EncodedContractCall)
+		copy(dAtA[i:], m.EncodedContractCall)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.EncodedContractCall)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContractCallProtoJson) > 0 {
+		i -= len(m.ContractCallProtoJson)
+		copy(dAtA[i:], m.ContractCallProtoJson)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ContractCallProtoJson)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarVoteThresholdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarVoteThresholdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarVoteThresholdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VoteThreshold) > 0 {
+		i -= len(m.VoteThreshold)
+		copy(dAtA[i:], m.VoteThreshold)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.VoteThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarSelectorAllowlistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarSelectorAllowlistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarSelectorAllowlistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarPausedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarPausedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarPausedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarPausedProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarPausedProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarPausedProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarABIProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarABIProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarABIProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarABIProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarABIProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarABIProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddManagedCellarIDsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CellarIds != nil {
+		l = m.CellarIds.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarMetadata) > 0 {
+		for _, e := range m.CellarMetadata {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddManagedCellarIDsProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.PublisherDomain)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarMetadata) > 0 {
+		for _, e := range m.CellarMetadata {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveManagedCellarIDsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CellarIds != nil {
+		l = m.CellarIds.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveManagedCellarIDsProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCorkProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovProposal(uint64(m.BlockHeight))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.ContractCallProtoJson)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCorkProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovProposal(uint64(m.BlockHeight))
+	}
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.ContractCallProtoJson)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.EncodedContractCall)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarVoteThresholdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SetCellarVoteThresholdProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.VoteThreshold)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarSelectorAllowlistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarPausedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetCellarPausedProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarABIProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarABIProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCellarMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SetCellarMetadataProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddManagedCellarIDsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellarIds == nil {
+				m.CellarIds = &CellarIDSet{}
+			}
+			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarMetadata = append(m.CellarMetadata, CellarMetadataEntry{})
+			if err := m.CellarMetadata[len(m.CellarMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarMetadata = append(m.CellarMetadata, CellarMetadataEntry{})
+			if err := m.CellarMetadata[len(m.CellarMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveManagedCellarIDsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellarIds == nil {
+				m.CellarIds = &CellarIDSet{}
+			}
+			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCorkProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCorkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = append(m.EncodedContractCall[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedContractCall == nil {
+				m.EncodedContractCall = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCorkProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCorkProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedContractCall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedContractCall = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCellarVoteThresholdProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCellarVoteThresholdProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCellarVoteThresholdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCellarVoteThresholdProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCellarVoteThresholdProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCellarVoteThresholdProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCellarSelectorAllowlistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCellarSelectorAllowlistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCellarSelectorAllowlistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCellarSelectorAllowlistProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCellarSelectorAllowlistProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCellarSelectorAllowlistProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+func (m *SetCellarPausedProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1065,10 +4823,10 @@ func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddManagedCellarIDsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarPausedProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarPausedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1137,9 +4895,9 @@ func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -1149,33 +4907,29 @@ func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CellarIds == nil {
-				m.CellarIds = &CellarIDSet{}
-			}
-			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -1185,24 +4939,12 @@ func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -1224,7 +4966,7 @@ func (m *AddManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetCellarPausedProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1247,10 +4989,10 @@ func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarPausedProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarPausedProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1319,7 +5061,7 @@ func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1347,13 +5089,13 @@ func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherDomain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -1363,24 +5105,12 @@ func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublisherDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
@@ -1434,7 +5164,7 @@ func (m *AddManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
+func (m *SetCellarABIProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,10 +5187,10 @@ func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveManagedCellarIDsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarABIProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveManagedCellarIDsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarABIProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1529,9 +5259,9 @@ func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -1541,27 +5271,55 @@ func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CellarIds == nil {
-				m.CellarIds = &CellarIDSet{}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
 			}
-			if err := m.CellarIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1584,7 +5342,7 @@ func (m *RemoveManagedCellarIDsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetCellarABIProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,10 +5365,10 @@ func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveManagedCellarIDsProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarABIProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveManagedCellarIDsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarABIProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1679,7 +5437,7 @@ func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1707,9 +5465,41 @@ func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -1762,7 +5552,7 @@ func (m *RemoveManagedCellarIDsProposalWithDeposit) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
+func (m *SetCellarMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,10 +5575,10 @@ func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledCorkProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledCorkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1856,27 +5646,8 @@ func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,13 +5675,13 @@ func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -1920,23 +5691,24 @@ func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1959,7 +5731,7 @@ func (m *ScheduledCorkProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *SetCellarMetadataProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1982,10 +5754,10 @@ func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledCorkProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCellarMetadataProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledCorkProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCellarMetadataProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2053,27 +5825,8 @@ func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2101,13 +5854,13 @@ func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			m.CellarId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallProtoJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2117,25 +5870,26 @@ func (m *ScheduledCorkProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractCallProtoJson = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,6 +52,18 @@ func TestScheduledCorkProposalValidation(t *testing.T) {
 			expPass: false,
 			err:     errorsmod.Wrap(ErrInvalidJSON, "cannot have empty contract call"),
 		},
+		{
+			name: "Encoded contract call without proto JSON",
+			scheduledCorkProposal: ScheduledCorkProposal{
+				Title:                 "Scheduled Cork",
+				Description:           "Schedules a cork via governance",
+				BlockHeight:           1,
+				EncodedContractCall:   []byte{0x1f, 0x2c, 0x3d, 0x4e},
+				TargetContractAddress: "0x0000000000000000000000000000000000000000",
+			},
+			expPass: true,
+			err:     nil,
+		},
 		{
 			name: "Invalid JSON",
 			scheduledCorkProposal: ScheduledCorkProposal{
@@ -75,3 +89,304 @@ func TestScheduledCorkProposalValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestSetCellarVoteThresholdProposalValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal SetCellarVoteThresholdProposal
+		expPass  bool
+		err      error
+	}{
+		{
+			name: "Happy path",
+			proposal: SetCellarVoteThresholdProposal{
+				Title:         "Cellar vote threshold",
+				Description:   "Sets a cellar vote threshold via governance",
+				CellarId:      "0x0000000000000000000000000000000000000000",
+				VoteThreshold: sdk.MustNewDecFromStr("0.8"),
+			},
+			expPass: true,
+			err:     nil,
+		},
+		{
+			name: "Cellar address invalid",
+			proposal: SetCellarVoteThresholdProposal{
+				Title:         "Cellar vote threshold",
+				Description:   "Sets a cellar vote threshold via governance",
+				CellarId:      "0x01",
+				VoteThreshold: sdk.MustNewDecFromStr("0.8"),
+			},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidEthereumAddress, "0x01"),
+		},
+		{
+			name: "Threshold out of range",
+			proposal: SetCellarVoteThresholdProposal{
+				Title:         "Cellar vote threshold",
+				Description:   "Sets a cellar vote threshold via governance",
+				CellarId:      "0x0000000000000000000000000000000000000000",
+				VoteThreshold: sdk.MustNewDecFromStr("1.5"),
+			},
+			expPass: false,
+			err:     validateVoteThreshold(sdk.MustNewDecFromStr("1.5")),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Equal(t, tc.err.Error(), err.Error())
+		}
+	}
+}
+
+func TestSetCellarSelectorAllowlistProposalValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal SetCellarSelectorAllowlistProposal
+		expPass  bool
+		err      error
+	}{
+		{
+			name: "Happy path",
+			proposal: SetCellarSelectorAllowlistProposal{
+				Title:       "Cellar selector allowlist",
+				Description: "Sets a cellar selector allowlist via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Selectors:   []string{"0x12345678", "9ABCDEF0"},
+			},
+			expPass: true,
+			err:     nil,
+		},
+		{
+			name: "Empty selectors clear the allowlist",
+			proposal: SetCellarSelectorAllowlistProposal{
+				Title:       "Cellar selector allowlist",
+				Description: "Clears a cellar selector allowlist via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+			},
+			expPass: true,
+			err:     nil,
+		},
+		{
+			name: "Cellar address invalid",
+			proposal: SetCellarSelectorAllowlistProposal{
+				Title:       "Cellar selector allowlist",
+				Description: "Sets a cellar selector allowlist via governance",
+				CellarId:    "0x01",
+				Selectors:   []string{"0x12345678"},
+			},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidEthereumAddress, "0x01"),
+		},
+		{
+			name: "Selector wrong length",
+			proposal: SetCellarSelectorAllowlistProposal{
+				Title:       "Cellar selector allowlist",
+				Description: "Sets a cellar selector allowlist via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Selectors:   []string{"0x123456"},
+			},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidSelector, "0x123456"),
+		},
+		{
+			name: "Duplicate selector",
+			proposal: SetCellarSelectorAllowlistProposal{
+				Title:       "Cellar selector allowlist",
+				Description: "Sets a cellar selector allowlist via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Selectors:   []string{"0x9abcdef0", "0x9ABCDEF0"},
+			},
+			expPass: false,
+			err:     errorsmod.Wrapf(ErrInvalidSelector, "duplicate selector 0x9abcdef0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Equal(t, tc.err.Error(), err.Error())
+		}
+	}
+}
+
+func TestSetCellarABIProposalValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal SetCellarABIProposal
+		expPass  bool
+	}{
+		{
+			name: "Happy path",
+			proposal: SetCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Abi:         testCellarABI,
+			},
+			expPass: true,
+		},
+		{
+			name: "Empty ABI removes the registered ABI",
+			proposal: SetCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Removes a cellar ABI via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+			},
+			expPass: true,
+		},
+		{
+			name: "Cellar address invalid",
+			proposal: SetCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				CellarId:    "0x01",
+				Abi:         testCellarABI,
+			},
+			expPass: false,
+		},
+		{
+			name: "ABI invalid",
+			proposal: SetCellarABIProposal{
+				Title:       "Cellar ABI",
+				Description: "Registers a cellar ABI via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Abi:         "[{",
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAddManagedCellarIDsProposalMetadataValidation(t *testing.T) {
+	cellarID := "0x123801a7D398351b8bE11C439e05C5B3259aeC9B"
+	metadata := CellarMetadata{
+		Name:            "Dollary-doos LP",
+		Version:         "v2.5",
+		AssetDenom:      "usdc",
+		StrategySpecUrl: "https://example.com/strategies/dollary-doos-lp",
+	}
+
+	testCases := []struct {
+		name     string
+		metadata []CellarMetadataEntry
+		expPass  bool
+	}{
+		{
+			name:    "No metadata",
+			expPass: true,
+		},
+		{
+			name:     "Happy path",
+			metadata: []CellarMetadataEntry{{CellarId: cellarID, Metadata: metadata}},
+			expPass:  true,
+		},
+		{
+			name:     "Metadata for a cellar not being added",
+			metadata: []CellarMetadataEntry{{CellarId: "0x456801a7D398351b8bE11C439e05C5B3259aeC9B", Metadata: metadata}},
+			expPass:  false,
+		},
+		{
+			name:     "Duplicate metadata",
+			metadata: []CellarMetadataEntry{{CellarId: cellarID, Metadata: metadata}, {CellarId: cellarID, Metadata: metadata}},
+			expPass:  false,
+		},
+		{
+			name:     "Invalid strategy spec URL",
+			metadata: []CellarMetadataEntry{{CellarId: cellarID, Metadata: CellarMetadata{StrategySpecUrl: "ftp://example.com"}}},
+			expPass:  false,
+		},
+		{
+			name:     "Invalid asset denom",
+			metadata: []CellarMetadataEntry{{CellarId: cellarID, Metadata: CellarMetadata{AssetDenom: "1"}}},
+			expPass:  false,
+		},
+		{
+			name:     "Name too long",
+			metadata: []CellarMetadataEntry{{CellarId: cellarID, Metadata: CellarMetadata{Name: strings.Repeat("a", MaxCellarMetadataFieldLength+1)}}},
+			expPass:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewAddManagedCellarIDsProposal("Add Cellar", "Adds a cellar via governance", &CellarIDSet{Ids: []string{cellarID}}, "example.com", tc.metadata)
+		err := proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSetCellarMetadataProposalValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal SetCellarMetadataProposal
+		expPass  bool
+	}{
+		{
+			name: "Happy path",
+			proposal: SetCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Metadata:    CellarMetadata{Name: "Dollary-doos LP", StrategySpecUrl: "https://example.com/spec"},
+			},
+			expPass: true,
+		},
+		{
+			name: "Empty metadata clears the metadata",
+			proposal: SetCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Clears a cellar's metadata via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+			},
+			expPass: true,
+		},
+		{
+			name: "Cellar address invalid",
+			proposal: SetCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				CellarId:    "0x01",
+			},
+			expPass: false,
+		},
+		{
+			name: "Strategy spec URL invalid",
+			proposal: SetCellarMetadataProposal{
+				Title:       "Cellar Metadata",
+				Description: "Describes a cellar via governance",
+				CellarId:    "0x0000000000000000000000000000000000000000",
+				Metadata:    CellarMetadata{StrategySpecUrl: "not a url"},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
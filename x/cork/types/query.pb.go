@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -29,6 +31,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ApprovalFilter restricts cork result queries by approval status
+type ApprovalFilter int32
+
+const (
+	// results are returned regardless of approval status
+	ApprovalFilter_APPROVAL_FILTER_ANY ApprovalFilter = 0
+	// only approved results are returned
+	ApprovalFilter_APPROVAL_FILTER_APPROVED ApprovalFilter = 1
+	// only results that failed to reach the vote threshold are returned
+	ApprovalFilter_APPROVAL_FILTER_REJECTED ApprovalFilter = 2
+)
+
+var ApprovalFilter_name = map[int32]string{
+	0: "APPROVAL_FILTER_ANY",
+	1: "APPROVAL_FILTER_APPROVED",
+	2: "APPROVAL_FILTER_REJECTED",
+}
+
+var ApprovalFilter_value = map[string]int32{
+	"APPROVAL_FILTER_ANY":      0,
+	"APPROVAL_FILTER_APPROVED": 1,
+	"APPROVAL_FILTER_REJECTED": 2,
+}
+
+func (x ApprovalFilter) String() string {
+	return proto.EnumName(ApprovalFilter_name, int32(x))
+}
+
+func (ApprovalFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params gRPC method.
 type QueryParamsRequest struct {
 }
@@ -114,6 +148,7 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryCellarIDsRequest is the request type for Query/QueryCellarIDs gRPC method.
 type QueryCellarIDsRequest struct {
+	Pagination query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
 }
 
 func (m *QueryCellarIDsRequest) Reset()         { *m = QueryCellarIDsRequest{} }
@@ -149,9 +184,17 @@ func (m *QueryCellarIDsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCellarIDsRequest proto.InternalMessageInfo
 
+func (m *QueryCellarIDsRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
 // QueryCellarIDsResponse is the response type for Query/QueryCellars gRPC method.
 type QueryCellarIDsResponse struct {
-	CellarIds []string `protobuf:"bytes,1,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	CellarIds  []string           `protobuf:"bytes,1,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
 func (m *QueryCellarIDsResponse) Reset()         { *m = QueryCellarIDsResponse{} }
@@ -194,8 +237,26 @@ func (m *QueryCellarIDsResponse) GetCellarIds() []string {
 	return nil
 }
 
+func (m *QueryCellarIDsResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
 // QueryScheduledCorksRequest
 type QueryScheduledCorksRequest struct {
+	Pagination query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+	// only return corks targeting this cellar address when set
+	TargetContractAddress string `protobuf:"bytes,2,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// only return corks scheduled by this validator address when set
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// only return corks scheduled at or after this height when non-zero
+	MinBlockHeight uint64 `protobuf:"varint,4,opt,name=min_block_height,json=minBlockHeight,proto3" json:"min_block_height,omitempty"`
+	// only return corks scheduled at or before this height when non-zero
+	MaxBlockHeight uint64 `protobuf:"varint,5,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,6,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksRequest) Reset()         { *m = QueryScheduledCorksRequest{} }
@@ -231,9 +292,54 @@ func (m *QueryScheduledCorksRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryScheduledCorksRequest proto.InternalMessageInfo
 
+func (m *QueryScheduledCorksRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksRequest) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *QueryScheduledCorksRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *QueryScheduledCorksRequest) GetMinBlockHeight() uint64 {
+	if m != nil {
+		return m.MinBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryScheduledCorksRequest) GetMaxBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryScheduledCorksRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksResponse
 type QueryScheduledCorksResponse struct {
-	Corks []*ScheduledCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledCork   `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksResponse) Reset()         { *m = QueryScheduledCorksResponse{} }
@@ -276,6 +382,20 @@ func (m *QueryScheduledCorksResponse) GetCorks() []*ScheduledCork {
 	return nil
 }
 
+func (m *QueryScheduledCorksResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 // QueryScheduledBlockHeightsRequest
 type QueryScheduledBlockHeightsRequest struct {
 }
@@ -360,7 +480,10 @@ func (m *QueryScheduledBlockHeightsResponse) GetBlockHeights() []uint64 {
 
 // QueryScheduledCorksByBlockHeightRequest
 type QueryScheduledCorksByBlockHeightRequest struct {
-	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight uint64            `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Pagination  query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,3,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksByBlockHeightRequest) Reset() {
@@ -405,9 +528,26 @@ func (m *QueryScheduledCorksByBlockHeightRequest) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *QueryScheduledCorksByBlockHeightRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksByBlockHeightResponse
 type QueryScheduledCorksByBlockHeightResponse struct {
-	Corks []*ScheduledCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledCork   `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksByBlockHeightResponse) Reset() {
@@ -452,9 +592,26 @@ func (m *QueryScheduledCorksByBlockHeightResponse) GetCorks() []*ScheduledCork {
 	return nil
 }
 
+func (m *QueryScheduledCorksByBlockHeightResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 // QueryScheduledCorksByIDRequest
 type QueryScheduledCorksByIDRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,3,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryScheduledCorksByIDRequest) Reset()         { *m = QueryScheduledCorksByIDRequest{} }
@@ -497,9 +654,26 @@ func (m *QueryScheduledCorksByIDRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryScheduledCorksByIDRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryScheduledCorksByIDRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 // QueryScheduledCorksByIDResponse
 type QueryScheduledCorksByIDResponse struct {
-	Corks []*ScheduledCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Corks      []*ScheduledCork   `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks,omitempty"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryScheduledCorksByIDResponse) Reset()         { *m = QueryScheduledCorksByIDResponse{} }
@@ -542,8 +716,24 @@ func (m *QueryScheduledCorksByIDResponse) GetCorks() []*ScheduledCork {
 	return nil
 }
 
+func (m *QueryScheduledCorksByIDResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+func (m *QueryScheduledCorksByIDResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
+}
+
 type QueryCorkResultRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// decode the cork's contract call with its cellar's registered ABI
+	DecodeCall bool `protobuf:"varint,2,opt,name=decode_call,json=decodeCall,proto3" json:"decode_call,omitempty"`
 }
 
 func (m *QueryCorkResultRequest) Reset()         { *m = QueryCorkResultRequest{} }
@@ -586,8 +776,17 @@ func (m *QueryCorkResultRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryCorkResultRequest) GetDecodeCall() bool {
+	if m != nil {
+		return m.DecodeCall
+	}
+	return false
+}
+
 type QueryCorkResultResponse struct {
 	CorkResult *CorkResult `protobuf:"bytes,1,opt,name=corkResult,proto3" json:"corkResult,omitempty"`
+	// decoded contract call, when requested and the cellar has a registered ABI
+	DecodedCall *DecodedContractCall `protobuf:"bytes,2,opt,name=decoded_call,json=decodedCall,proto3" json:"decoded_call,omitempty"`
 }
 
 func (m *QueryCorkResultResponse) Reset()         { *m = QueryCorkResultResponse{} }
@@ -630,7 +829,24 @@ func (m *QueryCorkResultResponse) GetCorkResult() *CorkResult {
 	return nil
 }
 
+func (m *QueryCorkResultResponse) GetDecodedCall() *DecodedContractCall {
+	if m != nil {
+		return m.DecodedCall
+	}
+	return nil
+}
+
 type QueryCorkResultsRequest struct {
+	Pagination query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+	// only return results for corks targeting this cellar address when set
+	TargetContractAddress string `protobuf:"bytes,2,opt,name=target_contract_address,json=targetContractAddress,proto3" json:"target_contract_address,omitempty"`
+	// only return results tallied at or after this height when non-zero
+	MinBlockHeight uint64 `protobuf:"varint,3,opt,name=min_block_height,json=minBlockHeight,proto3" json:"min_block_height,omitempty"`
+	// only return results tallied at or before this height when non-zero
+	MaxBlockHeight uint64         `protobuf:"varint,4,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	Approval       ApprovalFilter `protobuf:"varint,5,opt,name=approval,proto3,enum=cork.v2.ApprovalFilter" json:"approval,omitempty"`
+	// decode the corks' contract calls with their cellars' registered ABIs
+	DecodeCalls bool `protobuf:"varint,6,opt,name=decode_calls,json=decodeCalls,proto3" json:"decode_calls,omitempty"`
 }
 
 func (m *QueryCorkResultsRequest) Reset()         { *m = QueryCorkResultsRequest{} }
@@ -666,8 +882,53 @@ func (m *QueryCorkResultsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCorkResultsRequest proto.InternalMessageInfo
 
+func (m *QueryCorkResultsRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
+}
+
+func (m *QueryCorkResultsRequest) GetTargetContractAddress() string {
+	if m != nil {
+		return m.TargetContractAddress
+	}
+	return ""
+}
+
+func (m *QueryCorkResultsRequest) GetMinBlockHeight() uint64 {
+	if m != nil {
+		return m.MinBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryCorkResultsRequest) GetMaxBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryCorkResultsRequest) GetApproval() ApprovalFilter {
+	if m != nil {
+		return m.Approval
+	}
+	return ApprovalFilter_APPROVAL_FILTER_ANY
+}
+
+func (m *QueryCorkResultsRequest) GetDecodeCalls() bool {
+	if m != nil {
+		return m.DecodeCalls
+	}
+	return false
+}
+
 type QueryCorkResultsResponse struct {
-	CorkResults []*CorkResult `protobuf:"bytes,1,rep,name=corkResults,proto3" json:"corkResults,omitempty"`
+	CorkResults []*CorkResult      `protobuf:"bytes,1,rep,name=corkResults,proto3" json:"corkResults,omitempty"`
+	Pagination  query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// decoded contract calls of the corks whose cellars have a registered ABI, when requested
+	DecodedCalls []DecodedContractCall `protobuf:"bytes,3,rep,name=decoded_calls,json=decodedCalls,proto3" json:"decoded_calls"`
 }
 
 func (m *QueryCorkResultsResponse) Reset()         { *m = QueryCorkResultsResponse{} }
@@ -710,1131 +971,9472 @@ func (m *QueryCorkResultsResponse) GetCorkResults() []*CorkResult {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cork.v2.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cork.v2.QueryParamsResponse")
-	proto.RegisterType((*QueryCellarIDsRequest)(nil), "cork.v2.QueryCellarIDsRequest")
-	proto.RegisterType((*QueryCellarIDsResponse)(nil), "cork.v2.QueryCellarIDsResponse")
-	proto.RegisterType((*QueryScheduledCorksRequest)(nil), "cork.v2.QueryScheduledCorksRequest")
-	proto.RegisterType((*QueryScheduledCorksResponse)(nil), "cork.v2.QueryScheduledCorksResponse")
-	proto.RegisterType((*QueryScheduledBlockHeightsRequest)(nil), "cork.v2.QueryScheduledBlockHeightsRequest")
-	proto.RegisterType((*QueryScheduledBlockHeightsResponse)(nil), "cork.v2.QueryScheduledBlockHeightsResponse")
-	proto.RegisterType((*QueryScheduledCorksByBlockHeightRequest)(nil), "cork.v2.QueryScheduledCorksByBlockHeightRequest")
-	proto.RegisterType((*QueryScheduledCorksByBlockHeightResponse)(nil), "cork.v2.QueryScheduledCorksByBlockHeightResponse")
-	proto.RegisterType((*QueryScheduledCorksByIDRequest)(nil), "cork.v2.QueryScheduledCorksByIDRequest")
-	proto.RegisterType((*QueryScheduledCorksByIDResponse)(nil), "cork.v2.QueryScheduledCorksByIDResponse")
-	proto.RegisterType((*QueryCorkResultRequest)(nil), "cork.v2.QueryCorkResultRequest")
-	proto.RegisterType((*QueryCorkResultResponse)(nil), "cork.v2.QueryCorkResultResponse")
-	proto.RegisterType((*QueryCorkResultsRequest)(nil), "cork.v2.QueryCorkResultsRequest")
-	proto.RegisterType((*QueryCorkResultsResponse)(nil), "cork.v2.QueryCorkResultsResponse")
+func (m *QueryCorkResultsResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
 }
 
-func init() { proto.RegisterFile("cork/v2/query.proto", fileDescriptor_5f2ffa9107b7d7f7) }
-
-var fileDescriptor_5f2ffa9107b7d7f7 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4e, 0xdb, 0x58,
-	0x14, 0xc6, 0x63, 0x06, 0x18, 0xe5, 0x84, 0x81, 0xd1, 0xe1, 0xbf, 0x01, 0x87, 0x18, 0x66, 0x88,
-	0x68, 0x88, 0x69, 0x10, 0x62, 0xdd, 0x80, 0xaa, 0x46, 0xad, 0xda, 0x92, 0x6e, 0xaa, 0x6e, 0xa2,
-	0x24, 0xbe, 0x72, 0xdc, 0xfc, 0xb9, 0xc1, 0x76, 0x22, 0x22, 0x84, 0x54, 0xb1, 0xee, 0xa2, 0x52,
-	0x1f, 0xa1, 0xab, 0x4a, 0x7d, 0x10, 0x96, 0xa8, 0xdd, 0x74, 0x55, 0x55, 0xd0, 0x07, 0xa9, 0x7c,
-	0x7d, 0x6d, 0x6c, 0xc7, 0x49, 0x28, 0xbb, 0xe4, 0x9c, 0xef, 0x7e, 0xdf, 0xcf, 0xce, 0x3d, 0x47,
-	0x81, 0xd9, 0x2a, 0x35, 0xea, 0x4a, 0x37, 0xa7, 0x9c, 0x74, 0x88, 0xd1, 0xcb, 0xb6, 0x0d, 0x6a,
-	0x51, 0xfc, 0xdb, 0x2e, 0x66, 0xbb, 0x39, 0x71, 0x4e, 0xa3, 0x1a, 0x65, 0x35, 0xc5, 0xfe, 0xe4,
-	0xb4, 0xc5, 0x55, 0x8d, 0x52, 0xad, 0x41, 0x94, 0x72, 0x5b, 0x57, 0xca, 0xad, 0x16, 0xb5, 0xca,
-	0x96, 0x4e, 0x5b, 0x26, 0xef, 0xce, 0xbb, 0x8e, 0x1a, 0x69, 0x11, 0x53, 0x77, 0xcb, 0xe8, 0x96,
-	0x99, 0x37, 0xab, 0xc9, 0x73, 0x80, 0xc7, 0x76, 0xec, 0xcb, 0xb2, 0x51, 0x6e, 0x9a, 0x45, 0x72,
-	0xd2, 0x21, 0xa6, 0x25, 0x1f, 0xc1, 0x6c, 0xa0, 0x6a, 0xb6, 0x69, 0xcb, 0x24, 0xb8, 0x03, 0x93,
-	0x6d, 0x56, 0x59, 0x12, 0xd6, 0x85, 0x74, 0x22, 0x37, 0x93, 0xe5, 0x94, 0x59, 0x47, 0x98, 0x1f,
-	0xbf, 0xfc, 0x91, 0x8c, 0x15, 0xb9, 0x48, 0x5e, 0x84, 0x79, 0xe6, 0x72, 0x48, 0x1a, 0x8d, 0xb2,
-	0x51, 0x38, 0xf2, 0xec, 0x0f, 0x60, 0x21, 0xdc, 0xe0, 0x09, 0x6b, 0x00, 0x55, 0x56, 0x2c, 0xe9,
-	0xaa, 0x9d, 0xf2, 0x57, 0x3a, 0x5e, 0x8c, 0x3b, 0x95, 0x82, 0x6a, 0xca, 0xab, 0x20, 0xb2, 0x83,
-	0xaf, 0xaa, 0x35, 0xa2, 0x76, 0x1a, 0x44, 0x3d, 0xa4, 0x46, 0xdd, 0xb3, 0x7d, 0x0a, 0x2b, 0x91,
-	0x5d, 0xee, 0x9d, 0x81, 0x09, 0x1b, 0xd7, 0xb1, 0x4d, 0xe4, 0x16, 0x3c, 0xf8, 0x80, 0xbe, 0xe8,
-	0x88, 0xe4, 0x0d, 0x48, 0x05, 0xcd, 0xf2, 0x0d, 0x5a, 0xad, 0x3f, 0x21, 0xba, 0x56, 0xb3, 0xbc,
-	0xc4, 0x02, 0xc8, 0xc3, 0x44, 0x3c, 0x78, 0x03, 0xfe, 0xa9, 0xd8, 0xf5, 0x52, 0xcd, 0x69, 0x30,
-	0x80, 0xf1, 0xe2, 0x54, 0xc5, 0x27, 0x96, 0x9f, 0xc1, 0x56, 0x04, 0x7c, 0xbe, 0xe7, 0x73, 0xe4,
-	0xa9, 0x98, 0x82, 0x29, 0xbf, 0x1f, 0xfb, 0x31, 0xc6, 0x8b, 0x09, 0x9f, 0x9d, 0xfc, 0x1a, 0xd2,
-	0xa3, 0xdd, 0xee, 0xf5, 0x5e, 0x76, 0x41, 0x8a, 0x74, 0x2e, 0x1c, 0xb9, 0x78, 0xd3, 0x30, 0xa6,
-	0xab, 0x0c, 0x2a, 0x5e, 0x1c, 0xd3, 0x55, 0xf9, 0x05, 0x24, 0x07, 0x9e, 0xb8, 0x17, 0x42, 0xda,
-	0xbd, 0x3e, 0x76, 0x8d, 0x98, 0x9d, 0x86, 0x35, 0x28, 0xfa, 0x39, 0x2c, 0xf6, 0x29, 0x79, 0xe4,
-	0x1e, 0x40, 0xd5, 0xab, 0xf2, 0xfb, 0x3c, 0xeb, 0xe5, 0xfa, 0x0e, 0xf8, 0x64, 0xf2, 0x72, 0x9f,
-	0x9f, 0x77, 0x15, 0x8e, 0x61, 0xa9, 0xbf, 0xc5, 0xb3, 0xf6, 0x21, 0x71, 0x6b, 0xe2, 0x3e, 0x64,
-	0x64, 0x98, 0x5f, 0x97, 0xfb, 0x12, 0x87, 0x09, 0xe6, 0x89, 0x75, 0x48, 0xf8, 0xe6, 0x11, 0x57,
-	0xbc, 0xa3, 0xfd, 0xb3, 0x2b, 0xae, 0x46, 0x37, 0x1d, 0x14, 0x39, 0x75, 0xf1, 0xed, 0xd7, 0xc7,
-	0xb1, 0x15, 0x5c, 0x56, 0x4c, 0xda, 0x6c, 0x92, 0x86, 0x4e, 0x0c, 0xc5, 0x5d, 0x0b, 0xce, 0xd8,
-	0xe2, 0x29, 0x4c, 0x07, 0xa7, 0x13, 0xa5, 0xa0, 0x65, 0x78, 0x9e, 0xc5, 0xe4, 0xc0, 0x3e, 0x4f,
-	0xfd, 0x8f, 0xa5, 0x26, 0x71, 0x2d, 0x22, 0xf5, 0x76, 0xde, 0xf1, 0xbd, 0xc0, 0xf7, 0x4e, 0xf0,
-	0xaa, 0xe0, 0x46, 0xd0, 0x3f, 0x72, 0xfa, 0xc5, 0xcd, 0xe1, 0x22, 0x4e, 0xb2, 0xcd, 0x48, 0x36,
-	0x51, 0x8e, 0x20, 0x31, 0xdd, 0x23, 0x25, 0x76, 0xcf, 0xf0, 0xb3, 0x10, 0x5e, 0x37, 0xfe, 0xf1,
-	0xc6, 0xed, 0x01, 0x81, 0x11, 0x8b, 0x42, 0x7c, 0x70, 0x27, 0x2d, 0x67, 0xcc, 0x31, 0xc6, 0x0c,
-	0x6e, 0x0f, 0x65, 0x0c, 0xac, 0x14, 0xfc, 0x2a, 0xc0, 0xfa, 0xa8, 0x89, 0xc7, 0xdd, 0x61, 0xaf,
-	0x28, 0x6a, 0xd5, 0x88, 0x0f, 0xff, 0xe0, 0x04, 0xa7, 0x2f, 0x30, 0xfa, 0x43, 0x7c, 0x34, 0xfa,
-	0x0d, 0x97, 0x2a, 0xbd, 0xc0, 0x63, 0x28, 0x67, 0xfe, 0x6f, 0xe7, 0xf8, 0x49, 0xe0, 0xf3, 0xd6,
-	0xbf, 0x3a, 0x70, 0x6b, 0x38, 0x99, 0xb7, 0x8e, 0xc4, 0xf4, 0x68, 0x21, 0x27, 0xdf, 0x67, 0xe4,
-	0x0a, 0xee, 0xdc, 0x8d, 0x5c, 0x57, 0x95, 0x33, 0x5d, 0x3d, 0xc7, 0x0b, 0x01, 0x66, 0x42, 0xa3,
-	0x8f, 0xe1, 0x89, 0x08, 0x6f, 0x2a, 0x71, 0x7d, 0xb0, 0x80, 0xd3, 0x64, 0x18, 0xcd, 0xff, 0xb8,
-	0x19, 0x35, 0x33, 0xd4, 0xa8, 0x97, 0x0c, 0x67, 0x4d, 0x38, 0x10, 0xef, 0x04, 0xf8, 0x37, 0xbc,
-	0x7f, 0x70, 0x60, 0x88, 0x77, 0x2f, 0x53, 0x43, 0x14, 0x9c, 0x63, 0x8b, 0x71, 0xa4, 0x30, 0x39,
-	0x82, 0x23, 0xff, 0xf8, 0xf2, 0x5a, 0x12, 0xae, 0xae, 0x25, 0xe1, 0xe7, 0xb5, 0x24, 0x7c, 0xb8,
-	0x91, 0x62, 0x57, 0x37, 0x52, 0xec, 0xfb, 0x8d, 0x14, 0x7b, 0x93, 0xd1, 0x74, 0xab, 0xd6, 0xa9,
-	0x64, 0xab, 0xb4, 0xa9, 0xb4, 0x89, 0xa6, 0xf5, 0xde, 0x76, 0x7d, 0x66, 0xdd, 0x03, 0xe5, 0xd4,
-	0x71, 0xb4, 0x7a, 0x6d, 0x62, 0x56, 0x26, 0xd9, 0x3f, 0x93, 0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0xad, 0x77, 0xc4, 0x18, 0x09, 0x00, 0x00,
+func (m *QueryCorkResultsResponse) GetDecodedCalls() []DecodedContractCall {
+	if m != nil {
+		return m.DecodedCalls
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// QueryParams queries the allocation module parameters.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryCellarIDs returns all cellars and current tick ranges
-	QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks
-	QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error)
-	// QueryScheduledBlockHeights returns all scheduled block heights
-	QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks at a block height
-	QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error)
-	// QueryScheduledCorks returns all scheduled corks with the specified ID
-	QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error)
-	QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error)
-	QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error)
+type QueryCellarVoteThresholdRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCellarVoteThresholdRequest) Reset()         { *m = QueryCellarVoteThresholdRequest{} }
+func (m *QueryCellarVoteThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarVoteThresholdRequest) ProtoMessage()    {}
+func (*QueryCellarVoteThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{16}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCellarVoteThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarVoteThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarVoteThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarVoteThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarVoteThresholdRequest.Merge(m, src)
+}
+func (m *QueryCellarVoteThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarVoteThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarVoteThresholdRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error) {
-	out := new(QueryCellarIDsResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarVoteThresholdRequest proto.InternalMessageInfo
+
+func (m *QueryCellarVoteThresholdRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error) {
-	out := new(QueryScheduledCorksResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCellarVoteThresholdResponse struct {
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
+	// true if the threshold is a cellar specific override rather than the module parameter
+	IsOverride bool `protobuf:"varint,2,opt,name=is_override,json=isOverride,proto3" json:"is_override,omitempty"`
 }
 
-func (c *queryClient) QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error) {
-	out := new(QueryScheduledBlockHeightsResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledBlockHeights", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCellarVoteThresholdResponse) Reset()         { *m = QueryCellarVoteThresholdResponse{} }
+func (m *QueryCellarVoteThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarVoteThresholdResponse) ProtoMessage()    {}
+func (*QueryCellarVoteThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{17}
+}
+func (m *QueryCellarVoteThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarVoteThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarVoteThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCellarVoteThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarVoteThresholdResponse.Merge(m, src)
+}
+func (m *QueryCellarVoteThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarVoteThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarVoteThresholdResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error) {
-	out := new(QueryScheduledCorksByBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorksByBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarVoteThresholdResponse proto.InternalMessageInfo
+
+func (m *QueryCellarVoteThresholdResponse) GetIsOverride() bool {
+	if m != nil {
+		return m.IsOverride
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error) {
-	out := new(QueryScheduledCorksByIDResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorksByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryValidatorCorkCountRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *QueryValidatorCorkCountRequest) Reset()         { *m = QueryValidatorCorkCountRequest{} }
+func (m *QueryValidatorCorkCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCorkCountRequest) ProtoMessage()    {}
+func (*QueryValidatorCorkCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{18}
+}
+func (m *QueryValidatorCorkCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCorkCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCorkCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorCorkCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCorkCountRequest.Merge(m, src)
+}
+func (m *QueryValidatorCorkCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCorkCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCorkCountRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error) {
-	out := new(QueryCorkResultResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkResult", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorCorkCountRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorCorkCountRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error) {
-	out := new(QueryCorkResultsResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkResults", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryValidatorCorkCountResponse struct {
+	Count     uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryValidatorCorkCountResponse) Reset()         { *m = QueryValidatorCorkCountResponse{} }
+func (m *QueryValidatorCorkCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCorkCountResponse) ProtoMessage()    {}
+func (*QueryValidatorCorkCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{19}
+}
+func (m *QueryValidatorCorkCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCorkCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCorkCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorCorkCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCorkCountResponse.Merge(m, src)
+}
+func (m *QueryValidatorCorkCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCorkCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCorkCountResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// QueryParams queries the allocation module parameters.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryCellarIDs returns all cellars and current tick ranges
-	QueryCellarIDs(context.Context, *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks
-	QueryScheduledCorks(context.Context, *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error)
-	// QueryScheduledBlockHeights returns all scheduled block heights
-	QueryScheduledBlockHeights(context.Context, *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error)
-	// QueryScheduledCorks returns all scheduled corks at a block height
-	QueryScheduledCorksByBlockHeight(context.Context, *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error)
-	// QueryScheduledCorks returns all scheduled corks with the specified ID
-	QueryScheduledCorksByID(context.Context, *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error)
-	QueryCorkResult(context.Context, *QueryCorkResultRequest) (*QueryCorkResultResponse, error)
-	QueryCorkResults(context.Context, *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error)
+var xxx_messageInfo_QueryValidatorCorkCountResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorCorkCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryValidatorCorkCountResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+type QueryCorkExecutionStatusRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryCellarIDs(ctx context.Context, req *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDs not implemented")
+
+func (m *QueryCorkExecutionStatusRequest) Reset()         { *m = QueryCorkExecutionStatusRequest{} }
+func (m *QueryCorkExecutionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkExecutionStatusRequest) ProtoMessage()    {}
+func (*QueryCorkExecutionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{20}
 }
-func (*UnimplementedQueryServer) QueryScheduledCorks(ctx context.Context, req *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorks not implemented")
+func (m *QueryCorkExecutionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryScheduledBlockHeights(ctx context.Context, req *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledBlockHeights not implemented")
+func (m *QueryCorkExecutionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkExecutionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryScheduledCorksByBlockHeight(ctx context.Context, req *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByBlockHeight not implemented")
+func (m *QueryCorkExecutionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkExecutionStatusRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryScheduledCorksByID(ctx context.Context, req *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByID not implemented")
+func (m *QueryCorkExecutionStatusRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryCorkResult(ctx context.Context, req *QueryCorkResultRequest) (*QueryCorkResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResult not implemented")
+func (m *QueryCorkExecutionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkExecutionStatusRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryCorkResults(ctx context.Context, req *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResults not implemented")
+
+var xxx_messageInfo_QueryCorkExecutionStatusRequest proto.InternalMessageInfo
+
+func (m *QueryCorkExecutionStatusRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryCorkExecutionStatusResponse struct {
+	// submitted to the bridge but not yet executed
+	Pending  []*CorkResult `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	Executed []*CorkResult `protobuf:"bytes,2,rep,name=executed,proto3" json:"executed,omitempty"`
+	// submitted to the bridge but the contract call timed out before it was executed
+	TimedOut []*CorkResult `protobuf:"bytes,3,rep,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+func (m *QueryCorkExecutionStatusResponse) Reset()         { *m = QueryCorkExecutionStatusResponse{} }
+func (m *QueryCorkExecutionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkExecutionStatusResponse) ProtoMessage()    {}
+func (*QueryCorkExecutionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{21}
+}
+func (m *QueryCorkExecutionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkExecutionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkExecutionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCorkExecutionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkExecutionStatusResponse.Merge(m, src)
+}
+func (m *QueryCorkExecutionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkExecutionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkExecutionStatusResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryCellarIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCellarIDsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCellarIDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryCellarIDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCellarIDs(ctx, req.(*QueryCellarIDsRequest))
+var xxx_messageInfo_QueryCorkExecutionStatusResponse proto.InternalMessageInfo
+
+func (m *QueryCorkExecutionStatusResponse) GetPending() []*CorkResult {
+	if m != nil {
+		return m.Pending
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryScheduledCorks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorks(ctx, req.(*QueryScheduledCorksRequest))
+func (m *QueryCorkExecutionStatusResponse) GetExecuted() []*CorkResult {
+	if m != nil {
+		return m.Executed
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryScheduledBlockHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledBlockHeightsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryScheduledBlockHeights",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, req.(*QueryScheduledBlockHeightsRequest))
+func (m *QueryCorkExecutionStatusResponse) GetTimedOut() []*CorkResult {
+	if m != nil {
+		return m.TimedOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryScheduledCorksByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksByBlockHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryScheduledCorksByBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, req.(*QueryScheduledCorksByBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCellarSelectorAllowlistRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-func _Query_QueryScheduledCorksByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledCorksByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryScheduledCorksByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryScheduledCorksByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryScheduledCorksByID(ctx, req.(*QueryScheduledCorksByIDRequest))
+func (m *QueryCellarSelectorAllowlistRequest) Reset()         { *m = QueryCellarSelectorAllowlistRequest{} }
+func (m *QueryCellarSelectorAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistRequest) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{22}
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistRequest.Merge(m, src)
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryCorkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCorkResultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCorkResult(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryCorkResult",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCorkResult(ctx, req.(*QueryCorkResultRequest))
+var xxx_messageInfo_QueryCellarSelectorAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryCorkResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCorkResultsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCorkResults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Query/QueryCorkResults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCorkResults(ctx, req.(*QueryCorkResultsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCellarSelectorAllowlistResponse struct {
+	// empty if the cellar has no allowlist, in which case any function may be called
+	Selectors []string `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cork.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryCellarIDs",
-			Handler:    _Query_QueryCellarIDs_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorks",
-			Handler:    _Query_QueryScheduledCorks_Handler,
-		},
-		{
-			MethodName: "QueryScheduledBlockHeights",
-			Handler:    _Query_QueryScheduledBlockHeights_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorksByBlockHeight",
-			Handler:    _Query_QueryScheduledCorksByBlockHeight_Handler,
-		},
-		{
-			MethodName: "QueryScheduledCorksByID",
-			Handler:    _Query_QueryScheduledCorksByID_Handler,
-		},
-		{
-			MethodName: "QueryCorkResult",
-			Handler:    _Query_QueryCorkResult_Handler,
-		},
-		{
-			MethodName: "QueryCorkResults",
-			Handler:    _Query_QueryCorkResults_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cork/v2/query.proto",
+func (m *QueryCellarSelectorAllowlistResponse) Reset()         { *m = QueryCellarSelectorAllowlistResponse{} }
+func (m *QueryCellarSelectorAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistResponse) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{23}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarSelectorAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarSelectorAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarSelectorAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistResponse) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCellarSelectorAllowlistsRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCellarSelectorAllowlistsRequest) Reset()         { *m = QueryCellarSelectorAllowlistsRequest{} }
+func (m *QueryCellarSelectorAllowlistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistsRequest) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{24}
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCellarIDsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.Merge(m, src)
 }
-
-func (m *QueryCellarIDsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryCellarIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarSelectorAllowlistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCellarIDsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryCellarSelectorAllowlistsRequest proto.InternalMessageInfo
 
-func (m *QueryCellarIDsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCellarSelectorAllowlistsResponse struct {
+	Allowlists []CellarSelectorAllowlist `protobuf:"bytes,1,rep,name=allowlists,proto3" json:"allowlists"`
 }
 
-func (m *QueryCellarIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CellarIds[iNdEx])
-			copy(dAtA[i:], m.CellarIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCellarSelectorAllowlistsResponse) Reset()         { *m = QueryCellarSelectorAllowlistsResponse{} }
+func (m *QueryCellarSelectorAllowlistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarSelectorAllowlistsResponse) ProtoMessage()    {}
+func (*QueryCellarSelectorAllowlistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{25}
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryScheduledCorksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.Merge(m, src)
 }
-
-func (m *QueryScheduledCorksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryScheduledCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarSelectorAllowlistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarSelectorAllowlistsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarSelectorAllowlistsResponse proto.InternalMessageInfo
+
+func (m *QueryCellarSelectorAllowlistsResponse) GetAllowlists() []CellarSelectorAllowlist {
+	if m != nil {
+		return m.Allowlists
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledCorksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCellarPauseStateRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
 }
 
-func (m *QueryScheduledCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCellarPauseStateRequest) Reset()         { *m = QueryCellarPauseStateRequest{} }
+func (m *QueryCellarPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarPauseStateRequest) ProtoMessage()    {}
+func (*QueryCellarPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{26}
+}
+func (m *QueryCellarPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCellarPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarPauseStateRequest.Merge(m, src)
+}
+func (m *QueryCellarPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarPauseStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarPauseStateRequest proto.InternalMessageInfo
+
+func (m *QueryCellarPauseStateRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryScheduledBlockHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCellarPauseStateResponse struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (m *QueryScheduledBlockHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCellarPauseStateResponse) Reset()         { *m = QueryCellarPauseStateResponse{} }
+func (m *QueryCellarPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarPauseStateResponse) ProtoMessage()    {}
+func (*QueryCellarPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{27}
+}
+func (m *QueryCellarPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCellarPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarPauseStateResponse.Merge(m, src)
+}
+func (m *QueryCellarPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarPauseStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCellarPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryCellarPauseStateResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryScheduledBlockHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPausedCellarsRequest struct {
 }
 
-func (m *QueryScheduledBlockHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockHeights) > 0 {
-		dAtA3 := make([]byte, len(m.BlockHeights)*10)
-		var j2 int
-		for _, num := range m.BlockHeights {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+func (m *QueryPausedCellarsRequest) Reset()         { *m = QueryPausedCellarsRequest{} }
+func (m *QueryPausedCellarsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCellarsRequest) ProtoMessage()    {}
+func (*QueryPausedCellarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{28}
+}
+func (m *QueryPausedCellarsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedCellarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCellarsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryScheduledCorksByBlockHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPausedCellarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCellarsRequest.Merge(m, src)
+}
+func (m *QueryPausedCellarsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedCellarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCellarsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPausedCellarsRequest proto.InternalMessageInfo
+
+type QueryPausedCellarsResponse struct {
+	CellarIds []string `protobuf:"bytes,1,rep,name=cellar_ids,json=cellarIds,proto3" json:"cellar_ids,omitempty"`
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPausedCellarsResponse) Reset()         { *m = QueryPausedCellarsResponse{} }
+func (m *QueryPausedCellarsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCellarsResponse) ProtoMessage()    {}
+func (*QueryPausedCellarsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{29}
+}
+func (m *QueryPausedCellarsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedCellarsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCellarsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPausedCellarsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCellarsResponse.Merge(m, src)
+}
+func (m *QueryPausedCellarsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedCellarsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCellarsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPausedCellarsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedCellarsResponse) GetCellarIds() []string {
+	if m != nil {
+		return m.CellarIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkCommitmentsRequest struct {
+	// block height to filter by, all commitments are returned when zero
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCorkCommitmentsRequest) Reset()         { *m = QueryCorkCommitmentsRequest{} }
+func (m *QueryCorkCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkCommitmentsRequest) ProtoMessage()    {}
+func (*QueryCorkCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{30}
+}
+func (m *QueryCorkCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryCorkCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkCommitmentsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryCorkCommitmentsRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryScheduledCorksByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkCommitmentsResponse struct {
+	Commitments []CorkCommitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments"`
 }
 
-func (m *QueryScheduledCorksByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCorkCommitmentsResponse) Reset()         { *m = QueryCorkCommitmentsResponse{} }
+func (m *QueryCorkCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkCommitmentsResponse) ProtoMessage()    {}
+func (*QueryCorkCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{31}
+}
+func (m *QueryCorkCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryCorkCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkCommitmentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QueryCorkCommitmentsResponse) GetCommitments() []CorkCommitment {
+	if m != nil {
+		return m.Commitments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledCorksByIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkParticipationRequest struct {
+	// hex encoded cork ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryScheduledCorksByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCorkParticipationRequest) Reset()         { *m = QueryCorkParticipationRequest{} }
+func (m *QueryCorkParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkParticipationRequest) ProtoMessage()    {}
+func (*QueryCorkParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{32}
+}
+func (m *QueryCorkParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkParticipationRequest.Merge(m, src)
+}
+func (m *QueryCorkParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkParticipationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryCorkParticipationRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCorkResultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCorkParticipationResponse struct {
+	Participation CorkParticipation `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
 }
 
-func (m *QueryCorkResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCorkParticipationResponse) Reset()         { *m = QueryCorkParticipationResponse{} }
+func (m *QueryCorkParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkParticipationResponse) ProtoMessage()    {}
+func (*QueryCorkParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{33}
+}
+func (m *QueryCorkParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorkParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkParticipationResponse.Merge(m, src)
+}
+func (m *QueryCorkParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkParticipationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorkParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryCorkParticipationResponse) GetParticipation() CorkParticipation {
+	if m != nil {
+		return m.Participation
 	}
-	return dAtA[:n], nil
+	return CorkParticipation{}
 }
 
-func (m *QueryCorkResultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorParticipationRate is a validator's participation counters along with its participation rate
+type ValidatorParticipationRate struct {
+	Participation     ValidatorParticipation                 `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
+	ParticipationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=participation_rate,json=participationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation_rate"`
 }
 
-func (m *QueryCorkResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CorkResult != nil {
-		{
-			size, err := m.CorkResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ValidatorParticipationRate) Reset()         { *m = ValidatorParticipationRate{} }
+func (m *ValidatorParticipationRate) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipationRate) ProtoMessage()    {}
+func (*ValidatorParticipationRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{34}
+}
+func (m *ValidatorParticipationRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipationRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipationRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorParticipationRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipationRate.Merge(m, src)
+}
+func (m *ValidatorParticipationRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipationRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipationRate.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorParticipationRate proto.InternalMessageInfo
+
+func (m *ValidatorParticipationRate) GetParticipation() ValidatorParticipation {
+	if m != nil {
+		return m.Participation
 	}
-	return dAtA[:n], nil
+	return ValidatorParticipation{}
 }
 
-func (m *QueryCorkResultsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorParticipationRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryCorkResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorParticipationRequest) Reset()         { *m = QueryValidatorParticipationRequest{} }
+func (m *QueryValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationRequest) ProtoMessage()    {}
+func (*QueryValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{35}
+}
+func (m *QueryValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationRequest.Merge(m, src)
+}
+func (m *QueryValidatorParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorParticipationRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCorkResultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorParticipationResponse struct {
+	Participation ValidatorParticipationRate `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
 }
 
-func (m *QueryCorkResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CorkResults) > 0 {
-		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorParticipationResponse) Reset()         { *m = QueryValidatorParticipationResponse{} }
+func (m *QueryValidatorParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationResponse) ProtoMessage()    {}
+func (*QueryValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{36}
+}
+func (m *QueryValidatorParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryValidatorParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryValidatorParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorParticipationResponse) GetParticipation() ValidatorParticipationRate {
+	if m != nil {
+		return m.Participation
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ValidatorParticipationRate{}
 }
 
-func (m *QueryCellarIDsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryValidatorParticipationsRequest struct {
 }
 
-func (m *QueryCellarIDsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CellarIds) > 0 {
-		for _, s := range m.CellarIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorParticipationsRequest) Reset()         { *m = QueryValidatorParticipationsRequest{} }
+func (m *QueryValidatorParticipationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationsRequest) ProtoMessage()    {}
+func (*QueryValidatorParticipationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{37}
+}
+func (m *QueryValidatorParticipationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorParticipationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorParticipationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorParticipationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryValidatorParticipationsRequest proto.InternalMessageInfo
+
+type QueryValidatorParticipationsResponse struct {
+	Participations []ValidatorParticipationRate `protobuf:"bytes,1,rep,name=participations,proto3" json:"participations"`
 }
 
-func (m *QueryScheduledCorksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorParticipationsResponse) Reset()         { *m = QueryValidatorParticipationsResponse{} }
+func (m *QueryValidatorParticipationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationsResponse) ProtoMessage()    {}
+func (*QueryValidatorParticipationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{38}
+}
+func (m *QueryValidatorParticipationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorParticipationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorParticipationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorParticipationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledBlockHeightsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorParticipationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorParticipationsResponse) GetParticipations() []ValidatorParticipationRate {
+	if m != nil {
+		return m.Participations
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryScheduledBlockHeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BlockHeights) > 0 {
-		l = 0
-		for _, e := range m.BlockHeights {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	return n
+// QueryGovernanceScheduledCorksRequest is the request type for the Query/QueryGovernanceScheduledCorks gRPC method.
+type QueryGovernanceScheduledCorksRequest struct {
 }
 
-func (m *QueryScheduledCorksByBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGovernanceScheduledCorksRequest) Reset()         { *m = QueryGovernanceScheduledCorksRequest{} }
+func (m *QueryGovernanceScheduledCorksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGovernanceScheduledCorksRequest) ProtoMessage()    {}
+func (*QueryGovernanceScheduledCorksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{39}
+}
+func (m *QueryGovernanceScheduledCorksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceScheduledCorksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceScheduledCorksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+}
+func (m *QueryGovernanceScheduledCorksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceScheduledCorksRequest.Merge(m, src)
+}
+func (m *QueryGovernanceScheduledCorksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceScheduledCorksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceScheduledCorksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovernanceScheduledCorksRequest proto.InternalMessageInfo
+
+// QueryGovernanceScheduledCorksResponse is the response type for the Query/QueryGovernanceScheduledCorks gRPC method.
+type QueryGovernanceScheduledCorksResponse struct {
+	Corks []GovernanceScheduledCork `protobuf:"bytes,1,rep,name=corks,proto3" json:"corks"`
+}
+
+func (m *QueryGovernanceScheduledCorksResponse) Reset()         { *m = QueryGovernanceScheduledCorksResponse{} }
+func (m *QueryGovernanceScheduledCorksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGovernanceScheduledCorksResponse) ProtoMessage()    {}
+func (*QueryGovernanceScheduledCorksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{40}
+}
+func (m *QueryGovernanceScheduledCorksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceScheduledCorksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceScheduledCorksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGovernanceScheduledCorksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceScheduledCorksResponse.Merge(m, src)
+}
+func (m *QueryGovernanceScheduledCorksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceScheduledCorksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceScheduledCorksResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGovernanceScheduledCorksResponse proto.InternalMessageInfo
+
+func (m *QueryGovernanceScheduledCorksResponse) GetCorks() []GovernanceScheduledCork {
+	if m != nil {
+		return m.Corks
 	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryCellarABIRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+}
+
+func (m *QueryCellarABIRequest) Reset()         { *m = QueryCellarABIRequest{} }
+func (m *QueryCellarABIRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIRequest) ProtoMessage()    {}
+func (*QueryCellarABIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{41}
+}
+func (m *QueryCellarABIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCellarABIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIRequest.Merge(m, src)
+}
+func (m *QueryCellarABIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCellarABIRequest proto.InternalMessageInfo
+
+func (m *QueryCellarABIRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryCellarABIResponse struct {
+	// empty if no ABI has been registered for the cellar
+	Abi string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *QueryCellarABIResponse) Reset()         { *m = QueryCellarABIResponse{} }
+func (m *QueryCellarABIResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIResponse) ProtoMessage()    {}
+func (*QueryCellarABIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{42}
+}
+func (m *QueryCellarABIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCellarABIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIResponse.Merge(m, src)
+}
+func (m *QueryCellarABIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledCorksByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCellarABIResponse proto.InternalMessageInfo
+
+func (m *QueryCellarABIResponse) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	var l int
-	_ = l
-	if len(m.Corks) > 0 {
-		for _, e := range m.Corks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryCellarABIsRequest struct {
+}
+
+func (m *QueryCellarABIsRequest) Reset()         { *m = QueryCellarABIsRequest{} }
+func (m *QueryCellarABIsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIsRequest) ProtoMessage()    {}
+func (*QueryCellarABIsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{43}
+}
+func (m *QueryCellarABIsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCellarABIsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIsRequest.Merge(m, src)
+}
+func (m *QueryCellarABIsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCellarABIsRequest proto.InternalMessageInfo
+
+type QueryCellarABIsResponse struct {
+	Abis []CellarABI `protobuf:"bytes,1,rep,name=abis,proto3" json:"abis"`
+}
+
+func (m *QueryCellarABIsResponse) Reset()         { *m = QueryCellarABIsResponse{} }
+func (m *QueryCellarABIsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCellarABIsResponse) ProtoMessage()    {}
+func (*QueryCellarABIsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{44}
+}
+func (m *QueryCellarABIsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCellarABIsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCellarABIsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCellarABIsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCellarABIsResponse.Merge(m, src)
+}
+func (m *QueryCellarABIsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCellarABIsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCellarABIsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCellarABIsResponse proto.InternalMessageInfo
+
+func (m *QueryCellarABIsResponse) GetAbis() []CellarABI {
+	if m != nil {
+		return m.Abis
 	}
-	return n
+	return nil
 }
 
-func (m *QueryCorkResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryManagedCellarRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+}
+
+func (m *QueryManagedCellarRequest) Reset()         { *m = QueryManagedCellarRequest{} }
+func (m *QueryManagedCellarRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarRequest) ProtoMessage()    {}
+func (*QueryManagedCellarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{45}
+}
+func (m *QueryManagedCellarRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.CorkResult != nil {
-		l = m.CorkResult.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryManagedCellarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarRequest.Merge(m, src)
+}
+func (m *QueryManagedCellarRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryManagedCellarRequest proto.InternalMessageInfo
+
+func (m *QueryManagedCellarRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryCorkResultsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryManagedCellarResponse struct {
+	Cellar ManagedCellar `protobuf:"bytes,1,opt,name=cellar,proto3" json:"cellar"`
+}
+
+func (m *QueryManagedCellarResponse) Reset()         { *m = QueryManagedCellarResponse{} }
+func (m *QueryManagedCellarResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarResponse) ProtoMessage()    {}
+func (*QueryManagedCellarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{46}
+}
+func (m *QueryManagedCellarResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryManagedCellarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarResponse.Merge(m, src)
+}
+func (m *QueryManagedCellarResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCorkResultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryManagedCellarResponse proto.InternalMessageInfo
+
+func (m *QueryManagedCellarResponse) GetCellar() ManagedCellar {
+	if m != nil {
+		return m.Cellar
 	}
-	var l int
-	_ = l
-	if len(m.CorkResults) > 0 {
-		for _, e := range m.CorkResults {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ManagedCellar{}
+}
+
+type QueryManagedCellarsRequest struct {
+	Pagination query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (m *QueryManagedCellarsRequest) Reset()         { *m = QueryManagedCellarsRequest{} }
+func (m *QueryManagedCellarsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarsRequest) ProtoMessage()    {}
+func (*QueryManagedCellarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{47}
+}
+func (m *QueryManagedCellarsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryManagedCellarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarsRequest.Merge(m, src)
+}
+func (m *QueryManagedCellarsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarsRequest.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryManagedCellarsRequest proto.InternalMessageInfo
+
+func (m *QueryManagedCellarsRequest) GetPagination() query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageRequest{}
 }
-func sozQuery(x uint64) (n int) {
+
+type QueryManagedCellarsResponse struct {
+	Cellars    []ManagedCellar    `protobuf:"bytes,1,rep,name=cellars,proto3" json:"cellars"`
+	Pagination query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (m *QueryManagedCellarsResponse) Reset()         { *m = QueryManagedCellarsResponse{} }
+func (m *QueryManagedCellarsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagedCellarsResponse) ProtoMessage()    {}
+func (*QueryManagedCellarsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{48}
+}
+func (m *QueryManagedCellarsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagedCellarsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagedCellarsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryManagedCellarsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagedCellarsResponse.Merge(m, src)
+}
+func (m *QueryManagedCellarsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagedCellarsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagedCellarsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryManagedCellarsResponse proto.InternalMessageInfo
+
+func (m *QueryManagedCellarsResponse) GetCellars() []ManagedCellar {
+	if m != nil {
+		return m.Cellars
+	}
+	return nil
+}
+
+func (m *QueryManagedCellarsResponse) GetPagination() query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return query.PageResponse{}
+}
+
+// QueryCorkTallyRequest is the request type for the Query/QueryCorkTally gRPC method. Exactly one of block_height
+// and id must be set.
+type QueryCorkTallyRequest struct {
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// hex encoded cork ID, only this cork's tally is returned
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryCorkTallyRequest) Reset()         { *m = QueryCorkTallyRequest{} }
+func (m *QueryCorkTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkTallyRequest) ProtoMessage()    {}
+func (*QueryCorkTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{49}
+}
+func (m *QueryCorkTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCorkTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkTallyRequest.Merge(m, src)
+}
+func (m *QueryCorkTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCorkTallyRequest proto.InternalMessageInfo
+
+func (m *QueryCorkTallyRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *QueryCorkTallyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// CorkTally is the voting power currently behind a scheduled cork
+type CorkTally struct {
+	Cork        Cork   `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork"`
+	Id          []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// summed consensus power of the validators that scheduled the cork
+	Power uint64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+	// power as a percentage of the last total power
+	ApprovalPercentage string `protobuf:"bytes,5,opt,name=approval_percentage,json=approvalPercentage,proto3" json:"approval_percentage,omitempty"`
+	// vote threshold that applies to the cork's cellar
+	VoteThreshold string `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// true if the cork would pass were it tallied now
+	Approved bool     `protobuf:"varint,7,opt,name=approved,proto3" json:"approved,omitempty"`
+	Voters   []string `protobuf:"bytes,8,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *CorkTally) Reset()         { *m = CorkTally{} }
+func (m *CorkTally) String() string { return proto.CompactTextString(m) }
+func (*CorkTally) ProtoMessage()    {}
+func (*CorkTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{50}
+}
+func (m *CorkTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorkTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorkTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorkTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorkTally.Merge(m, src)
+}
+func (m *CorkTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorkTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorkTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorkTally proto.InternalMessageInfo
+
+func (m *CorkTally) GetCork() Cork {
+	if m != nil {
+		return m.Cork
+	}
+	return Cork{}
+}
+
+func (m *CorkTally) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *CorkTally) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CorkTally) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *CorkTally) GetApprovalPercentage() string {
+	if m != nil {
+		return m.ApprovalPercentage
+	}
+	return ""
+}
+
+func (m *CorkTally) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
+	}
+	return ""
+}
+
+func (m *CorkTally) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+func (m *CorkTally) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+// QueryCorkTallyResponse is the response type for the Query/QueryCorkTally gRPC method.
+type QueryCorkTallyResponse struct {
+	Tallies []CorkTally `protobuf:"bytes,1,rep,name=tallies,proto3" json:"tallies"`
+	// last total consensus power of the validator set
+	TotalPower uint64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *QueryCorkTallyResponse) Reset()         { *m = QueryCorkTallyResponse{} }
+func (m *QueryCorkTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorkTallyResponse) ProtoMessage()    {}
+func (*QueryCorkTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2ffa9107b7d7f7, []int{51}
+}
+func (m *QueryCorkTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorkTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorkTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCorkTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorkTallyResponse.Merge(m, src)
+}
+func (m *QueryCorkTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorkTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorkTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCorkTallyResponse proto.InternalMessageInfo
+
+func (m *QueryCorkTallyResponse) GetTallies() []CorkTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+func (m *QueryCorkTallyResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cork.v2.ApprovalFilter", ApprovalFilter_name, ApprovalFilter_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "cork.v2.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cork.v2.QueryParamsResponse")
+	proto.RegisterType((*QueryCellarIDsRequest)(nil), "cork.v2.QueryCellarIDsRequest")
+	proto.RegisterType((*QueryCellarIDsResponse)(nil), "cork.v2.QueryCellarIDsResponse")
+	proto.RegisterType((*QueryScheduledCorksRequest)(nil), "cork.v2.QueryScheduledCorksRequest")
+	proto.RegisterType((*QueryScheduledCorksResponse)(nil), "cork.v2.QueryScheduledCorksResponse")
+	proto.RegisterType((*QueryScheduledBlockHeightsRequest)(nil), "cork.v2.QueryScheduledBlockHeightsRequest")
+	proto.RegisterType((*QueryScheduledBlockHeightsResponse)(nil), "cork.v2.QueryScheduledBlockHeightsResponse")
+	proto.RegisterType((*QueryScheduledCorksByBlockHeightRequest)(nil), "cork.v2.QueryScheduledCorksByBlockHeightRequest")
+	proto.RegisterType((*QueryScheduledCorksByBlockHeightResponse)(nil), "cork.v2.QueryScheduledCorksByBlockHeightResponse")
+	proto.RegisterType((*QueryScheduledCorksByIDRequest)(nil), "cork.v2.QueryScheduledCorksByIDRequest")
+	proto.RegisterType((*QueryScheduledCorksByIDResponse)(nil), "cork.v2.QueryScheduledCorksByIDResponse")
+	proto.RegisterType((*QueryCorkResultRequest)(nil), "cork.v2.QueryCorkResultRequest")
+	proto.RegisterType((*QueryCorkResultResponse)(nil), "cork.v2.QueryCorkResultResponse")
+	proto.RegisterType((*QueryCorkResultsRequest)(nil), "cork.v2.QueryCorkResultsRequest")
+	proto.RegisterType((*QueryCorkResultsResponse)(nil), "cork.v2.QueryCorkResultsResponse")
+	proto.RegisterType((*QueryCellarVoteThresholdRequest)(nil), "cork.v2.QueryCellarVoteThresholdRequest")
+	proto.RegisterType((*QueryCellarVoteThresholdResponse)(nil), "cork.v2.QueryCellarVoteThresholdResponse")
+	proto.RegisterType((*QueryValidatorCorkCountRequest)(nil), "cork.v2.QueryValidatorCorkCountRequest")
+	proto.RegisterType((*QueryValidatorCorkCountResponse)(nil), "cork.v2.QueryValidatorCorkCountResponse")
+	proto.RegisterType((*QueryCorkExecutionStatusRequest)(nil), "cork.v2.QueryCorkExecutionStatusRequest")
+	proto.RegisterType((*QueryCorkExecutionStatusResponse)(nil), "cork.v2.QueryCorkExecutionStatusResponse")
+	proto.RegisterType((*QueryCellarSelectorAllowlistRequest)(nil), "cork.v2.QueryCellarSelectorAllowlistRequest")
+	proto.RegisterType((*QueryCellarSelectorAllowlistResponse)(nil), "cork.v2.QueryCellarSelectorAllowlistResponse")
+	proto.RegisterType((*QueryCellarSelectorAllowlistsRequest)(nil), "cork.v2.QueryCellarSelectorAllowlistsRequest")
+	proto.RegisterType((*QueryCellarSelectorAllowlistsResponse)(nil), "cork.v2.QueryCellarSelectorAllowlistsResponse")
+	proto.RegisterType((*QueryCellarPauseStateRequest)(nil), "cork.v2.QueryCellarPauseStateRequest")
+	proto.RegisterType((*QueryCellarPauseStateResponse)(nil), "cork.v2.QueryCellarPauseStateResponse")
+	proto.RegisterType((*QueryPausedCellarsRequest)(nil), "cork.v2.QueryPausedCellarsRequest")
+	proto.RegisterType((*QueryPausedCellarsResponse)(nil), "cork.v2.QueryPausedCellarsResponse")
+	proto.RegisterType((*QueryCorkCommitmentsRequest)(nil), "cork.v2.QueryCorkCommitmentsRequest")
+	proto.RegisterType((*QueryCorkCommitmentsResponse)(nil), "cork.v2.QueryCorkCommitmentsResponse")
+	proto.RegisterType((*QueryCorkParticipationRequest)(nil), "cork.v2.QueryCorkParticipationRequest")
+	proto.RegisterType((*QueryCorkParticipationResponse)(nil), "cork.v2.QueryCorkParticipationResponse")
+	proto.RegisterType((*ValidatorParticipationRate)(nil), "cork.v2.ValidatorParticipationRate")
+	proto.RegisterType((*QueryValidatorParticipationRequest)(nil), "cork.v2.QueryValidatorParticipationRequest")
+	proto.RegisterType((*QueryValidatorParticipationResponse)(nil), "cork.v2.QueryValidatorParticipationResponse")
+	proto.RegisterType((*QueryValidatorParticipationsRequest)(nil), "cork.v2.QueryValidatorParticipationsRequest")
+	proto.RegisterType((*QueryValidatorParticipationsResponse)(nil), "cork.v2.QueryValidatorParticipationsResponse")
+	proto.RegisterType((*QueryGovernanceScheduledCorksRequest)(nil), "cork.v2.QueryGovernanceScheduledCorksRequest")
+	proto.RegisterType((*QueryGovernanceScheduledCorksResponse)(nil), "cork.v2.QueryGovernanceScheduledCorksResponse")
+	proto.RegisterType((*QueryCellarABIRequest)(nil), "cork.v2.QueryCellarABIRequest")
+	proto.RegisterType((*QueryCellarABIResponse)(nil), "cork.v2.QueryCellarABIResponse")
+	proto.RegisterType((*QueryCellarABIsRequest)(nil), "cork.v2.QueryCellarABIsRequest")
+	proto.RegisterType((*QueryCellarABIsResponse)(nil), "cork.v2.QueryCellarABIsResponse")
+	proto.RegisterType((*QueryManagedCellarRequest)(nil), "cork.v2.QueryManagedCellarRequest")
+	proto.RegisterType((*QueryManagedCellarResponse)(nil), "cork.v2.QueryManagedCellarResponse")
+	proto.RegisterType((*QueryManagedCellarsRequest)(nil), "cork.v2.QueryManagedCellarsRequest")
+	proto.RegisterType((*QueryManagedCellarsResponse)(nil), "cork.v2.QueryManagedCellarsResponse")
+	proto.RegisterType((*QueryCorkTallyRequest)(nil), "cork.v2.QueryCorkTallyRequest")
+	proto.RegisterType((*CorkTally)(nil), "cork.v2.CorkTally")
+	proto.RegisterType((*QueryCorkTallyResponse)(nil), "cork.v2.QueryCorkTallyResponse")
+}
+
+func init() { proto.RegisterFile("cork/v2/query.proto", fileDescriptor_5f2ffa9107b7d7f7) }
+
+var fileDescriptor_5f2ffa9107b7d7f7 = []byte{
+	// 2446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xd8, 0x4e, 0x62, 0x1f, 0x27, 0xae, 0xb9, 0x49, 0xe3, 0xed, 0xc4, 0xd9, 0xb5, 0x67,
+	0xfd, 0xb1, 0x71, 0x9c, 0x9d, 0xc4, 0xf9, 0xaa, 0x08, 0x4a, 0x59, 0x7f, 0x24, 0x6c, 0x49, 0x89,
+	0x3b, 0x49, 0x23, 0x81, 0x84, 0x46, 0xe3, 0x9d, 0xab, 0xf5, 0xd4, 0xb3, 0x3b, 0xdb, 0x99, 0xd9,
+	0x6d, 0xac, 0x10, 0x09, 0x55, 0x02, 0x81, 0x84, 0x44, 0x25, 0xc4, 0x4b, 0x25, 0x1e, 0x10, 0x08,
+	0xd1, 0x17, 0x78, 0x01, 0x95, 0x17, 0x78, 0x8e, 0xc4, 0x4b, 0x05, 0x2f, 0x88, 0x87, 0x0a, 0x25,
+	0x48, 0x88, 0x37, 0xfe, 0x04, 0x34, 0xf7, 0x63, 0x76, 0x3e, 0xee, 0xcc, 0x4e, 0x50, 0x8b, 0xaa,
+	0x3e, 0x79, 0xe7, 0xdc, 0x73, 0xce, 0xfd, 0x9d, 0x73, 0xef, 0x3d, 0xf7, 0x9e, 0x9f, 0x0c, 0xa7,
+	0x5a, 0x8e, 0x7b, 0xa0, 0x0e, 0x36, 0xd4, 0x77, 0xfa, 0xd8, 0x3d, 0xac, 0xf7, 0x5c, 0xc7, 0x77,
+	0xd0, 0xf1, 0x40, 0x58, 0x1f, 0x6c, 0xc8, 0xa7, 0xdb, 0x4e, 0xdb, 0x21, 0x32, 0x35, 0xf8, 0x45,
+	0x87, 0xe5, 0xf9, 0xb6, 0xe3, 0xb4, 0x6d, 0xac, 0x1a, 0x3d, 0x4b, 0x35, 0xba, 0x5d, 0xc7, 0x37,
+	0x7c, 0xcb, 0xe9, 0x7a, 0x6c, 0xf4, 0x65, 0xee, 0xb1, 0x8d, 0xbb, 0xd8, 0xb3, 0xb8, 0x18, 0x71,
+	0x31, 0xf1, 0x4d, 0x65, 0x6b, 0x2d, 0xc7, 0xeb, 0x38, 0x9e, 0xba, 0x67, 0x78, 0x98, 0x02, 0x50,
+	0x07, 0x97, 0xf7, 0xb0, 0x6f, 0x5c, 0x56, 0x7b, 0x46, 0xdb, 0xea, 0x12, 0xbf, 0x54, 0x57, 0x39,
+	0x0d, 0xe8, 0xcd, 0x40, 0x63, 0xd7, 0x70, 0x8d, 0x8e, 0xa7, 0xe1, 0x77, 0xfa, 0xd8, 0xf3, 0x95,
+	0x6d, 0x38, 0x15, 0x93, 0x7a, 0x3d, 0xa7, 0xeb, 0x61, 0x74, 0x11, 0x8e, 0xf5, 0x88, 0xa4, 0x24,
+	0x2d, 0x48, 0xb5, 0xe9, 0x8d, 0x97, 0xea, 0x2c, 0xa2, 0x3a, 0x55, 0xdc, 0x9c, 0x78, 0xfa, 0x49,
+	0xe5, 0x88, 0xc6, 0x94, 0x14, 0x0c, 0x2f, 0x13, 0x2f, 0x5b, 0xd8, 0xb6, 0x0d, 0xb7, 0xb9, 0xcd,
+	0xdd, 0xa3, 0xbb, 0x00, 0x43, 0x20, 0xcc, 0xd7, 0x4a, 0x9d, 0xa2, 0xae, 0x07, 0xa8, 0xeb, 0x34,
+	0x6d, 0x0c, 0x75, 0x7d, 0xd7, 0x68, 0x63, 0x66, 0xcb, 0xa6, 0x88, 0xd8, 0x2b, 0xdf, 0x97, 0xe0,
+	0x4c, 0x72, 0x1e, 0x06, 0xf8, 0x1c, 0x40, 0x8b, 0x08, 0x75, 0xcb, 0x0c, 0x40, 0x8f, 0xd7, 0xa6,
+	0xb4, 0x29, 0x2a, 0x69, 0x9a, 0x1e, 0x7a, 0x23, 0x86, 0x63, 0x8c, 0xe0, 0x58, 0x1d, 0x89, 0x83,
+	0xfa, 0x16, 0x00, 0xf9, 0x68, 0x0c, 0x64, 0x02, 0xe4, 0x7e, 0x6b, 0x1f, 0x9b, 0x7d, 0x1b, 0x9b,
+	0x5b, 0x8e, 0x7b, 0xf0, 0xd9, 0x44, 0x8d, 0xae, 0xc3, 0x9c, 0x6f, 0xb8, 0x6d, 0xec, 0xeb, 0x2d,
+	0xa7, 0xeb, 0xbb, 0x46, 0xcb, 0xd7, 0x0d, 0xd3, 0x74, 0xb1, 0xe7, 0x91, 0x40, 0xa6, 0xb4, 0x97,
+	0xe9, 0xf0, 0x16, 0x1b, 0x6d, 0xd0, 0x41, 0x34, 0x0f, 0x53, 0x03, 0xc3, 0xb6, 0x4c, 0xc3, 0x77,
+	0xdc, 0xd2, 0x38, 0xd1, 0x1c, 0x0a, 0x50, 0x0d, 0x66, 0x3b, 0x56, 0x57, 0xdf, 0xb3, 0x9d, 0xd6,
+	0x81, 0xbe, 0x8f, 0xad, 0xf6, 0xbe, 0x5f, 0x9a, 0x58, 0x90, 0x6a, 0x13, 0xda, 0x4c, 0xc7, 0xea,
+	0x6e, 0x06, 0xe2, 0xaf, 0x11, 0x29, 0xd1, 0x34, 0x1e, 0xc5, 0x35, 0x8f, 0x32, 0x4d, 0xe3, 0x51,
+	0x54, 0x73, 0x11, 0x4e, 0x98, 0xb8, 0xe5, 0x98, 0x58, 0x6f, 0x19, 0xb6, 0xed, 0x95, 0x8e, 0x2d,
+	0x48, 0xb5, 0x49, 0x6d, 0x9a, 0xca, 0xb6, 0x02, 0x91, 0xf2, 0x5c, 0x82, 0xb3, 0xc2, 0xcc, 0xb1,
+	0x75, 0x5c, 0x87, 0xa3, 0xc1, 0x4e, 0xa3, 0x4b, 0x38, 0xbd, 0x71, 0x26, 0xdc, 0x77, 0x31, 0x7d,
+	0x8d, 0x2a, 0x7d, 0xca, 0xcb, 0x8a, 0xee, 0xc0, 0x49, 0x8a, 0xd5, 0x64, 0x01, 0x8c, 0x13, 0x10,
+	0xf3, 0x21, 0x88, 0x6d, 0x3a, 0xca, 0x33, 0x1d, 0x84, 0xc4, 0xdc, 0xb0, 0xc0, 0x4d, 0x1a, 0x65,
+	0x15, 0x16, 0xe3, 0x41, 0x46, 0xb2, 0x14, 0x1e, 0xbd, 0x26, 0x28, 0x79, 0x4a, 0x2c, 0x21, 0x55,
+	0x38, 0x19, 0xcd, 0x3c, 0x4d, 0xcc, 0x84, 0x76, 0x62, 0x2f, 0xa2, 0xac, 0xfc, 0x51, 0x82, 0x55,
+	0x41, 0x56, 0x37, 0x0f, 0x23, 0x2e, 0xf9, 0xe6, 0x5c, 0x84, 0x13, 0xb1, 0xa5, 0x94, 0xc8, 0x52,
+	0x4e, 0x47, 0xfc, 0xa1, 0xbb, 0x82, 0xb4, 0xfe, 0xef, 0xfb, 0x37, 0xb9, 0x2b, 0xc6, 0xd3, 0xbb,
+	0xe2, 0x3f, 0x12, 0xd4, 0x46, 0xe3, 0xff, 0x42, 0x6d, 0x91, 0x9f, 0x4b, 0x50, 0x16, 0x86, 0xdc,
+	0xdc, 0xe6, 0x2b, 0x35, 0x03, 0x63, 0x96, 0x49, 0xd6, 0x67, 0x4a, 0x1b, 0xb3, 0xcc, 0xff, 0xff,
+	0xb2, 0xfc, 0x4b, 0x82, 0x4a, 0x26, 0xc6, 0x2f, 0xd4, 0x6a, 0x34, 0xf9, 0xc5, 0x12, 0x60, 0xc5,
+	0x5e, 0xdf, 0xf6, 0xb3, 0x16, 0xa1, 0x02, 0xd3, 0x91, 0xb4, 0x91, 0x10, 0x26, 0x35, 0x18, 0x66,
+	0x4d, 0xf9, 0xb1, 0x04, 0x73, 0x29, 0x5f, 0x2c, 0x59, 0x57, 0x00, 0x5a, 0xa1, 0x94, 0x5d, 0x0c,
+	0xa7, 0x42, 0xb0, 0x11, 0x83, 0x88, 0x1a, 0x7a, 0x0d, 0x4e, 0x44, 0x83, 0x64, 0x59, 0xcb, 0x8d,
+	0x51, 0x9b, 0x8e, 0x44, 0xa7, 0xfc, 0x79, 0x2c, 0x85, 0xe8, 0x73, 0x76, 0x55, 0x89, 0x2e, 0xa3,
+	0xf1, 0xc2, 0x97, 0xd1, 0x84, 0xf0, 0x32, 0xba, 0x02, 0x93, 0x46, 0xaf, 0xe7, 0x3a, 0x03, 0xc3,
+	0x26, 0xd7, 0xd5, 0xcc, 0xc6, 0x5c, 0x98, 0xb2, 0x06, 0x1b, 0xb8, 0x6d, 0xd9, 0x3e, 0x76, 0xb5,
+	0x50, 0xb1, 0xc8, 0x0d, 0xf6, 0x6f, 0x09, 0x4a, 0xe9, 0x6c, 0xb2, 0x05, 0xbe, 0x06, 0xd3, 0xc3,
+	0x95, 0xe3, 0x67, 0x42, 0xb8, 0xc2, 0x51, 0xbd, 0xcf, 0xed, 0xb1, 0xb8, 0x05, 0x95, 0xc8, 0x7b,
+	0xeb, 0xa1, 0xe3, 0xe3, 0x07, 0xfb, 0x2e, 0xf6, 0xf6, 0x1d, 0xdb, 0xe4, 0x1b, 0xe8, 0x2c, 0x4c,
+	0x85, 0x0f, 0x2f, 0x76, 0x4c, 0x26, 0xf9, 0xbb, 0x4b, 0xf9, 0x40, 0x82, 0x85, 0x6c, 0x07, 0x2c,
+	0x67, 0x6f, 0xc1, 0xcc, 0xc0, 0xf1, 0xb1, 0xee, 0xf3, 0x11, 0xea, 0x66, 0xb3, 0x1e, 0x00, 0xfa,
+	0xfb, 0x27, 0x95, 0x95, 0xb6, 0xe5, 0xef, 0xf7, 0xf7, 0xea, 0x2d, 0xa7, 0xa3, 0xb2, 0xf7, 0x2e,
+	0xfd, 0x73, 0xd1, 0x33, 0x0f, 0x54, 0xff, 0xb0, 0x87, 0xbd, 0x20, 0x20, 0xed, 0xe4, 0x20, 0xea,
+	0x3e, 0x38, 0xa8, 0x96, 0xa7, 0x3b, 0x03, 0xec, 0xba, 0x96, 0x89, 0xf9, 0x41, 0xb5, 0xbc, 0x7b,
+	0x4c, 0xa2, 0xdc, 0x62, 0x05, 0xf8, 0x21, 0x7f, 0x13, 0x05, 0xab, 0xb3, 0xe5, 0xf4, 0xbb, 0xe1,
+	0xd9, 0x8f, 0xbd, 0xa0, 0xa4, 0xc4, 0x0b, 0x4a, 0x79, 0x0b, 0x2a, 0x99, 0xf6, 0x2c, 0xb4, 0xd3,
+	0x41, 0x71, 0xec, 0x77, 0xf9, 0x25, 0x4b, 0x3f, 0x02, 0xb7, 0x2e, 0xee, 0x18, 0x56, 0xd7, 0xea,
+	0xb6, 0x09, 0xae, 0x09, 0x6d, 0x28, 0x18, 0xe6, 0xdc, 0x71, 0x0f, 0x76, 0x1e, 0xe1, 0x56, 0x3f,
+	0x58, 0xd2, 0xfb, 0xbe, 0xe1, 0xf7, 0xbd, 0x42, 0x39, 0xff, 0x5d, 0x98, 0x73, 0x91, 0x83, 0xf0,
+	0x7d, 0x7f, 0xbc, 0x87, 0xbb, 0x66, 0x00, 0x20, 0x67, 0x8f, 0x72, 0x1d, 0xa4, 0xc2, 0x24, 0x26,
+	0x9e, 0xb0, 0x59, 0x1a, 0xcb, 0xd6, 0x0f, 0x95, 0xd0, 0x25, 0x98, 0xf2, 0xad, 0x0e, 0x36, 0x75,
+	0xa7, 0xef, 0x97, 0xc6, 0x73, 0x2c, 0x88, 0xd6, 0xbd, 0xbe, 0xaf, 0x6c, 0x42, 0x35, 0xb2, 0x53,
+	0xee, 0x63, 0x1b, 0xb7, 0x7c, 0xc7, 0x6d, 0xd8, 0xb6, 0xf3, 0xae, 0x6d, 0x79, 0x7e, 0xa1, 0xd0,
+	0xb7, 0x61, 0x29, 0xdf, 0x07, 0x8b, 0x7e, 0x1e, 0xa6, 0x3c, 0x36, 0x18, 0xf6, 0x0a, 0xa1, 0x40,
+	0x59, 0xc9, 0xf7, 0x12, 0xbe, 0xdf, 0x1c, 0x58, 0x1e, 0xa1, 0xc7, 0xa6, 0xbb, 0x0d, 0x60, 0x84,
+	0x52, 0x96, 0xef, 0x85, 0x61, 0x36, 0xc4, 0xe6, 0xfc, 0x58, 0x0f, 0x2d, 0x95, 0x9b, 0x30, 0x1f,
+	0x99, 0x70, 0xd7, 0xe8, 0x7b, 0x38, 0x58, 0x56, 0x5c, 0x28, 0x37, 0x37, 0xe0, 0x5c, 0x86, 0x31,
+	0x43, 0x79, 0x26, 0x68, 0xf9, 0xfa, 0x1e, 0xa6, 0xa6, 0x93, 0x1a, 0xfb, 0x52, 0xce, 0xc2, 0x2b,
+	0xac, 0x43, 0x0c, 0x3e, 0xa9, 0x79, 0x98, 0x83, 0x9b, 0x20, 0x8b, 0x06, 0x0b, 0x35, 0x65, 0xca,
+	0x57, 0x59, 0x2b, 0x40, 0xcf, 0x4d, 0xa7, 0x63, 0xf9, 0x1d, 0xdc, 0xf5, 0xbd, 0xe2, 0x0f, 0x55,
+	0x45, 0x87, 0x79, 0xb1, 0x07, 0x06, 0xe0, 0xb5, 0xa0, 0x1c, 0x87, 0x62, 0x96, 0xfa, 0xb9, 0xd8,
+	0x46, 0x1c, 0x9a, 0xb1, 0x8c, 0x47, 0x2d, 0x14, 0x95, 0x67, 0xcd, 0x71, 0x0f, 0x76, 0x0d, 0xd7,
+	0xb7, 0x5a, 0x56, 0x8f, 0xd4, 0xd8, 0x8c, 0xe7, 0x81, 0xb2, 0x0f, 0xe5, 0x2c, 0x83, 0x70, 0x37,
+	0x9c, 0xec, 0x45, 0x07, 0xd8, 0xa5, 0x2b, 0xc7, 0x50, 0xc5, 0x4c, 0x19, 0xb0, 0xb8, 0x99, 0xf2,
+	0x54, 0x02, 0x39, 0x2c, 0x3d, 0xf1, 0xa9, 0x0c, 0x1f, 0xa3, 0xaf, 0x8b, 0xa7, 0xa9, 0x84, 0xd3,
+	0x88, 0x6d, 0x85, 0x73, 0xa1, 0x6f, 0x03, 0x8a, 0x09, 0x74, 0xd7, 0xf0, 0x69, 0x49, 0x7d, 0xf1,
+	0x32, 0xfd, 0xa5, 0x5e, 0x12, 0xab, 0xb2, 0xc9, 0x3a, 0xa1, 0x8c, 0x70, 0x0a, 0x55, 0xe3, 0x01,
+	0x54, 0x73, 0x7d, 0xb0, 0xec, 0xdf, 0x13, 0xa7, 0xa5, 0x3a, 0x22, 0x2d, 0x01, 0x4c, 0xf1, 0x32,
+	0x2c, 0xe7, 0xce, 0x1b, 0x1e, 0x94, 0x43, 0x58, 0xca, 0x57, 0x63, 0xf8, 0xde, 0x84, 0x99, 0x98,
+	0x7f, 0xbe, 0x69, 0x5f, 0x00, 0x60, 0xc2, 0x41, 0x58, 0xcf, 0xee, 0x04, 0x77, 0x61, 0xd7, 0xe8,
+	0xb6, 0xb0, 0x90, 0xb5, 0x50, 0x30, 0x2c, 0x8f, 0xd0, 0x63, 0x18, 0xbf, 0x12, 0x7f, 0xf2, 0x0f,
+	0x4b, 0x59, 0x86, 0x25, 0xc3, 0x45, 0x8d, 0x94, 0xab, 0x31, 0xae, 0xa8, 0xb1, 0xd9, 0x2c, 0x54,
+	0xbe, 0xd6, 0xe0, 0x4c, 0xd2, 0x8a, 0xa1, 0x99, 0x85, 0x71, 0x63, 0xcf, 0x62, 0x06, 0xc1, 0x4f,
+	0xa5, 0x94, 0xd4, 0x0d, 0x43, 0xbc, 0x03, 0x73, 0xa9, 0x91, 0xb0, 0x8f, 0x99, 0x30, 0xf6, 0x2c,
+	0x1e, 0x13, 0x4a, 0x94, 0xe7, 0xc6, 0x66, 0x93, 0x45, 0x41, 0xb4, 0x94, 0x57, 0x59, 0x51, 0x7c,
+	0xc3, 0xe8, 0x1a, 0x6d, 0x5e, 0xf8, 0x0a, 0x05, 0xa2, 0x81, 0x2c, 0xb2, 0x64, 0x28, 0xae, 0xc2,
+	0x31, 0xaa, 0xc9, 0xf6, 0xe5, 0xb0, 0x9d, 0x8a, 0xe9, 0x73, 0xfa, 0x8d, 0xea, 0x2a, 0x6f, 0x8b,
+	0x7c, 0x7e, 0x46, 0x1c, 0xdc, 0x2f, 0x39, 0x81, 0x93, 0x9c, 0x8c, 0x45, 0x70, 0x1d, 0x8e, 0x53,
+	0x54, 0xe9, 0x8e, 0x50, 0x14, 0x02, 0x57, 0xfe, 0xb4, 0x19, 0xba, 0xd7, 0xf9, 0x2e, 0x73, 0xdc,
+	0x83, 0x07, 0x86, 0x6d, 0x1f, 0xbe, 0x00, 0xfd, 0x41, 0x6b, 0xfa, 0x58, 0x58, 0xd3, 0x7f, 0x38,
+	0x06, 0x53, 0xa1, 0x1f, 0xb4, 0x0a, 0x13, 0x41, 0x40, 0x2c, 0x91, 0x27, 0x63, 0x65, 0x9b, 0xef,
+	0x91, 0x40, 0x16, 0x71, 0x73, 0x82, 0x74, 0x8e, 0xc9, 0x99, 0xc7, 0xd3, 0x33, 0x9f, 0x86, 0xa3,
+	0x3d, 0xe7, 0x5d, 0xec, 0xb2, 0x96, 0x86, 0x7e, 0x20, 0x15, 0x4e, 0xf1, 0x06, 0x45, 0xef, 0x61,
+	0xb7, 0x85, 0xbb, 0xbe, 0xd1, 0xc6, 0xa4, 0xa9, 0x99, 0xd2, 0x10, 0x1f, 0xda, 0x0d, 0x47, 0xd0,
+	0x72, 0xea, 0x45, 0x7d, 0x8c, 0xe8, 0x26, 0x5e, 0xc8, 0x32, 0xef, 0x90, 0xb0, 0x59, 0x3a, 0x4e,
+	0xee, 0xfc, 0xf0, 0x3b, 0x78, 0x0d, 0x04, 0xca, 0xae, 0x57, 0x9a, 0x24, 0xd7, 0x36, 0xfb, 0x52,
+	0x3a, 0x91, 0x46, 0x99, 0xe5, 0x95, 0x2d, 0xfc, 0x06, 0x1c, 0xf7, 0x0d, 0xdb, 0xb6, 0xb0, 0xe0,
+	0x0c, 0x71, 0x65, 0xbe, 0xe8, 0x4c, 0x31, 0x78, 0xa3, 0xfb, 0x8e, 0x1f, 0x84, 0x45, 0xa2, 0xa6,
+	0x6f, 0x61, 0x20, 0xa2, 0xdd, 0x40, 0xb2, 0x86, 0x61, 0x26, 0xde, 0xab, 0xa1, 0x39, 0x38, 0xd5,
+	0xd8, 0xdd, 0xd5, 0xee, 0x3d, 0x6c, 0xdc, 0xd5, 0x6f, 0x37, 0xef, 0x3e, 0xd8, 0xd1, 0xf4, 0xc6,
+	0x37, 0xbe, 0x39, 0x7b, 0x04, 0xcd, 0x43, 0x29, 0x35, 0x40, 0xbe, 0x77, 0xb6, 0x67, 0x25, 0xd1,
+	0xa8, 0xb6, 0xf3, 0xfa, 0xce, 0xd6, 0x83, 0x9d, 0xed, 0xd9, 0xb1, 0x8d, 0x3f, 0x95, 0xe1, 0x28,
+	0x09, 0x0b, 0x1d, 0xc0, 0x74, 0x84, 0x0f, 0x47, 0x67, 0xc3, 0x18, 0xd2, 0xdc, 0xb9, 0x3c, 0x2f,
+	0x1e, 0xa4, 0xf9, 0x50, 0x16, 0xdf, 0xfb, 0xeb, 0x3f, 0x7f, 0x32, 0x76, 0x16, 0xbd, 0xa2, 0x7a,
+	0x4e, 0xa7, 0x83, 0x6d, 0x0b, 0xbb, 0x2a, 0xa7, 0xf0, 0x29, 0x6d, 0x8e, 0x1e, 0xc1, 0x4c, 0x9c,
+	0xce, 0x46, 0xe5, 0xb8, 0xcb, 0x24, 0x9f, 0x2e, 0x57, 0x32, 0xc7, 0xd9, 0xac, 0xcb, 0x64, 0xd6,
+	0x0a, 0x3a, 0x27, 0x98, 0x75, 0xf8, 0x16, 0x43, 0x3f, 0x92, 0x18, 0xef, 0x1f, 0x2f, 0xf1, 0xa8,
+	0x1a, 0xf7, 0x2f, 0xbc, 0x28, 0xe4, 0xa5, 0x7c, 0x25, 0x86, 0x64, 0x8d, 0x20, 0x59, 0x42, 0x8a,
+	0x00, 0x89, 0xc7, 0x4d, 0x74, 0x4a, 0x0b, 0x7d, 0x28, 0x25, 0xf9, 0xf4, 0x28, 0x17, 0x8a, 0xd6,
+	0x32, 0x26, 0x14, 0xb0, 0xaa, 0xf2, 0x85, 0x42, 0xba, 0x0c, 0xe3, 0x06, 0xc1, 0xb8, 0x8e, 0xd6,
+	0x72, 0x31, 0xc6, 0xf8, 0x57, 0xf4, 0x17, 0xde, 0x5f, 0xe5, 0x70, 0x95, 0xe8, 0x52, 0x5e, 0x8a,
+	0x44, 0xb4, 0xac, 0x7c, 0xf9, 0x05, 0x2c, 0x18, 0xfa, 0x26, 0x41, 0xbf, 0x85, 0x1a, 0xa3, 0x33,
+	0xac, 0xef, 0x1d, 0xc6, 0xc2, 0x50, 0x1f, 0x47, 0xbf, 0x9e, 0xa0, 0x5f, 0x70, 0xd2, 0x2a, 0xcd,
+	0xf4, 0xa1, 0xd5, 0x7c, 0x64, 0x21, 0x5f, 0x29, 0xd7, 0x46, 0x2b, 0x32, 0xe4, 0xd7, 0x08, 0x72,
+	0x15, 0x5d, 0x2c, 0x86, 0xdc, 0x32, 0xd5, 0xc7, 0x96, 0xf9, 0x04, 0xbd, 0x27, 0xc1, 0x4b, 0x09,
+	0xea, 0x05, 0x25, 0x4f, 0x44, 0x92, 0xc0, 0x93, 0x17, 0xb2, 0x15, 0x18, 0x9a, 0x75, 0x82, 0x66,
+	0x05, 0x2d, 0x89, 0xce, 0x8c, 0xe3, 0x1e, 0xe8, 0x2e, 0xd1, 0xf7, 0x28, 0x88, 0xef, 0x4a, 0x30,
+	0x9b, 0xf0, 0xe4, 0xa1, 0xcc, 0x49, 0xc2, 0x7d, 0xb9, 0x98, 0xa3, 0xc1, 0x70, 0xac, 0x12, 0x1c,
+	0x8b, 0xa8, 0x32, 0x02, 0x07, 0xfa, 0x55, 0x48, 0x41, 0xa5, 0x69, 0x15, 0x54, 0x13, 0x95, 0x08,
+	0x11, 0x75, 0x23, 0x9f, 0x2f, 0xa0, 0x59, 0x60, 0xc1, 0xe2, 0x57, 0x8d, 0xfa, 0x38, 0x2c, 0x33,
+	0x4f, 0xd0, 0x87, 0x7c, 0x5b, 0xa5, 0x39, 0x92, 0xe4, 0xb6, 0xca, 0x64, 0x61, 0xe4, 0xda, 0x68,
+	0x45, 0x86, 0xf2, 0x26, 0x41, 0x79, 0x0d, 0x5d, 0x11, 0xa1, 0xe4, 0x66, 0x64, 0x5b, 0xe9, 0x84,
+	0x89, 0x51, 0x1f, 0x87, 0xd2, 0x27, 0xe8, 0xd7, 0x51, 0x5e, 0x2f, 0xc1, 0x9b, 0xa4, 0x92, 0x9a,
+	0xc9, 0xcd, 0xc8, 0xe7, 0x0b, 0x68, 0x32, 0xb8, 0x37, 0x08, 0xdc, 0xcb, 0x48, 0x15, 0xc0, 0xc5,
+	0xdc, 0x46, 0xf7, 0x88, 0x51, 0x2c, 0xad, 0x1f, 0x49, 0x31, 0x26, 0x20, 0xc5, 0x1d, 0xa0, 0x75,
+	0xd1, 0xca, 0x66, 0x71, 0x2a, 0xf2, 0xc5, 0x82, 0xda, 0x0c, 0xf6, 0x97, 0x09, 0xec, 0xab, 0x68,
+	0x43, 0x74, 0x78, 0x99, 0x95, 0x3e, 0xa4, 0x2d, 0x62, 0xc8, 0x7f, 0x23, 0xc1, 0xb9, 0xbc, 0x49,
+	0x3c, 0x54, 0x0c, 0x4c, 0x98, 0xee, 0x7a, 0x51, 0x75, 0x06, 0xbe, 0x4e, 0xc0, 0xd7, 0xd0, 0x4a,
+	0x31, 0xf0, 0xe8, 0x03, 0x29, 0xd6, 0xae, 0x0c, 0x79, 0x13, 0xb4, 0x2c, 0x9a, 0x39, 0x45, 0xca,
+	0xc8, 0x2b, 0xa3, 0xd4, 0x0a, 0x5c, 0x45, 0x84, 0x89, 0x21, 0x1b, 0x01, 0xc7, 0xb2, 0xf9, 0x03,
+	0x09, 0x50, 0x9a, 0x7e, 0x41, 0x4a, 0xf2, 0x5d, 0x92, 0x26, 0x6e, 0xe4, 0x6a, 0xae, 0x0e, 0xc3,
+	0x74, 0x9e, 0x60, 0xaa, 0xa2, 0xc5, 0x2c, 0x4c, 0xa6, 0xce, 0x9f, 0xef, 0x3f, 0x93, 0x22, 0x0f,
+	0xc3, 0x58, 0x67, 0x8a, 0x56, 0xd2, 0x27, 0x42, 0xd4, 0xdf, 0xcb, 0xab, 0x23, 0xf5, 0x0a, 0xa4,
+	0x8a, 0x1c, 0xee, 0x58, 0x03, 0x4c, 0xab, 0xf6, 0x1f, 0x78, 0xdb, 0x22, 0x6e, 0x9f, 0xd1, 0x85,
+	0x8c, 0x22, 0x23, 0x44, 0xba, 0x5e, 0x4c, 0x99, 0xc1, 0xbd, 0x45, 0xe0, 0xbe, 0x8a, 0xae, 0xe7,
+	0x56, 0xa5, 0x04, 0xe6, 0x48, 0x61, 0xfa, 0x2d, 0x3f, 0xed, 0xe2, 0x79, 0x3c, 0x54, 0x08, 0x8e,
+	0x97, 0x71, 0xda, 0x47, 0x10, 0x12, 0xb9, 0xc9, 0xce, 0x40, 0x8f, 0xde, 0x97, 0xe0, 0xb4, 0x88,
+	0x97, 0x43, 0x4b, 0xe9, 0x25, 0x4e, 0x13, 0x7f, 0xf2, 0xf2, 0x08, 0x2d, 0x86, 0xec, 0x02, 0x41,
+	0xb6, 0x8c, 0xaa, 0x59, 0xdb, 0x20, 0x42, 0xe4, 0xa1, 0xdf, 0xf3, 0xc2, 0x93, 0xc5, 0x6e, 0x24,
+	0x0b, 0xcf, 0x08, 0xb6, 0x44, 0xae, 0x17, 0x55, 0x2f, 0x70, 0x83, 0xb6, 0x43, 0x63, 0x3d, 0xf9,
+	0x32, 0xfe, 0x9e, 0x14, 0xeb, 0x11, 0x1a, 0x9b, 0x4d, 0x71, 0x8f, 0x30, 0xe4, 0x51, 0xe4, 0x4a,
+	0xe6, 0x78, 0x91, 0xf3, 0x43, 0xab, 0x4b, 0x40, 0x72, 0xc4, 0x4a, 0xcd, 0x77, 0xf8, 0xcb, 0x8b,
+	0x7b, 0xf3, 0x50, 0xd6, 0x3c, 0x5e, 0xd6, 0xcb, 0x2b, 0x45, 0xba, 0x28, 0x2b, 0x04, 0xc9, 0x02,
+	0x2a, 0xe7, 0x23, 0x41, 0x3f, 0xe5, 0x85, 0x2e, 0x46, 0x21, 0x24, 0x0b, 0x9d, 0x88, 0x8c, 0x91,
+	0xab, 0xb9, 0x3a, 0x0c, 0xc7, 0x75, 0x82, 0xe3, 0x12, 0xaa, 0x0b, 0x70, 0x74, 0xa8, 0x05, 0xaf,
+	0x74, 0xb1, 0xac, 0x84, 0x6d, 0x54, 0xcc, 0x6d, 0xaa, 0x8d, 0x12, 0xf2, 0x32, 0xf2, 0x52, 0xbe,
+	0x52, 0x81, 0x36, 0x2a, 0x01, 0x6d, 0xd8, 0x4f, 0x86, 0x64, 0x45, 0x39, 0x7d, 0x94, 0xa2, 0x6c,
+	0x88, 0x5c, 0xc9, 0x1c, 0x2f, 0xd2, 0x4f, 0x06, 0x87, 0xcc, 0x27, 0x7d, 0xfd, 0xed, 0xa7, 0xcf,
+	0xca, 0xd2, 0xc7, 0xcf, 0xca, 0xd2, 0x3f, 0x9e, 0x95, 0xa5, 0xf7, 0x9f, 0x97, 0x8f, 0x7c, 0xfc,
+	0xbc, 0x7c, 0xe4, 0x6f, 0xcf, 0xcb, 0x47, 0xbe, 0xb5, 0x1e, 0xa1, 0x85, 0x7b, 0xb8, 0xdd, 0x3e,
+	0x7c, 0x7b, 0x10, 0x71, 0x35, 0xb8, 0xa1, 0x3e, 0xa2, 0xfe, 0x08, 0x41, 0xbc, 0x77, 0x8c, 0xfc,
+	0xaf, 0xda, 0x95, 0xff, 0x0e, 0x00, 0xb7, 0x13, 0x76, 0x0e, 0x56, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryParams queries the allocation module parameters.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryCellarIDs returns all cellars and current tick ranges
+	QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks
+	QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error)
+	// QueryScheduledBlockHeights returns all scheduled block heights
+	QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks at a block height
+	QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error)
+	// QueryScheduledCorks returns all scheduled corks with the specified ID
+	QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error)
+	QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error)
+	QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error)
+	// QueryCellarVoteThreshold returns the vote threshold that applies to a cellar
+	QueryCellarVoteThreshold(ctx context.Context, in *QueryCellarVoteThresholdRequest, opts ...grpc.CallOption) (*QueryCellarVoteThresholdResponse, error)
+	// QueryValidatorCorkCount returns the number of corks a validator has scheduled and its remaining quota
+	QueryValidatorCorkCount(ctx context.Context, in *QueryValidatorCorkCountRequest, opts ...grpc.CallOption) (*QueryValidatorCorkCountResponse, error)
+	// QueryCorkExecutionStatus returns a cellar's approved corks grouped by their Ethereum execution status
+	QueryCorkExecutionStatus(ctx context.Context, in *QueryCorkExecutionStatusRequest, opts ...grpc.CallOption) (*QueryCorkExecutionStatusResponse, error)
+	// QueryCellarSelectorAllowlist returns the function selectors validators may call on a cellar
+	QueryCellarSelectorAllowlist(ctx context.Context, in *QueryCellarSelectorAllowlistRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistResponse, error)
+	// QueryCellarSelectorAllowlists returns every cellar's function selector allowlist
+	QueryCellarSelectorAllowlists(ctx context.Context, in *QueryCellarSelectorAllowlistsRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistsResponse, error)
+	// QueryCellarPauseState returns whether a cellar is paused
+	QueryCellarPauseState(ctx context.Context, in *QueryCellarPauseStateRequest, opts ...grpc.CallOption) (*QueryCellarPauseStateResponse, error)
+	// QueryPausedCellars returns the IDs of all paused cellars
+	QueryPausedCellars(ctx context.Context, in *QueryPausedCellarsRequest, opts ...grpc.CallOption) (*QueryPausedCellarsResponse, error)
+	// QueryCorkParticipation returns which bonded validators voted for a tallied cork
+	QueryCorkParticipation(ctx context.Context, in *QueryCorkParticipationRequest, opts ...grpc.CallOption) (*QueryCorkParticipationResponse, error)
+	// QueryValidatorParticipation returns a validator's cork vote participation over the participation window
+	QueryValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error)
+	// QueryValidatorParticipations returns every tracked validator's cork vote participation
+	QueryValidatorParticipations(ctx context.Context, in *QueryValidatorParticipationsRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationsResponse, error)
+	// QueryCorkCommitments returns validators' cork vote commitments, optionally limited to a single block height
+	QueryCorkCommitments(ctx context.Context, in *QueryCorkCommitmentsRequest, opts ...grpc.CallOption) (*QueryCorkCommitmentsResponse, error)
+	// QueryGovernanceScheduledCorks returns the corks scheduled by governance that have yet to be executed
+	QueryGovernanceScheduledCorks(ctx context.Context, in *QueryGovernanceScheduledCorksRequest, opts ...grpc.CallOption) (*QueryGovernanceScheduledCorksResponse, error)
+	// QueryCellarABI returns the contract ABI registered for a cellar
+	QueryCellarABI(ctx context.Context, in *QueryCellarABIRequest, opts ...grpc.CallOption) (*QueryCellarABIResponse, error)
+	// QueryCellarABIs returns every registered cellar contract ABI
+	QueryCellarABIs(ctx context.Context, in *QueryCellarABIsRequest, opts ...grpc.CallOption) (*QueryCellarABIsResponse, error)
+	// QueryManagedCellar returns the record of a managed cellar, including its metadata
+	QueryManagedCellar(ctx context.Context, in *QueryManagedCellarRequest, opts ...grpc.CallOption) (*QueryManagedCellarResponse, error)
+	// QueryManagedCellars returns the records of all managed cellars
+	QueryManagedCellars(ctx context.Context, in *QueryManagedCellarsRequest, opts ...grpc.CallOption) (*QueryManagedCellarsResponse, error)
+	// QueryCorkTally previews the tally of the corks scheduled for a block height using current validator power
+	QueryCorkTally(ctx context.Context, in *QueryCorkTallyRequest, opts ...grpc.CallOption) (*QueryCorkTallyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarIDs(ctx context.Context, in *QueryCellarIDsRequest, opts ...grpc.CallOption) (*QueryCellarIDsResponse, error) {
+	out := new(QueryCellarIDsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledCorks(ctx context.Context, in *QueryScheduledCorksRequest, opts ...grpc.CallOption) (*QueryScheduledCorksResponse, error) {
+	out := new(QueryScheduledCorksResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledBlockHeights(ctx context.Context, in *QueryScheduledBlockHeightsRequest, opts ...grpc.CallOption) (*QueryScheduledBlockHeightsResponse, error) {
+	out := new(QueryScheduledBlockHeightsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledBlockHeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledCorksByBlockHeight(ctx context.Context, in *QueryScheduledCorksByBlockHeightRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByBlockHeightResponse, error) {
+	out := new(QueryScheduledCorksByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorksByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledCorksByID(ctx context.Context, in *QueryScheduledCorksByIDRequest, opts ...grpc.CallOption) (*QueryScheduledCorksByIDResponse, error) {
+	out := new(QueryScheduledCorksByIDResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryScheduledCorksByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkResult(ctx context.Context, in *QueryCorkResultRequest, opts ...grpc.CallOption) (*QueryCorkResultResponse, error) {
+	out := new(QueryCorkResultResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkResults(ctx context.Context, in *QueryCorkResultsRequest, opts ...grpc.CallOption) (*QueryCorkResultsResponse, error) {
+	out := new(QueryCorkResultsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarVoteThreshold(ctx context.Context, in *QueryCellarVoteThresholdRequest, opts ...grpc.CallOption) (*QueryCellarVoteThresholdResponse, error) {
+	out := new(QueryCellarVoteThresholdResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarVoteThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorCorkCount(ctx context.Context, in *QueryValidatorCorkCountRequest, opts ...grpc.CallOption) (*QueryValidatorCorkCountResponse, error) {
+	out := new(QueryValidatorCorkCountResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryValidatorCorkCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkExecutionStatus(ctx context.Context, in *QueryCorkExecutionStatusRequest, opts ...grpc.CallOption) (*QueryCorkExecutionStatusResponse, error) {
+	out := new(QueryCorkExecutionStatusResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkExecutionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarSelectorAllowlist(ctx context.Context, in *QueryCellarSelectorAllowlistRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistResponse, error) {
+	out := new(QueryCellarSelectorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarSelectorAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarSelectorAllowlists(ctx context.Context, in *QueryCellarSelectorAllowlistsRequest, opts ...grpc.CallOption) (*QueryCellarSelectorAllowlistsResponse, error) {
+	out := new(QueryCellarSelectorAllowlistsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarSelectorAllowlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarPauseState(ctx context.Context, in *QueryCellarPauseStateRequest, opts ...grpc.CallOption) (*QueryCellarPauseStateResponse, error) {
+	out := new(QueryCellarPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPausedCellars(ctx context.Context, in *QueryPausedCellarsRequest, opts ...grpc.CallOption) (*QueryPausedCellarsResponse, error) {
+	out := new(QueryPausedCellarsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryPausedCellars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkParticipation(ctx context.Context, in *QueryCorkParticipationRequest, opts ...grpc.CallOption) (*QueryCorkParticipationResponse, error) {
+	out := new(QueryCorkParticipationResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error) {
+	out := new(QueryValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorParticipations(ctx context.Context, in *QueryValidatorParticipationsRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationsResponse, error) {
+	out := new(QueryValidatorParticipationsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryValidatorParticipations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkCommitments(ctx context.Context, in *QueryCorkCommitmentsRequest, opts ...grpc.CallOption) (*QueryCorkCommitmentsResponse, error) {
+	out := new(QueryCorkCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryGovernanceScheduledCorks(ctx context.Context, in *QueryGovernanceScheduledCorksRequest, opts ...grpc.CallOption) (*QueryGovernanceScheduledCorksResponse, error) {
+	out := new(QueryGovernanceScheduledCorksResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryGovernanceScheduledCorks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarABI(ctx context.Context, in *QueryCellarABIRequest, opts ...grpc.CallOption) (*QueryCellarABIResponse, error) {
+	out := new(QueryCellarABIResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCellarABIs(ctx context.Context, in *QueryCellarABIsRequest, opts ...grpc.CallOption) (*QueryCellarABIsResponse, error) {
+	out := new(QueryCellarABIsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCellarABIs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryManagedCellar(ctx context.Context, in *QueryManagedCellarRequest, opts ...grpc.CallOption) (*QueryManagedCellarResponse, error) {
+	out := new(QueryManagedCellarResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryManagedCellar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryManagedCellars(ctx context.Context, in *QueryManagedCellarsRequest, opts ...grpc.CallOption) (*QueryManagedCellarsResponse, error) {
+	out := new(QueryManagedCellarsResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryManagedCellars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCorkTally(ctx context.Context, in *QueryCorkTallyRequest, opts ...grpc.CallOption) (*QueryCorkTallyResponse, error) {
+	out := new(QueryCorkTallyResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Query/QueryCorkTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryParams queries the allocation module parameters.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryCellarIDs returns all cellars and current tick ranges
+	QueryCellarIDs(context.Context, *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks
+	QueryScheduledCorks(context.Context, *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error)
+	// QueryScheduledBlockHeights returns all scheduled block heights
+	QueryScheduledBlockHeights(context.Context, *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error)
+	// QueryScheduledCorks returns all scheduled corks at a block height
+	QueryScheduledCorksByBlockHeight(context.Context, *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error)
+	// QueryScheduledCorks returns all scheduled corks with the specified ID
+	QueryScheduledCorksByID(context.Context, *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error)
+	QueryCorkResult(context.Context, *QueryCorkResultRequest) (*QueryCorkResultResponse, error)
+	QueryCorkResults(context.Context, *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error)
+	// QueryCellarVoteThreshold returns the vote threshold that applies to a cellar
+	QueryCellarVoteThreshold(context.Context, *QueryCellarVoteThresholdRequest) (*QueryCellarVoteThresholdResponse, error)
+	// QueryValidatorCorkCount returns the number of corks a validator has scheduled and its remaining quota
+	QueryValidatorCorkCount(context.Context, *QueryValidatorCorkCountRequest) (*QueryValidatorCorkCountResponse, error)
+	// QueryCorkExecutionStatus returns a cellar's approved corks grouped by their Ethereum execution status
+	QueryCorkExecutionStatus(context.Context, *QueryCorkExecutionStatusRequest) (*QueryCorkExecutionStatusResponse, error)
+	// QueryCellarSelectorAllowlist returns the function selectors validators may call on a cellar
+	QueryCellarSelectorAllowlist(context.Context, *QueryCellarSelectorAllowlistRequest) (*QueryCellarSelectorAllowlistResponse, error)
+	// QueryCellarSelectorAllowlists returns every cellar's function selector allowlist
+	QueryCellarSelectorAllowlists(context.Context, *QueryCellarSelectorAllowlistsRequest) (*QueryCellarSelectorAllowlistsResponse, error)
+	// QueryCellarPauseState returns whether a cellar is paused
+	QueryCellarPauseState(context.Context, *QueryCellarPauseStateRequest) (*QueryCellarPauseStateResponse, error)
+	// QueryPausedCellars returns the IDs of all paused cellars
+	QueryPausedCellars(context.Context, *QueryPausedCellarsRequest) (*QueryPausedCellarsResponse, error)
+	// QueryCorkParticipation returns which bonded validators voted for a tallied cork
+	QueryCorkParticipation(context.Context, *QueryCorkParticipationRequest) (*QueryCorkParticipationResponse, error)
+	// QueryValidatorParticipation returns a validator's cork vote participation over the participation window
+	QueryValidatorParticipation(context.Context, *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error)
+	// QueryValidatorParticipations returns every tracked validator's cork vote participation
+	QueryValidatorParticipations(context.Context, *QueryValidatorParticipationsRequest) (*QueryValidatorParticipationsResponse, error)
+	// QueryCorkCommitments returns validators' cork vote commitments, optionally limited to a single block height
+	QueryCorkCommitments(context.Context, *QueryCorkCommitmentsRequest) (*QueryCorkCommitmentsResponse, error)
+	// QueryGovernanceScheduledCorks returns the corks scheduled by governance that have yet to be executed
+	QueryGovernanceScheduledCorks(context.Context, *QueryGovernanceScheduledCorksRequest) (*QueryGovernanceScheduledCorksResponse, error)
+	// QueryCellarABI returns the contract ABI registered for a cellar
+	QueryCellarABI(context.Context, *QueryCellarABIRequest) (*QueryCellarABIResponse, error)
+	// QueryCellarABIs returns every registered cellar contract ABI
+	QueryCellarABIs(context.Context, *QueryCellarABIsRequest) (*QueryCellarABIsResponse, error)
+	// QueryManagedCellar returns the record of a managed cellar, including its metadata
+	QueryManagedCellar(context.Context, *QueryManagedCellarRequest) (*QueryManagedCellarResponse, error)
+	// QueryManagedCellars returns the records of all managed cellars
+	QueryManagedCellars(context.Context, *QueryManagedCellarsRequest) (*QueryManagedCellarsResponse, error)
+	// QueryCorkTally previews the tally of the corks scheduled for a block height using current validator power
+	QueryCorkTally(context.Context, *QueryCorkTallyRequest) (*QueryCorkTallyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarIDs(ctx context.Context, req *QueryCellarIDsRequest) (*QueryCellarIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarIDs not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorks(ctx context.Context, req *QueryScheduledCorksRequest) (*QueryScheduledCorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorks not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledBlockHeights(ctx context.Context, req *QueryScheduledBlockHeightsRequest) (*QueryScheduledBlockHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledBlockHeights not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorksByBlockHeight(ctx context.Context, req *QueryScheduledCorksByBlockHeightRequest) (*QueryScheduledCorksByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledCorksByID(ctx context.Context, req *QueryScheduledCorksByIDRequest) (*QueryScheduledCorksByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledCorksByID not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkResult(ctx context.Context, req *QueryCorkResultRequest) (*QueryCorkResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResult not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkResults(ctx context.Context, req *QueryCorkResultsRequest) (*QueryCorkResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkResults not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarVoteThreshold(ctx context.Context, req *QueryCellarVoteThresholdRequest) (*QueryCellarVoteThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarVoteThreshold not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorCorkCount(ctx context.Context, req *QueryValidatorCorkCountRequest) (*QueryValidatorCorkCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorCorkCount not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkExecutionStatus(ctx context.Context, req *QueryCorkExecutionStatusRequest) (*QueryCorkExecutionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkExecutionStatus not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarSelectorAllowlist(ctx context.Context, req *QueryCellarSelectorAllowlistRequest) (*QueryCellarSelectorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarSelectorAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarSelectorAllowlists(ctx context.Context, req *QueryCellarSelectorAllowlistsRequest) (*QueryCellarSelectorAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarSelectorAllowlists not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarPauseState(ctx context.Context, req *QueryCellarPauseStateRequest) (*QueryCellarPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarPauseState not implemented")
+}
+func (*UnimplementedQueryServer) QueryPausedCellars(ctx context.Context, req *QueryPausedCellarsRequest) (*QueryPausedCellarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPausedCellars not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkParticipation(ctx context.Context, req *QueryCorkParticipationRequest) (*QueryCorkParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkParticipation not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorParticipation(ctx context.Context, req *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorParticipation not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorParticipations(ctx context.Context, req *QueryValidatorParticipationsRequest) (*QueryValidatorParticipationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorParticipations not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkCommitments(ctx context.Context, req *QueryCorkCommitmentsRequest) (*QueryCorkCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkCommitments not implemented")
+}
+func (*UnimplementedQueryServer) QueryGovernanceScheduledCorks(ctx context.Context, req *QueryGovernanceScheduledCorksRequest) (*QueryGovernanceScheduledCorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGovernanceScheduledCorks not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarABI(ctx context.Context, req *QueryCellarABIRequest) (*QueryCellarABIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarABI not implemented")
+}
+func (*UnimplementedQueryServer) QueryCellarABIs(ctx context.Context, req *QueryCellarABIsRequest) (*QueryCellarABIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCellarABIs not implemented")
+}
+func (*UnimplementedQueryServer) QueryManagedCellar(ctx context.Context, req *QueryManagedCellarRequest) (*QueryManagedCellarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryManagedCellar not implemented")
+}
+func (*UnimplementedQueryServer) QueryManagedCellars(ctx context.Context, req *QueryManagedCellarsRequest) (*QueryManagedCellarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryManagedCellars not implemented")
+}
+func (*UnimplementedQueryServer) QueryCorkTally(ctx context.Context, req *QueryCorkTallyRequest) (*QueryCorkTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCorkTally not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarIDs(ctx, req.(*QueryCellarIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryScheduledCorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorks(ctx, req.(*QueryScheduledCorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledBlockHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledBlockHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryScheduledBlockHeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledBlockHeights(ctx, req.(*QueryScheduledBlockHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorksByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryScheduledCorksByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorksByBlockHeight(ctx, req.(*QueryScheduledCorksByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledCorksByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCorksByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledCorksByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryScheduledCorksByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledCorksByID(ctx, req.(*QueryScheduledCorksByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkResult(ctx, req.(*QueryCorkResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkResults(ctx, req.(*QueryCorkResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarVoteThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarVoteThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarVoteThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarVoteThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarVoteThreshold(ctx, req.(*QueryCellarVoteThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorCorkCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCorkCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorCorkCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryValidatorCorkCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorCorkCount(ctx, req.(*QueryValidatorCorkCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkExecutionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkExecutionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkExecutionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkExecutionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkExecutionStatus(ctx, req.(*QueryCorkExecutionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarSelectorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarSelectorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarSelectorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarSelectorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarSelectorAllowlist(ctx, req.(*QueryCellarSelectorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarSelectorAllowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarSelectorAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarSelectorAllowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarSelectorAllowlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarSelectorAllowlists(ctx, req.(*QueryCellarSelectorAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarPauseState(ctx, req.(*QueryCellarPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPausedCellars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedCellarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPausedCellars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryPausedCellars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPausedCellars(ctx, req.(*QueryPausedCellarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkParticipation(ctx, req.(*QueryCorkParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorParticipation(ctx, req.(*QueryValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorParticipations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorParticipationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorParticipations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryValidatorParticipations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorParticipations(ctx, req.(*QueryValidatorParticipationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkCommitments(ctx, req.(*QueryCorkCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryGovernanceScheduledCorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceScheduledCorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGovernanceScheduledCorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryGovernanceScheduledCorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGovernanceScheduledCorks(ctx, req.(*QueryGovernanceScheduledCorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarABI(ctx, req.(*QueryCellarABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCellarABIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCellarABIsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCellarABIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCellarABIs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCellarABIs(ctx, req.(*QueryCellarABIsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryManagedCellar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagedCellarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryManagedCellar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryManagedCellar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryManagedCellar(ctx, req.(*QueryManagedCellarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryManagedCellars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagedCellarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryManagedCellars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryManagedCellars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryManagedCellars(ctx, req.(*QueryManagedCellarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCorkTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorkTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCorkTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Query/QueryCorkTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCorkTally(ctx, req.(*QueryCorkTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cork.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryCellarIDs",
+			Handler:    _Query_QueryCellarIDs_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorks",
+			Handler:    _Query_QueryScheduledCorks_Handler,
+		},
+		{
+			MethodName: "QueryScheduledBlockHeights",
+			Handler:    _Query_QueryScheduledBlockHeights_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorksByBlockHeight",
+			Handler:    _Query_QueryScheduledCorksByBlockHeight_Handler,
+		},
+		{
+			MethodName: "QueryScheduledCorksByID",
+			Handler:    _Query_QueryScheduledCorksByID_Handler,
+		},
+		{
+			MethodName: "QueryCorkResult",
+			Handler:    _Query_QueryCorkResult_Handler,
+		},
+		{
+			MethodName: "QueryCorkResults",
+			Handler:    _Query_QueryCorkResults_Handler,
+		},
+		{
+			MethodName: "QueryCellarVoteThreshold",
+			Handler:    _Query_QueryCellarVoteThreshold_Handler,
+		},
+		{
+			MethodName: "QueryValidatorCorkCount",
+			Handler:    _Query_QueryValidatorCorkCount_Handler,
+		},
+		{
+			MethodName: "QueryCorkExecutionStatus",
+			Handler:    _Query_QueryCorkExecutionStatus_Handler,
+		},
+		{
+			MethodName: "QueryCellarSelectorAllowlist",
+			Handler:    _Query_QueryCellarSelectorAllowlist_Handler,
+		},
+		{
+			MethodName: "QueryCellarSelectorAllowlists",
+			Handler:    _Query_QueryCellarSelectorAllowlists_Handler,
+		},
+		{
+			MethodName: "QueryCellarPauseState",
+			Handler:    _Query_QueryCellarPauseState_Handler,
+		},
+		{
+			MethodName: "QueryPausedCellars",
+			Handler:    _Query_QueryPausedCellars_Handler,
+		},
+		{
+			MethodName: "QueryCorkParticipation",
+			Handler:    _Query_QueryCorkParticipation_Handler,
+		},
+		{
+			MethodName: "QueryValidatorParticipation",
+			Handler:    _Query_QueryValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "QueryValidatorParticipations",
+			Handler:    _Query_QueryValidatorParticipations_Handler,
+		},
+		{
+			MethodName: "QueryCorkCommitments",
+			Handler:    _Query_QueryCorkCommitments_Handler,
+		},
+		{
+			MethodName: "QueryGovernanceScheduledCorks",
+			Handler:    _Query_QueryGovernanceScheduledCorks_Handler,
+		},
+		{
+			MethodName: "QueryCellarABI",
+			Handler:    _Query_QueryCellarABI_Handler,
+		},
+		{
+			MethodName: "QueryCellarABIs",
+			Handler:    _Query_QueryCellarABIs_Handler,
+		},
+		{
+			MethodName: "QueryManagedCellar",
+			Handler:    _Query_QueryManagedCellar_Handler,
+		},
+		{
+			MethodName: "QueryManagedCellars",
+			Handler:    _Query_QueryManagedCellars_Handler,
+		},
+		{
+			MethodName: "QueryCorkTally",
+			Handler:    _Query_QueryCorkTally_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cork/v2/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledBlockHeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledBlockHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledBlockHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledBlockHeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledBlockHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledBlockHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHeights) > 0 {
+		dAtA7 := make([]byte, len(m.BlockHeights)*10)
+		var j6 int
+		for _, num := range m.BlockHeights {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCorksByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCorksByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCorksByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCall {
+		i--
+		if m.DecodeCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodedCall != nil {
+		{
+			size, err := m.DecodedCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CorkResult != nil {
+		{
+			size, err := m.CorkResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecodeCalls {
+		i--
+		if m.DecodeCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Approval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Approval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetContractAddress) > 0 {
+		i -= len(m.TargetContractAddress)
+		copy(dAtA[i:], m.TargetContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodedCalls) > 0 {
+		for iNdEx := len(m.DecodedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CorkResults) > 0 {
+		for iNdEx := len(m.CorkResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorkResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarVoteThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarVoteThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarVoteThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarVoteThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarVoteThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarVoteThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOverride {
+		i--
+		if m.IsOverride {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCorkCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCorkCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCorkCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCorkCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCorkCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCorkCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkExecutionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkExecutionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkExecutionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkExecutionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkExecutionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkExecutionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimedOut) > 0 {
+		for iNdEx := len(m.TimedOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Executed) > 0 {
+		for iNdEx := len(m.Executed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlists) > 0 {
+		for iNdEx := len(m.Allowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCellarsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCellarsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCellarsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCellarsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCellarsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCellarsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for iNdEx := len(m.CellarIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CellarIds[iNdEx])
+			copy(dAtA[i:], m.CellarIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipationRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipationRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipationRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ParticipationRate.Size()
+		i -= size
+		if _, err := m.ParticipationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for iNdEx := len(m.Participations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovernanceScheduledCorksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovernanceScheduledCorksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovernanceScheduledCorksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovernanceScheduledCorksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovernanceScheduledCorksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovernanceScheduledCorksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for iNdEx := len(m.Corks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCellarABIsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCellarABIsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCellarABIsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abis) > 0 {
+		for iNdEx := len(m.Abis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Abis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cellar.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagedCellarsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagedCellarsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagedCellarsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Cellars) > 0 {
+		for iNdEx := len(m.Cellars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cellars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorkTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorkTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorkTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VoteThreshold) > 0 {
+		i -= len(m.VoteThreshold)
+		copy(dAtA[i:], m.VoteThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApprovalPercentage) > 0 {
+		i -= len(m.ApprovalPercentage)
+		copy(dAtA[i:], m.ApprovalPercentage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ApprovalPercentage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorkTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorkTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorkTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCellarIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCellarIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCorksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockHeight))
+	}
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledBlockHeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledBlockHeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeights) > 0 {
+		l = 0
+		for _, e := range m.BlockHeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduledCorksByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DecodeCall {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCorkResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorkResult != nil {
+		l = m.CorkResult.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DecodedCall != nil {
+		l = m.DecodedCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorkResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TargetContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockHeight))
+	}
+	if m.Approval != 0 {
+		n += 1 + sovQuery(uint64(m.Approval))
+	}
+	if m.DecodeCalls {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCorkResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CorkResults) > 0 {
+		for _, e := range m.CorkResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DecodedCalls) > 0 {
+		for _, e := range m.DecodedCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarVoteThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarVoteThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsOverride {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorCorkCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCorkCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *QueryCorkExecutionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorkExecutionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Executed) > 0 {
+		for _, e := range m.Executed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TimedOut) > 0 {
+		for _, e := range m.TimedOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCellarSelectorAllowlistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlists) > 0 {
+		for _, e := range m.Allowlists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPausedCellarsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedCellarsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CellarIds) > 0 {
+		for _, s := range m.CellarIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryCorkCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorkParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorParticipationRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ParticipationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorParticipationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorParticipationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for _, e := range m.Participations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGovernanceScheduledCorksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGovernanceScheduledCorksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corks) > 0 {
+		for _, e := range m.Corks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCellarABIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarABIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCellarABIsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCellarABIsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Abis) > 0 {
+		for _, e := range m.Abis {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryManagedCellarRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagedCellarResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cellar.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryManagedCellarsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryManagedCellarsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cellars) > 0 {
+		for _, e := range m.Cellars {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pagination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCorkTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CorkTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cork.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = len(m.ApprovalPercentage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoteThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCorkTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
+			}
+			m.MinBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockHeights = append(m.BlockHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockHeights) == 0 {
+					m.BlockHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockHeights = append(m.BlockHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corks = append(m.Corks, &ScheduledCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CorkResult == nil {
+				m.CorkResult = &CorkResult{}
+			}
+			if err := m.CorkResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedCall == nil {
+				m.DecodedCall = &DecodedContractCall{}
+			}
+			if err := m.DecodedCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
+			}
+			m.MinBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			m.Approval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approval |= ApprovalFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeCalls = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorkResults = append(m.CorkResults, &CorkResult{})
+			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCalls = append(m.DecodedCalls, DecodedContractCall{})
+			if err := m.DecodedCalls[len(m.DecodedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarVoteThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarVoteThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarVoteThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarVoteThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarVoteThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarVoteThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOverride", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOverride = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCorkCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCorkCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCorkCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCorkCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCorkCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCorkCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkExecutionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkExecutionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkExecutionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkExecutionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkExecutionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkExecutionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &CorkResult{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executed = append(m.Executed, &CorkResult{})
+			if err := m.Executed[len(m.Executed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedOut = append(m.TimedOut, &CorkResult{})
+			if err := m.TimedOut[len(m.TimedOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarSelectorAllowlistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarSelectorAllowlistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlists = append(m.Allowlists, CellarSelectorAllowlist{})
+			if err := m.Allowlists[len(m.Allowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarPauseStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarPauseStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCellarPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCellarPauseStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCellarPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedCellarsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCellarsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCellarsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedCellarsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCellarsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCellarsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkCommitmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, CorkCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorkParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorkParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorkParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipationRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipationRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipationRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorParticipationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,10 +10459,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorParticipationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorParticipationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1884,7 +10486,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorParticipationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1907,15 +10509,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorParticipationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorParticipationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1942,7 +10544,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Participations = append(m.Participations, ValidatorParticipationRate{})
+			if err := m.Participations[len(m.Participations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1967,7 +10570,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGovernanceScheduledCorksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,10 +10593,10 @@ func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovernanceScheduledCorksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovernanceScheduledCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2017,7 +10620,7 @@ func (m *QueryCellarIDsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGovernanceScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2040,17 +10643,17 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCellarIDsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGovernanceScheduledCorksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCellarIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGovernanceScheduledCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellarIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2060,23 +10663,25 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellarIds = append(m.CellarIds, string(dAtA[iNdEx:postIndex]))
+			m.Corks = append(m.Corks, GovernanceScheduledCork{})
+			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2099,7 +10704,7 @@ func (m *QueryCellarIDsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,12 +10727,44 @@ func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2149,7 +10786,7 @@ func (m *QueryScheduledCorksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,17 +10809,17 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2192,25 +10829,23 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2233,7 +10868,7 @@ func (m *QueryScheduledCorksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2256,10 +10891,10 @@ func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2283,7 +10918,7 @@ func (m *QueryScheduledBlockHeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCellarABIsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,88 +10941,46 @@ func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCellarABIsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledBlockHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCellarABIsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlockHeights = append(m.BlockHeights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlockHeights) == 0 {
-					m.BlockHeights = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlockHeights = append(m.BlockHeights, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeights", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abis = append(m.Abis, CellarABI{})
+			if err := m.Abis[len(m.Abis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2409,7 +11002,7 @@ func (m *QueryScheduledBlockHeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2432,17 +11025,17 @@ func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2452,11 +11045,24 @@ func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2478,7 +11084,7 @@ func (m *QueryScheduledCorksByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2501,15 +11107,15 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cellar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2536,8 +11142,7 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cellar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2562,7 +11167,7 @@ func (m *QueryScheduledCorksByBlockHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,17 +11190,17 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2605,23 +11210,24 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2644,7 +11250,7 @@ func (m *QueryScheduledCorksByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagedCellarsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,15 +11273,49 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagedCellarsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledCorksByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagedCellarsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Corks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cellars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cellars = append(m.Cellars, ManagedCellar{})
+			if err := m.Cellars[len(m.Cellars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2702,8 +11342,7 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Corks = append(m.Corks, &ScheduledCork{})
-			if err := m.Corks[len(m.Corks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2728,7 +11367,7 @@ func (m *QueryScheduledCorksByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2751,13 +11390,32 @@ func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkTallyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -2810,7 +11468,7 @@ func (m *QueryCorkResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
+func (m *CorkTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2833,15 +11491,15 @@ func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CorkTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CorkTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2868,63 +11526,198 @@ func (m *QueryCorkResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CorkResult == nil {
-				m.CorkResult = &CorkResult{}
-			}
-			if err := m.CorkResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ApprovalPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2946,7 +11739,7 @@ func (m *QueryCorkResultsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCorkTallyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2969,15 +11762,15 @@ func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCorkResultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCorkTallyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCorkResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCorkTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorkResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3004,11 +11797,30 @@ func (m *QueryCorkResultsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorkResults = append(m.CorkResults, &CorkResult{})
-			if err := m.CorkResults[len(m.CorkResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tallies = append(m.Tallies, CorkTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
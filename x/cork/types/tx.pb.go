@@ -136,243 +136,2053 @@ func (m *MsgScheduleCorkResponse) GetId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgScheduleCorkRequest)(nil), "cork.v2.MsgScheduleCorkRequest")
-	proto.RegisterType((*MsgScheduleCorkResponse)(nil), "cork.v2.MsgScheduleCorkResponse")
+// MsgCancelScheduledCorkRequest - sdk.Msg for withdrawing a validator's scheduled cork before it is tallied
+type MsgCancelScheduledCorkRequest struct {
+	// hex encoded cork ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the block height the cork was scheduled for
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// signer account address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() { proto.RegisterFile("cork/v2/tx.proto", fileDescriptor_271bdc677f232222) }
+func (m *MsgCancelScheduledCorkRequest) Reset()         { *m = MsgCancelScheduledCorkRequest{} }
+func (m *MsgCancelScheduledCorkRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledCorkRequest) ProtoMessage()    {}
+func (*MsgCancelScheduledCorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{2}
+}
+func (m *MsgCancelScheduledCorkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledCorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledCorkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledCorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledCorkRequest.Merge(m, src)
+}
+func (m *MsgCancelScheduledCorkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledCorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledCorkRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_271bdc677f232222 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x9b, 0x6e, 0x4c, 0x96, 0x4d, 0x91, 0x1c, 0x66, 0xd9, 0x21, 0x76, 0x3b, 0x55, 0x90,
-	0x06, 0xea, 0xc1, 0xbb, 0x82, 0x78, 0xd9, 0xc1, 0x7a, 0x11, 0x2f, 0x42, 0xdb, 0x3f, 0x69, 0x6c,
-	0xbb, 0xd4, 0x26, 0x2d, 0xdb, 0x5b, 0xf8, 0x58, 0x1e, 0x77, 0xf4, 0x28, 0xed, 0x8b, 0xc8, 0xe2,
-	0x14, 0x41, 0x77, 0xcc, 0xef, 0xfb, 0x91, 0x2f, 0xf9, 0xf0, 0x71, 0x2c, 0xab, 0x8c, 0x35, 0x01,
-	0xd3, 0x2b, 0xbf, 0xac, 0xa4, 0x96, 0xe4, 0x60, 0x4b, 0xfc, 0x26, 0x98, 0x92, 0xef, 0xc8, 0x00,
-	0x13, 0xce, 0x1b, 0x3c, 0x59, 0x28, 0x7e, 0x1f, 0xa7, 0x90, 0xd4, 0x39, 0x5c, 0xcb, 0x2a, 0x0b,
-	0xe1, 0xa5, 0x06, 0xa5, 0xc9, 0x0c, 0xf7, 0xb7, 0x9e, 0x83, 0x5c, 0xe4, 0x8d, 0x82, 0x43, 0x7f,
-	0x77, 0x8b, 0x6f, 0x1c, 0x13, 0x91, 0x19, 0x1e, 0x47, 0xb9, 0x8c, 0xb3, 0xa7, 0x14, 0x04, 0x4f,
-	0xb5, 0x63, 0xbb, 0xc8, 0xeb, 0x87, 0x23, 0xc3, 0x6e, 0x0d, 0x22, 0x13, 0x3c, 0x50, 0x82, 0x2f,
-	0xa1, 0x72, 0x7a, 0x2e, 0xf2, 0x86, 0xe1, 0xee, 0x34, 0x3f, 0xc3, 0x27, 0x7f, 0x7a, 0x55, 0x29,
-	0x97, 0x0a, 0xc8, 0x11, 0xb6, 0x45, 0x62, 0x6a, 0x87, 0xa1, 0x2d, 0x92, 0xe0, 0x01, 0xf7, 0x16,
-	0x8a, 0x93, 0x3b, 0x3c, 0xfe, 0xad, 0x93, 0xd3, 0x9f, 0x17, 0xfd, 0xff, 0x81, 0xa9, 0xbb, 0x5f,
-	0xf8, 0x6a, 0xba, 0xba, 0x79, 0x6b, 0x29, 0xda, 0xb4, 0x14, 0x7d, 0xb4, 0x14, 0xbd, 0x76, 0xd4,
-	0xda, 0x74, 0xd4, 0x7a, 0xef, 0xa8, 0xf5, 0x78, 0xce, 0x85, 0x4e, 0xeb, 0xc8, 0x8f, 0x65, 0xc1,
-	0x4a, 0xe0, 0x7c, 0xfd, 0xdc, 0x30, 0x25, 0x8b, 0x02, 0x72, 0x01, 0x15, 0x6b, 0x2e, 0xd9, 0xca,
-	0x8c, 0xc8, 0xf4, 0xba, 0x04, 0x15, 0x0d, 0xcc, 0x96, 0x17, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x61, 0xc9, 0x52, 0x38, 0x7c, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MsgCancelScheduledCorkRequest proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledCorkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgCancelScheduledCorkRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCancelScheduledCorkRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ScheduleCork(ctx context.Context, in *MsgScheduleCorkRequest, opts ...grpc.CallOption) (*MsgScheduleCorkResponse, error)
+type MsgCancelScheduledCorkResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelScheduledCorkResponse) Reset()         { *m = MsgCancelScheduledCorkResponse{} }
+func (m *MsgCancelScheduledCorkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledCorkResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledCorkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{3}
+}
+func (m *MsgCancelScheduledCorkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledCorkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledCorkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledCorkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledCorkResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledCorkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledCorkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledCorkResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgCancelScheduledCorkResponse proto.InternalMessageInfo
+
+// MsgSetCellarPausedRequest - sdk.Msg for the pause guardian to pause or resume a cellar
+type MsgSetCellarPausedRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	Paused   bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// signer account address, must be the pause guardian
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (c *msgClient) ScheduleCork(ctx context.Context, in *MsgScheduleCorkRequest, opts ...grpc.CallOption) (*MsgScheduleCorkResponse, error) {
-	out := new(MsgScheduleCorkResponse)
-	err := c.cc.Invoke(ctx, "/cork.v2.Msg/ScheduleCork", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetCellarPausedRequest) Reset()         { *m = MsgSetCellarPausedRequest{} }
+func (m *MsgSetCellarPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCellarPausedRequest) ProtoMessage()    {}
+func (*MsgSetCellarPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{4}
+}
+func (m *MsgSetCellarPausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCellarPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCellarPausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetCellarPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCellarPausedRequest.Merge(m, src)
+}
+func (m *MsgSetCellarPausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCellarPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCellarPausedRequest.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	ScheduleCork(context.Context, *MsgScheduleCorkRequest) (*MsgScheduleCorkResponse, error)
+var xxx_messageInfo_MsgSetCellarPausedRequest proto.InternalMessageInfo
+
+func (m *MsgSetCellarPausedRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetCellarPausedRequest) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (*UnimplementedMsgServer) ScheduleCork(ctx context.Context, req *MsgScheduleCorkRequest) (*MsgScheduleCorkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCork not implemented")
+func (m *MsgSetCellarPausedRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgSetCellarPausedResponse struct {
 }
 
-func _Msg_ScheduleCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgScheduleCorkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetCellarPausedResponse) Reset()         { *m = MsgSetCellarPausedResponse{} }
+func (m *MsgSetCellarPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCellarPausedResponse) ProtoMessage()    {}
+func (*MsgSetCellarPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{5}
+}
+func (m *MsgSetCellarPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCellarPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCellarPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ScheduleCork(ctx, in)
+}
+func (m *MsgSetCellarPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCellarPausedResponse.Merge(m, src)
+}
+func (m *MsgSetCellarPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCellarPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCellarPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCellarPausedResponse proto.InternalMessageInfo
+
+// MsgCommitCorkRequest - sdk.Msg for committing to a cork vote on a commit-reveal cellar without disclosing the cork
+type MsgCommitCorkRequest struct {
+	CellarId string `protobuf:"bytes,1,opt,name=cellar_id,json=cellarId,proto3" json:"cellar_id,omitempty"`
+	// the block height the committed cork will be scheduled for
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// hex encoded keccak256 hash of the cork ID, salt and validator address
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signer account address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCommitCorkRequest) Reset()         { *m = MsgCommitCorkRequest{} }
+func (m *MsgCommitCorkRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitCorkRequest) ProtoMessage()    {}
+func (*MsgCommitCorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{6}
+}
+func (m *MsgCommitCorkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitCorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitCorkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cork.v2.Msg/ScheduleCork",
+}
+func (m *MsgCommitCorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitCorkRequest.Merge(m, src)
+}
+func (m *MsgCommitCorkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitCorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitCorkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitCorkRequest proto.InternalMessageInfo
+
+func (m *MsgCommitCorkRequest) GetCellarId() string {
+	if m != nil {
+		return m.CellarId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ScheduleCork(ctx, req.(*MsgScheduleCorkRequest))
+	return ""
+}
+
+func (m *MsgCommitCorkRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cork.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ScheduleCork",
-			Handler:    _Msg_ScheduleCork_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cork/v2/tx.proto",
+func (m *MsgCommitCorkRequest) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
 }
 
-func (m *MsgScheduleCorkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCommitCorkRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgScheduleCorkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCommitCorkResponse struct {
 }
 
-func (m *MsgScheduleCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgCommitCorkResponse) Reset()         { *m = MsgCommitCorkResponse{} }
+func (m *MsgCommitCorkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitCorkResponse) ProtoMessage()    {}
+func (*MsgCommitCorkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{7}
+}
+func (m *MsgCommitCorkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitCorkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitCorkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgCommitCorkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitCorkResponse.Merge(m, src)
+}
+func (m *MsgCommitCorkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitCorkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitCorkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitCorkResponse proto.InternalMessageInfo
+
+// MsgRevealCorkRequest - sdk.Msg for revealing a committed cork vote, scheduling the cork if it matches the commitment
+type MsgRevealCorkRequest struct {
+	// the committed cork
+	Cork *Cork `protobuf:"bytes,1,opt,name=cork,proto3" json:"cork,omitempty"`
+	// the block height the cork was committed for
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// hex encoded salt used to create the commitment
+	Salt string `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// signer account address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRevealCorkRequest) Reset()         { *m = MsgRevealCorkRequest{} }
+func (m *MsgRevealCorkRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealCorkRequest) ProtoMessage()    {}
+func (*MsgRevealCorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{8}
+}
+func (m *MsgRevealCorkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealCorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealCorkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Cork != nil {
-		{
-			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgRevealCorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealCorkRequest.Merge(m, src)
+}
+func (m *MsgRevealCorkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealCorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealCorkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealCorkRequest proto.InternalMessageInfo
+
+func (m *MsgRevealCorkRequest) GetCork() *Cork {
+	if m != nil {
+		return m.Cork
+	}
+	return nil
+}
+
+func (m *MsgRevealCorkRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgRevealCorkRequest) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *MsgRevealCorkRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type MsgRevealCorkResponse struct {
+	// cork ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRevealCorkResponse) Reset()         { *m = MsgRevealCorkResponse{} }
+func (m *MsgRevealCorkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealCorkResponse) ProtoMessage()    {}
+func (*MsgRevealCorkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271bdc677f232222, []int{9}
+}
+func (m *MsgRevealCorkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealCorkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealCorkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealCorkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealCorkResponse.Merge(m, src)
+}
+func (m *MsgRevealCorkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealCorkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealCorkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealCorkResponse proto.InternalMessageInfo
+
+func (m *MsgRevealCorkResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgScheduleCorkRequest)(nil), "cork.v2.MsgScheduleCorkRequest")
+	proto.RegisterType((*MsgScheduleCorkResponse)(nil), "cork.v2.MsgScheduleCorkResponse")
+	proto.RegisterType((*MsgCancelScheduledCorkRequest)(nil), "cork.v2.MsgCancelScheduledCorkRequest")
+	proto.RegisterType((*MsgCancelScheduledCorkResponse)(nil), "cork.v2.MsgCancelScheduledCorkResponse")
+	proto.RegisterType((*MsgSetCellarPausedRequest)(nil), "cork.v2.MsgSetCellarPausedRequest")
+	proto.RegisterType((*MsgSetCellarPausedResponse)(nil), "cork.v2.MsgSetCellarPausedResponse")
+	proto.RegisterType((*MsgCommitCorkRequest)(nil), "cork.v2.MsgCommitCorkRequest")
+	proto.RegisterType((*MsgCommitCorkResponse)(nil), "cork.v2.MsgCommitCorkResponse")
+	proto.RegisterType((*MsgRevealCorkRequest)(nil), "cork.v2.MsgRevealCorkRequest")
+	proto.RegisterType((*MsgRevealCorkResponse)(nil), "cork.v2.MsgRevealCorkResponse")
+}
+
+func init() { proto.RegisterFile("cork/v2/tx.proto", fileDescriptor_271bdc677f232222) }
+
+var fileDescriptor_271bdc677f232222 = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x24, 0x2a, 0xcd, 0xb4, 0xfc, 0xd1, 0x42, 0xdb, 0x60, 0xe8, 0xe2, 0x1a, 0x89,
+	0x06, 0x09, 0xd9, 0x52, 0x38, 0x70, 0x27, 0x12, 0x02, 0xa1, 0x48, 0x60, 0x6e, 0xbd, 0x54, 0x8e,
+	0x3d, 0xd8, 0x6e, 0xec, 0xd8, 0x78, 0x37, 0x56, 0xfb, 0x06, 0x9c, 0x10, 0x4f, 0xc3, 0x33, 0x70,
+	0xec, 0x91, 0x23, 0x4a, 0x5e, 0x04, 0x79, 0xb3, 0x69, 0x1c, 0xd7, 0x0e, 0x48, 0xf4, 0xe6, 0xfd,
+	0x66, 0x76, 0xbe, 0x9f, 0x76, 0x3f, 0x2f, 0xdc, 0x73, 0xe2, 0x74, 0x6c, 0x66, 0x7d, 0x93, 0x9f,
+	0x1b, 0x49, 0x1a, 0xf3, 0x98, 0xdc, 0xca, 0x15, 0x23, 0xeb, 0xab, 0x64, 0x59, 0x12, 0x82, 0x28,
+	0xea, 0x19, 0xec, 0x0f, 0x99, 0xf7, 0xc9, 0xf1, 0xd1, 0x9d, 0x86, 0x38, 0x88, 0xd3, 0xb1, 0x85,
+	0x5f, 0xa6, 0xc8, 0x38, 0x39, 0x82, 0x76, 0xde, 0xd7, 0x55, 0x34, 0xa5, 0xb7, 0xd3, 0xbf, 0x6d,
+	0xc8, 0x29, 0x86, 0xe8, 0x11, 0x25, 0x72, 0x04, 0xbb, 0xa3, 0x30, 0x76, 0xc6, 0xa7, 0x3e, 0x06,
+	0x9e, 0xcf, 0xbb, 0x4d, 0x4d, 0xe9, 0xb5, 0xad, 0x1d, 0xa1, 0xbd, 0x15, 0x12, 0xd9, 0x87, 0x2d,
+	0x16, 0x78, 0x13, 0x4c, 0xbb, 0x2d, 0x4d, 0xe9, 0x75, 0x2c, 0xb9, 0xd2, 0x9f, 0xc3, 0xc1, 0x35,
+	0x5f, 0x96, 0xc4, 0x13, 0x86, 0xe4, 0x0e, 0x34, 0x03, 0x57, 0xd8, 0x76, 0xac, 0x66, 0xe0, 0xea,
+	0x67, 0x70, 0x38, 0x64, 0xde, 0xc0, 0x9e, 0x38, 0x18, 0x2e, 0x37, 0xb8, 0x45, 0xd2, 0xd2, 0x86,
+	0xff, 0xc1, 0xd2, 0x80, 0xd6, 0x79, 0x2d, 0xe8, 0x74, 0x1f, 0x1e, 0xe6, 0xe0, 0xc8, 0x07, 0x18,
+	0x86, 0x76, 0xfa, 0xc1, 0x9e, 0x32, 0x74, 0x97, 0x24, 0x8f, 0xa0, 0xe3, 0x08, 0xf9, 0xf4, 0x0a,
+	0x68, 0x7b, 0x21, 0xbc, 0x73, 0x73, 0xcf, 0x44, 0x74, 0x0b, 0xa0, 0x6d, 0x4b, 0xae, 0x6a, 0x59,
+	0x1e, 0x83, 0x5a, 0xe5, 0x24, 0x39, 0xbe, 0x29, 0xf0, 0x20, 0x47, 0x8d, 0xa3, 0x28, 0xe0, 0xc5,
+	0xd3, 0xd8, 0xc8, 0xf0, 0x0f, 0x47, 0x43, 0x01, 0x1c, 0x31, 0x34, 0xc2, 0x09, 0x97, 0x48, 0x05,
+	0xa5, 0x80, 0xdb, 0x5e, 0xc3, 0x3d, 0x80, 0xbd, 0x12, 0x8f, 0x24, 0xfd, 0xba, 0x20, 0xb5, 0x30,
+	0x43, 0x3b, 0xbc, 0xf9, 0x84, 0x11, 0x68, 0x33, 0x3b, 0x5c, 0x92, 0x8a, 0xef, 0x5a, 0xc6, 0x63,
+	0xd8, 0x2b, 0x91, 0x54, 0x67, 0xae, 0xff, 0xa3, 0x05, 0xad, 0x21, 0xf3, 0xc8, 0x47, 0xd8, 0x2d,
+	0x66, 0x94, 0x3c, 0xb9, 0x82, 0xac, 0xfe, 0x6b, 0x54, 0xad, 0xbe, 0x41, 0x5a, 0x7d, 0x86, 0xfb,
+	0x15, 0xf9, 0x22, 0xcf, 0x8a, 0x1b, 0xeb, 0xc3, 0xae, 0x1e, 0xff, 0xb5, 0x4f, 0xfa, 0x9c, 0xc0,
+	0xdd, 0x52, 0x76, 0x88, 0xbe, 0x06, 0x57, 0x19, 0x61, 0xf5, 0xe9, 0xc6, 0x1e, 0x39, 0xfb, 0x3d,
+	0xc0, 0xea, 0xa2, 0xc9, 0xe1, 0x1a, 0x52, 0x39, 0x90, 0x2a, 0xad, 0x2b, 0xaf, 0x86, 0xad, 0x6e,
+	0x64, 0x7d, 0xd8, 0xb5, 0xcc, 0xa8, 0xb4, 0xae, 0xbc, 0x18, 0xf6, 0xfa, 0xcd, 0xcf, 0x19, 0x55,
+	0x2e, 0x67, 0x54, 0xf9, 0x3d, 0xa3, 0xca, 0xf7, 0x39, 0x6d, 0x5c, 0xce, 0x69, 0xe3, 0xd7, 0x9c,
+	0x36, 0x4e, 0x5e, 0x78, 0x01, 0xf7, 0xa7, 0x23, 0xc3, 0x89, 0x23, 0x33, 0x41, 0xcf, 0xbb, 0x38,
+	0xcb, 0x4c, 0x16, 0x47, 0x11, 0x86, 0x01, 0xa6, 0x66, 0xf6, 0xca, 0x3c, 0x17, 0xef, 0xa2, 0xc9,
+	0x2f, 0x12, 0x64, 0xa3, 0x2d, 0xf1, 0x3c, 0xbe, 0xfc, 0x33, 0x00, 0x97, 0x47, 0x6f, 0x64, 0x4f,
+	0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ScheduleCork(ctx context.Context, in *MsgScheduleCorkRequest, opts ...grpc.CallOption) (*MsgScheduleCorkResponse, error)
+	CancelScheduledCork(ctx context.Context, in *MsgCancelScheduledCorkRequest, opts ...grpc.CallOption) (*MsgCancelScheduledCorkResponse, error)
+	SetCellarPaused(ctx context.Context, in *MsgSetCellarPausedRequest, opts ...grpc.CallOption) (*MsgSetCellarPausedResponse, error)
+	CommitCork(ctx context.Context, in *MsgCommitCorkRequest, opts ...grpc.CallOption) (*MsgCommitCorkResponse, error)
+	RevealCork(ctx context.Context, in *MsgRevealCorkRequest, opts ...grpc.CallOption) (*MsgRevealCorkResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleCork(ctx context.Context, in *MsgScheduleCorkRequest, opts ...grpc.CallOption) (*MsgScheduleCorkResponse, error) {
+	out := new(MsgScheduleCorkResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Msg/ScheduleCork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledCork(ctx context.Context, in *MsgCancelScheduledCorkRequest, opts ...grpc.CallOption) (*MsgCancelScheduledCorkResponse, error) {
+	out := new(MsgCancelScheduledCorkResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Msg/CancelScheduledCork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCellarPaused(ctx context.Context, in *MsgSetCellarPausedRequest, opts ...grpc.CallOption) (*MsgSetCellarPausedResponse, error) {
+	out := new(MsgSetCellarPausedResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Msg/SetCellarPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitCork(ctx context.Context, in *MsgCommitCorkRequest, opts ...grpc.CallOption) (*MsgCommitCorkResponse, error) {
+	out := new(MsgCommitCorkResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Msg/CommitCork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealCork(ctx context.Context, in *MsgRevealCorkRequest, opts ...grpc.CallOption) (*MsgRevealCorkResponse, error) {
+	out := new(MsgRevealCorkResponse)
+	err := c.cc.Invoke(ctx, "/cork.v2.Msg/RevealCork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ScheduleCork(context.Context, *MsgScheduleCorkRequest) (*MsgScheduleCorkResponse, error)
+	CancelScheduledCork(context.Context, *MsgCancelScheduledCorkRequest) (*MsgCancelScheduledCorkResponse, error)
+	SetCellarPaused(context.Context, *MsgSetCellarPausedRequest) (*MsgSetCellarPausedResponse, error)
+	CommitCork(context.Context, *MsgCommitCorkRequest) (*MsgCommitCorkResponse, error)
+	RevealCork(context.Context, *MsgRevealCorkRequest) (*MsgRevealCorkResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ScheduleCork(ctx context.Context, req *MsgScheduleCorkRequest) (*MsgScheduleCorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCork not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledCork(ctx context.Context, req *MsgCancelScheduledCorkRequest) (*MsgCancelScheduledCorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledCork not implemented")
+}
+func (*UnimplementedMsgServer) SetCellarPaused(ctx context.Context, req *MsgSetCellarPausedRequest) (*MsgSetCellarPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCellarPaused not implemented")
+}
+func (*UnimplementedMsgServer) CommitCork(ctx context.Context, req *MsgCommitCorkRequest) (*MsgCommitCorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitCork not implemented")
+}
+func (*UnimplementedMsgServer) RevealCork(ctx context.Context, req *MsgRevealCorkRequest) (*MsgRevealCorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealCork not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ScheduleCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Msg/ScheduleCork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCork(ctx, req.(*MsgScheduleCorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledCorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledCork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Msg/CancelScheduledCork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledCork(ctx, req.(*MsgCancelScheduledCorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCellarPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCellarPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCellarPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Msg/SetCellarPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCellarPaused(ctx, req.(*MsgSetCellarPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitCorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitCork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Msg/CommitCork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitCork(ctx, req.(*MsgCommitCorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealCork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealCorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealCork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cork.v2.Msg/RevealCork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealCork(ctx, req.(*MsgRevealCorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cork.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleCork",
+			Handler:    _Msg_ScheduleCork_Handler,
+		},
+		{
+			MethodName: "CancelScheduledCork",
+			Handler:    _Msg_CancelScheduledCork_Handler,
+		},
+		{
+			MethodName: "SetCellarPaused",
+			Handler:    _Msg_SetCellarPaused_Handler,
+		},
+		{
+			MethodName: "CommitCork",
+			Handler:    _Msg_CommitCork_Handler,
+		},
+		{
+			MethodName: "RevealCork",
+			Handler:    _Msg_RevealCork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cork/v2/tx.proto",
+}
+
+func (m *MsgScheduleCorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleCorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledCorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledCorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledCorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledCorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCellarPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCellarPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCellarPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCellarPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCellarPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCellarPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitCorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitCorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellarId) > 0 {
+		i -= len(m.CellarId)
+		copy(dAtA[i:], m.CellarId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CellarId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitCorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitCorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealCorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealCorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealCorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cork != nil {
+		{
+			size, err := m.Cork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealCorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealCorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgScheduleCorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleCorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledCorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledCorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCellarPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetCellarPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitCorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellarId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitCorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealCorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cork != nil {
+		l = m.Cork.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealCorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgScheduleCorkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleCorkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cork == nil {
+				m.Cork = &Cork{}
+			}
+			if err := m.Cork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleCorkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleCorkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledCorkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledCorkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledCorkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledCorkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCellarPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCellarPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCellarPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCellarPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCellarPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCellarPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitCorkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitCorkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellarId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellarId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgScheduleCorkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgScheduleCorkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgScheduleCorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgScheduleCorkRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Cork != nil {
-		l = m.Cork.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.BlockHeight))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCommitCorkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitCorkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgScheduleCorkResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgScheduleCorkRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealCorkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -395,10 +2205,10 @@ func (m *MsgScheduleCorkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleCorkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealCorkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealCorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -457,6 +2267,38 @@ func (m *MsgScheduleCorkRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -509,7 +2351,7 @@ func (m *MsgScheduleCorkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleCorkResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealCorkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -532,10 +2374,10 @@ func (m *MsgScheduleCorkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleCorkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealCorkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealCorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
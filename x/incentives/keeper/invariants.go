@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/sommelier/v7/x/incentives/types"
+)
+
+// RegisterInvariants registers all incentives invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "params", ParamsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the incentives module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ParamsInvariant(k)(ctx)
+	}
+}
+
+// ParamsInvariant checks that the stored parameters are valid, so that the per block distribution can be paid out
+func ParamsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParamSet(ctx)
+		if err := params.ValidateBasic(); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "params", fmt.Sprintf("invalid params: %s\n", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "params", "params are valid\n"), false
+	}
+}
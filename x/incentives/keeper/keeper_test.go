@@ -100,3 +100,12 @@ func (suite *KeeperTestSuite) TestGetAPY() {
 	expected := sdk.NewDecFromInt(distributionPerBlock.Amount.Mul(sdk.NewInt(int64(blocksPerYear)))).Quo(sdk.NewDecFromInt(stakingTotalSupply).Mul(bondedRatio))
 	require.Equal(expected, incentivesKeeper.GetAPY(ctx))
 }
+
+func (suite *KeeperTestSuite) TestParamsInvariant() {
+	ctx, incentivesKeeper := suite.ctx, suite.incentivesKeeper
+	require := suite.Require()
+
+	incentivesKeeper.SetParams(ctx, incentivesTypes.DefaultParams())
+	_, broken := ParamsInvariant(incentivesKeeper)(ctx)
+	require.False(broken)
+}
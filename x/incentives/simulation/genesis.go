@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/peggyjv/sommelier/v7/app/params"
+	"github.com/peggyjv/sommelier/v7/x/incentives/types"
+)
+
+// Simulation parameter constants
+const (
+	DistributionPerBlock   = "distribution_per_block"
+	IncentivesCutoffHeight = "incentives_cutoff_height"
+)
+
+// GenDistributionPerBlock randomized DistributionPerBlock
+func GenDistributionPerBlock(r *rand.Rand) sdk.Coin {
+	return sdk.NewInt64Coin(params.BaseCoinUnit, int64(r.Intn(1000)))
+}
+
+// GenIncentivesCutoffHeight randomized IncentivesCutoffHeight
+func GenIncentivesCutoffHeight(r *rand.Rand) uint64 {
+	return uint64(r.Intn(500))
+}
+
+// RandomizedGenState generates a random GenesisState for incentives
+func RandomizedGenState(simState *module.SimulationState) {
+	var distributionPerBlock sdk.Coin
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionPerBlock, &distributionPerBlock, simState.Rand,
+		func(r *rand.Rand) { distributionPerBlock = GenDistributionPerBlock(r) },
+	)
+
+	var incentivesCutoffHeight uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IncentivesCutoffHeight, &incentivesCutoffHeight, simState.Rand,
+		func(r *rand.Rand) { incentivesCutoffHeight = GenIncentivesCutoffHeight(r) },
+	)
+
+	incentivesGenesis := types.DefaultGenesisState()
+	incentivesGenesis.Params.DistributionPerBlock = distributionPerBlock
+	incentivesGenesis.Params.IncentivesCutoffHeight = incentivesCutoffHeight
+
+	bz, err := json.MarshalIndent(&incentivesGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated incentives parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&incentivesGenesis)
+}
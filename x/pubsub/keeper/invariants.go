@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/types"
+)
+
+// RegisterInvariants registers all pubsub invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "publisher-intents", PublisherIntentsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "subscriber-intents", SubscriberIntentsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the pubsub module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PublisherIntentsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return SubscriberIntentsInvariant(k)(ctx)
+	}
+}
+
+// PublisherIntentsInvariant checks that every publisher intent references an existing publisher
+func PublisherIntentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IteratePublisherIntents(ctx, func(publisherIntent types.PublisherIntent) (stop bool) {
+			if _, found := k.GetPublisher(ctx, publisherIntent.PublisherDomain); !found {
+				broken = true
+				msg += fmt.Sprintf("\tpublisher intent for subscription %s references missing publisher %s\n",
+					publisherIntent.SubscriptionId, publisherIntent.PublisherDomain)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "publisher intents",
+			fmt.Sprintf("publisher intents with missing references:\n%s", msg)), broken
+	}
+}
+
+// SubscriberIntentsInvariant checks that every subscriber intent references an existing publisher intent and
+// subscriber
+func SubscriberIntentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IterateSubscriberIntents(ctx, func(_ sdk.AccAddress, subscriberIntent types.SubscriberIntent) (stop bool) {
+			if _, found := k.GetPublisherIntent(ctx, subscriberIntent.SubscriptionId, subscriberIntent.PublisherDomain); !found {
+				broken = true
+				msg += fmt.Sprintf("\tsubscriber intent of %s for subscription %s references missing publisher intent from %s\n",
+					subscriberIntent.SubscriberAddress, subscriberIntent.SubscriptionId, subscriberIntent.PublisherDomain)
+			}
+			subscriberAddress, err := sdk.AccAddressFromBech32(subscriberIntent.SubscriberAddress)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tsubscriber intent for subscription %s has invalid subscriber address %s\n",
+					subscriberIntent.SubscriptionId, subscriberIntent.SubscriberAddress)
+			} else if _, found := k.GetSubscriber(ctx, subscriberAddress); !found {
+				broken = true
+				msg += fmt.Sprintf("\tsubscriber intent for subscription %s references missing subscriber %s\n",
+					subscriberIntent.SubscriptionId, subscriberIntent.SubscriberAddress)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "subscriber intents",
+			fmt.Sprintf("subscriber intents with missing references:\n%s", msg)), broken
+	}
+}
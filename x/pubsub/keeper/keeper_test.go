@@ -1,3 +1,64 @@
 package keeper
 
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	moduletestutil "github.com/peggyjv/sommelier/v7/testutil"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/types"
+	"github.com/stretchr/testify/require"
+)
+
 // TODO(bolten): keeper testing here
+
+func TestInvariants(t *testing.T) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	params := paramskeeper.NewKeeper(encCfg.Codec, codec.NewLegacyAmino(), key, tkey)
+	params.Subspace(types.ModuleName)
+	subSpace, found := params.GetSubspace(types.ModuleName)
+	require.True(t, found)
+	k := NewKeeper(encCfg.Codec, key, subSpace, nil, nil)
+
+	subscriberAddress := sdk.AccAddress("subscriber__________")
+	publisher := types.Publisher{Address: sdk.AccAddress("publisher___________").String(), Domain: "example.com"}
+	publisherIntent := types.PublisherIntent{SubscriptionId: "subscription", PublisherDomain: publisher.Domain}
+	subscriberIntent := types.SubscriberIntent{
+		SubscriptionId:    publisherIntent.SubscriptionId,
+		SubscriberAddress: subscriberAddress.String(),
+		PublisherDomain:   publisher.Domain,
+	}
+
+	k.SetPublisherIntent(ctx, publisherIntent)
+	k.SetSubscriberIntent(ctx, subscriberAddress, subscriberIntent)
+
+	msg, broken := PublisherIntentsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "references missing publisher example.com")
+	msg, broken = SubscriberIntentsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "references missing subscriber")
+
+	k.SetPublisher(ctx, publisher)
+	k.SetSubscriber(ctx, subscriberAddress, types.Subscriber{Address: subscriberAddress.String()})
+	_, broken = AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// deleting the publisher intent directly from the store leaves the subscriber intent dangling
+	ctx.KVStore(key).Delete(types.GetPublisherIntentByPublisherDomainKey(publisher.Domain, publisherIntent.SubscriptionId))
+	msg, broken = SubscriberIntentsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "references missing publisher intent")
+
+	// the keeper removes subscriber intents along with their publisher intent
+	k.SetPublisherIntent(ctx, publisherIntent)
+	k.DeletePublisherIntent(ctx, publisherIntent.SubscriptionId, publisher.Domain)
+	_, broken = AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
@@ -19,6 +19,7 @@ import (
 	sim "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/peggyjv/sommelier/v7/x/pubsub/client/cli"
 	"github.com/peggyjv/sommelier/v7/x/pubsub/keeper"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/simulation"
 	"github.com/peggyjv/sommelier/v7/x/pubsub/types"
 )
 
@@ -87,6 +88,8 @@ type AppModule struct {
 	AppModuleBasic
 	cdc           codec.Codec
 	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 	gravityKeeper types.GravityKeeper
 }
@@ -94,6 +97,8 @@ type AppModule struct {
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	gravityKeeper types.GravityKeeper,
 ) AppModule {
@@ -101,6 +106,8 @@ func NewAppModule(
 		AppModuleBasic: AppModuleBasic{},
 		cdc:            cdc,
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 		stakingKeeper:  stakingKeeper,
 		gravityKeeper:  gravityKeeper,
 	}
@@ -109,8 +116,10 @@ func NewAppModule(
 // Name returns the module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the pubsub module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the module's message routing key.
 func (am AppModule) Route() sdk.Route {
@@ -129,7 +138,8 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) WeightedOperations(simState module.SimulationState) []sim.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper,
+		am.stakingKeeper, am.gravityKeeper)
 }
 
 // RegisterServices registers module services.
@@ -168,8 +178,10 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the module.
-func (AppModule) GenerateGenesisState(simState *module.SimulationState) {}
+// GenerateGenesisState creates a randomized GenState of the pubsub module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
 
 // ProposalContents returns all the content functions used to
 // simulate governance proposals.
@@ -0,0 +1,89 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/types"
+)
+
+// CaCert is the self-signed ECDSA CA certificate used for simulated publishers and subscribers
+const CaCert = `-----BEGIN CERTIFICATE-----
+MIICHTCCAaKgAwIBAgIUTYD5x0zSg1rOztoJK8OEgWDl+yYwCgYIKoZIzj0EAwMw
+RTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUxITAfBgNVBAoMGElu
+dGVybmV0IFdpZGdpdHMgUHR5IEx0ZDAeFw0yMjAxMDUwNzIwMjlaFw0yNDAxMDUw
+NzIwMjlaMEUxCzAJBgNVBAYTAkFVMRMwEQYDVQQIDApTb21lLVN0YXRlMSEwHwYD
+VQQKDBhJbnRlcm5ldCBXaWRnaXRzIFB0eSBMdGQwdjAQBgcqhkjOPQIBBgUrgQQA
+IgNiAATi4OkAJaqyWwS1F6mBCBftwF/K02Zl07pg2C/WJxZEaGI/cRVTELt4Qsy2
+7SiGcJLIIsTQXfdNkyRue20/J/SpUDPMVbWNCozC2bS4DWd1n9uHlSMT4h7gZqxf
+SkkkecCjUzBRMB0GA1UdDgQWBBSngShmDy8kt2azMqFGD1ObYaXT0DAfBgNVHSME
+GDAWgBSngShmDy8kt2azMqFGD1ObYaXT0DAPBgNVHRMBAf8EBTADAQH/MAoGCCqG
+SM49BAMDA2kAMGYCMQCel/W4B/LB75j0WHEHrKSoED17D4w+OrXlK6wnpVRSyOmZ
+A0B4pBO4uh3ldwCZnBACMQC0whN1TI8a9Ku90nfvZ+D2kKMg/p39SmCDadQJNzwc
+kp4YI2VJp0zYzt/xLiBRbZc=
+-----END CERTIFICATE-----
+`
+
+// RandomDomain returns a random publisher domain
+func RandomDomain(r *rand.Rand) string {
+	return fmt.Sprintf("%s.example.com", strings.ToLower(simtypes.RandStringOfLength(r, 10)))
+}
+
+// RandomPublisherIntent returns a random publisher intent for the given domain that any subscriber may subscribe to
+func RandomPublisherIntent(r *rand.Rand, publisherDomain string) types.PublisherIntent {
+	subscriptionID := strings.ToLower(simtypes.RandStringOfLength(r, 10))
+
+	return types.PublisherIntent{
+		SubscriptionId:     subscriptionID,
+		PublisherDomain:    publisherDomain,
+		Method:             types.PublishMethod_PULL,
+		PullUrl:            fmt.Sprintf("https://%s/%s", publisherDomain, subscriptionID),
+		AllowedSubscribers: types.AllowedSubscribers_ANY,
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for pubsub, where a few of the simulation accounts are
+// publishers and subscribers with intents between them
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	pubsubGenesis := types.DefaultGenesisState()
+
+	for _, account := range simState.Accounts {
+		switch r.Intn(10) {
+		case 0:
+			publisher := types.Publisher{
+				Address: account.Address.String(),
+				Domain:  RandomDomain(r),
+				CaCert:  CaCert,
+			}
+			pubsubGenesis.Publishers = append(pubsubGenesis.Publishers, &publisher)
+
+			for i, n := 0, r.Intn(3); i < n; i++ {
+				publisherIntent := RandomPublisherIntent(r, publisher.Domain)
+				pubsubGenesis.PublisherIntents = append(pubsubGenesis.PublisherIntents, &publisherIntent)
+			}
+		case 1, 2:
+			subscriber := types.Subscriber{Address: account.Address.String()}
+			pubsubGenesis.Subscribers = append(pubsubGenesis.Subscribers, &subscriber)
+		}
+	}
+
+	for _, subscriber := range pubsubGenesis.Subscribers {
+		for _, publisherIntent := range pubsubGenesis.PublisherIntents {
+			if r.Intn(2) == 0 {
+				pubsubGenesis.SubscriberIntents = append(pubsubGenesis.SubscriberIntents, &types.SubscriberIntent{
+					SubscriptionId:    publisherIntent.SubscriptionId,
+					SubscriberAddress: subscriber.Address,
+					PublisherDomain:   publisherIntent.PublisherDomain,
+				})
+			}
+		}
+	}
+
+	fmt.Printf("Selected randomly generated pubsub genesis with %d publishers and %d subscribers\n",
+		len(pubsubGenesis.Publishers), len(pubsubGenesis.Subscribers))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&pubsubGenesis)
+}
@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/keeper"
+	"github.com/peggyjv/sommelier/v7/x/pubsub/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddPublisherIntent     = "op_weight_msg_add_publisher_intent"     //nolint:gosec
+	OpWeightMsgRemovePublisherIntent  = "op_weight_msg_remove_publisher_intent"  //nolint:gosec
+	OpWeightMsgAddSubscriber          = "op_weight_msg_add_subscriber"           //nolint:gosec
+	OpWeightMsgRemoveSubscriber       = "op_weight_msg_remove_subscriber"        //nolint:gosec
+	OpWeightMsgAddSubscriberIntent    = "op_weight_msg_add_subscriber_intent"    //nolint:gosec
+	OpWeightMsgRemoveSubscriberIntent = "op_weight_msg_remove_subscriber_intent" //nolint:gosec
+
+	DefaultWeightMsgAddPublisherIntent     = 20
+	DefaultWeightMsgRemovePublisherIntent  = 10
+	DefaultWeightMsgAddSubscriber          = 20
+	DefaultWeightMsgRemoveSubscriber       = 5
+	DefaultWeightMsgAddSubscriberIntent    = 30
+	DefaultWeightMsgRemoveSubscriberIntent = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, sk types.StakingKeeper, gk types.GravityKeeper) simulation.WeightedOperations {
+	var (
+		weightMsgAddPublisherIntent     int
+		weightMsgRemovePublisherIntent  int
+		weightMsgAddSubscriber          int
+		weightMsgRemoveSubscriber       int
+		weightMsgAddSubscriberIntent    int
+		weightMsgRemoveSubscriberIntent int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddPublisherIntent, &weightMsgAddPublisherIntent, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddPublisherIntent = DefaultWeightMsgAddPublisherIntent
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemovePublisherIntent, &weightMsgRemovePublisherIntent, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemovePublisherIntent = DefaultWeightMsgRemovePublisherIntent
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddSubscriber, &weightMsgAddSubscriber, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddSubscriber = DefaultWeightMsgAddSubscriber
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveSubscriber, &weightMsgRemoveSubscriber, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveSubscriber = DefaultWeightMsgRemoveSubscriber
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddSubscriberIntent, &weightMsgAddSubscriberIntent, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddSubscriberIntent = DefaultWeightMsgAddSubscriberIntent
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveSubscriberIntent, &weightMsgRemoveSubscriberIntent, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveSubscriberIntent = DefaultWeightMsgRemoveSubscriberIntent
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAddPublisherIntent,
+			SimulateMsgAddPublisherIntent(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemovePublisherIntent,
+			SimulateMsgRemovePublisherIntent(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddSubscriber,
+			SimulateMsgAddSubscriber(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveSubscriber,
+			SimulateMsgRemoveSubscriber(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddSubscriberIntent,
+			SimulateMsgAddSubscriberIntent(ak, bk, k, sk, gk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveSubscriberIntent,
+			SimulateMsgRemoveSubscriberIntent(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAddPublisherIntent generates a MsgAddPublisherIntentRequest with a new random intent from a random
+// publisher
+func SimulateMsgAddPublisherIntent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		publishers := k.GetPublishers(ctx)
+		if len(publishers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPublisherIntentRequest, "no publishers"), nil, nil
+		}
+
+		publisher := publishers[r.Intn(len(publishers))]
+		simAccount, found := findAccount(accs, publisher.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPublisherIntentRequest, "publisher account not found"), nil, nil
+		}
+
+		publisherIntent := RandomPublisherIntent(r, publisher.Domain)
+		if _, found := k.GetPublisherIntent(ctx, publisherIntent.SubscriptionId, publisherIntent.PublisherDomain); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPublisherIntentRequest, "publisher intent already exists"), nil, nil
+		}
+
+		msg, err := types.NewMsgAddPublisherIntentRequest(publisherIntent, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPublisherIntentRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgRemovePublisherIntent generates a MsgRemovePublisherIntentRequest for a random publisher intent
+func SimulateMsgRemovePublisherIntent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		publisherIntents := k.GetPublisherIntents(ctx)
+		if len(publisherIntents) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemovePublisherIntentRequest, "no publisher intents"), nil, nil
+		}
+
+		publisherIntent := publisherIntents[r.Intn(len(publisherIntents))]
+		publisher, found := k.GetPublisher(ctx, publisherIntent.PublisherDomain)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemovePublisherIntentRequest, "publisher not found"), nil, nil
+		}
+
+		simAccount, found := findAccount(accs, publisher.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemovePublisherIntentRequest, "publisher account not found"), nil, nil
+		}
+
+		msg, err := types.NewMsgRemovePublisherIntentRequest(publisherIntent.SubscriptionId, publisherIntent.PublisherDomain, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemovePublisherIntentRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgAddSubscriber generates a MsgAddSubscriberRequest registering a random account as a subscriber
+func SimulateMsgAddSubscriber(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg, err := types.NewMsgAddSubscriberRequest(types.Subscriber{Address: simAccount.Address.String()}, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgRemoveSubscriber generates a MsgRemoveSubscriberRequest for a random subscriber
+func SimulateMsgRemoveSubscriber(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		subscribers := k.GetSubscribers(ctx)
+		if len(subscribers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberRequest, "no subscribers"), nil, nil
+		}
+
+		subscriber := subscribers[r.Intn(len(subscribers))]
+		simAccount, found := findAccount(accs, subscriber.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberRequest, "subscriber account not found"), nil, nil
+		}
+
+		msg, err := types.NewMsgRemoveSubscriberRequest(subscriber.Address, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgAddSubscriberIntent generates a MsgAddSubscriberIntentRequest from a random subscriber to a random
+// publisher intent the subscriber is allowed to subscribe to
+func SimulateMsgAddSubscriberIntent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper, gk types.GravityKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		subscribers := k.GetSubscribers(ctx)
+		if len(subscribers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "no subscribers"), nil, nil
+		}
+
+		publisherIntents := k.GetPublisherIntents(ctx)
+		if len(publisherIntents) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "no publisher intents"), nil, nil
+		}
+
+		subscriber := subscribers[r.Intn(len(subscribers))]
+		simAccount, found := findAccount(accs, subscriber.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "subscriber account not found"), nil, nil
+		}
+
+		publisherIntent := publisherIntents[r.Intn(len(publisherIntents))]
+		if _, found := k.GetSubscriberIntent(ctx, publisherIntent.SubscriptionId, simAccount.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "subscriber intent already exists"), nil, nil
+		}
+
+		if !canSubscribe(ctx, sk, gk, *subscriber, *publisherIntent, simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "subscriber not allowed by publisher intent"), nil, nil
+		}
+
+		subscriberIntent := types.SubscriberIntent{
+			SubscriptionId:    publisherIntent.SubscriptionId,
+			SubscriberAddress: subscriber.Address,
+			PublisherDomain:   publisherIntent.PublisherDomain,
+		}
+		msg, err := types.NewMsgAddSubscriberIntentRequest(subscriberIntent, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSubscriberIntentRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgRemoveSubscriberIntent generates a MsgRemoveSubscriberIntentRequest for a random subscriber intent
+func SimulateMsgRemoveSubscriberIntent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		subscriberIntents := k.GetSubscriberIntents(ctx)
+		if len(subscriberIntents) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberIntentRequest, "no subscriber intents"), nil, nil
+		}
+
+		subscriberIntent := subscriberIntents[r.Intn(len(subscriberIntents))]
+		simAccount, found := findAccount(accs, subscriberIntent.SubscriberAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberIntentRequest, "subscriber account not found"), nil, nil
+		}
+
+		if _, found := k.GetSubscriber(ctx, simAccount.Address); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberIntentRequest, "subscriber not found"), nil, nil
+		}
+
+		msg, err := types.NewMsgRemoveSubscriberIntentRequest(subscriberIntent.SubscriptionId, subscriberIntent.SubscriberAddress, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveSubscriberIntentRequest, "unable to build msg"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// canSubscribe mirrors the msg server's checks of a publisher intent's method and allowed subscribers
+func canSubscribe(ctx sdk.Context, sk types.StakingKeeper, gk types.GravityKeeper, subscriber types.Subscriber,
+	publisherIntent types.PublisherIntent, signer sdk.AccAddress) bool {
+	if publisherIntent.Method == types.PublishMethod_PUSH && subscriber.PushUrl == "" {
+		return false
+	}
+
+	switch publisherIntent.AllowedSubscribers {
+	case types.AllowedSubscribers_VALIDATORS:
+		validator := gk.GetOrchestratorValidatorAddress(ctx, signer)
+		if validator == nil {
+			validator = sdk.ValAddress(signer)
+		}
+
+		return sk.Validator(ctx, validator) != nil
+	case types.AllowedSubscribers_LIST:
+		for _, allowedAddress := range publisherIntent.AllowedAddresses {
+			if allowedAddress == signer.String() {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, addr)
+}
+
+func deliverTx(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}